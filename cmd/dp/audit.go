@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	dpoutput "github.com/pankaj-dahiya-devops/Devops-proxy/internal/output"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// renderOptions carries the presentation flags the audit render functions
+// share. The zero value renders the plain default table with the banner
+// shown; --summary output also needs rankBy.
+type renderOptions struct {
+	summary   bool
+	rankBy    string
+	topN      int
+	colored   bool
+	quiet     bool
+	columns   []string // parsed --columns; nil keeps the default layout
+	histogram bool
+	currency  dpoutput.Currency
+	// compact writes JSON unindented (dp aws audit --all --compact).
+	compact bool
+	// findingsOnly writes only the findings array in JSON mode
+	// (dp aws audit --all --output-findings-only).
+	findingsOnly bool
+	// allProfiles adds the PROFILE column to AWS tables.
+	allProfiles bool
+	// showRiskChains groups Kubernetes findings by risk chain.
+	showRiskChains bool
+}
+
+// auditOptions holds the flags every audit command registers through
+// addAuditFlags. validate checks them and fills formats and render.columns.
+type auditOptions struct {
+	outputFmt      string
+	outputTemplate string
+	filePath       string
+	policyPath     string
+	signKey        string
+	statePath      string
+	maxFindingAge  int
+	columnNames    []string
+	sortBy         string
+	failOnErrors   bool
+	timings        bool
+	render         renderOptions
+
+	// formats is the parsed --output list; formats[0] is written to stdout.
+	formats []string
+}
+
+// addAuditFlags registers the flags shared by every audit command on cmd.
+// banner names the table banner line --quiet suppresses.
+func addAuditFlags(cmd *cobra.Command, o *auditOptions, banner string) {
+	cmd.Flags().StringVar(&o.outputFmt, "output", "table", outputFlagUsage)
+	cmd.Flags().StringVar(&o.outputTemplate, "output-template", "", "Path to a Go text/template rendered against the audit report (overrides --output)")
+	cmd.Flags().BoolVar(&o.render.summary, "summary", false, "Print compact summary: totals, severity breakdown, top findings (see --top)")
+	cmd.Flags().StringVar(&o.render.rankBy, "rank-by", rankBySavings, "Top Findings ranking in --summary output: savings, severity, or risk")
+	cmd.Flags().IntVar(&o.render.topN, "top", defaultTopFindings, "Number of findings listed in the --summary Top Findings table (values below 1 use the default)")
+	cmd.Flags().StringVar(&o.filePath, "file", "", "Write full JSON report to this file path (in addition to stdout output)")
+	cmd.Flags().StringVar(&o.policyPath, "policy", "", "Path to dp.yaml policy file (auto-detected if omitted and ./dp.yaml exists)")
+	cmd.Flags().BoolVar(&o.render.colored, "color", false, "Enable colored severity output in table format (not CI-safe)")
+	cmd.Flags().BoolVar(&o.render.quiet, "quiet", false, fmt.Sprintf("Suppress the %s banner line in table output (no effect on JSON)", banner))
+	cmd.Flags().StringVar(&o.signKey, "sign-key", "", "Path to an ed25519 private key (PKCS#8 PEM); signs the report into its signature field and writes <file>.sig alongside --file")
+	addStateFlags(cmd, &o.statePath, &o.maxFindingAge)
+	addColumnsFlag(cmd, &o.columnNames)
+	addHistogramFlag(cmd, &o.render.histogram)
+	addSortFlag(cmd, &o.sortBy)
+	cmd.Flags().BoolVar(&o.timings, "timings", false, "Print per-stage timing breakdown to stderr and add timings to report metadata")
+	cmd.Flags().BoolVar(&o.failOnErrors, "fail-on-errors", false, "Exit with code 3 when a collector or rule failed (report errors is non-empty) and the audit did not fail otherwise")
+}
+
+// validate checks the shared flags, parses --output into formats (setting
+// outputFmt to the stdout format) and --columns into render.columns.
+func (o *auditOptions) validate() error {
+	if err := validateRankBy(o.render.rankBy); err != nil {
+		return err
+	}
+	if err := validateSort(o.sortBy); err != nil {
+		return err
+	}
+	formats, err := parseOutputFormats(o.outputFmt, o.filePath)
+	if err != nil {
+		return err
+	}
+	o.formats = formats
+	o.outputFmt = formats[0]
+	if err := validateStateFlags(o.statePath, o.maxFindingAge); err != nil {
+		return err
+	}
+	columns, err := dpoutput.ParseTableColumns(o.columnNames)
+	if err != nil {
+		return err
+	}
+	o.render.columns = columns
+	return nil
+}
+
+// postAudit holds the steps of finishAudit that differ between commands.
+type postAudit struct {
+	// minConfidence drops less confident findings before anything else; the
+	// zero value keeps every finding.
+	minConfidence models.Confidence
+	// settle records report.Summary.ExitCode once the findings are final and
+	// returns the status the command ends with.
+	settle func(report *models.AuditReport) auditExitStatus
+	// detailedExit ends the command the way dp aws audit --all does: the
+	// status goes to stderr (as JSON in machine-readable modes) and the
+	// process exits with status.ExitCode. Otherwise policy enforcement is
+	// returned as errPolicyEnforced (see auditExitCode).
+	detailedExit bool
+	// explain, when non-nil, replaces the normal output and the exit code
+	// handling (dp kubernetes audit --explain-*).
+	explain func(w io.Writer, report *models.AuditReport) error
+	// render writes report in one --output format to w.
+	render func(w io.Writer, report *models.AuditReport, format string, ro renderOptions) error
+}
+
+// domainExitStatus records the exit code of a single-domain audit in report
+// (see setExitCode) and returns the matching status. PolicyDomains names
+// domain when policyFailed.
+func domainExitStatus(report *models.AuditReport, domain string, policyFailed bool) auditExitStatus {
+	setExitCode(report, policyFailed)
+	status := auditExitStatus{ExitCode: report.Summary.ExitCode}
+	if policyFailed {
+		status.PolicyDomains = []string{domain}
+	}
+	return status
+}
+
+// finishAudit runs the steps every audit command shares once its engine has
+// produced report: confidence filtering, finding state, sorting, the exit
+// code, signing, --file, rendering each --output format, and exiting.
+func finishAudit(cmd *cobra.Command, o *auditOptions, report *models.AuditReport, policyCfg *policy.PolicyConfig, p postAudit) error {
+	if p.minConfidence != "" {
+		engine.FilterByConfidence(report, p.minConfidence, policyCfg)
+	}
+	if err := applyFindingState(report, o.statePath, o.maxFindingAge, policyCfg); err != nil {
+		return err
+	}
+	sortReportFindings(report, o.sortBy)
+	status := p.settle(report)
+	if o.failOnErrors && p.explain == nil {
+		status = failOnAuditErrors(report, status)
+	}
+	if err := signReportWithKey(report, o.signKey); err != nil {
+		return err
+	}
+
+	if o.filePath != "" {
+		if err := writeReportToFile(o.filePath, report, o.render.compact); err != nil {
+			return err
+		}
+	}
+
+	stdout := cmd.OutOrStdout()
+	if p.explain != nil {
+		return p.explain(stdout, report)
+	}
+	render := func(w io.Writer, format string, toStdout bool) error {
+		if o.outputTemplate != "" && toStdout {
+			return dpoutput.RenderTemplate(w, report, o.outputTemplate)
+		}
+		// Derived --output files get plain, complete output.
+		ro := o.render
+		ro.colored = ro.colored && toStdout
+		ro.findingsOnly = ro.findingsOnly && toStdout
+		return p.render(w, report, format, ro)
+	}
+	if err := renderFormats(stdout, o.filePath, o.formats, render); err != nil {
+		return err
+	}
+	if o.timings {
+		printTimings(os.Stderr, report)
+	}
+
+	if p.detailedExit {
+		if status.ExitCode == 0 {
+			return nil
+		}
+		machineReadable := o.outputFmt == "json" || o.outputTemplate != ""
+		if err := writeAuditExitStatus(os.Stderr, status, machineReadable); err != nil {
+			return err
+		}
+		if !noExitCode(cmd) {
+			os.Exit(status.ExitCode)
+		}
+		return nil
+	}
+	code, err := auditExitCode(os.Stderr, report, len(status.PolicyDomains) > 0, noExitCode(cmd), o.outputFmt)
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		os.Exit(code)
+	}
+	return nil
+}
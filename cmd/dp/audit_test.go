@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// newTestAuditCmd returns a command carrying the shared audit flags, parsed
+// from args, with stdout captured in the returned buffer.
+func newTestAuditCmd(t *testing.T, args ...string) (*cobra.Command, *auditOptions, *bytes.Buffer) {
+	t.Helper()
+	var opts auditOptions
+	cmd := &cobra.Command{Use: "audit"}
+	addAuditFlags(cmd, &opts, "Profile:")
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("ParseFlags: %v", err)
+	}
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	return cmd, &opts, &out
+}
+
+func TestAuditOptionsValidate(t *testing.T) {
+	_, opts, _ := newTestAuditCmd(t, "--output", "json,table", "--file", "out.json", "--columns", "rule,resource")
+	if err := opts.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if opts.outputFmt != "json" {
+		t.Errorf("outputFmt = %q; want json (the stdout format)", opts.outputFmt)
+	}
+	if len(opts.formats) != 2 {
+		t.Errorf("formats = %v; want 2 entries", opts.formats)
+	}
+	if len(opts.render.columns) != 2 {
+		t.Errorf("render.columns = %v; want the parsed --columns", opts.render.columns)
+	}
+
+	for _, args := range [][]string{
+		{"--rank-by", "cost"},
+		{"--sort", "name"},
+		{"--output", "yaml"},
+		{"--columns", "nope"},
+	} {
+		_, opts, _ := newTestAuditCmd(t, args...)
+		if err := opts.validate(); err == nil {
+			t.Errorf("validate(%v): expected an error", args)
+		}
+	}
+}
+
+func TestDomainExitStatus(t *testing.T) {
+	report := makeReport([]models.Finding{{ID: "f1", Severity: models.SeverityLow}})
+	if got := domainExitStatus(report, "cost", false); got.ExitCode != 0 || got.PolicyDomains != nil {
+		t.Errorf("clean report: status = %+v; want exit 0 and no policy domains", got)
+	}
+
+	got := domainExitStatus(report, "cost", true)
+	if got.ExitCode != 1 || report.Summary.ExitCode != 1 {
+		t.Errorf("policy failure: status exit %d, summary exit %d; want 1 and 1", got.ExitCode, report.Summary.ExitCode)
+	}
+	if len(got.PolicyDomains) != 1 || got.PolicyDomains[0] != "cost" {
+		t.Errorf("PolicyDomains = %v; want [cost]", got.PolicyDomains)
+	}
+}
+
+func TestFinishAudit_WritesFileAndRendersStdout(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "report.json")
+	cmd, opts, out := newTestAuditCmd(t, "--output", "json", "--file", filePath, "--sort", "severity")
+	if err := opts.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	report := makeReport([]models.Finding{
+		{ID: "low", ResourceID: "r1", Severity: models.SeverityLow},
+		{ID: "medium", ResourceID: "r2", Severity: models.SeverityMedium},
+	})
+
+	settled := false
+	err := finishAudit(cmd, opts, report, nil, postAudit{
+		settle: func(report *models.AuditReport) auditExitStatus {
+			settled = true
+			return domainExitStatus(report, "cost", false)
+		},
+		render: renderAWSCostOutput,
+	})
+	if err != nil {
+		t.Fatalf("finishAudit: %v", err)
+	}
+	if !settled {
+		t.Error("settle was not called")
+	}
+
+	var stdout models.AuditReport
+	if err := json.Unmarshal(out.Bytes(), &stdout); err != nil {
+		t.Fatalf("stdout is not a JSON report: %v\n%s", err, out.String())
+	}
+	if len(stdout.Findings) != 2 || stdout.Findings[0].ID != "medium" {
+		t.Errorf("stdout findings = %+v; want 2 sorted by severity", stdout.Findings)
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		t.Errorf("--file not written: %v", err)
+	}
+}
+
+func TestFinishAudit_ExplainReplacesOutput(t *testing.T) {
+	cmd, opts, out := newTestAuditCmd(t)
+	if err := opts.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	err := finishAudit(cmd, opts, makeReport(nil), nil, postAudit{
+		settle: func(*models.AuditReport) auditExitStatus { return auditExitStatus{} },
+		explain: func(w io.Writer, _ *models.AuditReport) error {
+			_, err := w.Write([]byte("explained\n"))
+			return err
+		},
+		render: func(io.Writer, *models.AuditReport, string, renderOptions) error {
+			t.Error("render must not run in explain mode")
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("finishAudit: %v", err)
+	}
+	if out.String() != "explained\n" {
+		t.Errorf("stdout = %q; want only the explanation", out.String())
+	}
+}
+
+func TestFailOnAuditErrors(t *testing.T) {
+	errs := []models.AuditError{{Stage: models.AuditStageCollect, Provider: "aws/cost", Profile: "staging", Message: "AccessDenied"}}
+
+	clean := makeReport(nil)
+	if got := failOnAuditErrors(clean, auditExitStatus{}); got.ExitCode != 0 || got.Reasons != nil {
+		t.Errorf("no errors: status = %+v; want unchanged", got)
+	}
+
+	report := makeReport(nil)
+	report.Errors = errs
+	got := failOnAuditErrors(report, auditExitStatus{})
+	if got.ExitCode != exitCodeErrors || report.Summary.ExitCode != exitCodeErrors {
+		t.Errorf("errors only: status exit %d, summary exit %d; want %d", got.ExitCode, report.Summary.ExitCode, exitCodeErrors)
+	}
+	if got.Errors != 1 || len(got.Reasons) != 1 || got.Reasons[0] != exitReasonErrors {
+		t.Errorf("errors only: status = %+v; want 1 error and reason %s", got, exitReasonErrors)
+	}
+
+	// A policy failure keeps its exit code; the errors are still reported.
+	report = makeReport(nil)
+	report.Errors = errs
+	report.Summary.ExitCode = exitCodePolicy
+	got = failOnAuditErrors(report, auditExitStatus{ExitCode: exitCodePolicy, Reasons: []string{exitReasonPolicy}})
+	if got.ExitCode != exitCodePolicy || report.Summary.ExitCode != exitCodePolicy {
+		t.Errorf("policy and errors: exit %d, summary exit %d; want %d", got.ExitCode, report.Summary.ExitCode, exitCodePolicy)
+	}
+	if len(got.Reasons) != 2 {
+		t.Errorf("policy and errors: Reasons = %v; want policy_enforcement and audit_errors", got.Reasons)
+	}
+
+	var buf bytes.Buffer
+	if err := writeAuditExitStatus(&buf, got, false); err != nil {
+		t.Fatalf("writeAuditExitStatus: %v", err)
+	}
+	if !strings.Contains(buf.String(), "audit completed with 1 error(s)") {
+		t.Errorf("text output missing errors message:\n%s", buf.String())
+	}
+}
+
+func TestFinishAudit_FailOnErrorsRecordsExitCode(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "report.json")
+	cmd, opts, _ := newTestAuditCmd(t, "--output", "json", "--file", filePath, "--fail-on-errors")
+	// Keep the test process alive; the report still records the real code.
+	cmd.Flags().Bool("no-exit-code", true, "")
+	if err := opts.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	report := makeReport(nil)
+	report.Errors = []models.AuditError{{Stage: models.AuditStageCollect, Provider: "aws/security", Message: "AccessDenied"}}
+
+	err := finishAudit(cmd, opts, report, nil, postAudit{
+		settle: func(report *models.AuditReport) auditExitStatus {
+			return domainExitStatus(report, "security", false)
+		},
+		render: renderAWSSecurityOutput,
+	})
+	if err != nil {
+		t.Fatalf("finishAudit: %v", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("read --file: %v", err)
+	}
+	var saved models.AuditReport
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("--file is not a JSON report: %v", err)
+	}
+	if saved.Summary.ExitCode != exitCodeErrors {
+		t.Errorf("summary.exit_code = %d; want %d", saved.Summary.ExitCode, exitCodeErrors)
+	}
+}
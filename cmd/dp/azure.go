@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	dpoutput "github.com/pankaj-dahiya-devops/Devops-proxy/internal/output"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+	azcommon "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+	azurecost "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/cost"
+	azurecostpack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/azure_cost"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+func newAzureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "azure",
+		Short: "Azure provider commands",
+	}
+	cmd.AddCommand(newAzureAuditCmd())
+	return cmd
+}
+
+func newAzureAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Run an audit against an Azure subscription",
+	}
+	cmd.AddCommand(newAzureCostCmd())
+	return cmd
+}
+
+// newAzureCostCmd mirrors newCostCmd for a single Azure subscription. Flags
+// that only make sense for AWS (--profile, --all-profiles, --region) are
+// replaced by --subscription.
+func newAzureCostCmd() *cobra.Command {
+	var (
+		opts          auditOptions
+		subscription  string
+		days          int
+		showPassed    bool
+		annotate      bool
+		annotateKeys  []string
+		minConfidence string
+		currencyCode  string
+		fxRate        float64
+	)
+
+	cmd := &cobra.Command{
+		Use:          "cost",
+		Short:        "Audit Azure cost and identify wasted spend",
+		SilenceUsage: true, // business-outcome exits must not print usage
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			confidence, err := engine.ParseConfidence(minConfidence)
+			if err != nil {
+				return fmt.Errorf("--min-confidence: %w", err)
+			}
+			opts.render.currency, err = parseCurrencyFlags(currencyCode, fxRate)
+			if err != nil {
+				return err
+			}
+			policyCfg, err := loadPolicyFile(opts.policyPath)
+			if err != nil {
+				return fmt.Errorf("load policy: %w", err)
+			}
+
+			provider := azcommon.NewDefaultAzureClientProvider()
+			collector := azurecost.NewDefaultCostCollector()
+
+			registry := rules.NewDefaultRuleRegistry()
+			for _, r := range azurecostpack.New() {
+				registry.Register(r)
+			}
+
+			eng := engine.NewAzureCostEngine(provider, collector, registry, policyCfg)
+
+			auditOpts := engine.AuditOptions{
+				AuditType:        engine.AuditTypeCost,
+				Subscription:     subscription,
+				DaysBack:         days,
+				ReportFormat:     engine.ReportFormat(opts.outputFmt),
+				ShowPassed:       showPassed,
+				AnnotateFindings: annotate,
+				AnnotateKeys:     annotateKeys,
+				Timings:          opts.timings,
+			}
+
+			report, err := eng.RunAudit(cmd.Context(), auditOpts)
+			if err != nil {
+				return fmt.Errorf("audit failed: %w", err)
+			}
+			return finishAudit(cmd, &opts, report, policyCfg, postAudit{
+				minConfidence: confidence,
+				settle: func(report *models.AuditReport) auditExitStatus {
+					return domainExitStatus(report, "cost", policy.ShouldFail("cost", report.Findings, policyCfg))
+				},
+				render: renderAzureCostOutput,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&subscription, "subscription", "", "Azure subscription ID (default: AZURE_SUBSCRIPTION_ID)")
+	cmd.Flags().IntVar(&days, "days", 30, "Lookback window in days for Azure Monitor CPU metrics")
+	addAuditFlags(cmd, &opts, "Subscription:")
+	cmd.Flags().BoolVar(&showPassed, "show-passed", false, "List resources that produced no findings in a Passed section (table) or passed_resources (JSON)")
+	cmd.Flags().BoolVar(&annotate, "annotate-findings", false, "Copy each finding's resource tags into metadata.resource_tags")
+	cmd.Flags().StringSliceVar(&annotateKeys, "annotate-key", nil, "Tag key glob copied by --annotate-findings (repeatable; default: all keys)")
+	addMinConfidenceFlag(cmd, &minConfidence)
+	addCurrencyFlags(cmd, &currencyCode, &fxRate)
+
+	return cmd
+}
+
+// renderAzureCostOutput writes the Azure cost audit report to w. It matches
+// renderAWSCostOutput except that the banner names the subscription and the
+// location column is labelled LOCATION.
+func renderAzureCostOutput(w io.Writer, report *models.AuditReport, outputFmt string, ro renderOptions) error {
+	if outputFmt == "json" {
+		return encodeJSON(w, report, false)
+	}
+	if ro.summary {
+		printSummaryWithCurrency(w, report, ro.rankBy, ro.topN, ro.currency)
+		return nil
+	}
+	if !ro.quiet {
+		s := report.Summary
+		fmt.Fprintf(w, "Subscription: %-36s  Locations: %d  Findings: %d  Est. Savings: %s/mo\n",
+			report.AccountID, len(report.Regions), s.TotalFindings, ro.currency.Format(s.TotalEstimatedMonthlySavings))
+		renderErrorWarning(w, report)
+		if len(report.Findings) > 0 {
+			fmt.Fprintln(w)
+		}
+	}
+	if ro.histogram {
+		renderHistogram(w, report)
+	}
+	dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
+		Colored:        ro.colored,
+		IncludeSavings: true,
+		IncludeDomain:  false,
+		LocationLabel:  "LOCATION",
+		Currency:       ro.currency,
+		Columns:        ro.columns,
+	})
+	renderPassedSection(w, report, "LOCATION")
+	return nil
+}
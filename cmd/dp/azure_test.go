@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestAzureCostCmd_RegisteredUnderRoot(t *testing.T) {
+	cmd, _, err := newRootCmd().Find([]string{"azure", "audit", "cost"})
+	if err != nil || cmd.Name() != "cost" {
+		t.Fatalf("dp azure audit cost not found: %v", err)
+	}
+	for _, name := range []string{"subscription", "days", "output", "file", "policy", "show-passed", "sign-key"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("--%s flag not registered on azure audit cost", name)
+		}
+	}
+	if cmd.Flags().Lookup("profile") != nil {
+		t.Error("--profile must not be registered on azure audit cost")
+	}
+}
+
+func TestRenderAzureCostOutput_Banner(t *testing.T) {
+	report := makeReport([]models.Finding{{
+		ID: "AZURE_DISK_UNATTACHED-rg/orphan", RuleID: "AZURE_DISK_UNATTACHED",
+		ResourceID: "rg/orphan", ResourceType: models.ResourceAzureDisk, Region: "westeurope",
+		Severity: models.SeverityMedium, EstimatedMonthlySavings: 9,
+	}})
+	report.AccountID = "sub-1"
+	report.Regions = []string{"westeurope"}
+
+	var buf bytes.Buffer
+	if err := renderAzureCostOutput(&buf, report, "table", renderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"Subscription: sub-1", "Locations: 1", "LOCATION", "rg/orphan"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q; got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Profile:") {
+		t.Errorf("Azure banner must not mention Profile; got:\n%s", out)
+	}
+}
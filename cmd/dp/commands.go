@@ -3,11 +3,16 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -26,6 +31,7 @@ import (
 	costpack    "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/aws_cost"
 	dppack      "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/aws_dataprotection"
 	secpack     "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/aws_security"
+	azurecostpack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/azure_cost"
 	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
 	k8sekpack   "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_eks"
 )
@@ -35,22 +41,36 @@ func newRootCmd() *cobra.Command {
 		Use:   "dp",
 		Short: "DevOps Proxy — extensible DevOps execution engine",
 	}
+	root.PersistentFlags().Bool("no-exit-code", false, "Always exit 0 from audit commands, even on policy enforcement or CRITICAL/HIGH findings (findings still render; for dry runs)")
 	root.AddCommand(newAWSCmd())
+	root.AddCommand(newAzureCmd())
 	root.AddCommand(newKubernetesCmd())
 	root.AddCommand(newPolicyCmd())
+	root.AddCommand(newReportCmd())
 	root.AddCommand(newVersionCmd())
 	root.AddCommand(newDoctorCmd())
+	root.AddCommand(newServeCmd())
 	return root
 }
 
 func newVersionCmd() *cobra.Command {
-	return &cobra.Command{
+	var jsonOut bool
+
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print dp version, commit, and build date",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if jsonOut {
+				return json.NewEncoder(cmd.OutOrStdout()).Encode(version.Current())
+			}
 			fmt.Fprint(cmd.OutOrStdout(), version.Info())
+			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&jsonOut, "json", false, "Print version information as JSON")
+
+	return cmd
 }
 
 func newAWSCmd() *cobra.Command {
@@ -64,16 +84,18 @@ func newAWSCmd() *cobra.Command {
 
 func newAuditCmd() *cobra.Command {
 	var (
-		all         bool
-		profile     string
-		allProfiles bool
-		regions     []string
-		days        int
-		outputFmt   string
-		summary     bool
-		filePath    string
-		policyPath  string
-		color       bool
+		opts           auditOptions
+		all            bool
+		profile        string
+		allProfiles    bool
+		profileRegex   string
+		regions        []string
+		days           int
+		collectorCache bool
+		minConfidence  string
+		currencyCode   string
+		fxRate         float64
+		dryRun         bool
 	)
 
 	cmd := &cobra.Command{
@@ -84,12 +106,47 @@ func newAuditCmd() *cobra.Command {
 			if !all {
 				return cmd.Help()
 			}
-			return runAllDomainsAudit(
-				cmd.Context(),
-				profile, allProfiles, regions, days,
-				outputFmt, summary, filePath, policyPath, color,
-				cmd.OutOrStdout(),
-			)
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			if err := validateFindingsOnlyFlags(opts.render.findingsOnly, opts.outputFmt, opts.outputTemplate); err != nil {
+				return err
+			}
+			confidence, err := engine.ParseConfidence(minConfidence)
+			if err != nil {
+				return fmt.Errorf("--min-confidence: %w", err)
+			}
+			opts.render.currency, err = parseCurrencyFlags(currencyCode, fxRate)
+			if err != nil {
+				return err
+			}
+			opts.render.allProfiles = allProfiles || profileRegex != ""
+			if dryRun {
+				costCollector := awscost.NewDefaultCostCollector()
+				secPlan := awssecurity.NewDefaultSecurityCollector().Plan
+				domains := []dryRunDomain{
+					{name: "cost", plans: []func([]string) []common.APICall{costCollector.Plan}},
+					{name: "security", plans: []func([]string) []common.APICall{secPlan}},
+				}
+				// With the collector cache the data protection domain reuses
+				// the regional inventory and the security data; only its
+				// lookback-dependent cost calls remain.
+				if collectorCache {
+					domains = append(domains, dryRunDomain{name: "dataprotection", plans: []func([]string) []common.APICall{costCollector.PlanMetrics}})
+				} else {
+					domains = append(domains, dryRunDomain{name: "dataprotection", plans: []func([]string) []common.APICall{costCollector.Plan, secPlan}})
+				}
+				return renderDryRun(cmd.OutOrStdout(), opts.outputFmt, regions, domains...)
+			}
+			auditOpts := engine.AllAWSAuditOptions{
+				Profile:      profile,
+				AllProfiles:  allProfiles,
+				ProfileRegex: profileRegex,
+				Regions:      regions,
+				DaysBack:     days,
+				Timings:      opts.timings,
+			}
+			return runAllDomainsAudit(cmd, &opts, auditOpts, collectorCache, confidence)
 		},
 	}
 
@@ -100,42 +157,46 @@ func newAuditCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&all, "all", false, "Run all AWS audit domains: cost, security, dataprotection")
 	cmd.Flags().StringVar(&profile, "profile", "", "AWS profile name (default: uses environment / default profile)")
 	cmd.Flags().BoolVar(&allProfiles, "all-profiles", false, "Audit all configured AWS profiles")
+	cmd.Flags().StringVar(&profileRegex, "profile-regex", "", "Audit only configured AWS profiles whose names match this regular expression (implies --all-profiles)")
 	cmd.Flags().StringSliceVar(&regions, "region", nil, "AWS region(s) to audit (default: all active regions)")
 	cmd.Flags().IntVar(&days, "days", 30, "Lookback window in days for cost queries")
-	cmd.Flags().StringVar(&outputFmt, "output", "table", "Output format: json or table")
-	cmd.Flags().BoolVar(&summary, "summary", false, "Print compact summary: totals, severity breakdown, top-5 findings by savings")
-	cmd.Flags().StringVar(&filePath, "file", "", "Write full JSON report to this file path (in addition to stdout output)")
-	cmd.Flags().StringVar(&policyPath, "policy", "", "Path to dp.yaml policy file (auto-detected if omitted and ./dp.yaml exists)")
-	cmd.Flags().BoolVar(&color, "color", false, "Enable colored severity output in table format (not CI-safe)")
+	addAuditFlags(cmd, &opts, "Profile:/Context:")
+	cmd.Flags().BoolVar(&collectorCache, "collector-cache", true, "Share collected AWS data between the cost, security, and data protection domains (disable with --collector-cache=false)")
+	addMinConfidenceFlag(cmd, &minConfidence)
+	addDryRunFlag(cmd, &dryRun)
+	addCurrencyFlags(cmd, &currencyCode, &fxRate)
+	cmd.Flags().BoolVar(&opts.render.findingsOnly, "output-findings-only", false, "With --output json, print only the findings array instead of the full report (--file still gets the full report)")
+	cmd.Flags().BoolVar(&opts.render.compact, "compact", false, "Write JSON without indentation, both to stdout with --output json and to --file")
 
 	return cmd
 }
 
 // runAllDomainsAudit wires the three AWS domain engines, executes the unified
-// audit, renders output to w, and returns an error when policy enforcement
-// fires on any domain or when CRITICAL/HIGH findings exist.
+// audit described by auditOpts and finishes it with finishAudit: the process
+// exits non-zero when policy enforcement fires on any domain (exit 2) or when
+// CRITICAL/HIGH findings exist (exit 1); see allDomainsExitStatus.
 // Kubernetes is intentionally excluded — use dp kubernetes audit for Kubernetes governance checks.
-func runAllDomainsAudit(
-	ctx context.Context,
-	profile string,
-	allProfiles bool,
-	regions []string,
-	days int,
-	outputFmt string,
-	summary bool,
-	filePath string,
-	policyPath string,
-	colored bool,
-	w io.Writer,
-) error {
-	policyCfg, err := loadPolicyFile(policyPath)
+// Escalated severities (--max-finding-age) and findings dropped below
+// minConfidence count towards the severity exit code but not towards the
+// engine's per-domain policy enforcement.
+//
+// When collectorCache is true the domain engines share one in-memory
+// common.CollectorCache: the data protection engine reuses the regional
+// inventory (EC2, EBS, RDS, ...) the cost engine described and the security
+// data, and only fetches its own lookback-dependent metrics.
+func runAllDomainsAudit(cmd *cobra.Command, o *auditOptions, auditOpts engine.AllAWSAuditOptions, collectorCache bool, minConfidence models.Confidence) error {
+	policyCfg, err := loadPolicyFile(o.policyPath)
 	if err != nil {
 		return fmt.Errorf("load policy: %w", err)
 	}
 
 	awsProvider := common.NewDefaultAWSClientProvider()
-	costCollector := awscost.NewDefaultCostCollector()
-	secCollector := awssecurity.NewDefaultSecurityCollector()
+	var cache *common.CollectorCache // nil disables caching
+	if collectorCache {
+		cache = common.NewCollectorCache()
+	}
+	costCollector := awscost.NewCachingCollector(cache)
+	secCollector := awssecurity.NewCachingCollector(awssecurity.NewDefaultSecurityCollector(), cache)
 
 	costReg := rules.NewDefaultRuleRegistry()
 	for _, r := range costpack.New() {
@@ -156,83 +217,284 @@ func runAllDomainsAudit(
 
 	allEng := engine.NewAllAWSDomainsEngine(costEng, secEng, dpEng, policyCfg)
 
-	opts := engine.AllAWSAuditOptions{
-		Profile:     profile,
-		AllProfiles: allProfiles,
-		Regions:     regions,
-		DaysBack:    days,
-	}
-
-	report, enforcedDomains, err := allEng.RunAllAWSAudit(ctx, opts)
+	report, enforcedDomains, err := allEng.RunAllAWSAudit(cmd.Context(), auditOpts)
 	if err != nil {
 		return fmt.Errorf("all-domain audit failed: %w", err)
 	}
+	return finishAudit(cmd, o, report, policyCfg, postAudit{
+		minConfidence: minConfidence,
+		settle: func(report *models.AuditReport) auditExitStatus {
+			status := allDomainsExitStatus(report, enforcedDomains)
+			report.Summary.ExitCode = status.ExitCode
+			return status
+		},
+		detailedExit: true,
+		render:       renderAllDomainsOutput,
+	})
+}
 
-	if filePath != "" {
-		if err := writeReportToFile(filePath, report); err != nil {
-			return err
+// renderAllDomainsOutput writes the unified AWS report produced by
+// dp aws audit --all to w. JSON mode is checked first so it takes priority
+// over --summary; ro.compact writes it unindented and ro.findingsOnly writes
+// only the findings array. The table adds a DOMAIN column and, with
+// ro.allProfiles, a PROFILE column.
+func renderAllDomainsOutput(w io.Writer, report *models.AuditReport, outputFmt string, ro renderOptions) error {
+	if outputFmt == "json" && ro.findingsOnly {
+		if err := encodeFindingsJSON(w, report.Findings, ro.compact); err != nil {
+			return fmt.Errorf("encode findings: %w", err)
 		}
+		return nil
 	}
-
 	if outputFmt == "json" {
-		if err := encodeJSON(w, report); err != nil {
+		if err := encodeJSON(w, report, ro.compact); err != nil {
 			return fmt.Errorf("encode report: %w", err)
 		}
-	} else if summary {
-		printSummary(w, report)
-	} else {
+		return nil
+	}
+	if ro.summary {
+		printSummaryWithCurrency(w, report, ro.rankBy, ro.topN, ro.currency)
+		return nil
+	}
+	if !ro.quiet {
 		s := report.Summary
-		fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d  Est. Savings: $%.2f/mo\n",
-			report.Profile, report.AccountID, len(report.Regions), s.TotalFindings, s.TotalEstimatedMonthlySavings)
+		fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d  Est. Savings: %s/mo\n",
+			report.Profile, report.AccountID, len(report.Regions), s.TotalFindings, ro.currency.Format(s.TotalEstimatedMonthlySavings))
+		renderErrorWarning(w, report)
 		if len(report.Findings) > 0 {
 			fmt.Fprintln(w)
 		}
-		dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
-			Colored:        colored,
-			IncludeSavings: true,
-			IncludeDomain:  true,
-			IncludeProfile: allProfiles,
-			LocationLabel:  "REGION",
-		})
 	}
+	if ro.histogram {
+		renderHistogram(w, report)
+	}
+	dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
+		Colored:        ro.colored,
+		IncludeSavings: true,
+		IncludeDomain:  true,
+		IncludeProfile: ro.allProfiles,
+		LocationLabel:  "REGION",
+		Currency:       ro.currency,
+		Columns:        ro.columns,
+	})
+	return nil
+}
 
+// Exit codes of dp aws audit --all. A severity failure keeps the historical
+// code 1; policy enforcement takes precedence when both fire. exitCodeErrors
+// is used by every audit command with --fail-on-errors when report errors are
+// the only failure.
+const (
+	exitCodeSeverity = 1
+	exitCodePolicy   = 2
+	exitCodeErrors   = 3
+)
+
+// Exit reasons reported in auditExitStatus.Reasons.
+const (
+	exitReasonPolicy   = "policy_enforcement"
+	exitReasonSeverity = "severity"
+	exitReasonErrors   = "audit_errors"
+)
+
+// auditExitStatus explains why dp aws audit --all exits non-zero. In JSON and
+// template output modes it is written to stderr as a single JSON object so CI
+// wrappers can tell a policy failure from a severity failure and see which
+// domains contributed.
+type auditExitStatus struct {
+	ExitCode        int      `json:"exit_code"`
+	Reasons         []string `json:"reasons,omitempty"`
+	PolicyDomains   []string `json:"policy_domains,omitempty"`
+	SeverityDomains []string `json:"severity_domains,omitempty"`
+	Errors          int      `json:"errors,omitempty"`
+}
+
+// allDomainsExitStatus derives the exit status of an all-domains audit from
+// the domains whose enforcement policy fired and the CRITICAL/HIGH findings in
+// report. SeverityDomains lists, sorted, the domains owning such findings.
+func allDomainsExitStatus(report *models.AuditReport, enforcedDomains []string) auditExitStatus {
+	var status auditExitStatus
 	if len(enforcedDomains) > 0 {
-		return fmt.Errorf("policy enforcement triggered on domain(s): %s",
-			strings.Join(enforcedDomains, ", "))
+		status.ExitCode = exitCodePolicy
+		status.Reasons = append(status.Reasons, exitReasonPolicy)
+		status.PolicyDomains = append([]string(nil), enforcedDomains...)
 	}
-	if hasCriticalOrHighFindings(report.Findings) {
-		if outputFmt != "json" {
-			fmt.Fprintln(os.Stderr, "audit completed with CRITICAL or HIGH findings")
+	seen := make(map[string]bool)
+	for _, f := range report.Findings {
+		if f.Severity != models.SeverityCritical && f.Severity != models.SeverityHigh {
+			continue
+		}
+		if !seen[f.Domain] {
+			seen[f.Domain] = true
+			status.SeverityDomains = append(status.SeverityDomains, f.Domain)
 		}
-		os.Exit(1)
+	}
+	if len(seen) > 0 {
+		if status.ExitCode == 0 {
+			status.ExitCode = exitCodeSeverity
+		}
+		status.Reasons = append(status.Reasons, exitReasonSeverity)
+		sort.Strings(status.SeverityDomains)
+	}
+	return status
+}
+
+// writeAuditExitStatus reports a non-zero exit status on w. When
+// machineReadable is true it writes status as one line of JSON; otherwise it
+// writes the human-readable enforcement and severity messages.
+func writeAuditExitStatus(w io.Writer, status auditExitStatus, machineReadable bool) error {
+	if machineReadable {
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			return fmt.Errorf("encode exit status: %w", err)
+		}
+		return nil
+	}
+	if len(status.PolicyDomains) > 0 {
+		fmt.Fprintf(w, "policy enforcement triggered on domain(s): %s\n", strings.Join(status.PolicyDomains, ", "))
+	}
+	if len(status.SeverityDomains) > 0 {
+		fmt.Fprintln(w, "audit completed with CRITICAL or HIGH findings")
+	}
+	if status.Errors > 0 {
+		fmt.Fprintf(w, "audit completed with %d error(s); see the report's errors\n", status.Errors)
 	}
 	return nil
 }
 
+// failOnAuditErrors applies --fail-on-errors to status: when report carries
+// errors it adds the audit_errors reason and, unless another failure already
+// set the exit code, records exitCodeErrors in status and
+// report.Summary.ExitCode.
+func failOnAuditErrors(report *models.AuditReport, status auditExitStatus) auditExitStatus {
+	if len(report.Errors) == 0 {
+		return status
+	}
+	status.Reasons = append(status.Reasons, exitReasonErrors)
+	status.Errors = len(report.Errors)
+	if status.ExitCode == 0 {
+		status.ExitCode = exitCodeErrors
+		report.Summary.ExitCode = exitCodeErrors
+	}
+	return status
+}
+
+// addCurrencyFlags registers --currency and --fx-rate on a cost-reporting
+// command.
+func addCurrencyFlags(cmd *cobra.Command, code *string, rate *float64) {
+	cmd.Flags().StringVar(code, "currency", "USD", "ISO 4217 currency code used to display savings in table and summary output (JSON stays in USD)")
+	cmd.Flags().Float64Var(rate, "fx-rate", 0, "Units of --currency per US dollar used to convert displayed savings (required when --currency is not USD)")
+}
+
+// parseCurrencyFlags validates --currency and --fx-rate. The code must be a
+// three-letter ISO 4217 code; any currency other than USD needs a positive
+// --fx-rate because dp never fetches exchange rates itself.
+func parseCurrencyFlags(code string, rate float64) (dpoutput.Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return dpoutput.Currency{}, fmt.Errorf("--currency %q is not a three-letter ISO 4217 code", code)
+	}
+	if rate < 0 {
+		return dpoutput.Currency{}, fmt.Errorf("--fx-rate must be positive, got %g", rate)
+	}
+	if code != "USD" && rate == 0 {
+		return dpoutput.Currency{}, fmt.Errorf("--currency %s requires --fx-rate", code)
+	}
+	return dpoutput.Currency{Code: code, Rate: rate}, nil
+}
+
+// addColumnsFlag registers --columns on a command that renders a findings
+// table. Names are validated with dpoutput.ParseTableColumns.
+func addColumnsFlag(cmd *cobra.Command, columns *[]string) {
+	cmd.Flags().StringSliceVar(columns, "columns", nil, "Table columns to render, in order (comma-separated): "+strings.Join(dpoutput.TableColumns, ", ")+" (default: the command's standard layout)")
+}
+
+// addHistogramFlag registers --histogram on a command that renders a
+// findings table.
+func addHistogramFlag(cmd *cobra.Command, histogram *bool) {
+	cmd.Flags().BoolVar(histogram, "histogram", false, "Print a severity histogram bar (e.g. C██ H████ M██ L█) above the findings table, scaled to the terminal width ($COLUMNS, default 80)")
+}
+
+// addStateFlags registers --state-file and --max-finding-age on an audit
+// command.
+func addStateFlags(cmd *cobra.Command, statePath *string, maxAgeDays *int) {
+	cmd.Flags().StringVar(statePath, "state-file", "", "Path to a JSON file tracking when each finding was first and last seen; sets first_seen, last_seen and age_days (created if missing, rewritten every run)")
+	cmd.Flags().IntVar(maxAgeDays, "max-finding-age", 0, "Escalate findings open for more than this many days by one severity level (requires --state-file; 0 disables)")
+}
+
+// addMinConfidenceFlag registers --min-confidence on a command whose rules
+// include heuristic checks that report less than high confidence.
+func addMinConfidenceFlag(cmd *cobra.Command, minConfidence *string) {
+	cmd.Flags().StringVar(minConfidence, "min-confidence", string(models.ConfidenceLow), "Drop findings less confident than this level: high, medium, or low (default keeps every finding; idle-detection rules report medium or low)")
+}
+
+// validateStateFlags rejects a negative --max-finding-age and
+// --max-finding-age without --state-file.
+func validateStateFlags(statePath string, maxAgeDays int) error {
+	if maxAgeDays < 0 {
+		return fmt.Errorf("--max-finding-age must not be negative, got %d", maxAgeDays)
+	}
+	if maxAgeDays > 0 && statePath == "" {
+		return fmt.Errorf("--max-finding-age requires --state-file")
+	}
+	return nil
+}
+
+// applyFindingState loads the --state-file at statePath, stamps the report's
+// findings with their age (escalating those older than maxAgeDays) and saves
+// the updated state. The report's GeneratedAt is used as the current time. It
+// is a no-op when statePath is empty. Call it right after the audit so policy
+// enforcement and the exit code see escalated severities.
+func applyFindingState(report *models.AuditReport, statePath string, maxAgeDays int, policyCfg *policy.PolicyConfig) error {
+	if statePath == "" {
+		return nil
+	}
+	state, err := engine.LoadState(statePath)
+	if err != nil {
+		return err
+	}
+	now := report.GeneratedAt
+	if now.IsZero() {
+		now = time.Now()
+	}
+	engine.ApplyState(report, state, now, maxAgeDays, policyCfg)
+	return engine.SaveState(statePath, state)
+}
+
 // loadPolicyFile returns a PolicyConfig for the given path.
 // If path is empty, it auto-discovers dp.yaml in the current directory.
 // If neither is found, it returns nil (policy disabled — default behaviour).
+// Keys using a deprecated rule ID are reported on stderr.
 func loadPolicyFile(path string) (*policy.PolicyConfig, error) {
-	if path != "" {
-		return policy.LoadPolicy(path)
+	if path == "" {
+		if _, err := os.Stat("dp.yaml"); err != nil {
+			return nil, nil
+		}
+		path = "dp.yaml"
 	}
-	if _, err := os.Stat("dp.yaml"); err == nil {
-		return policy.LoadPolicy("dp.yaml")
+	cfg, err := policy.LoadPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range policy.DeprecatedRuleIDWarnings(cfg) {
+		fmt.Fprintf(os.Stderr, "warning: %s: %s\n", path, w)
 	}
-	return nil, nil
+	return cfg, nil
 }
 
 func newCostCmd() *cobra.Command {
 	var (
-		profile     string
-		allProfiles bool
-		regions     []string
-		days        int
-		outputFmt   string
-		summary     bool
-		filePath    string
-		policyPath  string
-		color       bool
+		opts          auditOptions
+		profile       string
+		allProfiles   bool
+		profileRegex  string
+		regions       []string
+		days          int
+		showPassed    bool
+		annotate      bool
+		annotateKeys  []string
+		minConfidence string
+		currencyCode  string
+		fxRate        float64
+		dryRun        bool
 	)
 
 	cmd := &cobra.Command{
@@ -240,13 +502,29 @@ func newCostCmd() *cobra.Command {
 		Short:        "Audit AWS cost and identify wasted spend",
 		SilenceUsage: true, // business-outcome exits must not print usage
 		RunE: func(cmd *cobra.Command, args []string) error {
-			policyCfg, err := loadPolicyFile(policyPath)
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			confidence, err := engine.ParseConfidence(minConfidence)
+			if err != nil {
+				return fmt.Errorf("--min-confidence: %w", err)
+			}
+			opts.render.currency, err = parseCurrencyFlags(currencyCode, fxRate)
+			if err != nil {
+				return err
+			}
+			opts.render.allProfiles = allProfiles || profileRegex != ""
+			policyCfg, err := loadPolicyFile(opts.policyPath)
 			if err != nil {
 				return fmt.Errorf("load policy: %w", err)
 			}
 
 			provider := common.NewDefaultAWSClientProvider()
 			collector := awscost.NewDefaultCostCollector()
+			if dryRun {
+				return renderDryRun(cmd.OutOrStdout(), opts.outputFmt, regions,
+					dryRunDomain{name: "cost", plans: []func([]string) []common.APICall{collector.Plan}})
+			}
 
 			registry := rules.NewDefaultRuleRegistry()
 			for _, r := range costpack.New() {
@@ -255,66 +533,60 @@ func newCostCmd() *cobra.Command {
 
 			eng := engine.NewAWSCostEngine(provider, collector, registry, policyCfg)
 
-			opts := engine.AuditOptions{
-				AuditType:    engine.AuditTypeCost,
-				Profile:      profile,
-				AllProfiles:  allProfiles,
-				Regions:      regions,
-				DaysBack:     days,
-				ReportFormat: engine.ReportFormat(outputFmt),
+			auditOpts := engine.AuditOptions{
+				AuditType:        engine.AuditTypeCost,
+				Profile:          profile,
+				AllProfiles:      allProfiles,
+				ProfileRegex:     profileRegex,
+				Regions:          regions,
+				DaysBack:         days,
+				ReportFormat:     engine.ReportFormat(opts.outputFmt),
+				ShowPassed:       showPassed,
+				AnnotateFindings: annotate,
+				AnnotateKeys:     annotateKeys,
+				Timings:          opts.timings,
 			}
 
-			report, err := eng.RunAudit(cmd.Context(), opts)
+			report, err := eng.RunAudit(cmd.Context(), auditOpts)
 			if err != nil {
 				return fmt.Errorf("audit failed: %w", err)
 			}
-
-			if filePath != "" {
-				if err := writeReportToFile(filePath, report); err != nil {
-					return err
-				}
-			}
-
-			if err := renderAWSCostOutput(os.Stdout, report, outputFmt, summary, color, allProfiles); err != nil {
-				return err
-			}
-
-			if policy.ShouldFail("cost", report.Findings, policyCfg) {
-				return fmt.Errorf("policy enforcement triggered: findings at or above configured fail_on_severity")
-			}
-			if hasCriticalOrHighFindings(report.Findings) {
-				if outputFmt != "json" {
-					fmt.Fprintln(os.Stderr, "audit completed with CRITICAL or HIGH findings")
-				}
-				os.Exit(1)
-			}
-			return nil
+			return finishAudit(cmd, &opts, report, policyCfg, postAudit{
+				minConfidence: confidence,
+				settle: func(report *models.AuditReport) auditExitStatus {
+					return domainExitStatus(report, "cost", policy.ShouldFail("cost", report.Findings, policyCfg))
+				},
+				render: renderAWSCostOutput,
+			})
 		},
 	}
 
 	cmd.Flags().StringVar(&profile, "profile", "", "AWS profile name (default: uses environment / default profile)")
 	cmd.Flags().BoolVar(&allProfiles, "all-profiles", false, "Audit all configured AWS profiles")
+	cmd.Flags().StringVar(&profileRegex, "profile-regex", "", "Audit only configured AWS profiles whose names match this regular expression (implies --all-profiles)")
 	cmd.Flags().StringSliceVar(&regions, "region", nil, "AWS region(s) to audit (default: all active regions)")
 	cmd.Flags().IntVar(&days, "days", 30, "Lookback window in days for cost and metric queries")
-	cmd.Flags().StringVar(&outputFmt, "output", "table", "Output format: json or table")
-	cmd.Flags().BoolVar(&summary, "summary", false, "Print compact summary: totals, severity breakdown, top-5 findings by savings")
-	cmd.Flags().StringVar(&filePath, "file", "", "Write full JSON report to this file path (in addition to stdout output)")
-	cmd.Flags().StringVar(&policyPath, "policy", "", "Path to dp.yaml policy file (auto-detected if omitted and ./dp.yaml exists)")
-	cmd.Flags().BoolVar(&color, "color", false, "Enable colored severity output in table format (not CI-safe)")
+	addAuditFlags(cmd, &opts, "Profile:/Context:")
+	cmd.Flags().BoolVar(&showPassed, "show-passed", false, "List resources that produced no findings in a Passed section (table) or passed_resources (JSON)")
+	cmd.Flags().BoolVar(&annotate, "annotate-findings", false, "Copy each finding's resource tags into metadata.resource_tags")
+	cmd.Flags().StringSliceVar(&annotateKeys, "annotate-key", nil, "Tag key glob copied by --annotate-findings (repeatable; default: all keys)")
+	addMinConfidenceFlag(cmd, &minConfidence)
+	addDryRunFlag(cmd, &dryRun)
+	addCurrencyFlags(cmd, &currencyCode, &fxRate)
 
 	return cmd
 }
 
 func newSecurityCmd() *cobra.Command {
 	var (
-		profile     string
-		allProfiles bool
-		regions     []string
-		outputFmt   string
-		summary     bool
-		filePath    string
-		policyPath  string
-		color       bool
+		opts         auditOptions
+		profile      string
+		allProfiles  bool
+		profileRegex string
+		regions      []string
+		days         int
+		showPassed   bool
+		dryRun       bool
 	)
 
 	cmd := &cobra.Command{
@@ -322,13 +594,21 @@ func newSecurityCmd() *cobra.Command {
 		Short:        "Audit AWS security posture: S3 public access, open SSH, IAM MFA, root access keys",
 		SilenceUsage: true, // business-outcome exits must not print usage
 		RunE: func(cmd *cobra.Command, args []string) error {
-			policyCfg, err := loadPolicyFile(policyPath)
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			opts.render.allProfiles = allProfiles || profileRegex != ""
+			policyCfg, err := loadPolicyFile(opts.policyPath)
 			if err != nil {
 				return fmt.Errorf("load policy: %w", err)
 			}
 
 			provider := common.NewDefaultAWSClientProvider()
 			collector := awssecurity.NewDefaultSecurityCollector()
+			if dryRun {
+				return renderDryRun(cmd.OutOrStdout(), opts.outputFmt, regions,
+					dryRunDomain{name: "security", plans: []func([]string) []common.APICall{collector.Plan}})
+			}
 
 			registry := rules.NewDefaultRuleRegistry()
 			for _, r := range secpack.New() {
@@ -337,64 +617,54 @@ func newSecurityCmd() *cobra.Command {
 
 			eng := engine.NewAWSSecurityEngine(provider, collector, registry, policyCfg)
 
-			opts := engine.AuditOptions{
+			auditOpts := engine.AuditOptions{
 				AuditType:    engine.AuditTypeSecurity,
 				Profile:      profile,
 				AllProfiles:  allProfiles,
+				ProfileRegex: profileRegex,
 				Regions:      regions,
-				ReportFormat: engine.ReportFormat(outputFmt),
+				DaysBack:     days,
+				ReportFormat: engine.ReportFormat(opts.outputFmt),
+				ShowPassed:   showPassed,
+				Timings:      opts.timings,
 			}
 
-			report, err := eng.RunAudit(cmd.Context(), opts)
+			report, err := eng.RunAudit(cmd.Context(), auditOpts)
 			if err != nil {
 				return fmt.Errorf("security audit failed: %w", err)
 			}
-
-			if filePath != "" {
-				if err := writeReportToFile(filePath, report); err != nil {
-					return err
-				}
-			}
-
-			if err := renderAWSSecurityOutput(os.Stdout, report, outputFmt, summary, color, allProfiles); err != nil {
-				return err
-			}
-
-			if policy.ShouldFail("security", report.Findings, policyCfg) {
-				return fmt.Errorf("policy enforcement triggered: findings at or above configured fail_on_severity")
-			}
-			if hasCriticalOrHighFindings(report.Findings) {
-				if outputFmt != "json" {
-					fmt.Fprintln(os.Stderr, "audit completed with CRITICAL or HIGH findings")
-				}
-				os.Exit(1)
-			}
-			return nil
+			return finishAudit(cmd, &opts, report, policyCfg, postAudit{
+				settle: func(report *models.AuditReport) auditExitStatus {
+					return domainExitStatus(report, "security", policy.ShouldFail("security", report.Findings, policyCfg))
+				},
+				render: renderAWSSecurityOutput,
+			})
 		},
 	}
 
 	cmd.Flags().StringVar(&profile, "profile", "", "AWS profile name (default: uses environment / default profile)")
 	cmd.Flags().BoolVar(&allProfiles, "all-profiles", false, "Audit all configured AWS profiles")
+	cmd.Flags().StringVar(&profileRegex, "profile-regex", "", "Audit only configured AWS profiles whose names match this regular expression (implies --all-profiles)")
 	cmd.Flags().StringSliceVar(&regions, "region", nil, "AWS region(s) to audit (default: all active regions)")
-	cmd.Flags().StringVar(&outputFmt, "output", "table", "Output format: json or table")
-	cmd.Flags().BoolVar(&summary, "summary", false, "Print compact summary: totals, severity breakdown, top-5 findings")
-	cmd.Flags().StringVar(&filePath, "file", "", "Write full JSON report to this file path (in addition to stdout output)")
-	cmd.Flags().StringVar(&policyPath, "policy", "", "Path to dp.yaml policy file (auto-detected if omitted and ./dp.yaml exists)")
-	cmd.Flags().BoolVar(&color, "color", false, "Enable colored severity output in table format (not CI-safe)")
+	cmd.Flags().IntVar(&days, "days", 30, "Only evaluate ECR images pushed within this many days")
+	addAuditFlags(cmd, &opts, "Profile:/Context:")
+	cmd.Flags().BoolVar(&showPassed, "show-passed", false, "List resources that produced no findings in a Passed section (table) or passed_resources (JSON)")
+	addDryRunFlag(cmd, &dryRun)
 
 	return cmd
 }
 
 func newDataProtectionCmd() *cobra.Command {
 	var (
-		profile     string
-		allProfiles bool
-		regions     []string
-		outputFmt   string
-		summary     bool
-		filePath    string
-		policyPath  string
-		color       bool
+		opts         auditOptions
+		profile      string
+		allProfiles  bool
+		profileRegex string
+		regions      []string
+		showPassed   bool
+		annotate     bool
+		annotateKeys []string
+		dryRun       bool
 	)
 
 	cmd := &cobra.Command{
@@ -402,7 +672,11 @@ func newDataProtectionCmd() *cobra.Command {
 		Short:        "Audit AWS data protection: EBS encryption, RDS encryption, S3 default encryption",
 		SilenceUsage: true, // business-outcome exits must not print usage
 		RunE: func(cmd *cobra.Command, args []string) error {
-			policyCfg, err := loadPolicyFile(policyPath)
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			opts.render.allProfiles = allProfiles || profileRegex != ""
+			policyCfg, err := loadPolicyFile(opts.policyPath)
 			if err != nil {
 				return fmt.Errorf("load policy: %w", err)
 			}
@@ -410,6 +684,10 @@ func newDataProtectionCmd() *cobra.Command {
 			provider := common.NewDefaultAWSClientProvider()
 			costCollector := awscost.NewDefaultCostCollector()
 			secCollector := awssecurity.NewDefaultSecurityCollector()
+			if dryRun {
+				return renderDryRun(cmd.OutOrStdout(), opts.outputFmt, regions,
+					dryRunDomain{name: "dataprotection", plans: []func([]string) []common.APICall{costCollector.Plan, secCollector.Plan}})
+			}
 
 			registry := rules.NewDefaultRuleRegistry()
 			for _, r := range dppack.New() {
@@ -418,50 +696,41 @@ func newDataProtectionCmd() *cobra.Command {
 
 			eng := engine.NewAWSDataProtectionEngine(provider, costCollector, secCollector, registry, policyCfg)
 
-			opts := engine.AuditOptions{
-				AuditType:    engine.AuditTypeDataProtection,
-				Profile:      profile,
-				AllProfiles:  allProfiles,
-				Regions:      regions,
-				ReportFormat: engine.ReportFormat(outputFmt),
+			auditOpts := engine.AuditOptions{
+				AuditType:        engine.AuditTypeDataProtection,
+				Profile:          profile,
+				AllProfiles:      allProfiles,
+				ProfileRegex:     profileRegex,
+				Regions:          regions,
+				ReportFormat:     engine.ReportFormat(opts.outputFmt),
+				ShowPassed:       showPassed,
+				AnnotateFindings: annotate,
+				AnnotateKeys:     annotateKeys,
+				Timings:          opts.timings,
 			}
 
-			report, err := eng.RunAudit(cmd.Context(), opts)
+			report, err := eng.RunAudit(cmd.Context(), auditOpts)
 			if err != nil {
 				return fmt.Errorf("data protection audit failed: %w", err)
 			}
-
-			if filePath != "" {
-				if err := writeReportToFile(filePath, report); err != nil {
-					return err
-				}
-			}
-
-			if err := renderAWSDataProtectionOutput(os.Stdout, report, outputFmt, summary, color, allProfiles); err != nil {
-				return err
-			}
-
-			if policy.ShouldFail("dataprotection", report.Findings, policyCfg) {
-				return fmt.Errorf("policy enforcement triggered: findings at or above configured fail_on_severity")
-			}
-			if hasCriticalOrHighFindings(report.Findings) {
-				if outputFmt != "json" {
-					fmt.Fprintln(os.Stderr, "audit completed with CRITICAL or HIGH findings")
-				}
-				os.Exit(1)
-			}
-			return nil
+			return finishAudit(cmd, &opts, report, policyCfg, postAudit{
+				settle: func(report *models.AuditReport) auditExitStatus {
+					return domainExitStatus(report, "dataprotection", policy.ShouldFail("dataprotection", report.Findings, policyCfg))
+				},
+				render: renderAWSDataProtectionOutput,
+			})
 		},
 	}
 
 	cmd.Flags().StringVar(&profile, "profile", "", "AWS profile name (default: uses environment / default profile)")
 	cmd.Flags().BoolVar(&allProfiles, "all-profiles", false, "Audit all configured AWS profiles")
+	cmd.Flags().StringVar(&profileRegex, "profile-regex", "", "Audit only configured AWS profiles whose names match this regular expression (implies --all-profiles)")
 	cmd.Flags().StringSliceVar(&regions, "region", nil, "AWS region(s) to audit (default: all active regions)")
-	cmd.Flags().StringVar(&outputFmt, "output", "table", "Output format: json or table")
-	cmd.Flags().BoolVar(&summary, "summary", false, "Print compact summary: totals, severity breakdown, top-5 findings")
-	cmd.Flags().StringVar(&filePath, "file", "", "Write full JSON report to this file path (in addition to stdout output)")
-	cmd.Flags().StringVar(&policyPath, "policy", "", "Path to dp.yaml policy file (auto-detected if omitted and ./dp.yaml exists)")
-	cmd.Flags().BoolVar(&color, "color", false, "Enable colored severity output in table format (not CI-safe)")
+	addAuditFlags(cmd, &opts, "Profile:/Context:")
+	cmd.Flags().BoolVar(&showPassed, "show-passed", false, "List resources that produced no findings in a Passed section (table) or passed_resources (JSON)")
+	cmd.Flags().BoolVar(&annotate, "annotate-findings", false, "Copy each finding's resource tags into metadata.resource_tags")
+	cmd.Flags().StringSliceVar(&annotateKeys, "annotate-key", nil, "Tag key glob copied by --annotate-findings (repeatable; default: all keys)")
+	addDryRunFlag(cmd, &dryRun)
 
 	return cmd
 }
@@ -478,42 +747,133 @@ func hasCriticalOrHighFindings(findings []models.Finding) bool {
 	return false
 }
 
-// encodeJSON writes report as indented JSON to w.
+// errPolicyEnforced is returned by single-domain audit commands when dp.yaml
+// enforcement fires.
+var errPolicyEnforced = errors.New("policy enforcement triggered: findings at or above configured fail_on_severity")
+
+// noExitCode reports whether the persistent --no-exit-code flag is set. It is
+// false when cmd runs outside the root command (e.g. in tests).
+func noExitCode(cmd *cobra.Command) bool {
+	v, err := cmd.Flags().GetBool("no-exit-code")
+	return err == nil && v
+}
+
+// auditExitCode decides how a single-domain audit command ends once its output
+// is rendered: errPolicyEnforced when policyFailed, otherwise the process exit
+// code report.Summary.ExitCode, with a CRITICAL/HIGH or --fail-on-errors note
+// on stderr outside JSON mode. When noExit (--no-exit-code) is set it returns 0 and nil instead
+// and only notes the suppressed failure on stderr; report.Summary.ExitCode
+// still records the real outcome.
+func auditExitCode(stderr io.Writer, report *models.AuditReport, policyFailed, noExit bool, outputFmt string) (int, error) {
+	if noExit {
+		if (policyFailed || report.Summary.ExitCode != 0) && outputFmt != "json" {
+			fmt.Fprintf(stderr, "--no-exit-code: ignoring failed audit (exit code %d)\n", max(report.Summary.ExitCode, 1))
+		}
+		return 0, nil
+	}
+	if policyFailed {
+		return 0, errPolicyEnforced
+	}
+	switch {
+	case outputFmt == "json":
+	case report.Summary.ExitCode == exitCodeErrors:
+		fmt.Fprintf(stderr, "audit completed with %d error(s); see the report's errors\n", len(report.Errors))
+	case report.Summary.ExitCode != 0:
+		fmt.Fprintln(stderr, "audit completed with CRITICAL or HIGH findings")
+	}
+	return report.Summary.ExitCode, nil
+}
+
+// setExitCode records in report.Summary.ExitCode the code the audit command
+// exits with: 1 when policy enforcement fired or any CRITICAL or HIGH finding
+// exists, 0 otherwise. Call it before the report is written to --file or
+// stdout so the persisted report reflects the outcome.
+func setExitCode(report *models.AuditReport, policyFailed bool) {
+	report.Summary.ExitCode = 0
+	if policyFailed || hasCriticalOrHighFindings(report.Findings) {
+		report.Summary.ExitCode = 1
+	}
+}
+
+// encodeJSON writes report as JSON to w, indented unless compact is true.
 // All render functions use this so tests can inject a bytes.Buffer.
-func encodeJSON(w io.Writer, report *models.AuditReport) error {
+func encodeJSON(w io.Writer, report *models.AuditReport, compact bool) error {
 	enc := json.NewEncoder(w)
-	enc.SetIndent("", "  ")
+	if !compact {
+		enc.SetIndent("", "  ")
+	}
 	return enc.Encode(report)
 }
 
+// encodeFindingsJSON writes findings as a top-level JSON array to w
+// (--output-findings-only), indented unless compact is true. No findings
+// encode as [] rather than null.
+func encodeFindingsJSON(w io.Writer, findings []models.Finding, compact bool) error {
+	if findings == nil {
+		findings = []models.Finding{}
+	}
+	enc := json.NewEncoder(w)
+	if !compact {
+		enc.SetIndent("", "  ")
+	}
+	return enc.Encode(findings)
+}
+
+// validateFindingsOnlyFlags rejects --output-findings-only outside plain JSON
+// output.
+func validateFindingsOnlyFlags(findingsOnly bool, outputFmt, outputTemplate string) error {
+	if !findingsOnly {
+		return nil
+	}
+	if outputFmt != "json" {
+		return fmt.Errorf("--output-findings-only requires --output json")
+	}
+	if outputTemplate != "" {
+		return fmt.Errorf("--output-findings-only and --output-template are mutually exclusive")
+	}
+	return nil
+}
+
 // renderKubernetesAuditOutput writes the kubernetes audit report to w.
 // JSON mode is checked first so it takes priority over --summary.
 // In JSON mode only the JSON payload is written; no banner or table.
-// When showRiskChains is true in table mode, findings are grouped by risk chain.
-func renderKubernetesAuditOutput(w io.Writer, report *models.AuditReport, outputFmt string, summary bool, colored bool, showRiskChains bool) error {
+// When ro.showRiskChains is true in table mode, findings are grouped by risk chain.
+// ro.quiet suppresses the Context: banner line in table mode.
+func renderKubernetesAuditOutput(w io.Writer, report *models.AuditReport, outputFmt string, ro renderOptions) error {
 	if outputFmt == "json" {
-		return encodeJSON(w, report)
+		return encodeJSON(w, report, false)
 	}
-	if summary {
-		printSummary(w, report)
+	if ro.summary {
+		printSummary(w, report, ro.rankBy, ro.topN)
 		return nil
 	}
-	s := report.Summary
-	fmt.Fprintf(w, "Context: %-30s  Findings: %d\n", report.Profile, s.TotalFindings)
-	if len(report.Findings) > 0 {
-		fmt.Fprintln(w)
+	if !ro.quiet {
+		s := report.Summary
+		fmt.Fprintf(w, "Context: %-30s  Findings: %d\n", report.Profile, s.TotalFindings)
+		renderErrorWarning(w, report)
+		if len(report.Findings) > 0 {
+			fmt.Fprintln(w)
+		}
+	}
+	if ro.histogram {
+		renderHistogram(w, report)
 	}
-	if showRiskChains {
-		renderRiskChainTable(w, report, colored)
+	if ro.showRiskChains {
+		renderRiskChainTable(w, report, ro.colored, ro.columns)
+		renderPassedSection(w, report, "CONTEXT")
+		renderImageSection(w, report)
 		return nil
 	}
 	dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
-		Colored:        colored,
+		Colored:        ro.colored,
 		IncludeSavings: false,
 		IncludeDomain:  false,
 		IncludeProfile: false,
 		LocationLabel:  "CONTEXT",
+		Columns:        ro.columns,
 	})
+	renderPassedSection(w, report, "CONTEXT")
+	renderImageSection(w, report)
 	return nil
 }
 
@@ -521,10 +881,11 @@ func renderKubernetesAuditOutput(w io.Writer, report *models.AuditReport, output
 // grouped by score to w. Attack path sections are printed BEFORE risk chain
 // sections. Findings not part of any path or chain are shown last under
 // "Other Findings".
-func renderRiskChainTable(w io.Writer, report *models.AuditReport, colored bool) {
+func renderRiskChainTable(w io.Writer, report *models.AuditReport, colored bool, columns []string) {
 	tableOpts := dpoutput.TableOptions{
 		Colored:       colored,
 		LocationLabel: "CONTEXT",
+		Columns:       columns,
 	}
 
 	hasPaths := len(report.Summary.AttackPaths) > 0
@@ -549,7 +910,14 @@ func renderRiskChainTable(w io.Writer, report *models.AuditReport, colored bool)
 	for _, ap := range report.Summary.AttackPaths {
 		fmt.Fprintf(w, "ATTACK PATH (Score: %d)\n", ap.Score)
 		fmt.Fprintf(w, "Description: %s\n", ap.Description)
-		fmt.Fprintf(w, "Layers: %s\n\n", strings.Join(ap.Layers, " → "))
+		if len(ap.Namespaces) > 0 {
+			fmt.Fprintf(w, "Namespaces (%d): %s\n", len(ap.Namespaces), strings.Join(ap.Namespaces, ", "))
+		}
+		fmt.Fprintf(w, "Layers: %s\n", strings.Join(ap.Layers, " → "))
+		if len(ap.Techniques) > 0 {
+			fmt.Fprintf(w, "ATT&CK: %s\n", strings.Join(ap.Techniques, ", "))
+		}
+		fmt.Fprintln(w)
 
 		var pathFindings []models.Finding
 		for _, id := range ap.FindingIDs {
@@ -594,111 +962,230 @@ func renderRiskChainTable(w io.Writer, report *models.AuditReport, colored bool)
 
 // renderAWSCostOutput writes the cost audit report to w.
 // JSON mode is checked first so it takes priority over --summary.
-func renderAWSCostOutput(w io.Writer, report *models.AuditReport, outputFmt string, summary bool, colored bool, allProfiles bool) error {
+// ro.quiet suppresses the banner line in table mode. ro.currency converts
+// savings in the banner, table, and summary; JSON always stays in USD.
+func renderAWSCostOutput(w io.Writer, report *models.AuditReport, outputFmt string, ro renderOptions) error {
 	if outputFmt == "json" {
-		return encodeJSON(w, report)
+		return encodeJSON(w, report, false)
 	}
-	if summary {
-		printSummary(w, report)
+	if ro.summary {
+		printSummaryWithCurrency(w, report, ro.rankBy, ro.topN, ro.currency)
 		return nil
 	}
-	s := report.Summary
-	fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d  Est. Savings: $%.2f/mo\n",
-		report.Profile, report.AccountID, len(report.Regions), s.TotalFindings, s.TotalEstimatedMonthlySavings)
-	if len(report.Findings) > 0 {
-		fmt.Fprintln(w)
+	if !ro.quiet {
+		s := report.Summary
+		fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d  Est. Savings: %s/mo\n",
+			report.Profile, report.AccountID, len(report.Regions), s.TotalFindings, ro.currency.Format(s.TotalEstimatedMonthlySavings))
+		renderErrorWarning(w, report)
+		if len(report.Findings) > 0 {
+			fmt.Fprintln(w)
+		}
+	}
+	if ro.histogram {
+		renderHistogram(w, report)
 	}
 	dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
-		Colored:        colored,
+		Colored:        ro.colored,
 		IncludeSavings: true,
 		IncludeDomain:  false,
-		IncludeProfile: allProfiles,
+		IncludeProfile: ro.allProfiles,
 		LocationLabel:  "REGION",
+		Currency:       ro.currency,
+		Columns:        ro.columns,
 	})
+	renderPassedSection(w, report, "REGION")
 	return nil
 }
 
 // renderAWSSecurityOutput writes the security audit report to w.
 // JSON mode is checked first so it takes priority over --summary.
-func renderAWSSecurityOutput(w io.Writer, report *models.AuditReport, outputFmt string, summary bool, colored bool, allProfiles bool) error {
+// ro.quiet suppresses the banner line in table mode.
+func renderAWSSecurityOutput(w io.Writer, report *models.AuditReport, outputFmt string, ro renderOptions) error {
 	if outputFmt == "json" {
-		return encodeJSON(w, report)
+		return encodeJSON(w, report, false)
 	}
-	if summary {
-		printSummary(w, report)
+	if ro.summary {
+		printSummary(w, report, ro.rankBy, ro.topN)
 		return nil
 	}
-	s := report.Summary
-	fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d\n",
-		report.Profile, report.AccountID, len(report.Regions), s.TotalFindings)
-	if len(report.Findings) > 0 {
-		fmt.Fprintln(w)
+	if !ro.quiet {
+		s := report.Summary
+		fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d\n",
+			report.Profile, report.AccountID, len(report.Regions), s.TotalFindings)
+		renderErrorWarning(w, report)
+		if len(report.Findings) > 0 {
+			fmt.Fprintln(w)
+		}
+	}
+	if ro.histogram {
+		renderHistogram(w, report)
 	}
 	dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
-		Colored:        colored,
+		Colored:        ro.colored,
 		IncludeSavings: false,
 		IncludeDomain:  false,
-		IncludeProfile: allProfiles,
+		IncludeProfile: ro.allProfiles,
 		LocationLabel:  "REGION",
+		Columns:        ro.columns,
 	})
+	renderPassedSection(w, report, "REGION")
 	return nil
 }
 
 // renderAWSDataProtectionOutput writes the data-protection audit report to w.
 // JSON mode is checked first so it takes priority over --summary.
-func renderAWSDataProtectionOutput(w io.Writer, report *models.AuditReport, outputFmt string, summary bool, colored bool, allProfiles bool) error {
+// ro.quiet suppresses the banner line in table mode.
+func renderAWSDataProtectionOutput(w io.Writer, report *models.AuditReport, outputFmt string, ro renderOptions) error {
 	if outputFmt == "json" {
-		return encodeJSON(w, report)
+		return encodeJSON(w, report, false)
 	}
-	if summary {
-		printSummary(w, report)
+	if ro.summary {
+		printSummary(w, report, ro.rankBy, ro.topN)
 		return nil
 	}
-	s := report.Summary
-	fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d\n",
-		report.Profile, report.AccountID, len(report.Regions), s.TotalFindings)
-	if len(report.Findings) > 0 {
-		fmt.Fprintln(w)
+	if !ro.quiet {
+		s := report.Summary
+		fmt.Fprintf(w, "Profile: %-20s  Account: %-14s  Regions: %d  Findings: %d\n",
+			report.Profile, report.AccountID, len(report.Regions), s.TotalFindings)
+		renderErrorWarning(w, report)
+		if len(report.Findings) > 0 {
+			fmt.Fprintln(w)
+		}
+	}
+	if ro.histogram {
+		renderHistogram(w, report)
 	}
 	dpoutput.RenderTable(w, report.Findings, dpoutput.TableOptions{
-		Colored:        colored,
+		Colored:        ro.colored,
 		IncludeSavings: false,
 		IncludeDomain:  false,
-		IncludeProfile: allProfiles,
+		IncludeProfile: ro.allProfiles,
 		LocationLabel:  "REGION",
+		Columns:        ro.columns,
 	})
+	renderPassedSection(w, report, "REGION")
 	return nil
 }
 
-// writeReportToFile serialises report as indented JSON and writes it to path,
-// creating or overwriting the file. It does not affect stdout output.
-func writeReportToFile(path string, report *models.AuditReport) error {
-	data, err := json.MarshalIndent(report, "", "  ")
+// renderHistogram prints the --histogram severity bar for report, scaled to
+// terminalWidth.
+func renderHistogram(w io.Writer, report *models.AuditReport) {
+	dpoutput.RenderHistogram(w, report.Summary, terminalWidth())
+}
+
+// terminalWidth returns the width of the terminal in columns, read from the
+// COLUMNS environment variable that interactive shells export. It falls back
+// to defaultTerminalWidth when COLUMNS is unset or invalid.
+func terminalWidth() int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	return defaultTerminalWidth
+}
+
+// renderErrorWarning prints the number of collector and rule failures recorded
+// in report.Errors under the table banner. It is a no-op for a clean audit.
+func renderErrorWarning(w io.Writer, report *models.AuditReport) {
+	if len(report.Errors) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Warnings: %d collector/rule error(s); results are partial (see \"errors\" in JSON output)\n",
+		len(report.Errors))
+}
+
+// renderPassedSection appends the --show-passed "Passed" table to the findings
+// table output. It is a no-op when the report has no passed resources.
+func renderPassedSection(w io.Writer, report *models.AuditReport, locationLabel string) {
+	if len(report.PassedResources) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	dpoutput.RenderPassed(w, report.PassedResources, locationLabel)
+}
+
+// renderImageSection appends the --image-inventory "Images" table recorded in
+// report.Metadata["images"]. It is a no-op when no inventory was recorded.
+func renderImageSection(w io.Writer, report *models.AuditReport) {
+	images, _ := report.Metadata["images"].([]models.KubernetesImage)
+	if len(images) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	dpoutput.RenderImages(w, images)
+}
+
+// writeReportToFile serialises report as JSON (indented unless compact is
+// true) and writes it to path, creating or overwriting the file. A signed
+// report also gets a sidecar path+".sig" holding the base64 signature. It does
+// not affect stdout output.
+func writeReportToFile(path string, report *models.AuditReport, compact bool) error {
+	var data []byte
+	var err error
+	if compact {
+		data, err = json.Marshal(report)
+	} else {
+		data, err = json.MarshalIndent(report, "", "  ")
+	}
 	if err != nil {
 		return fmt.Errorf("marshal report: %w", err)
 	}
 	if err := os.WriteFile(path, data, 0o644); err != nil {
 		return fmt.Errorf("write report file %q: %w", path, err)
 	}
+	if report.Signature != "" {
+		sigPath := path + ".sig"
+		if err := os.WriteFile(sigPath, []byte(report.Signature+"\n"), 0o644); err != nil {
+			return fmt.Errorf("write signature file %q: %w", sigPath, err)
+		}
+	}
 	return nil
 }
 
+// signReportWithKey signs report with the ed25519 key at keyPath (--sign-key).
+// It is a no-op when keyPath is empty. Call it after setExitCode so the
+// signature covers the final report.
+func signReportWithKey(report *models.AuditReport, keyPath string) error {
+	if keyPath == "" {
+		return nil
+	}
+	key, err := engine.LoadSigningKey(keyPath)
+	if err != nil {
+		return err
+	}
+	return engine.SignReport(report, key)
+}
+
 // printSummary renders a compact summary view to w:
 //   - Account / profile / region header
-//   - Total findings and total estimated monthly savings
+//   - Overall risk grade (A–F), total findings and total estimated monthly savings
 //   - Per-severity finding counts
-//   - Top 5 findings ranked by EstimatedMonthlySavings
+//   - Per-domain risk scores (all-domains AWS audit only)
+//   - Per-framework compliance pass/fail counts (when any rule is mapped)
+//   - Top findings ranked by rankBy; topN sets the count (see summaryTopN)
 //
 // It reuses the already-computed AuditReport; no engine logic is duplicated.
-func printSummary(w io.Writer, report *models.AuditReport) {
+// Savings are printed in US dollars; see printSummaryWithCurrency.
+func printSummary(w io.Writer, report *models.AuditReport, rankBy string, topN int) {
+	printSummaryWithCurrency(w, report, rankBy, topN, dpoutput.Currency{})
+}
+
+// printSummaryWithCurrency is printSummary with savings converted and
+// formatted by currency (--currency / --fx-rate).
+func printSummaryWithCurrency(w io.Writer, report *models.AuditReport, rankBy string, topN int, currency dpoutput.Currency) {
 	s := report.Summary
 
 	fmt.Fprintf(w, "Account:  %s\n", report.AccountID)
 	fmt.Fprintf(w, "Profile:  %s\n", report.Profile)
 	fmt.Fprintf(w, "Regions:  %d\n", len(report.Regions))
+	if n := len(report.Errors); n > 0 {
+		fmt.Fprintf(w, "Warnings: %d\n", n)
+	}
 	fmt.Fprintln(w)
+	if s.RiskGrade != "" {
+		fmt.Fprintf(w, "Risk Grade:            %s\n", s.RiskGrade)
+	}
 	fmt.Fprintf(w, "Total Findings:        %d\n", s.TotalFindings)
-	fmt.Fprintf(w, "Est. Monthly Savings:  $%.2f\n", s.TotalEstimatedMonthlySavings)
+	fmt.Fprintf(w, "Est. Monthly Savings:  %s\n", currency.Format(s.TotalEstimatedMonthlySavings))
 	fmt.Fprintln(w)
 	fmt.Fprintln(w, "Severity Breakdown")
 	fmt.Fprintf(w, "  %-10s  %d\n", "CRITICAL", s.CriticalFindings)
@@ -706,36 +1193,194 @@ func printSummary(w io.Writer, report *models.AuditReport) {
 	fmt.Fprintf(w, "  %-10s  %d\n", "MEDIUM", s.MediumFindings)
 	fmt.Fprintf(w, "  %-10s  %d\n", "LOW", s.LowFindings)
 
-	top := topFindingsBySavings(report.Findings, 5)
+	if len(s.DomainRiskScores) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Domain Risk Scores")
+		domains := make([]string, 0, len(s.DomainRiskScores))
+		for d := range s.DomainRiskScores {
+			domains = append(domains, d)
+		}
+		sort.Strings(domains)
+		for _, d := range domains {
+			fmt.Fprintf(w, "  %-14s  %d\n", d, s.DomainRiskScores[d])
+		}
+	}
+
+	if len(s.Compliance) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Compliance")
+		fmt.Fprintf(w, "  %-10s  %-6s  %s\n", "FRAMEWORK", "PASSED", "FAILED")
+		for _, c := range s.Compliance {
+			fmt.Fprintf(w, "  %-10s  %-6d  %d\n", c.Framework, c.RulesPassed, c.RulesFailed)
+		}
+	}
+
+	printTeamRisks(w, s.TeamRisks)
+
+	top := topFindings(report.Findings, summaryTopN(topN), rankBy)
 	if len(top) == 0 {
 		return
 	}
 
 	fmt.Fprintln(w)
-	fmt.Fprintln(w, "Top Findings by Savings")
+	fmt.Fprintln(w, rankTitles[rankBy])
 	fmt.Fprintf(w, "  %-42s  %-15s  %-10s  %s\n", "RESOURCE ID", "REGION", "SEVERITY", "SAVINGS/MO")
 	fmt.Fprintf(w, "  %s\n", strings.Repeat("-", 82))
 	for _, f := range top {
-		fmt.Fprintf(w, "  %-42s  %-15s  %-10s  $%.2f\n",
-			f.ResourceID, f.Region, string(f.Severity), f.EstimatedMonthlySavings)
+		fmt.Fprintf(w, "  %-42s  %-15s  %-10s  %s\n",
+			f.ResourceID, f.Region, string(f.Severity), currency.Format(f.EstimatedMonthlySavings))
 	}
 }
 
-// topFindingsBySavings returns up to n findings from the provided slice,
-// ordered by EstimatedMonthlySavings descending.
-// The original slice is not modified.
-func topFindingsBySavings(findings []models.Finding, n int) []models.Finding {
+// printTeamRisks prints one section per team from --format-findings-by owner:
+// the namespaces it owns and its severity counts. Nothing is printed when
+// risks is empty.
+func printTeamRisks(w io.Writer, risks []models.TeamRisk) {
+	if len(risks) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Team Risk")
+	for _, tr := range risks {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "  Team: %s\n", tr.Team)
+		if len(tr.Namespaces) > 0 {
+			fmt.Fprintf(w, "    Namespaces:  %s\n", strings.Join(tr.Namespaces, ", "))
+		}
+		fmt.Fprintf(w, "    Findings:    %d (CRITICAL %d, HIGH %d, MEDIUM %d, LOW %d)\n",
+			tr.TotalFindings, tr.CriticalFindings, tr.HighFindings, tr.MediumFindings, tr.LowFindings)
+	}
+}
+
+// defaultTopFindings is the number of findings in the --summary Top Findings
+// table when --top is not set.
+const defaultTopFindings = 5
+
+// defaultTerminalWidth is the --histogram line width when COLUMNS is unset.
+const defaultTerminalWidth = 80
+
+// summaryTopN returns the Top Findings table size for --top n. Zero and
+// negative values fall back to defaultTopFindings.
+func summaryTopN(n int) int {
+	if n < 1 {
+		return defaultTopFindings
+	}
+	return n
+}
+
+// Top Findings ranking modes accepted by --rank-by.
+const (
+	rankBySavings  = "savings"
+	rankBySeverity = "severity"
+	rankByRisk     = "risk"
+)
+
+// rankTitles is the Top Findings heading printed for each ranking mode.
+var rankTitles = map[string]string{
+	rankBySavings:  "Top Findings by Savings",
+	rankBySeverity: "Top Findings by Severity",
+	rankByRisk:     "Top Findings by Risk",
+}
+
+// rankComparators maps each ranking mode to a "less" function that orders
+// findings from most to least important under that mode.
+var rankComparators = map[string]func(a, b models.Finding) bool{
+	rankBySavings:  lessBySavings,
+	rankBySeverity: lessBySeverity,
+	rankByRisk:     lessByRisk,
+}
+
+// validateRankBy returns an error when rankBy is not a known ranking mode.
+func validateRankBy(rankBy string) error {
+	if _, ok := rankComparators[rankBy]; !ok {
+		return fmt.Errorf("invalid --rank-by %q: must be savings, severity, or risk", rankBy)
+	}
+	return nil
+}
+
+// findingsGroupingOwner is the --format-findings-by value that groups
+// Kubernetes findings by the team owning their namespace.
+const findingsGroupingOwner = "owner"
+
+// validateFormatFindingsBy rejects --format-findings-by values other than
+// empty (no grouping) and "owner".
+func validateFormatFindingsBy(groupBy string) error {
+	if groupBy != "" && groupBy != findingsGroupingOwner {
+		return fmt.Errorf("invalid --format-findings-by %q: must be owner", groupBy)
+	}
+	return nil
+}
+
+// summarySeverityRank orders severities for ranking; higher is more severe.
+var summarySeverityRank = map[models.Severity]int{
+	models.SeverityCritical: 4,
+	models.SeverityHigh:     3,
+	models.SeverityMedium:   2,
+	models.SeverityLow:      1,
+}
+
+// lessBySavings ranks by EstimatedMonthlySavings descending.
+func lessBySavings(a, b models.Finding) bool {
+	return a.EstimatedMonthlySavings > b.EstimatedMonthlySavings
+}
+
+// lessBySeverity ranks by severity descending, breaking ties by savings.
+func lessBySeverity(a, b models.Finding) bool {
+	ra, rb := summarySeverityRank[a.Severity], summarySeverityRank[b.Severity]
+	if ra != rb {
+		return ra > rb
+	}
+	return lessBySavings(a, b)
+}
+
+// lessByRisk ranks by Metadata["risk_chain_score"] descending, breaking ties
+// by severity then savings. Findings outside any risk chain score 0, so for
+// AWS reports this mode orders exactly like severity.
+func lessByRisk(a, b models.Finding) bool {
+	sa, _ := metadataInt(a, "risk_chain_score")
+	sb, _ := metadataInt(b, "risk_chain_score")
+	if sa != sb {
+		return sa > sb
+	}
+	return lessBySeverity(a, b)
+}
+
+// metadataInt returns the integer stored under key in f.Metadata. Engines
+// store scores as int, but a report decoded from JSON (dp render, dp diff)
+// carries them as float64, so both are accepted.
+func metadataInt(f models.Finding, key string) (int, bool) {
+	switch v := f.Metadata[key].(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	}
+	return 0, false
+}
+
+// topFindings returns up to n findings ordered by the rankBy comparator.
+// Unknown modes fall back to savings. The original slice is not modified.
+func topFindings(findings []models.Finding, n int, rankBy string) []models.Finding {
+	less, ok := rankComparators[rankBy]
+	if !ok {
+		less = lessBySavings
+	}
 	sorted := make([]models.Finding, len(findings))
 	copy(sorted, findings)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].EstimatedMonthlySavings > sorted[j].EstimatedMonthlySavings
-	})
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
 	if n > len(sorted) {
 		n = len(sorted)
 	}
 	return sorted[:n]
 }
 
+// topFindingsBySavings returns up to n findings from the provided slice,
+// ordered by EstimatedMonthlySavings descending.
+// The original slice is not modified.
+func topFindingsBySavings(findings []models.Finding, n int) []models.Finding {
+	return topFindings(findings, n, rankBySavings)
+}
+
 // ── policy commands ───────────────────────────────────────────────────────────
 
 func newPolicyCmd() *cobra.Command {
@@ -744,9 +1389,34 @@ func newPolicyCmd() *cobra.Command {
 		Short: "Policy management commands",
 	}
 	cmd.AddCommand(newPolicyValidateCmd())
+	cmd.AddCommand(newPolicyInitCmd())
 	return cmd
 }
 
+// allRuleIDs collects the IDs of every rule in every registered pack.
+func allRuleIDs() []string {
+	var ruleIDs []string
+	for _, r := range costpack.New() {
+		ruleIDs = append(ruleIDs, r.ID())
+	}
+	for _, r := range secpack.New() {
+		ruleIDs = append(ruleIDs, r.ID())
+	}
+	for _, r := range dppack.New() {
+		ruleIDs = append(ruleIDs, r.ID())
+	}
+	for _, r := range k8scorepack.New(nil) {
+		ruleIDs = append(ruleIDs, r.ID())
+	}
+	for _, r := range k8sekpack.New() {
+		ruleIDs = append(ruleIDs, r.ID())
+	}
+	for _, r := range azurecostpack.New() {
+		ruleIDs = append(ruleIDs, r.ID())
+	}
+	return ruleIDs
+}
+
 func newPolicyValidateCmd() *cobra.Command {
 	var policyPath string
 
@@ -763,25 +1433,7 @@ func newPolicyValidateCmd() *cobra.Command {
 				return fmt.Errorf("no policy file found at %q", policyPath)
 			}
 
-			// Collect all known rule IDs from every registered pack.
-			var ruleIDs []string
-			for _, r := range costpack.New() {
-				ruleIDs = append(ruleIDs, r.ID())
-			}
-			for _, r := range secpack.New() {
-				ruleIDs = append(ruleIDs, r.ID())
-			}
-			for _, r := range dppack.New() {
-				ruleIDs = append(ruleIDs, r.ID())
-			}
-			for _, r := range k8scorepack.New() {
-				ruleIDs = append(ruleIDs, r.ID())
-			}
-			for _, r := range k8sekpack.New() {
-				ruleIDs = append(ruleIDs, r.ID())
-			}
-
-			errs := policy.Validate(cfg, ruleIDs)
+			errs := policy.Validate(cfg, allRuleIDs())
 			if len(errs) > 0 {
 				for _, e := range errs {
 					fmt.Println(e)
@@ -800,6 +1452,94 @@ func newPolicyValidateCmd() *cobra.Command {
 	return cmd
 }
 
+// policyInitTemplate is the commented dp.yaml scaffold written by
+// `dp policy init`. Every key it sets must pass policy.Validate.
+const policyInitTemplate = `# dp.yaml — DevOps Proxy policy file
+# Validate changes with: dp policy validate --policy dp.yaml
+version: 1
+
+# Per-domain settings. Valid domains: cost, security, dataprotection, kubernetes.
+domains:
+  cost:
+    enabled: true
+    # Drop findings whose final severity is below MEDIUM.
+    # min_severity: MEDIUM
+  security:
+    enabled: true
+  dataprotection:
+    enabled: true
+  kubernetes:
+    enabled: true
+
+# Per-rule overrides keyed by rule ID.
+rules:
+  # Disable a rule entirely.
+  # EC2_LOW_CPU:
+  #   enabled: false
+  # Escalate a rule's severity.
+  # SG_OPEN_SSH:
+  #   severity: CRITICAL
+  # Tune a rule threshold.
+  # NAT_LOW_TRAFFIC:
+  #   params:
+  #     traffic_gb_threshold: 2.0
+
+# Suppress Kubernetes findings in system namespaces (kube-system, kube-public,
+# kube-node-lease) on every run, as if --exclude-system were passed.
+# kubernetes:
+#   exclude_system_default: true
+
+# CI enforcement: exit with an error when any finding in the domain is at or
+# above fail_on_severity.
+enforcement:
+  cost:
+    fail_on_severity: HIGH
+  security:
+    fail_on_severity: HIGH
+  dataprotection:
+    fail_on_severity: HIGH
+  kubernetes:
+    fail_on_severity: CRITICAL
+`
+
+func newPolicyInitCmd() *cobra.Command {
+	var (
+		outputPath string
+		force      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:          "init",
+		Short:        "Write a commented dp.yaml policy template",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPolicyInit(outputPath, force, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&outputPath, "output", "./dp.yaml", "Path to write the policy template to")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite the file if it already exists")
+
+	return cmd
+}
+
+// runPolicyInit writes policyInitTemplate to path. It refuses to replace an
+// existing file unless force is true.
+func runPolicyInit(path string, force bool, w io.Writer) error {
+	if !force {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists; use --force to overwrite", path)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("stat %s: %w", path, err)
+		}
+	}
+	if err := os.WriteFile(path, []byte(policyInitTemplate), 0o644); err != nil {
+		return fmt.Errorf("write policy template: %w", err)
+	}
+	fmt.Fprintf(w, "Policy template written to %s\n", path)
+	return nil
+}
+
 // ── kubernetes commands ───────────────────────────────────────────────────────
 
 func newKubernetesCmd() *cobra.Command {
@@ -813,18 +1553,22 @@ func newKubernetesCmd() *cobra.Command {
 }
 
 func newInspectCmd() *cobra.Command {
-	var contextName string
+	var (
+		contextName string
+		kubeconfig  string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "inspect",
 		Short: "Inspect a Kubernetes cluster: context, API server, node count, namespace count",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			provider := kube.NewDefaultKubeClientProvider()
+			provider := kube.NewKubeClientProviderFromPath(kubeconfig)
 			return runKubernetesInspect(cmd.Context(), provider, contextName, os.Stdout)
 		},
 	}
 
 	cmd.Flags().StringVar(&contextName, "context", "", "Kubeconfig context to use (default: current context)")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file (default: $KUBECONFIG or ~/.kube/config)")
 
 	return cmd
 }
@@ -854,6 +1598,56 @@ func printClusterInspect(w io.Writer, data *kube.ClusterData) {
 	fmt.Fprintf(w, "Namespaces:  %d\n", len(data.Namespaces))
 }
 
+// renderKubernetesExplanation writes the --explain-path, --explain-chain or
+// --explain-all view of report to w in place of the normal output. No policy
+// enforcement or exit-code logic applies.
+func renderKubernetesExplanation(w io.Writer, report *models.AuditReport, outputFmt string, explainScore, explainChain int, explainAll bool) error {
+	// explain-path mode: render a single attack path.
+	if explainScore > 0 {
+		path := dprender.FindPathByScore(report.Summary.AttackPaths, explainScore)
+		if outputFmt == "json" {
+			return dprender.WriteExplainJSON(w, path, explainScore)
+		}
+		if path == nil {
+			fmt.Fprintf(w, "No attack path found with score %d\n", explainScore)
+			return nil
+		}
+		dprender.RenderAttackPathExplanation(w, *path, report.Findings)
+		return nil
+	}
+
+	// explain-chain mode: render the risk chain(s) with this score.
+	if explainChain > 0 {
+		chains := dprender.FindChainsByScore(report.Summary.RiskChains, explainChain)
+		if outputFmt == "json" {
+			if err := dprender.WriteExplainChainJSON(w, chains, explainChain); err != nil {
+				return err
+			}
+		}
+		if len(chains) == 0 {
+			return fmt.Errorf("no risk chain found with score %d", explainChain)
+		}
+		if outputFmt == "json" {
+			return nil
+		}
+		for i, c := range chains {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			dprender.RenderRiskChainExplanation(w, c, report.Findings)
+		}
+		return nil
+	}
+
+	// explain-all mode: render every attack path and risk chain.
+	if outputFmt == "json" {
+		return dprender.WriteExplainAllJSON(w, report.Summary.AttackPaths, report.Summary.RiskChains)
+	}
+	dprender.RenderAllExplanations(w, report.Summary.AttackPaths, report.Summary.RiskChains, report.Findings)
+	return nil
+}
+
+
 // validateExplainFlags returns an error when --explain-path is set without
 // --show-risk-chains. Attack paths are only computed when ShowRiskChains is
 // enabled, so --explain-path requires it as a prerequisite.
@@ -864,19 +1658,110 @@ func validateExplainFlags(explainScore int, showRiskChains bool) error {
 	return nil
 }
 
+// validateOnlyChainsFlags returns an error when --only-chains is set without
+// --show-risk-chains, so the filtered findings are always shown alongside the
+// chains and attack paths they belong to.
+func validateOnlyChainsFlags(onlyChains, showRiskChains bool) error {
+	if onlyChains && !showRiskChains {
+		return fmt.Errorf("--only-chains requires --show-risk-chains")
+	}
+	return nil
+}
+
+// validateExplainChainFlags returns an error when --explain-chain is set
+// without --show-risk-chains. Risk chains are only grouped into the report
+// summary when ShowRiskChains is enabled.
+func validateExplainChainFlags(explainChain int, showRiskChains bool) error {
+	if explainChain > 0 && !showRiskChains {
+		return fmt.Errorf("--explain-chain requires --show-risk-chains")
+	}
+	return nil
+}
+
+// validateExplainAllFlags returns an error when --explain-all is set without
+// --show-risk-chains, or together with --explain-path or --explain-chain,
+// which each select a single explanation.
+func validateExplainAllFlags(explainAll bool, explainScore, explainChain int, showRiskChains bool) error {
+	if !explainAll {
+		return nil
+	}
+	if !showRiskChains {
+		return fmt.Errorf("--explain-all requires --show-risk-chains")
+	}
+	if explainScore > 0 || explainChain > 0 {
+		return fmt.Errorf("--explain-all cannot be combined with --explain-path or --explain-chain")
+	}
+	return nil
+}
+
+// validateContextFlags returns an error when --context and --context-all are
+// both set; the two select clusters in mutually exclusive ways.
+func validateContextFlags(contextName string, contextAll bool) error {
+	if contextAll && contextName != "" {
+		return fmt.Errorf("--context and --context-all are mutually exclusive")
+	}
+	return nil
+}
+
+// resolveExcludeSystem decides whether system-namespace findings are dropped.
+// An explicit --exclude-system or --include-system wins; otherwise dp.yaml
+// kubernetes.exclude_system_default applies, and without it findings are kept.
+func resolveExcludeSystem(excludeSystem, includeSystem bool, policyCfg *policy.PolicyConfig) (bool, error) {
+	switch {
+	case excludeSystem && includeSystem:
+		return false, fmt.Errorf("--exclude-system and --include-system are mutually exclusive")
+	case excludeSystem:
+		return true, nil
+	case includeSystem:
+		return false, nil
+	}
+	return policyCfg != nil && policyCfg.Kubernetes.ExcludeSystemDefault, nil
+}
+
+// validateDiffContextFlags returns an error when --diff-context is combined
+// with --context-all or names the same context as --context.
+func validateDiffContextFlags(contextName, diffContext string, contextAll bool) error {
+	if diffContext == "" {
+		return nil
+	}
+	if contextAll {
+		return fmt.Errorf("--diff-context and --context-all are mutually exclusive")
+	}
+	if diffContext == contextName {
+		return fmt.Errorf("--diff-context %q must differ from --context", diffContext)
+	}
+	return nil
+}
+
 // newKubernetesAuditCmd implements dp kubernetes audit.
 func newKubernetesAuditCmd() *cobra.Command {
 	var (
-		contextName    string
-		outputFmt      string
-		summary        bool
-		filePath       string
-		policyPath     string
-		color          bool
-		excludeSystem  bool
-		minRiskScore   int
-		showRiskChains bool
-		explainScore   int
+		opts          auditOptions
+		contextName   string
+		kubeconfig    string
+		contextAll    bool
+		strictRoot    bool
+		diffContext   string
+		excludeSystem bool
+		includeSystem bool
+		systemNS      []string
+		minRiskScore  int
+		collapsePaths bool
+		explainScore  int
+		explainChain  int
+		explainAll    bool
+		since         time.Duration
+		showPassed    bool
+		onlyRules     []string
+		skipRules     []string
+		concurrency   int
+		annotate      bool
+		annotateKeys  []string
+		imageInv      bool
+		onlyChains    bool
+		watch         bool
+		watchInterval time.Duration
+		groupBy       string
 	)
 
 	cmd := &cobra.Command{
@@ -884,7 +1769,14 @@ func newKubernetesAuditCmd() *cobra.Command {
 		Short:        "Audit a Kubernetes cluster: single-node, overallocated nodes, namespaces without LimitRanges",
 		SilenceUsage: true, // business-outcome exits must not print usage
 		RunE: func(cmd *cobra.Command, args []string) error {
-			policyCfg, err := loadPolicyFile(policyPath)
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			if err := validateFormatFindingsBy(groupBy); err != nil {
+				return err
+			}
+			showRiskChains := opts.render.showRiskChains
+			policyCfg, err := loadPolicyFile(opts.policyPath)
 			if err != nil {
 				return fmt.Errorf("load policy: %w", err)
 			}
@@ -892,19 +1784,43 @@ func newKubernetesAuditCmd() *cobra.Command {
 			if err := validateExplainFlags(explainScore, showRiskChains); err != nil {
 				return err
 			}
-
-			provider := kube.NewDefaultKubeClientProvider()
-
-			coreRegistry := rules.NewDefaultRuleRegistry()
-			for _, r := range k8scorepack.New() {
-				coreRegistry.Register(r)
+			if err := validateExplainChainFlags(explainChain, showRiskChains); err != nil {
+				return err
+			}
+			if err := validateOnlyChainsFlags(onlyChains, showRiskChains); err != nil {
+				return err
+			}
+			if err := validateExplainAllFlags(explainAll, explainScore, explainChain, showRiskChains); err != nil {
+				return err
+			}
+			if err := validateContextFlags(contextName, contextAll); err != nil {
+				return err
+			}
+			excludeSystem, err = resolveExcludeSystem(excludeSystem, includeSystem, policyCfg)
+			if err != nil {
+				return err
+			}
+			if err := validateDiffContextFlags(contextName, diffContext, contextAll); err != nil {
+				return err
+			}
+			explain := explainScore > 0 || explainChain > 0 || explainAll
+			if len(opts.formats) > 1 && (diffContext != "" || explain) {
+				return fmt.Errorf("multiple --output formats cannot be combined with --diff-context or the --explain-* flags")
+			}
+			if err := validateWatchFlags(watch, watchInterval, diffContext, explain, opts.filePath, opts.outputTemplate, opts.signKey); err != nil {
+				return err
+			}
+			if concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1, got %d", concurrency)
 			}
 
-			eksRegistry := rules.NewDefaultRuleRegistry()
-			for _, r := range k8sekpack.New() {
-				eksRegistry.Register(r)
+			coreRegistry, eksRegistry, err := kubernetesRegistries(policyCfg, k8scorepack.Options{StrictRoot: strictRoot}, onlyRules, skipRules)
+			if err != nil {
+				return err
 			}
 
+			provider := kube.NewKubeClientProviderFromPath(kubeconfig)
+
 			eng := engine.NewKubernetesEngineWithEKS(
 				provider,
 				coreRegistry,
@@ -913,68 +1829,312 @@ func newKubernetesAuditCmd() *cobra.Command {
 				policyCfg,
 			)
 
-			opts := engine.KubernetesAuditOptions{
-				ContextName:    contextName,
-				ReportFormat:   engine.ReportFormat(outputFmt),
-				ExcludeSystem:  excludeSystem,
-				MinRiskScore:   minRiskScore,
-				ShowRiskChains: showRiskChains,
+			auditOpts := engine.KubernetesAuditOptions{
+				ContextName:      contextName,
+				ReportFormat:     engine.ReportFormat(opts.outputFmt),
+				ExcludeSystem:    excludeSystem,
+				SystemNamespaces: systemNS,
+				MinRiskScore:     minRiskScore,
+				ShowRiskChains:   showRiskChains,
+				CollapsePaths:    collapsePaths,
+				Since:            since,
+				Timings:          opts.timings,
+				ShowPassed:       showPassed,
+				Concurrency:      concurrency,
+				AnnotateFindings: annotate,
+				AnnotateKeys:     annotateKeys,
+				ImageInventory:   imageInv,
+				GroupByOwner:     groupBy == findingsGroupingOwner,
 			}
 
-			report, err := eng.RunAudit(cmd.Context(), opts)
-			if err != nil {
-				return fmt.Errorf("kubernetes audit failed: %w", err)
-			}
-
-			if filePath != "" {
-				if err := writeReportToFile(filePath, report); err != nil {
-					return err
+			// watch mode: re-audit every --interval and print what changed
+			// until Ctrl-C. No policy enforcement, no exit-code-1 logic.
+			if watch {
+				ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+				defer stop()
+				ticker := time.NewTicker(watchInterval)
+				defer ticker.Stop()
+				audit := func(ctx context.Context) (*models.AuditReport, error) {
+					var report *models.AuditReport
+					var err error
+					if contextAll {
+						report, err = eng.RunAuditAllContexts(ctx, auditOpts)
+					} else {
+						report, err = eng.RunAudit(ctx, auditOpts)
+					}
+					if err != nil {
+						return nil, err
+					}
+					if err := applyFindingState(report, opts.statePath, opts.maxFindingAge, policyCfg); err != nil {
+						return nil, err
+					}
+					if onlyChains {
+						report.Findings = engine.FilterChainedFindings(report.Findings)
+					}
+					sortReportFindings(report, opts.sortBy)
+					return report, nil
+				}
+				render := func(w io.Writer, report *models.AuditReport) error {
+					return renderKubernetesAuditOutput(w, report, opts.outputFmt, opts.render)
 				}
+				return runKubernetesWatch(ctx, ticker.C, audit, render, opts.outputFmt, os.Stdout, os.Stderr)
 			}
 
-			// explain-path mode: render a single attack path and exit early.
+			// diff mode: audit both contexts and print only the differences.
 			// No normal table, no policy enforcement, no exit-code-1 logic.
-			if explainScore > 0 {
-				path := dprender.FindPathByScore(report.Summary.AttackPaths, explainScore)
-				if outputFmt == "json" {
-					return dprender.WriteExplainJSON(os.Stdout, path, explainScore)
+			if diffContext != "" {
+				diff, err := eng.RunContextDiff(cmd.Context(), auditOpts, diffContext)
+				if err != nil {
+					return fmt.Errorf("kubernetes audit failed: %w", err)
 				}
-				if path == nil {
-					fmt.Fprintf(os.Stdout, "No attack path found with score %d\n", explainScore)
-					return nil
-				}
-				dprender.RenderAttackPathExplanation(os.Stdout, *path, report.Findings)
-				return nil
+				return renderContextDiff(os.Stdout, diff, opts.outputFmt)
 			}
 
-			if err := renderKubernetesAuditOutput(os.Stdout, report, outputFmt, summary, color, showRiskChains); err != nil {
-				return err
+			var report *models.AuditReport
+			if contextAll {
+				report, err = eng.RunAuditAllContexts(cmd.Context(), auditOpts)
+			} else {
+				report, err = eng.RunAudit(cmd.Context(), auditOpts)
+			}
+			if err != nil {
+				return fmt.Errorf("kubernetes audit failed: %w", err)
+			}
+			if skipped, ok := report.Metadata["unreachable_contexts"].([]string); ok {
+				fmt.Fprintf(os.Stderr, "skipped unreachable contexts: %s\n", strings.Join(skipped, ", "))
 			}
 
-			if policy.ShouldFail("kubernetes", report.Findings, policyCfg) {
-				return fmt.Errorf("policy enforcement triggered: findings at or above configured fail_on_severity")
+			post := postAudit{
+				settle: func(report *models.AuditReport) auditExitStatus {
+					// The explain modes never fail the process, so their
+					// reports keep ExitCode 0.
+					var status auditExitStatus
+					if !explain {
+						status = domainExitStatus(report, "kubernetes", policy.ShouldFailKubernetes(report.Findings, policyCfg))
+					}
+					// --only-chains narrows the emitted findings only after
+					// enforcement and the exit code are settled; the summary
+					// keeps the totals.
+					if onlyChains {
+						report.Findings = engine.FilterChainedFindings(report.Findings)
+					}
+					return status
+				},
+				render: renderKubernetesAuditOutput,
 			}
-			if hasCriticalOrHighFindings(report.Findings) {
-				if outputFmt != "json" {
-					fmt.Fprintln(os.Stderr, "audit completed with CRITICAL or HIGH findings")
+			if explain {
+				post.explain = func(w io.Writer, report *models.AuditReport) error {
+					return renderKubernetesExplanation(w, report, opts.outputFmt, explainScore, explainChain, explainAll)
 				}
-				os.Exit(1)
 			}
-			return nil
+			return finishAudit(cmd, &opts, report, policyCfg, post)
 		},
 	}
 
 	cmd.Flags().StringVar(&contextName, "context", "", "Kubeconfig context to use (default: current context)")
-	cmd.Flags().StringVar(&outputFmt, "output", "table", "Output format: json or table")
-	cmd.Flags().BoolVar(&summary, "summary", false, "Print compact summary: totals, severity breakdown, top-5 findings")
-	cmd.Flags().StringVar(&filePath, "file", "", "Write full JSON report to this file path (in addition to stdout output)")
-	cmd.Flags().StringVar(&policyPath, "policy", "", "Path to dp.yaml policy file (auto-detected if omitted and ./dp.yaml exists)")
-	cmd.Flags().BoolVar(&color, "color", false, "Enable colored severity output in table format (not CI-safe)")
-	cmd.Flags().BoolVar(&excludeSystem, "exclude-system", false, "Exclude findings from system namespaces (kube-system, kube-public, kube-node-lease)")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file (default: $KUBECONFIG or ~/.kube/config)")
+	addAuditFlags(cmd, &opts, "Profile:/Context:")
+	cmd.Flags().BoolVar(&contextAll, "context-all", false, "Audit every kubeconfig context and merge the results (unreachable contexts are skipped)")
+	cmd.Flags().StringVar(&diffContext, "diff-context", "", "Also audit this kubeconfig context and print only the findings present in one cluster but not the other")
+	cmd.Flags().StringVar(&groupBy, "format-findings-by", "", "Group findings by owner: tag each with metadata.team from dp.yaml namespace_owners, add summary.team_risks, and print per-team sections under --summary")
+	cmd.Flags().BoolVar(&excludeSystem, "exclude-system", false, "Exclude findings from system namespaces (kube-system, kube-public, kube-node-lease, or dp.yaml system_namespaces)")
+	cmd.Flags().BoolVar(&includeSystem, "include-system", false, "Keep system-namespace findings even when dp.yaml sets kubernetes.exclude_system_default: true")
+	cmd.Flags().StringArrayVar(&systemNS, "system-namespace", nil, "Treat this namespace as a system namespace for namespace_type and --exclude-system (repeatable; adds to the default or dp.yaml set)")
 	cmd.Flags().IntVar(&minRiskScore, "min-risk-score", 0, "Only include findings with a risk chain score >= this value (0 = include all)")
-	cmd.Flags().BoolVar(&showRiskChains, "show-risk-chains", false, "Group findings by risk chain in table output; add risk_chains to JSON output")
+	cmd.Flags().BoolVar(&opts.render.showRiskChains, "show-risk-chains", false, "Group findings by risk chain in table output; add risk_chains to JSON output")
+	cmd.Flags().BoolVar(&collapsePaths, "collapse-paths", false, "Merge identical attack paths from different namespaces into one entry listing the namespaces")
 	cmd.Flags().IntVar(&explainScore, "explain-path", 0, "Print structured breakdown of the attack path with this score (requires --show-risk-chains)")
+	cmd.Flags().IntVar(&explainChain, "explain-chain", 0, "Print the reason and findings of the risk chain with this score (requires --show-risk-chains)")
+	cmd.Flags().BoolVar(&explainAll, "explain-all", false, "Print the breakdown of every attack path and risk chain in the report (requires --show-risk-chains)")
+	cmd.Flags().DurationVar(&since, "since", 0, "Only include pod, service, ingress, and service-account findings for resources created within this duration (e.g. 24h; 0 = no filter)")
+	cmd.Flags().BoolVar(&showPassed, "show-passed", false, "List cluster resources that produced no findings in a Passed section (table) or passed_resources (JSON)")
+	cmd.Flags().BoolVar(&annotate, "annotate-findings", false, "Copy the labels (nodes, namespaces, pods) or annotations (Services, ServiceAccounts) of each finding's resource into metadata.resource_tags")
+	cmd.Flags().StringSliceVar(&annotateKeys, "annotate-key", nil, "Label/annotation key glob copied by --annotate-findings (repeatable; default: all keys)")
+	cmd.Flags().BoolVar(&onlyChains, "only-chains", false, "Emit only findings that are part of a risk chain or attack path; the summary still counts every finding (requires --show-risk-chains)")
+	cmd.Flags().BoolVar(&watch, "watch", false, "Re-run the audit every --interval and print new and resolved findings until interrupted (JSON: one object per cycle)")
+	cmd.Flags().DurationVar(&watchInterval, "interval", time.Minute, "Time between --watch cycles")
+	cmd.Flags().BoolVar(&strictRoot, "strict-root", false, "Report K8S_POD_RUN_AS_ROOT for containers without runAsNonRoot (implicit root) at HIGH instead of MEDIUM")
+	cmd.Flags().BoolVar(&imageInv, "image-inventory", false, "Record distinct running container images with pod counts and namespaces under metadata.images (JSON) or an Images section (table)")
+	cmd.Flags().StringSliceVar(&onlyRules, "rules", nil, "Evaluate only these rule IDs (comma-separated)")
+	cmd.Flags().StringSliceVar(&skipRules, "skip-rules", nil, "Do not evaluate these rule IDs (comma-separated)")
+	cmd.Flags().IntVar(&concurrency, "concurrency", kube.DefaultCollectConcurrency, "Number of concurrent workers for per-namespace lookups and pod processing during collection")
 
 	return cmd
 }
 
+// kubernetesRegistries builds the core and EKS rule registries for
+// dp kubernetes audit. When only is non-empty just those rules are
+// registered; rules listed in skip are never registered. Every ID in only and
+// skip must name a rule from either pack. coreOpts carries the command-line
+// settings of the core rules (--strict-root).
+func kubernetesRegistries(policyCfg *policy.PolicyConfig, coreOpts k8scorepack.Options, only, skip []string) (core, eks *rules.DefaultRuleRegistry, err error) {
+	corePack := k8scorepack.NewWithOptions(policyCfg, coreOpts)
+	eksPack := k8sekpack.New()
+
+	known := make(map[string]struct{}, len(corePack)+len(eksPack))
+	for _, r := range corePack {
+		known[r.ID()] = struct{}{}
+	}
+	for _, r := range eksPack {
+		known[r.ID()] = struct{}{}
+	}
+	allow, err := ruleIDSet("--rules", only, known)
+	if err != nil {
+		return nil, nil, err
+	}
+	deny, err := ruleIDSet("--skip-rules", skip, known)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	selected := func(id string) bool {
+		if _, skipped := deny[id]; skipped {
+			return false
+		}
+		if len(allow) == 0 {
+			return true
+		}
+		_, ok := allow[id]
+		return ok
+	}
+
+	core = rules.NewDefaultRuleRegistry()
+	for _, r := range corePack {
+		if selected(r.ID()) {
+			core.Register(r)
+		}
+	}
+	eks = rules.NewDefaultRuleRegistry()
+	for _, r := range eksPack {
+		if selected(r.ID()) {
+			eks.Register(r)
+		}
+	}
+	return core, eks, nil
+}
+
+// ruleIDSet converts the rule IDs passed to flag into a set. Deprecated IDs
+// of renamed rules resolve to the current ID with a warning on stderr. It
+// returns an error listing every ID not present in known.
+func ruleIDSet(flag string, ids []string, known map[string]struct{}) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(ids))
+	var unknown []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if current := policy.CanonicalRuleID(id); current != id {
+			fmt.Fprintf(os.Stderr, "warning: %s: %s: rule ID is deprecated; use %s\n", flag, id, current)
+			id = current
+		}
+		if _, ok := known[id]; !ok {
+			unknown = append(unknown, id)
+			continue
+		}
+		set[id] = struct{}{}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%s: unknown rule ID(s): %s", flag, strings.Join(unknown, ", "))
+	}
+	return set, nil
+}
+
+
+// renderContextDiff writes the result of dp kubernetes audit --diff-context
+// to w. JSON output encodes the diff as-is; table output prints two columns,
+// the findings only in the primary context and those only in the other.
+func renderContextDiff(w io.Writer, diff engine.ReportDiff, outputFmt string) error {
+	if outputFmt == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diff)
+	}
+
+	left := diffColumn(diff.OnlyInA)
+	right := diffColumn(diff.OnlyInB)
+	headerA := "ONLY IN " + diff.A
+	headerB := "ONLY IN " + diff.B
+
+	width := len(headerA)
+	for _, l := range left {
+		width = max(width, len(l))
+	}
+	fmt.Fprintf(w, "%-*s  %s\n", width, headerA, headerB)
+	fmt.Fprintf(w, "%s  %s\n", strings.Repeat("-", width), strings.Repeat("-", max(len(headerB), 8)))
+	for i := 0; i < max(len(left), len(right)); i++ {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		fmt.Fprintln(w, strings.TrimRight(fmt.Sprintf("%-*s  %s", width, l, r), " "))
+	}
+	fmt.Fprintf(w, "\nOnly in %s: %d  Only in %s: %d\n", diff.A, len(diff.OnlyInA), diff.B, len(diff.OnlyInB))
+	return nil
+}
+
+// diffColumn formats diff entries as "RULE_ID namespace/resource" lines.
+// Cluster-level entries show "(cluster)"; an empty column shows "(none)".
+func diffColumn(entries []engine.DiffEntry) []string {
+	if len(entries) == 0 {
+		return []string{"(none)"}
+	}
+	lines := make([]string, 0, len(entries))
+	for _, e := range entries {
+		target := e.ResourceID
+		switch {
+		case target == "":
+			target = "(cluster)"
+		case e.Namespace != "":
+			target = e.Namespace + "/" + target
+		}
+		lines = append(lines, e.RuleID+" "+target)
+	}
+	return lines
+}
+
+// timingStages lists the Metadata["timings"] keys in the order printTimings
+// renders them.
+var timingStages = []struct{ key, label string }{
+	{"collection_ms", "Collection"},
+	{"evaluation_ms", "Rule evaluation"},
+	{"correlation_ms", "Correlation"},
+	{"total_ms", "Total"},
+}
+
+// printTimings writes the per-stage timing breakdown recorded in
+// report.Metadata["timings"] to w, skipping stages the audit did not record.
+// It is a no-op when no timings are present.
+func printTimings(w io.Writer, report *models.AuditReport) {
+	t, ok := reportTimings(report)
+	if !ok {
+		return
+	}
+	fmt.Fprintln(w, "Timings:")
+	for _, st := range timingStages {
+		if ms, ok := t[st.key]; ok {
+			fmt.Fprintf(w, "  %-16s %6d ms\n", st.label, ms)
+		}
+	}
+}
+
+// reportTimings returns report.Metadata["timings"]. The engines record a
+// map[string]int64; a report decoded from JSON holds a map[string]any of
+// float64 instead.
+func reportTimings(report *models.AuditReport) (map[string]int64, bool) {
+	switch t := report.Metadata["timings"].(type) {
+	case map[string]int64:
+		return t, true
+	case map[string]any:
+		out := make(map[string]int64, len(t))
+		for k, v := range t {
+			if ms, ok := v.(float64); ok {
+				out[k] = int64(ms)
+			}
+		}
+		return out, true
+	}
+	return nil, false
+}
@@ -4,20 +4,28 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	dpoutput "github.com/pankaj-dahiya-devops/Devops-proxy/internal/output"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
 	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
 )
 
 // ── kubernetes inspect test helpers ──────────────────────────────────────────
@@ -76,7 +84,7 @@ func capture(fn func(w *bytes.Buffer)) string {
 
 func TestPrintSummary_Header(t *testing.T) {
 	report := makeReport(nil)
-	out := capture(func(w *bytes.Buffer) { printSummary(w, report) })
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
 
 	for _, want := range []string{"111122223333", "staging", "2"} {
 		if !strings.Contains(out, want) {
@@ -85,6 +93,49 @@ func TestPrintSummary_Header(t *testing.T) {
 	}
 }
 
+func TestPrintSummary_DomainRiskScores(t *testing.T) {
+	report := makeReport(nil)
+	report.Summary.DomainRiskScores = map[string]int{"security": 15, "cost": 3, "dataprotection": 0}
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
+
+	if !strings.Contains(out, "Domain Risk Scores") {
+		t.Fatalf("output missing Domain Risk Scores section\ngot:\n%s", out)
+	}
+	costIdx := strings.Index(out, "cost ")
+	dpIdx := strings.Index(out, "dataprotection ")
+	secIdx := strings.Index(out, "security ")
+	if costIdx < 0 || dpIdx < 0 || secIdx < 0 || !(costIdx < dpIdx && dpIdx < secIdx) {
+		t.Errorf("domains missing or not sorted by name\ngot:\n%s", out)
+	}
+	if !strings.Contains(out, "15") {
+		t.Errorf("output missing security score 15\ngot:\n%s", out)
+	}
+}
+
+func TestPrintSummary_RiskGrade(t *testing.T) {
+	report := makeReport(nil)
+	report.Summary.RiskGrade = "C"
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
+
+	gradeIdx := strings.Index(out, "Risk Grade:            C\n")
+	totalIdx := strings.Index(out, "Total Findings:")
+	if gradeIdx < 0 || gradeIdx > totalIdx {
+		t.Errorf("want Risk Grade line before the totals\ngot:\n%s", out)
+	}
+
+	out = capture(func(w *bytes.Buffer) { printSummary(w, makeReport(nil), rankBySavings, defaultTopFindings) })
+	if strings.Contains(out, "Risk Grade") {
+		t.Errorf("Risk Grade printed for a report without a grade\ngot:\n%s", out)
+	}
+}
+
+func TestPrintSummary_NoDomainRiskScoresSection(t *testing.T) {
+	out := capture(func(w *bytes.Buffer) { printSummary(w, makeReport(nil), rankBySavings, defaultTopFindings) })
+	if strings.Contains(out, "Domain Risk Scores") {
+		t.Errorf("Domain Risk Scores section printed for a single-domain report\ngot:\n%s", out)
+	}
+}
+
 func TestPrintSummary_TotalsAndSavings(t *testing.T) {
 	findings := []models.Finding{
 		{ResourceID: "vol-1", Region: "us-east-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 8.00},
@@ -92,7 +143,7 @@ func TestPrintSummary_TotalsAndSavings(t *testing.T) {
 		{ResourceID: "i-1", Region: "eu-west-1", Severity: models.SeverityHigh, EstimatedMonthlySavings: 50.00},
 	}
 	report := makeReport(findings)
-	out := capture(func(w *bytes.Buffer) { printSummary(w, report) })
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
 
 	if !strings.Contains(out, "3") {
 		t.Errorf("output missing total findings count 3\ngot:\n%s", out)
@@ -111,7 +162,7 @@ func TestPrintSummary_SeverityBreakdown(t *testing.T) {
 		{ResourceID: "r-5", Severity: models.SeverityLow, EstimatedMonthlySavings: 8},
 	}
 	report := makeReport(findings)
-	out := capture(func(w *bytes.Buffer) { printSummary(w, report) })
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
 
 	for _, label := range []string{"CRITICAL", "HIGH", "MEDIUM", "LOW"} {
 		if !strings.Contains(out, label) {
@@ -120,9 +171,78 @@ func TestPrintSummary_SeverityBreakdown(t *testing.T) {
 	}
 }
 
+func TestPrintSummary_ComplianceBreakdown(t *testing.T) {
+	report := makeReport(nil)
+	report.Summary.Compliance = []models.FrameworkCompliance{
+		{Framework: "CIS-1.4", RulesPassed: 4, RulesFailed: 2},
+		{Framework: "PCI-DSS", RulesPassed: 3, RulesFailed: 1},
+	}
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
+
+	for _, want := range []string{"Compliance", "CIS-1.4     4       2", "PCI-DSS     3       1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\ngot:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrintSummary_NoCompliance_SkipsSection(t *testing.T) {
+	report := makeReport(nil)
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
+
+	if strings.Contains(out, "Compliance") {
+		t.Errorf("report without framework mappings must not print Compliance section\ngot:\n%s", out)
+	}
+}
+
+func TestPrintSummary_TeamRiskSections(t *testing.T) {
+	report := makeReport(nil)
+	report.Summary.TeamRisks = []models.TeamRisk{
+		{Team: "payments", Namespaces: []string{"payments-api", "payments-jobs"}, TotalFindings: 3, CriticalFindings: 1, HighFindings: 2},
+		{Team: "unassigned", TotalFindings: 1, LowFindings: 1},
+	}
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
+
+	for _, want := range []string{
+		"Team Risk",
+		"Team: payments",
+		"Namespaces:  payments-api, payments-jobs",
+		"Findings:    3 (CRITICAL 1, HIGH 2, MEDIUM 0, LOW 0)",
+		"Team: unassigned",
+		"Findings:    1 (CRITICAL 0, HIGH 0, MEDIUM 0, LOW 1)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\ngot:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "Team: payments") > strings.Index(out, "Team: unassigned") {
+		t.Errorf("teams must print in TeamRisks order\ngot:\n%s", out)
+	}
+}
+
+func TestPrintSummary_NoTeamRisks_SkipsSection(t *testing.T) {
+	report := makeReport(nil)
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
+
+	if strings.Contains(out, "Team Risk") {
+		t.Errorf("report without team_risks must not print Team Risk section\ngot:\n%s", out)
+	}
+}
+
+func TestValidateFormatFindingsBy(t *testing.T) {
+	for _, v := range []string{"", "owner"} {
+		if err := validateFormatFindingsBy(v); err != nil {
+			t.Errorf("validateFormatFindingsBy(%q) = %v; want nil", v, err)
+		}
+	}
+	if err := validateFormatFindingsBy("namespace"); err == nil || !strings.Contains(err.Error(), "--format-findings-by") {
+		t.Errorf("validateFormatFindingsBy(namespace) = %v; want an invalid --format-findings-by error", err)
+	}
+}
+
 func TestPrintSummary_NoFindings_SkipsTopTable(t *testing.T) {
 	report := makeReport(nil)
-	out := capture(func(w *bytes.Buffer) { printSummary(w, report) })
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
 
 	if strings.Contains(out, "Top Findings") {
 		t.Errorf("empty report must not print Top Findings section\ngot:\n%s", out)
@@ -136,7 +256,7 @@ func TestPrintSummary_TopFindingsPresent(t *testing.T) {
 		{ResourceID: "vol-mid", Region: "eu-west-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 8.00},
 	}
 	report := makeReport(findings)
-	out := capture(func(w *bytes.Buffer) { printSummary(w, report) })
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
 
 	if !strings.Contains(out, "Top Findings") {
 		t.Errorf("output missing Top Findings section\ngot:\n%s", out)
@@ -161,7 +281,7 @@ func TestPrintSummary_TopFindingsCappedAtFive(t *testing.T) {
 		}
 	}
 	report := makeReport(findings)
-	out := capture(func(w *bytes.Buffer) { printSummary(w, report) })
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, defaultTopFindings) })
 
 	// The 3 lowest-savings resources (vol-00, vol-01, vol-02) must NOT appear.
 	for _, absent := range []string{"vol-00", "vol-01", "vol-02"} {
@@ -175,6 +295,59 @@ func TestPrintSummary_TopFindingsCappedAtFive(t *testing.T) {
 	}
 }
 
+// summaryTopRows returns the resource IDs listed in the Top Findings table of
+// printSummary output, in order.
+func summaryTopRows(out string) []string {
+	_, table, ok := strings.Cut(out, "Top Findings")
+	if !ok {
+		return nil
+	}
+	var ids []string
+	for _, line := range strings.Split(table, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] == "by" || fields[0] == "RESOURCE" || strings.HasPrefix(fields[0], "---") {
+			continue
+		}
+		ids = append(ids, fields[0])
+	}
+	return ids
+}
+
+func TestPrintSummary_TopN(t *testing.T) {
+	findings := make([]models.Finding, 8)
+	for i := range findings {
+		findings[i] = models.Finding{
+			ResourceID:              fmt.Sprintf("vol-%02d", i),
+			Region:                  "us-east-1",
+			Severity:                models.SeverityLow,
+			EstimatedMonthlySavings: float64(i + 1),
+		}
+	}
+	report := makeReport(findings)
+
+	for _, tc := range []struct {
+		name string
+		top  int
+		want int
+	}{
+		{"top=3", 3, 3},
+		{"top=0 uses default", 0, defaultTopFindings},
+		{"negative uses default", -2, defaultTopFindings},
+		{"top above finding count", 20, len(findings)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySavings, tc.top) })
+			rows := summaryTopRows(out)
+			if len(rows) != tc.want {
+				t.Fatalf("Top Findings rows = %d %v; want %d\ngot:\n%s", len(rows), rows, tc.want, out)
+			}
+			if rows[0] != "vol-07" {
+				t.Errorf("first row = %q; want vol-07 (highest savings)", rows[0])
+			}
+		})
+	}
+}
+
 // ── topFindingsBySavings ─────────────────────────────────────────────────────
 
 func TestTopFindingsBySavings_Empty(t *testing.T) {
@@ -243,13 +416,196 @@ func TestTopFindingsBySavings_DoesNotMutateInput(t *testing.T) {
 	}
 }
 
+// ── topFindings / --rank-by ──────────────────────────────────────────────────
+
+// mixedRankFindings returns findings whose order differs under each ranking
+// mode: a cheap CRITICAL in a risk chain, an expensive LOW, and a HIGH and
+// MEDIUM in between.
+func mixedRankFindings() []models.Finding {
+	return []models.Finding{
+		{ResourceID: "nat-cheap", Severity: models.SeverityLow, EstimatedMonthlySavings: 400},
+		{ResourceID: "root-key", Severity: models.SeverityCritical, EstimatedMonthlySavings: 0},
+		{ResourceID: "web-pod", Severity: models.SeverityHigh, EstimatedMonthlySavings: 0,
+			Metadata: map[string]any{"risk_chain_score": 80}},
+		{ResourceID: "vol-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 20},
+		{ResourceID: "vol-2", Severity: models.SeverityHigh, EstimatedMonthlySavings: 30},
+	}
+}
+
+func rankedIDs(findings []models.Finding) []string {
+	ids := make([]string, len(findings))
+	for i, f := range findings {
+		ids[i] = f.ResourceID
+	}
+	return ids
+}
+
+func TestTopFindings_RankModes(t *testing.T) {
+	cases := []struct {
+		rankBy string
+		want   []string
+	}{
+		{rankBySavings, []string{"nat-cheap", "vol-2", "vol-1", "root-key", "web-pod"}},
+		{rankBySeverity, []string{"root-key", "vol-2", "web-pod", "vol-1", "nat-cheap"}},
+		{rankByRisk, []string{"web-pod", "root-key", "vol-2", "vol-1", "nat-cheap"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.rankBy, func(t *testing.T) {
+			got := rankedIDs(topFindings(mixedRankFindings(), 5, tc.rankBy))
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Errorf("order = %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTopFindings_UnknownModeFallsBackToSavings(t *testing.T) {
+	got := topFindings(mixedRankFindings(), 1, "bogus")
+	if len(got) != 1 || got[0].ResourceID != "nat-cheap" {
+		t.Errorf("top = %v; want [nat-cheap]", rankedIDs(got))
+	}
+}
+
+func TestPrintSummary_RankBySeverityHeading(t *testing.T) {
+	report := makeReport(mixedRankFindings())
+	out := capture(func(w *bytes.Buffer) { printSummary(w, report, rankBySeverity, defaultTopFindings) })
+	if !strings.Contains(out, "Top Findings by Severity") {
+		t.Errorf("output missing severity heading\ngot:\n%s", out)
+	}
+	// The CRITICAL root-key row must precede the expensive LOW NAT row.
+	if strings.Index(out, "root-key") > strings.Index(out, "nat-cheap") {
+		t.Errorf("root-key listed after nat-cheap under severity ranking\ngot:\n%s", out)
+	}
+}
+
+func TestRankByFlag_RegisteredAndValidated(t *testing.T) {
+	for name, cmd := range map[string]*cobra.Command{
+		"aws audit":        newAuditCmd(),
+		"aws audit cost":   newCostCmd(),
+		"kubernetes audit": newKubernetesAuditCmd(),
+	} {
+		flag := cmd.Flags().Lookup("rank-by")
+		if flag == nil {
+			t.Errorf("%s: --rank-by flag not registered", name)
+			continue
+		}
+		if flag.DefValue != "savings" {
+			t.Errorf("%s: --rank-by default = %q; want savings", name, flag.DefValue)
+		}
+	}
+	for _, mode := range []string{"savings", "severity", "risk"} {
+		if err := validateRankBy(mode); err != nil {
+			t.Errorf("validateRankBy(%q) = %v; want nil", mode, err)
+		}
+	}
+	if err := validateRankBy("dollars"); err == nil {
+		t.Error("validateRankBy(dollars) = nil; want error")
+	}
+}
+
+func TestOutputTemplateFlag_Registered(t *testing.T) {
+	for name, cmd := range map[string]*cobra.Command{
+		"aws audit":                newAuditCmd(),
+		"aws audit cost":           newCostCmd(),
+		"aws audit security":       newSecurityCmd(),
+		"aws audit dataprotection": newDataProtectionCmd(),
+		"kubernetes audit":         newKubernetesAuditCmd(),
+	} {
+		flag := cmd.Flags().Lookup("output-template")
+		if flag == nil {
+			t.Errorf("%s: --output-template flag not registered", name)
+			continue
+		}
+		if flag.DefValue != "" {
+			t.Errorf("%s: --output-template default = %q; want empty", name, flag.DefValue)
+		}
+	}
+}
+
+func TestShowPassedFlag_Registered(t *testing.T) {
+	for name, cmd := range map[string]*cobra.Command{
+		"aws audit cost":           newCostCmd(),
+		"aws audit security":       newSecurityCmd(),
+		"aws audit dataprotection": newDataProtectionCmd(),
+		"kubernetes audit":         newKubernetesAuditCmd(),
+	} {
+		flag := cmd.Flags().Lookup("show-passed")
+		if flag == nil {
+			t.Errorf("%s: --show-passed flag not registered", name)
+			continue
+		}
+		if flag.DefValue != "false" {
+			t.Errorf("%s: --show-passed default = %q; want false", name, flag.DefValue)
+		}
+	}
+}
+
+func TestSecurityCmd_DaysFlag(t *testing.T) {
+	flag := newSecurityCmd().Flags().Lookup("days")
+	if flag == nil {
+		t.Fatal("--days flag not registered on aws audit security")
+	}
+	if flag.DefValue != "30" {
+		t.Errorf("--days default = %q; want 30", flag.DefValue)
+	}
+}
+
+func TestRenderAWSCostOutput_PassedSection(t *testing.T) {
+	report := makeReport(nil)
+	report.PassedResources = []models.PassedResource{
+		{ResourceID: "i-healthy", ResourceType: models.ResourceAWSEC2, Region: "us-east-1", Domain: "cost"},
+	}
+
+	var buf bytes.Buffer
+	if err := renderAWSCostOutput(&buf, report, "table", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "Passed (1):") || !strings.Contains(out, "i-healthy") {
+		t.Errorf("table output missing Passed section:\n%s", out)
+	}
+
+	buf.Reset()
+	if err := renderAWSCostOutput(&buf, makeReport(nil), "table", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "Passed") {
+		t.Errorf("Passed section rendered without passed resources:\n%s", buf.String())
+	}
+}
+
+func TestRenderAWSCostOutput_ErrorWarningUnderBanner(t *testing.T) {
+	report := makeReport(nil)
+	report.Errors = []models.AuditError{
+		{Stage: models.AuditStageCollect, Provider: "aws/cost", Message: "collect data for profile \"prod\": throttled"},
+		{Stage: models.AuditStageEvaluate, Provider: "aws/cost", Region: "us-east-1", Message: "rule X panicked"},
+	}
+
+	var buf bytes.Buffer
+	if err := renderAWSCostOutput(&buf, report, "table", renderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) < 2 || !strings.HasPrefix(lines[0], "Profile:") || !strings.HasPrefix(lines[1], "Warnings: 2 ") {
+		t.Errorf("want warning count on the line after the banner; got:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := renderAWSCostOutput(&buf, makeReport(nil), "table", renderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "Warnings:") {
+		t.Errorf("warning rendered for a clean audit:\n%s", buf.String())
+	}
+}
+
 // ── writeReportToFile ─────────────────────────────────────────────────────────
 
 func TestWriteReportToFile_Success(t *testing.T) {
 	report := makeReport(nil)
 	path := filepath.Join(t.TempDir(), "report.json")
 
-	if err := writeReportToFile(path, report); err != nil {
+	if err := writeReportToFile(path, report, false); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -263,7 +619,7 @@ func TestWriteReportToFile_InvalidPath(t *testing.T) {
 	// Directory does not exist — write must fail.
 	path := filepath.Join(t.TempDir(), "nonexistent", "report.json")
 
-	if err := writeReportToFile(path, report); err == nil {
+	if err := writeReportToFile(path, report, false); err == nil {
 		t.Error("expected error for invalid path, got nil")
 	}
 }
@@ -275,7 +631,7 @@ func TestWriteReportToFile_ContentMatchesJSON(t *testing.T) {
 	report := makeReport(findings)
 	path := filepath.Join(t.TempDir(), "report.json")
 
-	if err := writeReportToFile(path, report); err != nil {
+	if err := writeReportToFile(path, report, false); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -306,6 +662,122 @@ func TestWriteReportToFile_ContentMatchesJSON(t *testing.T) {
 	}
 }
 
+func TestWriteReportToFile_SignedReportWritesSidecar(t *testing.T) {
+	report := makeReport(nil)
+	report.Signature = "c2lnbmF0dXJl"
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	if err := writeReportToFile(path, report, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sig, err := os.ReadFile(path + ".sig")
+	if err != nil {
+		t.Fatalf("read sidecar: %v", err)
+	}
+	if got := strings.TrimSpace(string(sig)); got != report.Signature {
+		t.Errorf("sidecar = %q; want %q", got, report.Signature)
+	}
+}
+
+func TestWriteReportToFile_UnsignedReportNoSidecar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := writeReportToFile(path, makeReport(nil), false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path + ".sig"); !os.IsNotExist(err) {
+		t.Errorf("expected no .sig sidecar for an unsigned report; stat err = %v", err)
+	}
+}
+
+// TestWriteReportToFile_Compact verifies that compact output is the indented
+// output with insignificant whitespace removed, and decodes to the same report.
+func TestWriteReportToFile_Compact(t *testing.T) {
+	report := makeReport([]models.Finding{
+		{ResourceID: "vol-abc", Region: "us-east-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 16.00},
+	})
+	dir := t.TempDir()
+	indentedPath, compactPath := filepath.Join(dir, "indented.json"), filepath.Join(dir, "compact.json")
+	if err := writeReportToFile(indentedPath, report, false); err != nil {
+		t.Fatalf("indented: %v", err)
+	}
+	if err := writeReportToFile(compactPath, report, true); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	indented, _ := os.ReadFile(indentedPath)
+	compacted, _ := os.ReadFile(compactPath)
+
+	if bytes.Contains(compacted, []byte("\n")) {
+		t.Errorf("compact file contains newlines:\n%s", compacted)
+	}
+	if len(compacted) >= len(indented) {
+		t.Errorf("compact file is %d bytes; want fewer than indented %d", len(compacted), len(indented))
+	}
+	var want bytes.Buffer
+	if err := json.Compact(&want, indented); err != nil {
+		t.Fatalf("json.Compact: %v", err)
+	}
+	if !bytes.Equal(compacted, want.Bytes()) {
+		t.Errorf("compact file differs from compacted indented file:\ngot:  %s\nwant: %s", compacted, want.Bytes())
+	}
+}
+
+// TestEncodeJSON_Compact verifies that --compact stdout output is a single
+// line holding the same JSON as the indented output.
+func TestEncodeJSON_Compact(t *testing.T) {
+	report := makeReport([]models.Finding{
+		{ResourceID: "vol-abc", Region: "us-east-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 16.00},
+	})
+	var indented, compacted bytes.Buffer
+	if err := encodeJSON(&indented, report, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := encodeJSON(&compacted, report, true); err != nil {
+		t.Fatal(err)
+	}
+	if n := bytes.Count(compacted.Bytes(), []byte("\n")); n != 1 {
+		t.Errorf("compact output has %d newlines; want 1 (trailing)", n)
+	}
+	var want bytes.Buffer
+	if err := json.Compact(&want, indented.Bytes()); err != nil {
+		t.Fatalf("json.Compact: %v", err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(compacted.Bytes()), want.Bytes()) {
+		t.Errorf("compact output differs from compacted indented output:\ngot:  %s\nwant: %s", compacted.Bytes(), want.Bytes())
+	}
+
+	var findings bytes.Buffer
+	if err := encodeFindingsJSON(&findings, report.Findings, true); err != nil {
+		t.Fatal(err)
+	}
+	if n := bytes.Count(findings.Bytes(), []byte("\n")); n != 1 {
+		t.Errorf("compact findings output has %d newlines; want 1", n)
+	}
+}
+
+func TestSignKeyFlag_Registered(t *testing.T) {
+	for name, cmd := range map[string]*cobra.Command{
+		"aws audit":                newAuditCmd(),
+		"aws audit cost":           newCostCmd(),
+		"aws audit security":       newSecurityCmd(),
+		"aws audit dataprotection": newDataProtectionCmd(),
+		"kubernetes audit":         newKubernetesAuditCmd(),
+	} {
+		if cmd.Flags().Lookup("sign-key") == nil {
+			t.Errorf("%s: --sign-key flag not registered", name)
+		}
+	}
+}
+
+func TestSignReportWithKey_EmptyPathIsNoOp(t *testing.T) {
+	report := makeReport(nil)
+	if err := signReportWithKey(report, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Signature != "" {
+		t.Errorf("Signature = %q; want empty without --sign-key", report.Signature)
+	}
+}
+
 // ── runKubernetesInspect ──────────────────────────────────────────────────────
 
 // TestRunKubernetesInspect_Output verifies that all four fields (Context,
@@ -424,7 +896,7 @@ func TestRenderKubernetesAuditOutput_JSONMode_PureJSON(t *testing.T) {
 	report.Profile = "my-cluster"
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "json", false, false, false); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "json", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -446,7 +918,7 @@ func TestRenderKubernetesAuditOutput_JSONMode_SummaryIgnored(t *testing.T) {
 	report.Profile = "my-cluster"
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "json", true, false, false); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "json", renderOptions{summary: true, rankBy: rankBySavings}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -469,7 +941,7 @@ func TestRenderKubernetesAuditOutput_JSONMode_IsValidJSON(t *testing.T) {
 	})
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "json", false, false, false); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "json", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -486,7 +958,7 @@ func TestRenderKubernetesAuditOutput_TableMode_ContextPresent(t *testing.T) {
 	report.Profile = "prod-cluster"
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, false); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -499,6 +971,92 @@ func TestRenderKubernetesAuditOutput_TableMode_ContextPresent(t *testing.T) {
 	}
 }
 
+// TestRenderKubernetesAuditOutput_Columns verifies that --columns replaces the
+// default table layout with the requested columns.
+func TestRenderKubernetesAuditOutput_Columns(t *testing.T) {
+	report := makeReport([]models.Finding{{
+		RuleID:       "K8S_POD_RUN_AS_ROOT",
+		ResourceID:   "api-7d9f",
+		ResourceType: models.ResourceK8sPod,
+		Region:       "prod-cluster",
+		Severity:     models.SeverityHigh,
+		Explanation:  "Pod runs as root.",
+		Metadata:     map[string]any{"namespace": "payments"},
+	}})
+
+	var buf bytes.Buffer
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{quiet: true, columns: []string{"namespace", "resource", "rule"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"NAMESPACE", "RULE", "payments", "K8S_POD_RUN_AS_ROOT"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output must contain %q; got:\n%s", want, out)
+		}
+	}
+	for _, absent := range []string{"CONTEXT", "MESSAGE", "Pod runs as root."} {
+		if strings.Contains(out, absent) {
+			t.Errorf("table output must not contain %q; got:\n%s", absent, out)
+		}
+	}
+}
+
+// TestRenderKubernetesAuditOutput_Histogram verifies that --histogram prints the
+// severity bar above the findings table, sized to $COLUMNS, and that the bar
+// is absent by default.
+func TestRenderKubernetesAuditOutput_Histogram(t *testing.T) {
+	t.Setenv("COLUMNS", "40")
+	report := makeReport([]models.Finding{
+		{RuleID: "K8S_POD_RUN_AS_ROOT", ResourceID: "api", Region: "prod", Severity: models.SeverityHigh},
+		{RuleID: "K8S_POD_NO_REQUESTS", ResourceID: "web", Region: "prod", Severity: models.SeverityLow},
+	})
+
+	var buf bytes.Buffer
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{quiet: true, histogram: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 40 columns leave 33 for bars: HIGH and LOW split them evenly.
+	wantBar := "C H" + strings.Repeat("█", 16) + " M L" + strings.Repeat("█", 16)
+	out := buf.String()
+	barAt, tableAt := strings.Index(out, wantBar), strings.Index(out, "RESOURCE")
+	if barAt < 0 || tableAt < 0 || barAt > tableAt {
+		t.Errorf("histogram %q must precede the table; got:\n%s", wantBar, out)
+	}
+
+	buf.Reset()
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "█") {
+		t.Errorf("histogram rendered without --histogram:\n%s", buf.String())
+	}
+}
+
+func TestRenderKubernetesAuditOutput_ImagesSection(t *testing.T) {
+	report := makeReport(nil)
+	report.Metadata = map[string]any{"images": []models.KubernetesImage{
+		{Image: "nginx:1.25", Pods: 2, Containers: 2, Namespaces: []string{"default", "web"}},
+	}}
+
+	var buf bytes.Buffer
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "Images (1):") || !strings.Contains(out, "nginx:1.25") {
+		t.Errorf("table output missing Images section:\n%s", out)
+	}
+
+	buf.Reset()
+	if err := renderKubernetesAuditOutput(&buf, makeReport(nil), "table", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "Images") {
+		t.Errorf("Images section rendered without an inventory:\n%s", buf.String())
+	}
+}
+
 // TestRenderKubernetesAuditOutput_ShowRiskChains_NoChains_FallbackMessage verifies
 // that when showRiskChains=true but no chains are present, the output contains
 // the fallback message "No risk chains detected."
@@ -510,7 +1068,7 @@ func TestRenderKubernetesAuditOutput_ShowRiskChains_NoChains_FallbackMessage(t *
 	// No RiskChains populated (ShowRiskChains was false in the engine or no chain fired).
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -541,7 +1099,7 @@ func TestRenderKubernetesAuditOutput_ShowRiskChains_ChainHeader(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -565,7 +1123,7 @@ func TestRenderKubernetesAuditOutput_ShowRiskChains_JSON_RiskChainsIncluded(t *t
 	}
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "json", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "json", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -599,7 +1157,7 @@ func TestRenderKubernetesAuditOutput_ShowRiskChains_OtherFindings(t *testing.T)
 	}
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -632,7 +1190,7 @@ func TestRenderRiskChainTable_AttackPathBeforeChain(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	out := buf.String()
@@ -668,7 +1226,7 @@ func TestRenderRiskChainTable_AttackPathContent(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	out := buf.String()
@@ -702,7 +1260,7 @@ func TestRenderRiskChainTable_OnlyAttackPaths(t *testing.T) {
 	// RiskChains intentionally nil.
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "table", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "table", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	out := buf.String()
@@ -733,7 +1291,7 @@ func TestRenderRiskChainTable_AttackPaths_JSON_Included(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	if err := renderKubernetesAuditOutput(&buf, report, "json", false, false, true); err != nil {
+	if err := renderKubernetesAuditOutput(&buf, report, "json", renderOptions{showRiskChains: true}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	out := buf.String()
@@ -759,7 +1317,7 @@ func TestRenderAWSCostOutput_JSONMode_PureJSON(t *testing.T) {
 	})
 
 	var buf bytes.Buffer
-	if err := renderAWSCostOutput(&buf, report, "json", false, false, false); err != nil {
+	if err := renderAWSCostOutput(&buf, report, "json", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -780,7 +1338,7 @@ func TestRenderAWSCostOutput_JSONMode_SummaryIgnored(t *testing.T) {
 	report := makeReport(nil)
 
 	var buf bytes.Buffer
-	if err := renderAWSCostOutput(&buf, report, "json", true, false, false); err != nil {
+	if err := renderAWSCostOutput(&buf, report, "json", renderOptions{summary: true, rankBy: rankBySavings}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -797,7 +1355,7 @@ func TestRenderAWSCostOutput_JSONMode_IsValidJSON(t *testing.T) {
 	})
 
 	var buf bytes.Buffer
-	if err := renderAWSCostOutput(&buf, report, "json", false, false, false); err != nil {
+	if err := renderAWSCostOutput(&buf, report, "json", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -817,7 +1375,7 @@ func TestRenderAWSCostOutput_TableMode_ProfilePresent(t *testing.T) {
 	// report.Profile is set by makeReport to "staging"
 
 	var buf bytes.Buffer
-	if err := renderAWSCostOutput(&buf, report, "table", false, false, false); err != nil {
+	if err := renderAWSCostOutput(&buf, report, "table", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -830,35 +1388,147 @@ func TestRenderAWSCostOutput_TableMode_ProfilePresent(t *testing.T) {
 	}
 }
 
-// ── renderAWSSecurityOutput ───────────────────────────────────────────────────
+// TestRenderAWSCostOutput_Currency verifies that --currency/--fx-rate convert
+// savings in the banner, table, and summary while JSON keeps the USD value.
+func TestRenderAWSCostOutput_Currency(t *testing.T) {
+	report := makeReport([]models.Finding{
+		{ResourceID: "i-1", Region: "us-east-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 1500},
+	})
+	eur := dpoutput.Currency{Code: "EUR", Rate: 0.9}
 
-// TestRenderAWSSecurityOutput_JSONMode_PureJSON verifies that JSON mode for
-// the security command produces no banner text.
-func TestRenderAWSSecurityOutput_JSONMode_PureJSON(t *testing.T) {
-	report := makeReport(nil)
+	for _, summary := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := renderAWSCostOutput(&buf, report, "table", renderOptions{summary: summary, rankBy: rankBySavings, currency: eur}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(buf.String(), "€1,350.00") || strings.Contains(buf.String(), "$") {
+			t.Errorf("summary=%v: want savings as €1,350.00 and no $ amounts; got:\n%s", summary, buf.String())
+		}
+	}
 
 	var buf bytes.Buffer
-	if err := renderAWSSecurityOutput(&buf, report, "json", false, false, false); err != nil {
+	if err := renderAWSCostOutput(&buf, report, "json", renderOptions{currency: eur}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-
-	out := buf.String()
-	if !strings.HasPrefix(strings.TrimSpace(out), "{") {
-		t.Errorf("JSON output must start with '{'; got: %.40s", out)
+	var got models.AuditReport
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
 	}
-	for _, banned := range []string{"Profile:", "Findings:"} {
-		if strings.Contains(out, banned) {
-			t.Errorf("JSON output must not contain banner text %q", banned)
-		}
+	if got.Findings[0].EstimatedMonthlySavings != 1500 {
+		t.Errorf("JSON savings = %v; want USD value 1500", got.Findings[0].EstimatedMonthlySavings)
 	}
 }
 
-// TestRenderAWSSecurityOutput_JSONMode_SummaryIgnored verifies JSON takes priority.
+// TestParseCurrencyFlags verifies --currency/--fx-rate validation.
+func TestParseCurrencyFlags(t *testing.T) {
+	if c, err := parseCurrencyFlags("USD", 0); err != nil || c.Code != "USD" {
+		t.Errorf("parseCurrencyFlags(USD, 0) = %+v, %v; want USD, nil", c, err)
+	}
+	if c, err := parseCurrencyFlags("eur", 0.92); err != nil || c.Code != "EUR" || c.Rate != 0.92 {
+		t.Errorf("parseCurrencyFlags(eur, 0.92) = %+v, %v; want EUR 0.92, nil", c, err)
+	}
+	for _, tc := range []struct {
+		code string
+		rate float64
+		want string
+	}{
+		{"EUR", 0, "--currency EUR requires --fx-rate"},
+		{"EURO", 1, "not a three-letter ISO 4217 code"},
+		{"USD", -1, "--fx-rate must be positive"},
+	} {
+		if _, err := parseCurrencyFlags(tc.code, tc.rate); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("parseCurrencyFlags(%q, %v) error = %v; want %q", tc.code, tc.rate, err, tc.want)
+		}
+	}
+}
+
+func TestValidateStateFlags(t *testing.T) {
+	if err := validateStateFlags("", 0); err != nil {
+		t.Errorf("validateStateFlags(\"\", 0) = %v; want nil", err)
+	}
+	if err := validateStateFlags("state.json", 30); err != nil {
+		t.Errorf("validateStateFlags(state.json, 30) = %v; want nil", err)
+	}
+	if err := validateStateFlags("", 30); err == nil || !strings.Contains(err.Error(), "requires --state-file") {
+		t.Errorf("validateStateFlags(\"\", 30) error = %v; want requires --state-file", err)
+	}
+	if err := validateStateFlags("state.json", -1); err == nil || !strings.Contains(err.Error(), "must not be negative") {
+		t.Errorf("validateStateFlags(state.json, -1) error = %v; want must not be negative", err)
+	}
+}
+
+func TestMinConfidenceFlag_RejectsUnknownLevel(t *testing.T) {
+	for name, newCmd := range map[string]func() *cobra.Command{
+		"aws cost":   newCostCmd,
+		"azure cost": newAzureCostCmd,
+	} {
+		cmd := newCmd()
+		cmd.SetArgs([]string{"--min-confidence", "certain"})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "--min-confidence") {
+			t.Errorf("%s: error = %v; want --min-confidence rejection", name, err)
+		}
+	}
+}
+
+// TestApplyFindingState_WritesAndReusesStateFile verifies that a second run
+// against the same --state-file keeps the first run's FirstSeen.
+func TestApplyFindingState_WritesAndReusesStateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	first := makeReport([]models.Finding{{RuleID: "EBS_UNATTACHED", ResourceID: "vol-1", Severity: models.SeverityMedium}})
+	first.GeneratedAt = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if err := applyFindingState(first, path, 0, nil); err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("state file not written: %v", err)
+	}
+
+	second := makeReport([]models.Finding{{RuleID: "EBS_UNATTACHED", ResourceID: "vol-1", Severity: models.SeverityMedium}})
+	second.GeneratedAt = first.GeneratedAt.Add(45 * 24 * time.Hour)
+	if err := applyFindingState(second, path, 30, nil); err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	f := second.Findings[0]
+	if !f.FirstSeen.Equal(first.GeneratedAt) || f.AgeDays != 45 {
+		t.Errorf("FirstSeen=%v AgeDays=%d; want %v 45", f.FirstSeen, f.AgeDays, first.GeneratedAt)
+	}
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("severity = %s; want HIGH after --max-finding-age escalation", f.Severity)
+	}
+}
+
+// ── renderAWSSecurityOutput ───────────────────────────────────────────────────
+
+// TestRenderAWSSecurityOutput_JSONMode_PureJSON verifies that JSON mode for
+// the security command produces no banner text.
+func TestRenderAWSSecurityOutput_JSONMode_PureJSON(t *testing.T) {
+	report := makeReport(nil)
+
+	var buf bytes.Buffer
+	if err := renderAWSSecurityOutput(&buf, report, "json", renderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(strings.TrimSpace(out), "{") {
+		t.Errorf("JSON output must start with '{'; got: %.40s", out)
+	}
+	for _, banned := range []string{"Profile:", "Findings:"} {
+		if strings.Contains(out, banned) {
+			t.Errorf("JSON output must not contain banner text %q", banned)
+		}
+	}
+}
+
+// TestRenderAWSSecurityOutput_JSONMode_SummaryIgnored verifies JSON takes priority.
 func TestRenderAWSSecurityOutput_JSONMode_SummaryIgnored(t *testing.T) {
 	report := makeReport(nil)
 
 	var buf bytes.Buffer
-	if err := renderAWSSecurityOutput(&buf, report, "json", true, false, false); err != nil {
+	if err := renderAWSSecurityOutput(&buf, report, "json", renderOptions{summary: true, rankBy: rankBySavings}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -875,7 +1545,7 @@ func TestRenderAWSDataProtectionOutput_JSONMode_PureJSON(t *testing.T) {
 	report := makeReport(nil)
 
 	var buf bytes.Buffer
-	if err := renderAWSDataProtectionOutput(&buf, report, "json", false, false, false); err != nil {
+	if err := renderAWSDataProtectionOutput(&buf, report, "json", renderOptions{}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -895,7 +1565,7 @@ func TestRenderAWSDataProtectionOutput_JSONMode_SummaryIgnored(t *testing.T) {
 	report := makeReport(nil)
 
 	var buf bytes.Buffer
-	if err := renderAWSDataProtectionOutput(&buf, report, "json", true, false, false); err != nil {
+	if err := renderAWSDataProtectionOutput(&buf, report, "json", renderOptions{summary: true, rankBy: rankBySavings}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -956,6 +1626,294 @@ func TestHasCriticalOrHighFindings_HighPresent(t *testing.T) {
 	}
 }
 
+// ── setExitCode ──────────────────────────────────────────────────────────────
+
+// TestSetExitCode_Scenarios verifies that Summary.ExitCode matches the exit
+// behaviour of the audit commands for every outcome: clean, LOW/MEDIUM only,
+// HIGH, CRITICAL, and policy enforcement on a finding below HIGH.
+func TestSetExitCode_Scenarios(t *testing.T) {
+	failOnMedium := &policy.PolicyConfig{
+		Enforcement: map[string]policy.EnforcementConfig{"cost": {FailOnSeverity: "MEDIUM"}},
+	}
+	medium := models.Finding{ResourceID: "r-1", Severity: models.SeverityMedium}
+
+	cases := []struct {
+		name     string
+		findings []models.Finding
+		cfg      *policy.PolicyConfig
+		want     int
+	}{
+		{"no findings", nil, nil, 0},
+		{"low and medium only", []models.Finding{{ResourceID: "r-0", Severity: models.SeverityLow}, medium}, nil, 0},
+		{"high finding", []models.Finding{{ResourceID: "r-2", Severity: models.SeverityHigh}}, nil, 1},
+		{"critical finding", []models.Finding{{ResourceID: "r-3", Severity: models.SeverityCritical}}, nil, 1},
+		{"policy enforcement", []models.Finding{medium}, failOnMedium, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := makeReport(tc.findings)
+			policyFailed := policy.ShouldFail("cost", report.Findings, tc.cfg)
+			setExitCode(report, policyFailed)
+			if report.Summary.ExitCode != tc.want {
+				t.Errorf("ExitCode = %d; want %d", report.Summary.ExitCode, tc.want)
+			}
+			// The process exits non-zero exactly when policy fails or the
+			// CRITICAL/HIGH gate fires; the field must agree with that.
+			exits := policyFailed || hasCriticalOrHighFindings(report.Findings)
+			if exits != (report.Summary.ExitCode == 1) {
+				t.Errorf("ExitCode = %d disagrees with process exit (%v)", report.Summary.ExitCode, exits)
+			}
+		})
+	}
+}
+
+// ── --no-exit-code ───────────────────────────────────────────────────────────
+
+// TestAuditExitCode_Scenarios verifies how single-domain audit commands end for
+// the severity and policy paths, with and without --no-exit-code.
+func TestAuditExitCode_Scenarios(t *testing.T) {
+	high := []models.Finding{{ResourceID: "r-1", Severity: models.SeverityHigh}}
+	cases := []struct {
+		name         string
+		findings     []models.Finding
+		policyFailed bool
+		noExit       bool
+		wantCode     int
+		wantErr      error
+		wantStderr   string
+	}{
+		{"clean", nil, false, false, 0, nil, ""},
+		{"severity", high, false, false, 1, nil, "audit completed with CRITICAL or HIGH findings"},
+		{"policy", nil, true, false, 0, errPolicyEnforced, ""},
+		{"severity suppressed", high, false, true, 0, nil, "--no-exit-code"},
+		{"policy suppressed", nil, true, true, 0, nil, "--no-exit-code"},
+		{"clean with flag", nil, false, true, 0, nil, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := makeReport(tc.findings)
+			setExitCode(report, tc.policyFailed)
+			recorded := report.Summary.ExitCode
+			var stderr bytes.Buffer
+			code, err := auditExitCode(&stderr, report, tc.policyFailed, tc.noExit, "table")
+			if code != tc.wantCode || !errors.Is(err, tc.wantErr) {
+				t.Errorf("auditExitCode = %d, %v; want %d, %v", code, err, tc.wantCode, tc.wantErr)
+			}
+			if tc.wantStderr == "" && stderr.Len() != 0 {
+				t.Errorf("stderr = %q; want empty", stderr.String())
+			}
+			if !strings.Contains(stderr.String(), tc.wantStderr) {
+				t.Errorf("stderr = %q; want it to contain %q", stderr.String(), tc.wantStderr)
+			}
+			if report.Summary.ExitCode != recorded {
+				t.Errorf("Summary.ExitCode changed from %d to %d; the report must keep the real outcome", recorded, report.Summary.ExitCode)
+			}
+		})
+	}
+}
+
+// TestAuditExitCode_NoExitJSONIsSilent verifies that --no-exit-code writes
+// nothing to stderr in JSON mode, matching the unsuppressed severity path.
+func TestAuditExitCode_NoExitJSONIsSilent(t *testing.T) {
+	report := makeReport([]models.Finding{{ResourceID: "r-1", Severity: models.SeverityCritical}})
+	setExitCode(report, false)
+	var stderr bytes.Buffer
+	if code, err := auditExitCode(&stderr, report, false, true, "json"); code != 0 || err != nil || stderr.Len() != 0 {
+		t.Errorf("auditExitCode = %d, %v, stderr %q; want 0, nil, empty", code, err, stderr.String())
+	}
+}
+
+// TestNoExitCode_PersistentFlag verifies that --no-exit-code set on the root
+// command reaches audit subcommands, and is false when a subcommand runs alone.
+func TestNoExitCode_PersistentFlag(t *testing.T) {
+	root := newRootCmd()
+	var got bool
+	probe := &cobra.Command{Use: "probe", RunE: func(cmd *cobra.Command, args []string) error {
+		got = noExitCode(cmd)
+		return nil
+	}}
+	root.AddCommand(probe)
+	root.SetArgs([]string{"--no-exit-code", "probe"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !got {
+		t.Error("noExitCode = false with --no-exit-code on the root command; want true")
+	}
+
+	if noExitCode(newSecurityCmd()) {
+		t.Error("noExitCode = true for a standalone subcommand; want false")
+	}
+}
+
+// TestAllDomainsExitStatus_Scenarios verifies the exit code, reasons, and
+// contributing domains of dp aws audit --all for policy-only, severity-only,
+// and combined failures.
+func TestAllDomainsExitStatus_Scenarios(t *testing.T) {
+	medium := models.Finding{ResourceID: "r-1", Severity: models.SeverityMedium, Domain: "cost"}
+	highSec := models.Finding{ResourceID: "r-2", Severity: models.SeverityHigh, Domain: "security"}
+	critDP := models.Finding{ResourceID: "r-3", Severity: models.SeverityCritical, Domain: "dataprotection"}
+
+	cases := []struct {
+		name         string
+		findings     []models.Finding
+		enforced     []string
+		wantCode     int
+		wantReasons  []string
+		wantPolicy   []string
+		wantSeverity []string
+	}{
+		{"clean", []models.Finding{medium}, nil, 0, nil, nil, nil},
+		{"policy only", []models.Finding{medium}, []string{"cost"}, 2, []string{"policy_enforcement"}, []string{"cost"}, nil},
+		{"severity only", []models.Finding{medium, highSec, critDP}, nil, 1, []string{"severity"}, nil, []string{"dataprotection", "security"}},
+		{"policy and severity", []models.Finding{medium, highSec}, []string{"cost"}, 2, []string{"policy_enforcement", "severity"}, []string{"cost"}, []string{"security"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := allDomainsExitStatus(makeReport(tc.findings), tc.enforced)
+			if status.ExitCode != tc.wantCode {
+				t.Errorf("ExitCode = %d; want %d", status.ExitCode, tc.wantCode)
+			}
+			if !reflect.DeepEqual(status.Reasons, tc.wantReasons) {
+				t.Errorf("Reasons = %v; want %v", status.Reasons, tc.wantReasons)
+			}
+			if !reflect.DeepEqual(status.PolicyDomains, tc.wantPolicy) {
+				t.Errorf("PolicyDomains = %v; want %v", status.PolicyDomains, tc.wantPolicy)
+			}
+			if !reflect.DeepEqual(status.SeverityDomains, tc.wantSeverity) {
+				t.Errorf("SeverityDomains = %v; want %v", status.SeverityDomains, tc.wantSeverity)
+			}
+		})
+	}
+}
+
+// TestWriteAuditExitStatus_JSONAndText verifies the structured stderr JSON in
+// machine-readable mode and the plain messages otherwise.
+func TestWriteAuditExitStatus_JSONAndText(t *testing.T) {
+	status := auditExitStatus{
+		ExitCode:        2,
+		Reasons:         []string{"policy_enforcement", "severity"},
+		PolicyDomains:   []string{"cost"},
+		SeverityDomains: []string{"security"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeAuditExitStatus(&buf, status, true); err != nil {
+		t.Fatalf("writeAuditExitStatus error: %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("stderr is not JSON: %v\n%s", err, buf.String())
+	}
+	if got["exit_code"] != float64(2) {
+		t.Errorf("exit_code = %v; want 2", got["exit_code"])
+	}
+	if domains, _ := got["policy_domains"].([]any); len(domains) != 1 || domains[0] != "cost" {
+		t.Errorf("policy_domains = %v; want [cost]", got["policy_domains"])
+	}
+	if domains, _ := got["severity_domains"].([]any); len(domains) != 1 || domains[0] != "security" {
+		t.Errorf("severity_domains = %v; want [security]", got["severity_domains"])
+	}
+
+	buf.Reset()
+	if err := writeAuditExitStatus(&buf, status, false); err != nil {
+		t.Fatalf("writeAuditExitStatus error: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "policy enforcement triggered on domain(s): cost") {
+		t.Errorf("text output missing policy message:\n%s", out)
+	}
+	if !strings.Contains(out, "audit completed with CRITICAL or HIGH findings") {
+		t.Errorf("text output missing severity message:\n%s", out)
+	}
+}
+
+// TestEncodeFindingsJSON_TopLevelArray verifies --output-findings-only output
+// parses as []models.Finding and that no findings encode as [].
+func TestEncodeFindingsJSON_TopLevelArray(t *testing.T) {
+	report := makeReport([]models.Finding{
+		{ID: "a", RuleID: "EBS_UNATTACHED", ResourceID: "vol-1", Severity: models.SeverityHigh},
+		{ID: "b", RuleID: "ROOT_ACCESS_KEY", ResourceID: "root", Severity: models.SeverityCritical},
+	})
+	var buf bytes.Buffer
+	if err := encodeFindingsJSON(&buf, report.Findings, false); err != nil {
+		t.Fatalf("encodeFindingsJSON: %v", err)
+	}
+	var got []models.Finding
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not a findings array: %v\n%s", err, buf.String())
+	}
+	if len(got) != 2 || got[1].RuleID != "ROOT_ACCESS_KEY" {
+		t.Errorf("got %d findings %+v; want the 2 report findings", len(got), got)
+	}
+
+	buf.Reset()
+	if err := encodeFindingsJSON(&buf, nil, false); err != nil {
+		t.Fatalf("encodeFindingsJSON(nil): %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("empty findings = %q; want []", buf.String())
+	}
+}
+
+func TestValidateFindingsOnlyFlags(t *testing.T) {
+	if err := validateFindingsOnlyFlags(false, "table", ""); err != nil {
+		t.Errorf("flag off: %v; want nil", err)
+	}
+	if err := validateFindingsOnlyFlags(true, "json", ""); err != nil {
+		t.Errorf("json: %v; want nil", err)
+	}
+	if err := validateFindingsOnlyFlags(true, "table", ""); err == nil {
+		t.Error("table output: want error")
+	}
+	if err := validateFindingsOnlyFlags(true, "json", "tmpl.txt"); err == nil {
+		t.Error("with --output-template: want error")
+	}
+}
+
+// TestSetExitCode_ResetsStaleValue verifies that a previously set code is
+// cleared when the outcome is clean.
+func TestSetExitCode_ResetsStaleValue(t *testing.T) {
+	report := makeReport(nil)
+	report.Summary.ExitCode = 1
+	setExitCode(report, false)
+	if report.Summary.ExitCode != 0 {
+		t.Errorf("ExitCode = %d; want 0", report.Summary.ExitCode)
+	}
+}
+
+// TestSetExitCode_PersistedInFileAndJSON verifies that exit_code is present in
+// both the --file report and JSON stdout output.
+func TestSetExitCode_PersistedInFileAndJSON(t *testing.T) {
+	report := makeReport([]models.Finding{{ResourceID: "r-1", Severity: models.SeverityHigh}})
+	setExitCode(report, false)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := writeReportToFile(path, report, false); err != nil {
+		t.Fatalf("writeReportToFile error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	var persisted models.AuditReport
+	if err := json.Unmarshal(data, &persisted); err != nil {
+		t.Fatalf("unmarshal file: %v", err)
+	}
+	if persisted.Summary.ExitCode != 1 {
+		t.Errorf("file exit_code = %d; want 1", persisted.Summary.ExitCode)
+	}
+
+	out := capture(func(w *bytes.Buffer) {
+		if err := renderAWSCostOutput(w, report, "json", renderOptions{}); err != nil {
+			t.Fatalf("render error: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"exit_code": 1`) {
+		t.Errorf("JSON output missing \"exit_code\": 1\ngot:\n%s", out)
+	}
+}
+
 // ── --output / --file flag registration ──────────────────────────────────────
 // These tests verify that every audit command declares --output (format, default
 // "table") and --file (file path, default "") flags consistently.
@@ -1080,6 +2038,19 @@ func TestAuditAllCmd_FileFlagRegistered(t *testing.T) {
 	}
 }
 
+// TestAuditAllCmd_CollectorCacheFlagRegistered verifies --collector-cache
+// on dp aws audit --all defaults to enabled.
+func TestAuditAllCmd_CollectorCacheFlagRegistered(t *testing.T) {
+	cmd := newAuditCmd()
+	flag := cmd.Flags().Lookup("collector-cache")
+	if flag == nil {
+		t.Fatal("--collector-cache flag not registered on aws audit command")
+	}
+	if flag.DefValue != "true" {
+		t.Errorf("--collector-cache default = %q; want true", flag.DefValue)
+	}
+}
+
 // ── Phase 8: --explain-path flag and validation ───────────────────────────────
 
 // TestCLI_ExplainRequiresShowRiskChains verifies the validateExplainFlags
@@ -1107,6 +2078,22 @@ func TestCLI_ExplainRequiresShowRiskChains(t *testing.T) {
 	}
 }
 
+// TestCLI_OnlyChainsRequiresShowRiskChains verifies validateOnlyChainsFlags:
+// --only-chains without --show-risk-chains must return an error.
+func TestCLI_OnlyChainsRequiresShowRiskChains(t *testing.T) {
+	if err := validateOnlyChainsFlags(true, false); err == nil {
+		t.Error("validateOnlyChainsFlags(true, false) = nil; want non-nil error")
+	} else if !strings.Contains(err.Error(), "--only-chains requires --show-risk-chains") {
+		t.Errorf("unexpected error message: %q", err.Error())
+	}
+	if err := validateOnlyChainsFlags(true, true); err != nil {
+		t.Errorf("validateOnlyChainsFlags(true, true) = %v; want nil", err)
+	}
+	if err := validateOnlyChainsFlags(false, false); err != nil {
+		t.Errorf("validateOnlyChainsFlags(false, false) = %v; want nil", err)
+	}
+}
+
 // TestKubernetesAuditCmd_ExplainPathFlag_Registered verifies that the
 // --explain-path flag is declared with default value 0 and type int.
 func TestKubernetesAuditCmd_ExplainPathFlag_Registered(t *testing.T) {
@@ -1122,3 +2109,561 @@ func TestKubernetesAuditCmd_ExplainPathFlag_Registered(t *testing.T) {
 		t.Errorf("--explain-path type = %q; want int", flag.Value.Type())
 	}
 }
+
+// TestCLI_ExplainChainRequiresShowRiskChains verifies that --explain-chain is
+// rejected without --show-risk-chains and accepted otherwise.
+func TestCLI_ExplainChainRequiresShowRiskChains(t *testing.T) {
+	if err := validateExplainChainFlags(80, false); err == nil {
+		t.Error("validateExplainChainFlags(80, false) = nil; want non-nil error")
+	} else if !strings.Contains(err.Error(), "--explain-chain requires --show-risk-chains") {
+		t.Errorf("unexpected error message: %q", err.Error())
+	}
+	if err := validateExplainChainFlags(80, true); err != nil {
+		t.Errorf("validateExplainChainFlags(80, true) = %v; want nil", err)
+	}
+	if err := validateExplainChainFlags(0, false); err != nil {
+		t.Errorf("validateExplainChainFlags(0, false) = %v; want nil", err)
+	}
+}
+
+// TestCLI_ExplainAllFlagValidation verifies that --explain-all requires
+// --show-risk-chains and cannot be combined with the single-score modes.
+func TestCLI_ExplainAllFlagValidation(t *testing.T) {
+	if err := validateExplainAllFlags(true, 0, 0, false); err == nil ||
+		!strings.Contains(err.Error(), "--explain-all requires --show-risk-chains") {
+		t.Errorf("validateExplainAllFlags without --show-risk-chains = %v; want requires error", err)
+	}
+	if err := validateExplainAllFlags(true, 98, 0, true); err == nil {
+		t.Error("validateExplainAllFlags with --explain-path = nil; want error")
+	}
+	if err := validateExplainAllFlags(true, 0, 80, true); err == nil {
+		t.Error("validateExplainAllFlags with --explain-chain = nil; want error")
+	}
+	if err := validateExplainAllFlags(true, 0, 0, true); err != nil {
+		t.Errorf("validateExplainAllFlags(true, 0, 0, true) = %v; want nil", err)
+	}
+	if err := validateExplainAllFlags(false, 98, 80, false); err != nil {
+		t.Errorf("validateExplainAllFlags(false, ...) = %v; want nil", err)
+	}
+	if newKubernetesAuditCmd().Flags().Lookup("explain-all") == nil {
+		t.Error("--explain-all flag not registered on kubernetes audit")
+	}
+}
+
+// TestKubernetesAuditCmd_ExplainChainFlag_Registered verifies that the
+// --explain-chain flag is declared with default value 0 and type int.
+func TestKubernetesAuditCmd_ExplainChainFlag_Registered(t *testing.T) {
+	flag := newKubernetesAuditCmd().Flags().Lookup("explain-chain")
+	if flag == nil {
+		t.Fatal("--explain-chain flag not registered on kubernetes audit command")
+	}
+	if flag.DefValue != "0" {
+		t.Errorf("--explain-chain default = %q; want 0", flag.DefValue)
+	}
+	if flag.Value.Type() != "int" {
+		t.Errorf("--explain-chain type = %q; want int", flag.Value.Type())
+	}
+}
+
+// TestKubernetesAuditCmd_CollapsePathsFlag_Registered verifies that the
+// --collapse-paths flag is declared with default value false.
+func TestKubernetesAuditCmd_CollapsePathsFlag_Registered(t *testing.T) {
+	flag := newKubernetesAuditCmd().Flags().Lookup("collapse-paths")
+	if flag == nil {
+		t.Fatal("--collapse-paths flag not registered on kubernetes audit command")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("--collapse-paths default = %q; want false", flag.DefValue)
+	}
+}
+
+func TestAuditCmds_QuietFlagRegistered(t *testing.T) {
+	cmds := map[string]func() *cobra.Command{
+		"audit --all":      newAuditCmd,
+		"cost":             newCostCmd,
+		"security":         newSecurityCmd,
+		"dataprotection":   newDataProtectionCmd,
+		"kubernetes audit": newKubernetesAuditCmd,
+	}
+	for name, build := range cmds {
+		flag := build().Flags().Lookup("quiet")
+		if flag == nil {
+			t.Errorf("--quiet flag not registered on %s command", name)
+			continue
+		}
+		if flag.DefValue != "false" {
+			t.Errorf("%s: --quiet default = %q; want false", name, flag.DefValue)
+		}
+	}
+}
+
+// TestRenderAuditOutput_Quiet verifies that quiet=true drops the banner line
+// in table mode for every audit renderer while the findings table is still
+// printed, and that quiet=false keeps the banner.
+func TestRenderAuditOutput_Quiet(t *testing.T) {
+	findings := []models.Finding{
+		{ID: "f1", RuleID: "EBS_UNATTACHED", ResourceID: "vol-quiet", Region: "us-east-1", Severity: models.SeverityMedium},
+	}
+	renderers := map[string]struct {
+		banner string
+		render func(w *bytes.Buffer, quiet bool) error
+	}{
+		"cost": {"Profile:", func(w *bytes.Buffer, quiet bool) error {
+			return renderAWSCostOutput(w, makeReport(findings), "table", renderOptions{quiet: quiet})
+		}},
+		"security": {"Profile:", func(w *bytes.Buffer, quiet bool) error {
+			return renderAWSSecurityOutput(w, makeReport(findings), "table", renderOptions{quiet: quiet})
+		}},
+		"dataprotection": {"Profile:", func(w *bytes.Buffer, quiet bool) error {
+			return renderAWSDataProtectionOutput(w, makeReport(findings), "table", renderOptions{quiet: quiet})
+		}},
+		"kubernetes": {"Context:", func(w *bytes.Buffer, quiet bool) error {
+			return renderKubernetesAuditOutput(w, makeReport(findings), "table", renderOptions{quiet: quiet})
+		}},
+	}
+	for name, r := range renderers {
+		var quietBuf, loudBuf bytes.Buffer
+		if err := r.render(&quietBuf, true); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if err := r.render(&loudBuf, false); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if strings.Contains(quietBuf.String(), r.banner) {
+			t.Errorf("%s: quiet output must not contain %q; got:\n%s", name, r.banner, quietBuf.String())
+		}
+		if !strings.Contains(quietBuf.String(), "vol-quiet") {
+			t.Errorf("%s: quiet output must still contain the findings table; got:\n%s", name, quietBuf.String())
+		}
+		if !strings.Contains(loudBuf.String(), r.banner) {
+			t.Errorf("%s: default output must contain %q; got:\n%s", name, r.banner, loudBuf.String())
+		}
+	}
+}
+
+// TestRenderAuditOutput_Quiet_JSONUnaffected verifies that quiet has no
+// effect on JSON output.
+func TestRenderAuditOutput_Quiet_JSONUnaffected(t *testing.T) {
+	report := makeReport(nil)
+	var quietBuf, loudBuf bytes.Buffer
+	if err := renderAWSCostOutput(&quietBuf, report, "json", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := renderAWSCostOutput(&loudBuf, report, "json", renderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quietBuf.String() != loudBuf.String() {
+		t.Errorf("JSON output differs with --quiet:\nquiet: %s\ndefault: %s", quietBuf.String(), loudBuf.String())
+	}
+}
+
+// TestKubernetesAuditCmd_ContextAllFlag verifies that --context-all is
+// registered with a false default and that combining it with --context is
+// rejected.
+func TestKubernetesAuditCmd_ContextAllFlag(t *testing.T) {
+	flag := newKubernetesAuditCmd().Flags().Lookup("context-all")
+	if flag == nil {
+		t.Fatal("--context-all flag not registered on kubernetes audit command")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("--context-all default = %q; want false", flag.DefValue)
+	}
+
+	if err := validateContextFlags("prod", true); err == nil {
+		t.Error("validateContextFlags(prod, true) = nil; want non-nil error")
+	}
+	if err := validateContextFlags("", true); err != nil {
+		t.Errorf("validateContextFlags(\"\", true) = %v; want nil", err)
+	}
+	if err := validateContextFlags("prod", false); err != nil {
+		t.Errorf("validateContextFlags(prod, false) = %v; want nil", err)
+	}
+}
+
+// TestResolveExcludeSystem covers the precedence flag > dp.yaml
+// kubernetes.exclude_system_default > built-in default (keep findings).
+func TestResolveExcludeSystem(t *testing.T) {
+	excludeByDefault := &policy.PolicyConfig{Kubernetes: policy.KubernetesConfig{ExcludeSystemDefault: true}}
+	includeByDefault := &policy.PolicyConfig{}
+	tests := []struct {
+		name             string
+		exclude, include bool
+		cfg              *policy.PolicyConfig
+		want             bool
+	}{
+		{"no flags, no policy", false, false, nil, false},
+		{"no flags, policy unset", false, false, includeByDefault, false},
+		{"no flags, policy excludes", false, false, excludeByDefault, true},
+		{"--exclude-system, no policy", true, false, nil, true},
+		{"--exclude-system, policy unset", true, false, includeByDefault, true},
+		{"--exclude-system, policy excludes", true, false, excludeByDefault, true},
+		{"--include-system, no policy", false, true, nil, false},
+		{"--include-system, policy unset", false, true, includeByDefault, false},
+		{"--include-system, policy excludes", false, true, excludeByDefault, false},
+	}
+	for _, tt := range tests {
+		got, err := resolveExcludeSystem(tt.exclude, tt.include, tt.cfg)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: resolveExcludeSystem = %v, %v; want %v", tt.name, got, err, tt.want)
+		}
+	}
+
+	if _, err := resolveExcludeSystem(true, true, excludeByDefault); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("--exclude-system with --include-system: error = %v; want mutually exclusive", err)
+	}
+}
+
+func TestKubernetesAuditCmd_IncludeSystemFlag(t *testing.T) {
+	flag := newKubernetesAuditCmd().Flags().Lookup("include-system")
+	if flag == nil {
+		t.Fatal("--include-system flag not registered on kubernetes audit command")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("--include-system default = %q; want false", flag.DefValue)
+	}
+}
+
+// TestKubernetesAuditCmd_DiffContextFlag verifies --diff-context registration
+// and its validation against --context and --context-all.
+func TestKubernetesAuditCmd_DiffContextFlag(t *testing.T) {
+	flag := newKubernetesAuditCmd().Flags().Lookup("diff-context")
+	if flag == nil {
+		t.Fatal("--diff-context flag not registered on kubernetes audit command")
+	}
+	if flag.DefValue != "" {
+		t.Errorf("--diff-context default = %q; want empty", flag.DefValue)
+	}
+
+	if err := validateDiffContextFlags("", "prod", true); err == nil {
+		t.Error("validateDiffContextFlags with --context-all = nil; want error")
+	}
+	if err := validateDiffContextFlags("prod", "prod", false); err == nil {
+		t.Error("validateDiffContextFlags(prod, prod) = nil; want error")
+	}
+	if err := validateDiffContextFlags("staging", "prod", false); err != nil {
+		t.Errorf("validateDiffContextFlags(staging, prod) = %v; want nil", err)
+	}
+	if err := validateDiffContextFlags("", "", true); err != nil {
+		t.Errorf("validateDiffContextFlags without --diff-context = %v; want nil", err)
+	}
+}
+
+// TestRenderContextDiff_Table verifies the two-column layout: entries only in
+// A on the left, only in B on the right, "(none)" for an empty side.
+func TestRenderContextDiff_Table(t *testing.T) {
+	diff := engine.ReportDiff{
+		A: "prod",
+		B: "staging",
+		OnlyInA: []engine.DiffEntry{
+			{RuleID: "K8S_POD_PRIVILEGED_CONTAINER", Namespace: "payments", ResourceID: "debug"},
+			{RuleID: "K8S_POD_SECURITY_ADMISSION_NOT_ENFORCED"},
+		},
+	}
+	out := capture(func(w *bytes.Buffer) {
+		if err := renderContextDiff(w, diff, "table"); err != nil {
+			t.Fatalf("renderContextDiff error: %v", err)
+		}
+	})
+
+	lines := strings.Split(out, "\n")
+	if !strings.HasPrefix(lines[0], "ONLY IN prod") || !strings.Contains(lines[0], "ONLY IN staging") {
+		t.Errorf("header = %q; want both column headers", lines[0])
+	}
+	if !strings.HasPrefix(lines[2], "K8S_POD_PRIVILEGED_CONTAINER payments/debug") || !strings.HasSuffix(lines[2], "(none)") {
+		t.Errorf("first row = %q; want privileged pod on the left and (none) on the right", lines[2])
+	}
+	if strings.TrimSpace(lines[3]) != "K8S_POD_SECURITY_ADMISSION_NOT_ENFORCED (cluster)" {
+		t.Errorf("second row = %q; want cluster-level entry on the left only", lines[3])
+	}
+	if !strings.Contains(out, "Only in prod: 2  Only in staging: 0") {
+		t.Errorf("output missing counts line\ngot:\n%s", out)
+	}
+}
+
+// TestRenderContextDiff_JSON verifies that JSON output is the encoded diff.
+func TestRenderContextDiff_JSON(t *testing.T) {
+	diff := engine.ReportDiff{
+		A:       "prod",
+		B:       "staging",
+		OnlyInB: []engine.DiffEntry{{RuleID: "K8S_POD_RUN_AS_ROOT", Namespace: "web", ResourceID: "api"}},
+	}
+	out := capture(func(w *bytes.Buffer) {
+		if err := renderContextDiff(w, diff, "json"); err != nil {
+			t.Fatalf("renderContextDiff error: %v", err)
+		}
+	})
+
+	var got engine.ReportDiff
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if got.A != "prod" || got.B != "staging" || len(got.OnlyInB) != 1 || got.OnlyInB[0] != diff.OnlyInB[0] {
+		t.Errorf("decoded diff = %+v; want %+v", got, diff)
+	}
+}
+
+// ── dp policy init ───────────────────────────────────────────────────────────
+
+// TestRunPolicyInit_TemplateValidates verifies that the generated dp.yaml loads
+// cleanly and passes policy.Validate against every known rule ID.
+func TestRunPolicyInit_TemplateValidates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dp.yaml")
+	var buf bytes.Buffer
+	if err := runPolicyInit(path, false, &buf); err != nil {
+		t.Fatalf("runPolicyInit: %v", err)
+	}
+
+	cfg, err := policy.LoadPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadPolicy on generated template: %v", err)
+	}
+	if errs := policy.Validate(cfg, allRuleIDs()); len(errs) != 0 {
+		t.Errorf("generated template failed validation: %v", errs)
+	}
+	// The examples are commented out: the template must not hide findings.
+	if len(cfg.Rules) != 0 || cfg.Domains["cost"].MinSeverity != "" || cfg.Kubernetes.ExcludeSystemDefault {
+		t.Errorf("generated template suppresses findings by default: %+v", cfg)
+	}
+	if !strings.Contains(buf.String(), path) {
+		t.Errorf("output %q does not mention written path", buf.String())
+	}
+}
+
+// TestRunPolicyInit_RefusesOverwriteWithoutForce verifies that an existing file
+// is left untouched when --force is not set.
+func TestRunPolicyInit_RefusesOverwriteWithoutForce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dp.yaml")
+	if err := os.WriteFile(path, []byte("existing"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runPolicyInit(path, false, &bytes.Buffer{}); err == nil {
+		t.Fatal("expected error when file exists and force is false")
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != "existing" {
+		t.Errorf("existing file was modified: %q", data)
+	}
+}
+
+// TestRunPolicyInit_ForceOverwrites verifies that --force replaces an existing file.
+func TestRunPolicyInit_ForceOverwrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dp.yaml")
+	if err := os.WriteFile(path, []byte("existing"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runPolicyInit(path, true, &bytes.Buffer{}); err != nil {
+		t.Fatalf("runPolicyInit with force: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != policyInitTemplate {
+		t.Error("file content does not match template after forced overwrite")
+	}
+}
+
+// TestPolicyInitCmd_Flags verifies the --output default and --force flag.
+func TestPolicyInitCmd_Flags(t *testing.T) {
+	cmd := newPolicyInitCmd()
+	out := cmd.Flags().Lookup("output")
+	if out == nil {
+		t.Fatal("--output flag not registered on policy init command")
+	}
+	if out.DefValue != "./dp.yaml" {
+		t.Errorf("--output default = %q; want ./dp.yaml", out.DefValue)
+	}
+	if cmd.Flags().Lookup("force") == nil {
+		t.Fatal("--force flag not registered on policy init command")
+	}
+}
+
+// ── --timings ────────────────────────────────────────────────────────────────
+
+// TestPrintTimings_AllStages verifies that every stage label is printed when
+// the report carries timings metadata.
+func TestPrintTimings_AllStages(t *testing.T) {
+	report := makeReport(nil)
+	report.Metadata = map[string]any{
+		"timings": map[string]int64{
+			"collection_ms": 12, "evaluation_ms": 3, "correlation_ms": 1, "total_ms": 17,
+		},
+	}
+	out := capture(func(w *bytes.Buffer) { printTimings(w, report) })
+	for _, want := range []string{"Collection", "Rule evaluation", "Correlation", "Total", "17 ms"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("timings output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+// TestPrintTimings_NoMetadata verifies that nothing is printed when the report
+// has no timings.
+func TestPrintTimings_NoMetadata(t *testing.T) {
+	out := capture(func(w *bytes.Buffer) { printTimings(w, makeReport(nil)) })
+	if out != "" {
+		t.Errorf("expected no output without timings; got %q", out)
+	}
+}
+
+// TestPrintTimings_SurvivesJSONRoundTrip verifies that timings are still
+// printed from a report decoded from JSON, where they are float64 values, and
+// that stages the audit did not record are skipped.
+func TestPrintTimings_SurvivesJSONRoundTrip(t *testing.T) {
+	report := makeReport(nil)
+	report.Metadata = map[string]any{
+		"timings": map[string]int64{"collection_ms": 12, "evaluation_ms": 3, "total_ms": 17},
+	}
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded models.AuditReport
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	out := capture(func(w *bytes.Buffer) { printTimings(w, &decoded) })
+	for _, want := range []string{"Collection", "12 ms", "Rule evaluation", "Total", "17 ms"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("timings output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Correlation") {
+		t.Errorf("unrecorded correlation stage printed:\n%s", out)
+	}
+}
+
+// TestAuditCmds_TimingsFlag_Registered verifies that every audit command
+// declares --timings.
+func TestAuditCmds_TimingsFlag_Registered(t *testing.T) {
+	cmds := map[string]func() *cobra.Command{
+		"aws audit --all":          newAuditCmd,
+		"aws audit cost":           newCostCmd,
+		"aws audit security":       newSecurityCmd,
+		"aws audit dataprotection": newDataProtectionCmd,
+		"azure audit cost":         newAzureCostCmd,
+		"kubernetes audit":         newKubernetesAuditCmd,
+	}
+	for name, newCmd := range cmds {
+		flag := newCmd().Flags().Lookup("timings")
+		if flag == nil {
+			t.Errorf("%s: --timings flag not registered", name)
+			continue
+		}
+		if flag.DefValue != "false" {
+			t.Errorf("%s: --timings default = %q; want false", name, flag.DefValue)
+		}
+	}
+}
+
+// ── --profile-regex ──────────────────────────────────────────────────────────
+
+// TestAWSAuditCmds_ProfileRegexFlagRegistered verifies that every AWS audit
+// command declares --profile-regex with an empty default.
+func TestAWSAuditCmds_ProfileRegexFlagRegistered(t *testing.T) {
+	cmds := map[string]func() *cobra.Command{
+		"audit --all":    newAuditCmd,
+		"cost":           newCostCmd,
+		"security":       newSecurityCmd,
+		"dataprotection": newDataProtectionCmd,
+	}
+	for name, build := range cmds {
+		flag := build().Flags().Lookup("profile-regex")
+		if flag == nil {
+			t.Errorf("--profile-regex flag not registered on %s command", name)
+			continue
+		}
+		if flag.DefValue != "" {
+			t.Errorf("%s: --profile-regex default = %q; want empty string", name, flag.DefValue)
+		}
+	}
+}
+
+// ── kubernetesRegistries (--rules / --skip-rules) ─────────────────────────────
+
+// auditWithRuleFilter runs a Kubernetes audit of a cluster holding one
+// privileged pod without a seccomp profile, using registries filtered by only
+// and skip, and returns the rule IDs that produced findings.
+func auditWithRuleFilter(t *testing.T, only, skip []string) map[string]bool {
+	t.Helper()
+	privileged := true
+	cs := fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "priv", Namespace: "production"},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:            "app",
+				SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+			}}},
+		},
+	)
+	core, _, err := kubernetesRegistries(nil, k8scorepack.Options{}, only, skip)
+	if err != nil {
+		t.Fatalf("kubernetesRegistries: %v", err)
+	}
+	provider := &testKubeProvider{clientset: cs, info: kube.ClusterInfo{ContextName: "rules-ctx"}}
+	report, err := engine.NewKubernetesEngine(provider, core, nil).
+		RunAudit(context.Background(), engine.KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit: %v", err)
+	}
+	got := make(map[string]bool)
+	for _, f := range report.Findings {
+		got[f.RuleID] = true
+	}
+	return got
+}
+
+func TestKubernetesRegistries_AllowlistRestrictsFindings(t *testing.T) {
+	got := auditWithRuleFilter(t, []string{"K8S_POD_NO_SECCOMP"}, nil)
+	if len(got) != 1 || !got["K8S_POD_NO_SECCOMP"] {
+		t.Errorf("rule IDs = %v; want only K8S_POD_NO_SECCOMP", got)
+	}
+}
+
+func TestKubernetesRegistries_DenylistRemovesFindings(t *testing.T) {
+	baseline := auditWithRuleFilter(t, nil, nil)
+	if !baseline["K8S_CLUSTER_INSUFFICIENT_NODES"] {
+		t.Fatalf("baseline rule IDs = %v; want K8S_CLUSTER_INSUFFICIENT_NODES", baseline)
+	}
+	got := auditWithRuleFilter(t, nil, []string{"K8S_CLUSTER_INSUFFICIENT_NODES"})
+	if got["K8S_CLUSTER_INSUFFICIENT_NODES"] {
+		t.Error("K8S_CLUSTER_INSUFFICIENT_NODES finding present despite --skip-rules")
+	}
+	if !got["K8S_PRIVILEGED_CONTAINER"] {
+		t.Errorf("rule IDs = %v; want K8S_PRIVILEGED_CONTAINER kept", got)
+	}
+}
+
+func TestKubernetesRegistries_DeprecatedRuleID(t *testing.T) {
+	got := auditWithRuleFilter(t, []string{"K8S_CLUSTER_SINGLE_NODE"}, nil)
+	if len(got) != 1 || !got["K8S_CLUSTER_INSUFFICIENT_NODES"] {
+		t.Errorf("--rules K8S_CLUSTER_SINGLE_NODE: rule IDs = %v; want only K8S_CLUSTER_INSUFFICIENT_NODES", got)
+	}
+	got = auditWithRuleFilter(t, nil, []string{"K8S_CLUSTER_SINGLE_NODE"})
+	if got["K8S_CLUSTER_INSUFFICIENT_NODES"] {
+		t.Error("--skip-rules K8S_CLUSTER_SINGLE_NODE: K8S_CLUSTER_INSUFFICIENT_NODES finding still present")
+	}
+}
+
+func TestKubernetesRegistries_UnknownRuleID(t *testing.T) {
+	for name, tc := range map[string]struct{ only, skip []string }{
+		"--rules":      {only: []string{"K8S_POD_NO_SECCOMP", "NOT_A_RULE"}},
+		"--skip-rules": {skip: []string{"NOT_A_RULE"}},
+	} {
+		_, _, err := kubernetesRegistries(nil, k8scorepack.Options{}, tc.only, tc.skip)
+		if err == nil || !strings.Contains(err.Error(), name) || !strings.Contains(err.Error(), "NOT_A_RULE") {
+			t.Errorf("%s: error = %v; want unknown rule ID NOT_A_RULE", name, err)
+		}
+	}
+}
+
+func TestKubernetesRegistries_FiltersEKSPack(t *testing.T) {
+	_, eks, err := kubernetesRegistries(nil, k8scorepack.Options{}, []string{"K8S_POD_NO_SECCOMP"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := len(eks.All()); n != 0 {
+		t.Errorf("EKS registry has %d rules; want 0 when --rules lists only a core rule", n)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	dppack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/aws_dataprotection"
 	k8spack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes"
 	secpack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/aws_security"
+	azurecostpack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/azure_cost"
 )
 
 // DoctorResult is the structured output of dp doctor. It can be serialised to
@@ -240,6 +241,9 @@ func doctorAllRuleIDs() []string {
 	for _, r := range k8spack.New() {
 		ids = append(ids, r.ID())
 	}
+	for _, r := range azurecostpack.New() {
+		ids = append(ids, r.ID())
+	}
 	return ids
 }
 
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+)
+
+// dryRunStep is one row of a --dry-run plan: an AWS API call and the audit
+// domain that needs it ("profile" for account and region discovery).
+type dryRunStep struct {
+	Domain string `json:"domain"`
+	common.APICall
+}
+
+// dryRunDomain pairs an audit domain with the Plan methods of the collectors
+// it runs.
+type dryRunDomain struct {
+	name  string
+	plans []func(regions []string) []common.APICall
+}
+
+// addDryRunFlag registers --dry-run on an AWS audit command.
+func addDryRunFlag(cmd *cobra.Command, dryRun *bool) {
+	cmd.Flags().BoolVar(dryRun, "dry-run", false, "List the AWS API calls the audit would make per domain and region, then exit 0 without calling AWS")
+}
+
+// buildDryRunPlan lists the calls an audit of domains over explicitRegions
+// would make: profile loading and region discovery first, then each
+// domain's collector plans. Without explicit regions, regional calls are
+// listed once under common.ActiveRegions. A call repeated within a domain
+// is listed once.
+func buildDryRunPlan(explicitRegions []string, domains ...dryRunDomain) []dryRunStep {
+	var steps []dryRunStep
+	for _, c := range common.ProfilePlan(explicitRegions) {
+		steps = append(steps, dryRunStep{Domain: "profile", APICall: c})
+	}
+	regions := common.PlanRegions(explicitRegions)
+	for _, d := range domains {
+		seen := make(map[common.APICall]bool)
+		for _, plan := range d.plans {
+			for _, c := range plan(regions) {
+				if seen[c] {
+					continue
+				}
+				seen[c] = true
+				steps = append(steps, dryRunStep{Domain: d.name, APICall: c})
+			}
+		}
+	}
+	return steps
+}
+
+// renderDryRun writes the plan for domains to w as a table, or as a JSON
+// array when outputFmt is "json". It never calls AWS.
+func renderDryRun(w io.Writer, outputFmt string, explicitRegions []string, domains ...dryRunDomain) error {
+	steps := buildDryRunPlan(explicitRegions, domains...)
+	if outputFmt == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(steps)
+	}
+	fmt.Fprintln(w, "Dry run: no AWS API calls were made. The audit would call:")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "  %-15s  %-20s  %s\n", "DOMAIN", "REGION", "API CALL")
+	for _, s := range steps {
+		fmt.Fprintf(w, "  %-15s  %-20s  %s:%s\n", s.Domain, s.Region, s.Service, s.Operation)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestBuildDryRunPlan_DiscoversRegionsWithoutRegionFlag(t *testing.T) {
+	steps := buildDryRunPlan(nil)
+	if len(steps) != 2 || steps[1].Service != "ec2" || steps[1].Operation != "DescribeRegions" {
+		t.Errorf("steps = %+v; want sts:GetCallerIdentity then ec2:DescribeRegions", steps)
+	}
+}
+
+func TestAuditCommands_DryRunListsDomainCalls(t *testing.T) {
+	tests := []struct {
+		name   string
+		newCmd func() *cobra.Command
+		args   []string
+		want   []string
+	}{
+		{"aws cost", newCostCmd, nil, []string{"cost", "ce:GetCostAndUsage", "ec2:DescribeVolumes"}},
+		{"aws security", newSecurityCmd, nil, []string{"security", "iam:ListUsers", "ec2:DescribeSecurityGroups"}},
+		{"aws dataprotection", newDataProtectionCmd, nil, []string{"dataprotection", "rds:DescribeDBInstances", "s3:GetBucketEncryption"}},
+		{"aws audit --all", newAuditCmd, []string{"--all"}, []string{"cost", "security", "kms:GetKeyRotationStatus"}},
+	}
+	for _, tt := range tests {
+		cmd := tt.newCmd()
+		var out bytes.Buffer
+		cmd.SetArgs(append(tt.args, "--dry-run", "--region", "eu-west-1", "--policy", ""))
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("%s --dry-run: %v", tt.name, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("%s --dry-run output missing %q:\n%s", tt.name, want, out.String())
+			}
+		}
+		if strings.Contains(out.String(), "DescribeRegions") {
+			t.Errorf("%s --dry-run with --region must not plan region discovery:\n%s", tt.name, out.String())
+		}
+	}
+}
+
+func TestAuditAll_DryRunJSON_WithoutCacheListsDataProtection(t *testing.T) {
+	cmd := newAuditCmd()
+	var out bytes.Buffer
+	cmd.SetArgs([]string{"--all", "--dry-run", "--output", "json", "--collector-cache=false", "--region", "eu-west-1"})
+	cmd.SetOut(&out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("--dry-run: %v", err)
+	}
+	var steps []dryRunStep
+	if err := json.Unmarshal(out.Bytes(), &steps); err != nil {
+		t.Fatalf("output is not a JSON plan: %v\n%s", err, out.String())
+	}
+	domains := make(map[string]bool)
+	for _, s := range steps {
+		domains[s.Domain] = true
+	}
+	for _, d := range []string{"profile", "cost", "security", "dataprotection"} {
+		if !domains[d] {
+			t.Errorf("plan has no %s calls; domains = %v", d, domains)
+		}
+	}
+}
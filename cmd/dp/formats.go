@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// outputFlagUsage is the --output help text shared by the audit commands.
+const outputFlagUsage = "Output format: json or table; a comma-separated list (e.g. table,json) prints the first to stdout and writes the rest to files named after --file"
+
+// outputFormatExt maps each --output format to the extension used for the
+// extra output files written in multi-format runs.
+var outputFormatExt = map[string]string{
+	"table": ".txt",
+	"json":  ".json",
+}
+
+// parseOutputFormats splits an --output value such as "table,json" into its
+// formats. A single format behaves exactly as before. With more than one, the
+// first is printed to stdout and every other format is written to a file
+// derived from filePath (see extraOutputPath), so --file is required.
+func parseOutputFormats(value, filePath string) ([]string, error) {
+	var formats []string
+	seen := make(map[string]bool)
+	for _, f := range strings.Split(value, ",") {
+		f = strings.TrimSpace(f)
+		if _, ok := outputFormatExt[f]; !ok {
+			return nil, fmt.Errorf("unsupported --output format %q: must be table or json", f)
+		}
+		if seen[f] {
+			return nil, fmt.Errorf("--output lists %q more than once", f)
+		}
+		seen[f] = true
+		formats = append(formats, f)
+	}
+	if len(formats) > 1 && filePath == "" {
+		return nil, fmt.Errorf("--output %s writes %s to a file: --file is required", value, strings.Join(formats[1:], ", "))
+	}
+	return formats, nil
+}
+
+// extraOutputPath returns the file an additional --output format is written
+// to: filePath with its extension replaced by the format's (report.json →
+// report.txt for table).
+func extraOutputPath(filePath, format string) string {
+	return strings.TrimSuffix(filePath, filepath.Ext(filePath)) + outputFormatExt[format]
+}
+
+// renderFormats calls render once per entry in formats: the first with stdout,
+// each further one with a buffer that is then written to
+// extraOutputPath(filePath, format). toStdout tells render which case it is in
+// so it can disable color and --output-template for files. A json format whose
+// derived path is filePath itself is skipped: --file already holds the full
+// JSON report there. Any other format whose path is filePath is an error, as
+// writing it would overwrite that report.
+func renderFormats(
+	stdout io.Writer,
+	filePath string,
+	formats []string,
+	render func(w io.Writer, format string, toStdout bool) error,
+) error {
+	for i, format := range formats {
+		if i > 0 && format != "json" && extraOutputPath(filePath, format) == filePath {
+			return fmt.Errorf("--output %s would be written to %q, which --file already uses for the JSON report; choose a --file path with another extension", format, filePath)
+		}
+	}
+	for i, format := range formats {
+		if i == 0 {
+			if err := render(stdout, format, true); err != nil {
+				return err
+			}
+			continue
+		}
+		path := extraOutputPath(filePath, format)
+		if path == filePath {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := render(&buf, format, false); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("write %s output file %q: %w", format, path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestParseOutputFormats(t *testing.T) {
+	tests := []struct {
+		value, file string
+		want        []string
+		wantErr     string
+	}{
+		{value: "table", want: []string{"table"}},
+		{value: "json", want: []string{"json"}},
+		{value: "table,json", file: "out.json", want: []string{"table", "json"}},
+		{value: " json , table ", file: "out.json", want: []string{"json", "table"}},
+		{value: "table,json", wantErr: "--file is required"},
+		{value: "table,table", file: "out.json", wantErr: "more than once"},
+		{value: "table,sarif", file: "out.json", wantErr: `unsupported --output format "sarif"`},
+		{value: "", wantErr: "unsupported --output format"},
+	}
+	for _, tt := range tests {
+		got, err := parseOutputFormats(tt.value, tt.file)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseOutputFormats(%q, %q) error = %v; want %q", tt.value, tt.file, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("parseOutputFormats(%q, %q) = %v, %v; want %v", tt.value, tt.file, got, err, tt.want)
+		}
+	}
+}
+
+func TestExtraOutputPath(t *testing.T) {
+	tests := []struct{ file, format, want string }{
+		{"report.json", "table", "report.txt"},
+		{"report.json", "json", "report.json"},
+		{"out/audit", "json", "out/audit.json"},
+		{"out/v1.2/audit.json", "table", "out/v1.2/audit.txt"},
+	}
+	for _, tt := range tests {
+		if got := extraOutputPath(tt.file, tt.format); got != tt.want {
+			t.Errorf("extraOutputPath(%q, %q) = %q; want %q", tt.file, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestRenderFormats_FirstToStdoutRestToFiles(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit")
+	var calls []string
+	render := func(w io.Writer, format string, toStdout bool) error {
+		if toStdout {
+			calls = append(calls, format+":stdout")
+		} else {
+			calls = append(calls, format+":file")
+		}
+		_, err := io.WriteString(w, "rendered "+format)
+		return err
+	}
+
+	var stdout bytes.Buffer
+	if err := renderFormats(&stdout, filePath, []string{"table", "json"}, render); err != nil {
+		t.Fatalf("renderFormats: %v", err)
+	}
+	if !slices.Equal(calls, []string{"table:stdout", "json:file"}) {
+		t.Errorf("render calls = %v; want [table:stdout json:file]", calls)
+	}
+	if stdout.String() != "rendered table" {
+		t.Errorf("stdout = %q; want the table rendering", stdout.String())
+	}
+	data, err := os.ReadFile(filePath + ".json")
+	if err != nil {
+		t.Fatalf("read derived file: %v", err)
+	}
+	if string(data) != "rendered json" {
+		t.Errorf("audit.json = %q; want the json rendering", data)
+	}
+}
+
+func TestRenderFormats_SkipsFormatWrittenByFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit.json")
+	var calls int
+	render := func(w io.Writer, format string, toStdout bool) error {
+		calls++
+		return nil
+	}
+	if err := renderFormats(io.Discard, filePath, []string{"table", "json"}, render); err != nil {
+		t.Fatalf("renderFormats: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("render called %d times; want 1 (audit.json is already the --file report)", calls)
+	}
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Errorf("renderFormats must not write %s itself; stat err = %v", filePath, err)
+	}
+}
+
+func TestRenderFormats_RejectsNonJSONFormatAtFilePath(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit.txt")
+	var calls int
+	render := func(w io.Writer, format string, toStdout bool) error {
+		calls++
+		return nil
+	}
+	err := renderFormats(io.Discard, filePath, []string{"json", "table"}, render)
+	if err == nil || !strings.Contains(err.Error(), "table") || !strings.Contains(err.Error(), filePath) {
+		t.Fatalf("error = %v; want a conflict naming table and %s", err, filePath)
+	}
+	if calls != 0 {
+		t.Errorf("render called %d times; want 0 before the conflict is reported", calls)
+	}
+}
+
+func TestRenderFormats_KubernetesJSONAndTable(t *testing.T) {
+	report := &models.AuditReport{
+		AuditType: "kubernetes",
+		Findings: []models.Finding{{
+			ID: "K8S_POD_NO_HEALTH_PROBES:ctx:prod/web/app", RuleID: "K8S_POD_NO_HEALTH_PROBES",
+			ResourceID: "web", ResourceType: models.ResourceK8sPod, Region: "ctx", Severity: models.SeverityLow,
+		}},
+	}
+	filePath := filepath.Join(t.TempDir(), "k8s.out")
+	render := func(w io.Writer, format string, toStdout bool) error {
+		return renderKubernetesAuditOutput(w, report, format, renderOptions{colored: toStdout, quiet: true})
+	}
+
+	var stdout bytes.Buffer
+	if err := renderFormats(&stdout, filePath, []string{"json", "table"}, render); err != nil {
+		t.Fatalf("renderFormats: %v", err)
+	}
+	var decoded models.AuditReport
+	if err := json.Unmarshal(stdout.Bytes(), &decoded); err != nil {
+		t.Fatalf("stdout is not JSON: %v\n%s", err, stdout.String())
+	}
+	table, err := os.ReadFile(filepath.Join(filepath.Dir(filePath), "k8s.txt"))
+	if err != nil {
+		t.Fatalf("read table file: %v", err)
+	}
+	if !strings.Contains(string(table), "web") || strings.Contains(string(table), "\x1b[") {
+		t.Errorf("table file should list the finding without color codes:\n%s", table)
+	}
+}
+
+func TestAuditCommands_MultiFormatRequiresFile(t *testing.T) {
+	for name, newCmd := range map[string]func() *cobra.Command{
+		"aws cost":         newCostCmd,
+		"aws security":     newSecurityCmd,
+		"kubernetes audit": newKubernetesAuditCmd,
+		"azure cost":       newAzureCostCmd,
+	} {
+		cmd := newCmd()
+		cmd.SetArgs([]string{"--output", "table,json"})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "--file is required") {
+			t.Errorf("%s: error = %v; want --file is required", name, err)
+		}
+	}
+}
+
+func TestKubernetesAudit_MultiFormatRejectsExplain(t *testing.T) {
+	cmd := newKubernetesAuditCmd()
+	cmd.SetArgs([]string{"--output", "table,json", "--file", filepath.Join(t.TempDir(), "a.json"), "--show-risk-chains", "--explain-all"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "multiple --output formats") {
+		t.Errorf("error = %v; want multiple --output formats rejection", err)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	dpoutput "github.com/pankaj-dahiya-devops/Devops-proxy/internal/output"
+)
+
+func newReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Audit report format utilities",
+	}
+	cmd.AddCommand(newReportSchemaCmd())
+	cmd.AddCommand(newReportRenderCmd())
+	return cmd
+}
+
+// newReportSchemaCmd prints the JSON Schema for the audit report produced by
+// --output json and --file, so downstream consumers can validate reports.
+func newReportSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema for audit reports",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := cmd.OutOrStdout().Write(models.ReportSchemaJSON())
+			return err
+		},
+	}
+}
+
+// newReportRenderCmd re-renders a JSON report saved with --file or
+// --output json, so a finished audit can be viewed in another format without
+// re-running it. The report must carry the current schema_version (see
+// engine.LoadReport).
+func newReportRenderCmd() *cobra.Command {
+	var (
+		filePath       string
+		outputFmt      string
+		outputTemplate string
+		summary        bool
+		rankBy         string
+		top            int
+		color          bool
+		quiet          bool
+		compact        bool
+		currencyCode   string
+		fxRate         float64
+		columnNames    []string
+		histogram      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:          "render",
+		Short:        "Render a saved JSON audit report as a table, summary, JSON, or template",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if outputFmt != "table" && outputFmt != "json" {
+				return fmt.Errorf("unsupported --output %q: must be table or json", outputFmt)
+			}
+			if err := validateRankBy(rankBy); err != nil {
+				return err
+			}
+			columns, err := dpoutput.ParseTableColumns(columnNames)
+			if err != nil {
+				return err
+			}
+			currency, err := parseCurrencyFlags(currencyCode, fxRate)
+			if err != nil {
+				return err
+			}
+			report, err := engine.LoadReport(filePath)
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			if outputTemplate != "" {
+				return dpoutput.RenderTemplate(w, report, outputTemplate)
+			}
+			if outputFmt == "json" {
+				return encodeJSON(w, report, compact)
+			}
+			return renderSavedReport(w, report, renderOptions{
+				summary:   summary,
+				rankBy:    rankBy,
+				topN:      top,
+				colored:   color,
+				quiet:     quiet,
+				columns:   columns,
+				histogram: histogram,
+				currency:  currency,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&filePath, "file", "", "Path to a JSON report written by --file or --output json")
+	cmd.Flags().StringVar(&outputFmt, "output", "table", "Output format: json or table")
+	cmd.Flags().StringVar(&outputTemplate, "output-template", "", "Path to a Go text/template rendered against the report (overrides --output)")
+	cmd.Flags().BoolVar(&summary, "summary", false, "Print compact summary: totals, severity breakdown, top findings (see --top)")
+	cmd.Flags().StringVar(&rankBy, "rank-by", rankBySavings, "Top Findings ranking in --summary output: savings, severity, or risk")
+	cmd.Flags().IntVar(&top, "top", defaultTopFindings, "Number of findings listed in the --summary Top Findings table (values below 1 use the default)")
+	cmd.Flags().BoolVar(&color, "color", false, "Enable colored severity output in table format (not CI-safe)")
+	cmd.Flags().BoolVar(&quiet, "quiet", false, "Suppress the Profile:/Context: banner line in table output (no effect on JSON)")
+	cmd.Flags().BoolVar(&compact, "compact", false, "Write JSON without indentation with --output json")
+	addColumnsFlag(cmd, &columnNames)
+	addHistogramFlag(cmd, &histogram)
+	addCurrencyFlags(cmd, &currencyCode, &fxRate)
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// renderSavedReport writes report as a table or --summary to w using the
+// renderer of the command that produced it, chosen by audit_type. Cost
+// reports without a profile come from dp azure cost; Profile "multi" marks a
+// multi-profile AWS report and adds the PROFILE column.
+func renderSavedReport(w io.Writer, report *models.AuditReport, ro renderOptions) error {
+	ro.allProfiles = report.Profile == "multi"
+	switch report.AuditType {
+	case "cost":
+		if report.Profile == "" {
+			return renderAzureCostOutput(w, report, "table", ro)
+		}
+		return renderAWSCostOutput(w, report, "table", ro)
+	case "security":
+		return renderAWSSecurityOutput(w, report, "table", ro)
+	case "dataprotection":
+		return renderAWSDataProtectionOutput(w, report, "table", ro)
+	case "all":
+		return renderAllDomainsOutput(w, report, "table", ro)
+	case "kubernetes":
+		return renderKubernetesAuditOutput(w, report, "table", ro)
+	default:
+		return fmt.Errorf("unsupported audit_type %q in report", report.AuditType)
+	}
+}
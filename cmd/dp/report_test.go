@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// compileReportSchema runs `dp report schema`, checks the output is valid
+// JSON, and compiles it into a validator.
+func compileReportSchema(t *testing.T) *jsonschema.Schema {
+	t.Helper()
+	var out bytes.Buffer
+	cmd := newReportCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"schema"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("report schema: %v", err)
+	}
+	if !json.Valid(out.Bytes()) {
+		t.Fatalf("report schema output is not valid JSON:\n%s", out.String())
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(out.Bytes()))
+	if err != nil {
+		t.Fatalf("unmarshal schema: %v", err)
+	}
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource("report.schema.json", doc); err != nil {
+		t.Fatalf("add schema resource: %v", err)
+	}
+	sch, err := c.Compile("report.schema.json")
+	if err != nil {
+		t.Fatalf("compile schema: %v", err)
+	}
+	return sch
+}
+
+// validateReport marshals report exactly as --output json does and validates
+// the result against sch.
+func validateReport(t *testing.T, sch *jsonschema.Schema, report *models.AuditReport) error {
+	t.Helper()
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("marshal report: %v", err)
+	}
+	inst, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unmarshal report: %v", err)
+	}
+	return sch.Validate(inst)
+}
+
+func TestReportSchema_KubernetesReportValidates(t *testing.T) {
+	sch := compileReportSchema(t)
+
+	privileged := true
+	cs := fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "priv", Namespace: "production"},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:            "app",
+				SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+			}}},
+		},
+	)
+	registry := rules.NewDefaultRuleRegistry()
+	for _, r := range k8scorepack.New(nil) {
+		registry.Register(r)
+	}
+	provider := &testKubeProvider{clientset: cs, info: kube.ClusterInfo{ContextName: "schema-ctx"}}
+	report, err := engine.NewKubernetesEngine(provider, registry, nil).
+		RunAudit(context.Background(), engine.KubernetesAuditOptions{ShowRiskChains: true})
+	if err != nil {
+		t.Fatalf("RunAudit: %v", err)
+	}
+	if len(report.Summary.RiskChains) == 0 {
+		t.Fatal("expected at least one risk chain so the RiskChain schema is exercised")
+	}
+
+	if err := validateReport(t, sch, report); err != nil {
+		t.Errorf("kubernetes report does not validate against schema: %v", err)
+	}
+}
+
+func TestReportSchema_CostReportWithAllSectionsValidates(t *testing.T) {
+	sch := compileReportSchema(t)
+
+	report := makeReport([]models.Finding{
+		{ID: "f1", RuleID: "EBS_UNATTACHED", ResourceID: "vol-1", Region: "us-east-1",
+			Severity: models.SeverityMedium, EstimatedMonthlySavings: 8,
+			Metadata: map[string]any{"size_gb": 100}},
+	})
+	report.CostSummary = &models.AWSCostSummary{
+		PeriodStart:      "2026-09-01",
+		PeriodEnd:        "2026-10-01",
+		TotalCostUSD:     1234.5,
+		ServiceBreakdown: []models.AWSServiceCost{{Service: "Amazon EC2", CostUSD: 1000}},
+	}
+	report.Summary.RiskChains = []models.RiskChain{{Score: 80, Reason: "r", FindingIDs: []string{"f1"}}}
+	report.Summary.AttackPaths = []models.AttackPath{{Score: 98, Layers: []string{"a", "b"}, FindingIDs: []string{"f1"}, Description: "d"}}
+	report.Summary.Compliance = []models.FrameworkCompliance{{Framework: "CIS-1.4", RulesPassed: 1}}
+	report.Errors = []models.AuditError{{Stage: models.AuditStageCollect, Provider: "aws/cost", Region: "us-east-1", Message: "m"}}
+
+	if err := validateReport(t, sch, report); err != nil {
+		t.Errorf("cost report does not validate against schema: %v", err)
+	}
+}
+
+// TestReportSchema_PolicyFailedAllDomainsReportValidates covers dp aws audit
+// --all, which records exit code 2 when policy enforcement fired.
+func TestReportSchema_PolicyFailedAllDomainsReportValidates(t *testing.T) {
+	sch := compileReportSchema(t)
+
+	report := makeReport([]models.Finding{{ID: "f1", RuleID: "EBS_UNATTACHED", Severity: models.SeverityHigh}})
+	report.Summary.ExitCode = exitCodePolicy
+
+	if err := validateReport(t, sch, report); err != nil {
+		t.Errorf("policy-failed report does not validate against schema: %v", err)
+	}
+
+	report.Summary.ExitCode = 4
+	if err := validateReport(t, sch, report); err == nil {
+		t.Error("expected validation error for unknown exit code 4")
+	}
+}
+
+// TestReportSchema_ErrorsFailedReportValidates covers --fail-on-errors, which
+// records exit code 3 and attributes profile failures in errors.
+func TestReportSchema_ErrorsFailedReportValidates(t *testing.T) {
+	sch := compileReportSchema(t)
+
+	report := makeReport(nil)
+	report.Summary.ExitCode = exitCodeErrors
+	report.Errors = []models.AuditError{{
+		Stage: models.AuditStageCollect, Provider: "aws/cost", Profile: "staging", Message: "AccessDenied",
+	}}
+
+	if err := validateReport(t, sch, report); err != nil {
+		t.Errorf("errors-failed report does not validate against schema: %v", err)
+	}
+}
+
+func TestReportSchema_RejectsUnknownSeverity(t *testing.T) {
+	sch := compileReportSchema(t)
+
+	report := makeReport([]models.Finding{{ID: "f1", Severity: "URGENT"}})
+	if err := validateReport(t, sch, report); err == nil {
+		t.Error("expected validation error for unknown severity URGENT")
+	}
+}
+
+// runReportRender saves report to a temp file and runs `dp report render
+// --file <path>` with extra args, returning stdout.
+func runReportRender(t *testing.T, report *models.AuditReport, args ...string) (string, error) {
+	t.Helper()
+	if report.SchemaVersion == "" {
+		report.SchemaVersion = models.ReportSchemaVersion
+	}
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := writeReportToFile(path, report, false); err != nil {
+		t.Fatalf("writeReportToFile: %v", err)
+	}
+	var out bytes.Buffer
+	cmd := newReportCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(append([]string{"render", "--file", path}, args...))
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestReportRender_KubernetesTable(t *testing.T) {
+	report := makeReport([]models.Finding{{
+		RuleID: "K8S_POD_RUN_AS_ROOT", ResourceID: "api-7d9f", ResourceType: models.ResourceK8sPod,
+		Region: "prod-cluster", Severity: models.SeverityHigh, Explanation: "Pod runs as root.",
+	}})
+	report.AuditType = "kubernetes"
+	report.Profile = "prod-cluster"
+
+	out, err := runReportRender(t, report)
+	if err != nil {
+		t.Fatalf("report render: %v", err)
+	}
+	for _, want := range []string{"Context: prod-cluster", "CONTEXT", "api-7d9f", "Pod runs as root."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output must contain %q; got:\n%s", want, out)
+		}
+	}
+}
+
+func TestReportRender_CostTableWithColumns(t *testing.T) {
+	report := makeReport([]models.Finding{{
+		RuleID: "EBS_UNATTACHED", ResourceID: "vol-1", Region: "us-east-1",
+		Severity: models.SeverityMedium, EstimatedMonthlySavings: 8,
+	}})
+
+	out, err := runReportRender(t, report, "--columns", "resource,savings")
+	if err != nil {
+		t.Fatalf("report render: %v", err)
+	}
+	if !strings.Contains(out, "Profile: staging") || !strings.Contains(out, "vol-1") || !strings.Contains(out, "$8.00") {
+		t.Errorf("unexpected cost table output:\n%s", out)
+	}
+	if strings.Contains(out, "EBS_UNATTACHED") {
+		t.Errorf("--columns must drop the rule column; got:\n%s", out)
+	}
+}
+
+func TestReportRender_JSONRoundTrip(t *testing.T) {
+	report := makeReport([]models.Finding{{ID: "f1", RuleID: "EBS_UNATTACHED", ResourceID: "vol-1", Severity: models.SeverityLow}})
+
+	out, err := runReportRender(t, report, "--output", "json", "--compact")
+	if err != nil {
+		t.Fatalf("report render: %v", err)
+	}
+	var got models.AuditReport
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not a JSON report: %v\n%s", err, out)
+	}
+	if got.ReportID != report.ReportID || len(got.Findings) != 1 || got.Findings[0].ID != "f1" {
+		t.Errorf("round-tripped report = %+v; want the saved report", got)
+	}
+	if strings.Count(strings.TrimSpace(out), "\n") != 0 {
+		t.Errorf("--compact output must be a single line; got:\n%s", out)
+	}
+}
+
+func TestReportRender_RejectsUnsupportedFormat(t *testing.T) {
+	_, err := runReportRender(t, makeReport(nil), "--output", "sarif")
+	if err == nil || !strings.Contains(err.Error(), `unsupported --output "sarif"`) {
+		t.Errorf("err = %v; want unsupported --output error", err)
+	}
+}
+
+func TestReportRender_RejectsUnknownAuditType(t *testing.T) {
+	report := makeReport(nil)
+	report.AuditType = "gcp"
+	_, err := runReportRender(t, report)
+	if err == nil || !strings.Contains(err.Error(), `unsupported audit_type "gcp"`) {
+		t.Errorf("err = %v; want unsupported audit_type error", err)
+	}
+}
+
+func TestReportRender_RejectsOtherSchemaVersion(t *testing.T) {
+	report := makeReport(nil)
+	report.SchemaVersion = "0"
+	_, err := runReportRender(t, report)
+	if err == nil || !strings.Contains(err.Error(), "schema_version") {
+		t.Errorf("err = %v; want schema_version error", err)
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	awseks "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/eks"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
+)
+
+// serveShutdownTimeout bounds how long dp serve waits for in-flight audits
+// after Ctrl-C before closing their connections.
+const serveShutdownTimeout = 10 * time.Second
+
+// kubernetesAuditor is the part of *engine.KubernetesEngine used by dp serve.
+type kubernetesAuditor interface {
+	RunAudit(ctx context.Context, opts engine.KubernetesAuditOptions) (*models.AuditReport, error)
+}
+
+func newServeCmd() *cobra.Command {
+	var (
+		listen     string
+		port       int
+		kubeconfig string
+		policyPath string
+	)
+
+	cmd := &cobra.Command{
+		Use:          "serve",
+		Short:        "Serve on-demand audits over HTTP (GET /audit/kubernetes?context=NAME)",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := serveAddr(listen, port)
+			if err != nil {
+				return err
+			}
+			policyCfg, err := loadPolicyFile(policyPath)
+			if err != nil {
+				return fmt.Errorf("load policy: %w", err)
+			}
+			coreRegistry, eksRegistry, err := kubernetesRegistries(policyCfg, k8scorepack.Options{}, nil, nil)
+			if err != nil {
+				return err
+			}
+			provider := kube.NewKubeClientProviderFromPath(kubeconfig)
+			eng := engine.NewKubernetesEngineWithEKS(
+				provider,
+				coreRegistry,
+				eksRegistry,
+				awseks.NewDefaultEKSCollector(),
+				policyCfg,
+			)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			srv := &http.Server{
+				Addr:              addr,
+				Handler:           newServeHandler(eng, provider),
+				ReadHeaderTimeout: 10 * time.Second,
+				BaseContext:       func(net.Listener) context.Context { return ctx },
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "dp serve: listening on %s (Ctrl-C to stop)\n", addr)
+			return runServer(ctx, srv)
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", "127.0.0.1", "Address to listen on; the unauthenticated API is only reachable locally by default (use 0.0.0.0 to listen on all interfaces)")
+	cmd.Flags().IntVar(&port, "port", 8080, "TCP port to listen on")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to kubeconfig file (default: $KUBECONFIG or ~/.kube/config)")
+	cmd.Flags().StringVar(&policyPath, "policy", "", "Path to dp.yaml policy file (default: ./dp.yaml if present)")
+
+	return cmd
+}
+
+// serveAddr validates --listen and --port and joins them into a listen
+// address. An empty --listen is rejected rather than silently binding every
+// interface.
+func serveAddr(listen string, port int) (string, error) {
+	if listen == "" {
+		return "", fmt.Errorf("--listen must not be empty; use 0.0.0.0 to listen on all interfaces")
+	}
+	if port < 1 || port > 65535 {
+		return "", fmt.Errorf("--port must be between 1 and 65535, got %d", port)
+	}
+	return net.JoinHostPort(listen, strconv.Itoa(port)), nil
+}
+
+// runServer serves srv until ctx is cancelled, then shuts it down gracefully.
+// A listen error (e.g. the port is in use) is returned immediately.
+func runServer(ctx context.Context, srv *http.Server) error {
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.ListenAndServe() }()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("serve: %w", err)
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("serve: shutdown: %w", err)
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serve: %w", err)
+	}
+	return nil
+}
+
+// newServeHandler returns the dp serve routes:
+//
+//	GET /healthz                        → {"status":"ok"}
+//	GET /audit/kubernetes?context=NAME  → the JSON audit report
+//
+// context is optional and defaults to the kubeconfig's current context. When
+// provider can list its contexts, an unknown name is rejected with 400 before
+// any audit runs; any audit failure is a 500. Errors are JSON: {"error":"..."}.
+// Policy enforcement and the CRITICAL/HIGH exit code do not apply.
+func newServeHandler(eng kubernetesAuditor, provider kube.KubeClientProvider) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc("GET /audit/kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		contextName := r.URL.Query().Get("context")
+		if lister, ok := provider.(kube.KubeContextLister); ok && contextName != "" {
+			contexts, err := lister.ListContexts()
+			if err != nil {
+				writeJSONError(w, http.StatusInternalServerError, fmt.Errorf("list contexts: %w", err))
+				return
+			}
+			if !slices.Contains(contexts, contextName) {
+				writeJSONError(w, http.StatusBadRequest, fmt.Errorf("unknown kubeconfig context %q", contextName))
+				return
+			}
+		}
+		report, err := eng.RunAudit(r.Context(), engine.KubernetesAuditOptions{
+			ContextName:  contextName,
+			ReportFormat: engine.ReportFormatJSON,
+		})
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, fmt.Errorf("kubernetes audit failed: %w", err))
+			return
+		}
+		writeJSON(w, http.StatusOK, report)
+	})
+	return mux
+}
+
+// writeJSON writes v as an indented JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
+
+// writeJSONError writes {"error": err} with the given status.
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
+)
+
+// listingKubeProvider serves one known context from a fake clientset and
+// implements kube.KubeContextLister; any other context fails like a missing
+// kubeconfig entry.
+type listingKubeProvider struct {
+	context   string
+	clientset k8sclient.Interface
+}
+
+func (p *listingKubeProvider) ClientsetForContext(contextName string) (k8sclient.Interface, kube.ClusterInfo, error) {
+	if contextName != "" && contextName != p.context {
+		return nil, kube.ClusterInfo{}, fmt.Errorf("context %q does not exist", contextName)
+	}
+	return p.clientset, kube.ClusterInfo{ContextName: p.context}, nil
+}
+
+func (p *listingKubeProvider) ListContexts() ([]string, error) {
+	return []string{p.context}, nil
+}
+
+// newTestServeServer starts an httptest server running newServeHandler with
+// the default Kubernetes core rules against provider.
+func newTestServeServer(t *testing.T, provider kube.KubeClientProvider) *httptest.Server {
+	t.Helper()
+	core, _, err := kubernetesRegistries(nil, k8scorepack.Options{}, nil, nil)
+	if err != nil {
+		t.Fatalf("kubernetesRegistries: %v", err)
+	}
+	srv := httptest.NewServer(newServeHandler(engine.NewKubernetesEngine(provider, core, nil), provider))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// servePrivilegedCluster returns a fake cluster with one privileged pod.
+func servePrivilegedCluster() k8sclient.Interface {
+	privileged := true
+	return fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "priv", Namespace: "production"},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:            "app",
+				SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+			}}},
+		},
+	)
+}
+
+// getJSON issues GET url and decodes the JSON body into v.
+func getJSON(t *testing.T, url string, v any) *http.Response {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("GET %s: Content-Type = %q; want application/json", url, ct)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("GET %s: body is not JSON: %v", url, err)
+	}
+	return resp
+}
+
+func TestServe_Healthz(t *testing.T) {
+	srv := newTestServeServer(t, &testKubeProvider{clientset: fake.NewSimpleClientset()})
+	var body map[string]string
+	resp := getJSON(t, srv.URL+"/healthz", &body)
+	if resp.StatusCode != http.StatusOK || body["status"] != "ok" {
+		t.Errorf("healthz = %d %v; want 200 {status: ok}", resp.StatusCode, body)
+	}
+}
+
+func TestServe_AuditKubernetes_ReturnsJSONReport(t *testing.T) {
+	provider := &testKubeProvider{clientset: servePrivilegedCluster(), info: kube.ClusterInfo{ContextName: "prod"}}
+	srv := newTestServeServer(t, provider)
+
+	var report models.AuditReport
+	resp := getJSON(t, srv.URL+"/audit/kubernetes?context=prod", &report)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d; want 200", resp.StatusCode)
+	}
+	if provider.calledWithCtx != "prod" {
+		t.Errorf("audited context %q; want prod", provider.calledWithCtx)
+	}
+	if report.AuditType != "kubernetes" {
+		t.Errorf("AuditType = %q; want kubernetes", report.AuditType)
+	}
+	found := false
+	for _, f := range report.Findings {
+		if f.RuleID == "K8S_PRIVILEGED_CONTAINER" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("report has no K8S_PRIVILEGED_CONTAINER finding: %+v", report.Findings)
+	}
+}
+
+func TestServe_AuditKubernetes_UnknownContextIs400(t *testing.T) {
+	srv := newTestServeServer(t, &listingKubeProvider{context: "prod", clientset: servePrivilegedCluster()})
+
+	var body map[string]string
+	resp := getJSON(t, srv.URL+"/audit/kubernetes?context=staging", &body)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d; want 400", resp.StatusCode)
+	}
+	if !strings.Contains(body["error"], `"staging"`) {
+		t.Errorf("error body = %v; want it to name the context", body)
+	}
+}
+
+func TestServe_AuditKubernetes_AuditFailureIs500(t *testing.T) {
+	// failKubeProvider cannot list contexts, so the failure surfaces from the
+	// audit itself.
+	srv := newTestServeServer(t, &failKubeProvider{})
+
+	var body map[string]string
+	resp := getJSON(t, srv.URL+"/audit/kubernetes?context=bad", &body)
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("status = %d; want 500", resp.StatusCode)
+	}
+	if !strings.Contains(body["error"], "kubernetes audit failed") || !strings.Contains(body["error"], "kubeconfig not found") {
+		t.Errorf("error body = %v; want the audit error", body)
+	}
+}
+
+func TestServeAddr(t *testing.T) {
+	cases := []struct {
+		listen  string
+		port    int
+		want    string
+		wantErr bool
+	}{
+		{listen: "127.0.0.1", port: 8080, want: "127.0.0.1:8080"},
+		{listen: "0.0.0.0", port: 9000, want: "0.0.0.0:9000"},
+		{listen: "::1", port: 8080, want: "[::1]:8080"},
+		{listen: "", port: 8080, wantErr: true},
+		{listen: "127.0.0.1", port: 0, wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := serveAddr(tc.listen, tc.port)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("serveAddr(%q, %d) error = %v; wantErr %v", tc.listen, tc.port, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("serveAddr(%q, %d) = %q; want %q", tc.listen, tc.port, got, tc.want)
+		}
+	}
+}
+
+func TestServeCmd_ListenDefaultsToLoopback(t *testing.T) {
+	flag := newServeCmd().Flags().Lookup("listen")
+	if flag == nil {
+		t.Fatal("--listen flag not registered on serve command")
+	}
+	if flag.DefValue != "127.0.0.1" {
+		t.Errorf("--listen default = %q; want 127.0.0.1", flag.DefValue)
+	}
+}
+
+func TestServe_RejectsNonGET(t *testing.T) {
+	srv := newTestServeServer(t, &testKubeProvider{clientset: fake.NewSimpleClientset()})
+	resp, err := http.Post(srv.URL+"/audit/kubernetes", "application/json", nil)
+	if err != nil {
+		t.Fatalf("POST: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d; want 405", resp.StatusCode)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// Finding orders accepted by --sort. severity, savings and risk share the
+// --rank-by comparators.
+const (
+	sortBySeverity = rankBySeverity
+	sortBySavings  = rankBySavings
+	sortByResource = "resource"
+	sortByRisk     = rankByRisk
+)
+
+// sortComparators maps each --sort key to a "less" function. Sorting is
+// stable, so findings that compare equal keep the engine's severity order.
+var sortComparators = map[string]func(a, b models.Finding) bool{
+	sortBySeverity: lessBySeverity,
+	sortBySavings:  lessBySavings,
+	sortByResource: lessByResource,
+	sortByRisk:     lessByRisk,
+}
+
+// lessByResource orders by ResourceID, then Region, ascending.
+func lessByResource(a, b models.Finding) bool {
+	if a.ResourceID != b.ResourceID {
+		return a.ResourceID < b.ResourceID
+	}
+	return a.Region < b.Region
+}
+
+// addSortFlag registers --sort on an audit command.
+func addSortFlag(cmd *cobra.Command, sortBy *string) {
+	cmd.Flags().StringVar(sortBy, "sort", sortBySeverity, "Order of findings in table and JSON output: severity, savings, resource, or risk")
+}
+
+// validateSort returns an error when sortBy is not a known --sort key.
+func validateSort(sortBy string) error {
+	if _, ok := sortComparators[sortBy]; !ok {
+		return fmt.Errorf("invalid --sort %q: must be severity, savings, resource, or risk", sortBy)
+	}
+	return nil
+}
+
+// sortReportFindings reorders report.Findings by the sortBy comparator. It
+// runs after correlation, filtering and summary computation, and before the
+// report is signed or rendered, so every output shows the same order.
+func sortReportFindings(report *models.AuditReport, sortBy string) {
+	less, ok := sortComparators[sortBy]
+	if !ok {
+		return
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return less(report.Findings[i], report.Findings[j])
+	})
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// sortTestReport returns findings in the engine's severity order:
+//
+//	a-high  HIGH    $5   risk 0    (vol-b)
+//	b-med   MEDIUM  $50  risk 80   (vol-c)
+//	c-low   LOW     $20  risk 0    (vol-a)
+func sortTestReport() *models.AuditReport {
+	return &models.AuditReport{Findings: []models.Finding{
+		{ID: "a-high", ResourceID: "vol-b", Region: "us-east-1", Severity: models.SeverityHigh, EstimatedMonthlySavings: 5},
+		{ID: "b-med", ResourceID: "vol-c", Region: "us-east-1", Severity: models.SeverityMedium, EstimatedMonthlySavings: 50,
+			Metadata: map[string]any{"risk_chain_score": 80}},
+		{ID: "c-low", ResourceID: "vol-a", Region: "us-east-1", Severity: models.SeverityLow, EstimatedMonthlySavings: 20},
+	}}
+}
+
+func findingIDs(findings []models.Finding) []string {
+	ids := make([]string, len(findings))
+	for i, f := range findings {
+		ids[i] = f.ID
+	}
+	return ids
+}
+
+func TestSortReportFindings(t *testing.T) {
+	tests := []struct {
+		sortBy string
+		want   []string
+	}{
+		{sortBySeverity, []string{"a-high", "b-med", "c-low"}},
+		{sortBySavings, []string{"b-med", "c-low", "a-high"}},
+		{sortByResource, []string{"c-low", "a-high", "b-med"}},
+		{sortByRisk, []string{"b-med", "a-high", "c-low"}},
+	}
+	for _, tt := range tests {
+		report := sortTestReport()
+		sortReportFindings(report, tt.sortBy)
+		if got := findingIDs(report.Findings); !slices.Equal(got, tt.want) {
+			t.Errorf("--sort %s: order = %v; want %v", tt.sortBy, got, tt.want)
+		}
+	}
+}
+
+func TestSortReportFindings_RiskFromDecodedJSON(t *testing.T) {
+	data, err := json.Marshal(sortTestReport())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var report models.AuditReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	sortReportFindings(&report, sortByRisk)
+	if got := findingIDs(report.Findings); !slices.Equal(got, []string{"b-med", "a-high", "c-low"}) {
+		t.Errorf("order = %v; want risk order [b-med a-high c-low] with float64 scores", got)
+	}
+	if got := findingIDs(topFindings(report.Findings, 1, rankByRisk)); !slices.Equal(got, []string{"b-med"}) {
+		t.Errorf("--rank-by risk top = %v; want [b-med]", got)
+	}
+}
+
+func TestSortReportFindings_ResourceTiesByRegion(t *testing.T) {
+	report := &models.AuditReport{Findings: []models.Finding{
+		{ID: "west", ResourceID: "bucket", Region: "us-west-2"},
+		{ID: "east", ResourceID: "bucket", Region: "us-east-1"},
+	}}
+	sortReportFindings(report, sortByResource)
+	if got := findingIDs(report.Findings); !slices.Equal(got, []string{"east", "west"}) {
+		t.Errorf("order = %v; want [east west]", got)
+	}
+}
+
+func TestSortReportFindings_JSONReflectsOrder(t *testing.T) {
+	report := sortTestReport()
+	report.AuditType = "kubernetes"
+	sortReportFindings(report, sortBySavings)
+
+	var buf bytes.Buffer
+	if err := renderKubernetesAuditOutput(&buf, report, "json", renderOptions{quiet: true}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	var decoded models.AuditReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+	if got := findingIDs(decoded.Findings); !slices.Equal(got, []string{"b-med", "c-low", "a-high"}) {
+		t.Errorf("JSON findings order = %v; want savings order [b-med c-low a-high]", got)
+	}
+}
+
+func TestAuditCommands_RejectUnknownSort(t *testing.T) {
+	for name, newCmd := range map[string]func() *cobra.Command{
+		"aws cost":         newCostCmd,
+		"aws security":     newSecurityCmd,
+		"kubernetes audit": newKubernetesAuditCmd,
+		"azure cost":       newAzureCostCmd,
+	} {
+		cmd := newCmd()
+		cmd.SetArgs([]string{"--sort", "age"})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), `invalid --sort "age"`) {
+			t.Errorf("%s: error = %v; want invalid --sort", name, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -42,6 +43,46 @@ func TestVersionCmd_Output(t *testing.T) {
 	}
 }
 
+func TestVersionCmd_JSON(t *testing.T) {
+	orig := version.Version
+	origC := version.Commit
+	origD := version.Date
+	t.Cleanup(func() {
+		version.Version = orig
+		version.Commit = origC
+		version.Date = origD
+	})
+
+	version.Version = "v1.2.3"
+	version.Commit = "deadbeef"
+	version.Date = "2026-01-15"
+
+	var buf bytes.Buffer
+	root := newRootCmd()
+	root.SetOut(&buf)
+	root.SetErr(&buf)
+	root.SetArgs([]string{"version", "--json"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("version --json returned error: %v", err)
+	}
+
+	var got map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("version --json output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	want := map[string]string{
+		"version":    "v1.2.3",
+		"commit":     "deadbeef",
+		"build_date": "2026-01-15",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q; want %q", k, got[k], v)
+		}
+	}
+}
+
 func TestVersionInfo_Format(t *testing.T) {
 	orig := version.Version
 	origC := version.Commit
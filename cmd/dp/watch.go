@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// watchCycle is the JSON object emitted for every --watch cycle. New and
+// Resolved compare the cycle with the previous one using engine.DiffReports;
+// in the first cycle every finding is new.
+type watchCycle struct {
+	Cycle    int                 `json:"cycle"`
+	New      []engine.DiffEntry  `json:"new"`
+	Resolved []engine.DiffEntry  `json:"resolved"`
+	Report   *models.AuditReport `json:"report"`
+}
+
+// validateWatchFlags returns an error when --interval is not positive or
+// --watch is combined with a flag that produces a single, final result
+// (--diff-context, the explain modes, --file, --output-template, --sign-key).
+func validateWatchFlags(watch bool, interval time.Duration, diffContext string, explain bool, filePath, outputTemplate, signKey string) error {
+	if !watch {
+		return nil
+	}
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive, got %s", interval)
+	}
+	switch {
+	case diffContext != "":
+		return fmt.Errorf("--watch and --diff-context are mutually exclusive")
+	case explain:
+		return fmt.Errorf("--watch cannot be combined with --explain-path, --explain-chain, or --explain-all")
+	case filePath != "":
+		return fmt.Errorf("--watch cannot be combined with --file")
+	case outputTemplate != "":
+		return fmt.Errorf("--watch cannot be combined with --output-template")
+	case signKey != "":
+		return fmt.Errorf("--watch cannot be combined with --sign-key")
+	}
+	return nil
+}
+
+// runKubernetesWatch runs audit once, then again on every value received from
+// ticks, until ctx is cancelled (Ctrl-C), at which point it returns nil.
+//
+// In JSON mode every cycle is written to w as one compact watchCycle object per
+// line. Otherwise the first cycle is written with render and each later cycle
+// as a one-line header followed by its new (+) and resolved (-) findings.
+// The first audit failing is returned as an error; later failures are reported
+// on errW and the next cycle is compared against the last successful one.
+// Policy enforcement and the CRITICAL/HIGH exit code do not apply.
+func runKubernetesWatch(
+	ctx context.Context,
+	ticks <-chan time.Time,
+	audit func(context.Context) (*models.AuditReport, error),
+	render func(io.Writer, *models.AuditReport) error,
+	outputFmt string,
+	w io.Writer,
+	errW io.Writer,
+) error {
+	prev := &models.AuditReport{}
+	for cycle := 1; ; cycle++ {
+		if cycle > 1 {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticks:
+			}
+		}
+
+		report, err := audit(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if cycle == 1 {
+				return fmt.Errorf("kubernetes audit failed: %w", err)
+			}
+			fmt.Fprintf(errW, "watch cycle %d failed: %v\n", cycle, err)
+			continue
+		}
+
+		diff := engine.DiffReports(prev, report)
+		if outputFmt == "json" {
+			c := watchCycle{Cycle: cycle, New: diff.OnlyInB, Resolved: diff.OnlyInA, Report: report}
+			if err := json.NewEncoder(w).Encode(c); err != nil {
+				return fmt.Errorf("encode watch cycle: %w", err)
+			}
+		} else if cycle == 1 {
+			if err := render(w, report); err != nil {
+				return err
+			}
+		} else {
+			renderWatchDelta(w, cycle, report, diff)
+		}
+		prev = report
+	}
+}
+
+// renderWatchDelta writes the table-mode summary of one --watch cycle:
+//
+//	[2026-10-15T10:00:00Z] cycle 2: 1 new, 0 resolved, 7 findings
+//	  + K8S_PRIVILEGED_CONTAINER production/priv
+func renderWatchDelta(w io.Writer, cycle int, report *models.AuditReport, diff engine.ReportDiff) {
+	fmt.Fprintf(w, "\n[%s] cycle %d: %d new, %d resolved, %d findings\n",
+		report.GeneratedAt.UTC().Format(time.RFC3339), cycle,
+		len(diff.OnlyInB), len(diff.OnlyInA), report.Summary.TotalFindings)
+	if len(diff.OnlyInB) > 0 {
+		for _, line := range diffColumn(diff.OnlyInB) {
+			fmt.Fprintf(w, "  + %s\n", line)
+		}
+	}
+	if len(diff.OnlyInA) > 0 {
+		for _, line := range diffColumn(diff.OnlyInA) {
+			fmt.Fprintf(w, "  - %s\n", line)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
+)
+
+func watchPod(ns, name string, privileged bool) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:            "app",
+			SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+		}}},
+	}
+}
+
+// runTwoWatchCycles drives runKubernetesWatch through two cycles against a
+// fake cluster. Before the second cycle the privileged pod "old" is deleted
+// and the privileged pod "new" is created; the watch is cancelled after the
+// second audit, as Ctrl-C would.
+func runTwoWatchCycles(t *testing.T, outputFmt string) string {
+	t.Helper()
+	cs := fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		watchPod("default", "old", true),
+	)
+	core, _, err := kubernetesRegistries(nil, k8scorepack.Options{}, []string{"K8S_PRIVILEGED_CONTAINER"}, nil)
+	if err != nil {
+		t.Fatalf("kubernetesRegistries: %v", err)
+	}
+	provider := &testKubeProvider{clientset: cs, info: kube.ClusterInfo{ContextName: "watch-ctx"}}
+	eng := engine.NewKubernetesEngine(provider, core, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ticks := make(chan time.Time, 1)
+	ticks <- time.Now() // exactly one tick: the second cycle
+
+	calls := 0
+	audit := func(ctx context.Context) (*models.AuditReport, error) {
+		calls++
+		if calls == 2 {
+			pods := cs.CoreV1().Pods("default")
+			if err := pods.Delete(ctx, "old", metav1.DeleteOptions{}); err != nil {
+				t.Fatalf("delete pod: %v", err)
+			}
+			if _, err := cs.CoreV1().Pods("production").Create(ctx, watchPod("production", "new", true), metav1.CreateOptions{}); err != nil {
+				t.Fatalf("create pod: %v", err)
+			}
+			defer cancel()
+		}
+		return eng.RunAudit(ctx, engine.KubernetesAuditOptions{})
+	}
+	render := func(w io.Writer, report *models.AuditReport) error {
+		return renderKubernetesAuditOutput(w, report, outputFmt, renderOptions{})
+	}
+
+	var out, errOut bytes.Buffer
+	if err := runKubernetesWatch(ctx, ticks, audit, render, outputFmt, &out, &errOut); err != nil {
+		t.Fatalf("runKubernetesWatch error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("audit ran %d times; want 2", calls)
+	}
+	if errOut.Len() > 0 {
+		t.Errorf("unexpected stderr output: %s", errOut.String())
+	}
+	return out.String()
+}
+
+func TestRunKubernetesWatch_TableDelta(t *testing.T) {
+	out := runTwoWatchCycles(t, "table")
+
+	// Cycle 1 is the normal audit table.
+	if !strings.Contains(out, "Context: watch-ctx") || !strings.Contains(out, "old") {
+		t.Errorf("first cycle should render the full table; got:\n%s", out)
+	}
+	for _, want := range []string{
+		"cycle 2: 1 new, 1 resolved, 1 findings",
+		"  + K8S_PRIVILEGED_CONTAINER production/new",
+		"  - K8S_PRIVILEGED_CONTAINER default/old",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q; got:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunKubernetesWatch_JSONOneObjectPerCycle(t *testing.T) {
+	out := runTwoWatchCycles(t, "json")
+
+	var cycles []watchCycle
+	sc := bufio.NewScanner(strings.NewReader(out))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		var c watchCycle
+		if err := json.Unmarshal(sc.Bytes(), &c); err != nil {
+			t.Fatalf("line is not a JSON object: %v\n%s", err, sc.Text())
+		}
+		cycles = append(cycles, c)
+	}
+	if len(cycles) != 2 {
+		t.Fatalf("got %d JSON lines; want 2\n%s", len(cycles), out)
+	}
+	if cycles[0].Cycle != 1 || len(cycles[0].New) != 1 || cycles[0].Report == nil {
+		t.Errorf("cycle 1 = %+v; want every finding new and a report", cycles[0])
+	}
+	c := cycles[1]
+	if c.Cycle != 2 || len(c.New) != 1 || c.New[0].ResourceID != "new" ||
+		len(c.Resolved) != 1 || c.Resolved[0].ResourceID != "old" {
+		t.Errorf("cycle 2 delta = new %v, resolved %v; want new [new], resolved [old]", c.New, c.Resolved)
+	}
+}
+
+func TestValidateWatchFlags(t *testing.T) {
+	if err := validateWatchFlags(false, 0, "other", true, "f", "t", "k"); err != nil {
+		t.Errorf("validateWatchFlags without --watch = %v; want nil", err)
+	}
+	if err := validateWatchFlags(true, time.Minute, "", false, "", "", ""); err != nil {
+		t.Errorf("validateWatchFlags(--watch) = %v; want nil", err)
+	}
+	cases := []struct {
+		name        string
+		interval    time.Duration
+		diffContext string
+		explain     bool
+		file        string
+		want        string
+	}{
+		{"zero interval", 0, "", false, "", "--interval must be positive"},
+		{"diff context", time.Minute, "other", false, "", "--diff-context"},
+		{"explain", time.Minute, "", true, "", "--explain-path"},
+		{"file", time.Minute, "", false, "r.json", "--file"},
+	}
+	for _, tc := range cases {
+		err := validateWatchFlags(true, tc.interval, tc.diffContext, tc.explain, tc.file, "", "")
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: error = %v; want mention of %q", tc.name, err, tc.want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -57,26 +58,67 @@ type AllAWSAuditOptions struct {
 	// AllProfiles, when true, runs all AWS domain audits across every configured profile.
 	AllProfiles bool
 
+	// ProfileRegex, when non-empty, restricts the audit to configured profiles
+	// whose names match this regular expression. Forwarded to every domain engine.
+	ProfileRegex string
+
 	// Regions is an explicit list of AWS regions to audit.
 	// When empty each engine discovers and iterates all active regions.
 	Regions []string
 
-	// DaysBack is the lookback window in days for cost queries. Defaults to 30 when zero.
+	// DaysBack is the lookback window in days for cost queries and security ECR
+	// image scoping. Defaults to 30 when zero.
 	DaysBack int
+
+	// Timings, when true, records in Metadata["timings"] the collection and
+	// rule evaluation time summed over the domains, the cross-domain
+	// correlation time and the total, in milliseconds.
+	Timings bool
+}
+
+// Severity weights and cap used by domainRiskScore.
+const (
+	riskWeightCritical = 10
+	riskWeightHigh     = 5
+	riskWeightMedium   = 2
+	riskWeightLow      = 1
+	maxDomainRiskScore = 100
+)
+
+// domainRiskScore returns the risk score of one domain's policy-filtered
+// findings: 10 per CRITICAL, 5 per HIGH, 2 per MEDIUM, and 1 per LOW finding,
+// capped at 100. INFO findings do not contribute.
+func domainRiskScore(findings []models.Finding) int {
+	score := 0
+	for _, f := range findings {
+		switch f.Severity {
+		case models.SeverityCritical:
+			score += riskWeightCritical
+		case models.SeverityHigh:
+			score += riskWeightHigh
+		case models.SeverityMedium:
+			score += riskWeightMedium
+		case models.SeverityLow:
+			score += riskWeightLow
+		}
+	}
+	return min(score, maxDomainRiskScore)
 }
 
 // RunAllAWSAudit executes the three AWS domain engines sequentially, checks
 // per-domain policy enforcement, concatenates all policy-filtered findings,
-// runs mergeFindings once for cross-domain deduplication, and sorts globally
-// by severity.
+// annotates resources flagged by more than one domain (correlateAWSFindings),
+// and sorts globally by severity.
 //
 // The returned []string lists the domains that triggered policy enforcement
 // (findings at or above the configured fail_on_severity threshold). Callers
 // must inspect this list and exit with code 1 when it is non-empty.
 //
-// The returned error covers only engine-level failures (provider errors, rule
-// evaluation errors). Policy enforcement is not an error; it is signalled via
-// the returned slice.
+// A domain engine that fails is recorded in report.Errors and contributes no
+// findings; the other domains are still reported. Each domain's own Errors are
+// carried over as well. The returned error is non-nil only when every domain
+// fails. Policy enforcement is not an error; it is signalled via the returned
+// slice.
 func (e *AllAWSDomainsEngine) RunAllAWSAudit(
 	ctx context.Context,
 	opts AllAWSAuditOptions,
@@ -86,38 +128,39 @@ func (e *AllAWSDomainsEngine) RunAllAWSAudit(
 		daysBack = 30
 	}
 
-	// -- Cost domain --
-	costReport, err := e.cost.RunAudit(ctx, AuditOptions{
-		AuditType:   AuditTypeCost,
-		Profile:     opts.Profile,
-		AllProfiles: opts.AllProfiles,
-		Regions:     opts.Regions,
-		DaysBack:    daysBack,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("cost audit: %w", err)
-	}
-
-	// -- Security domain --
-	secReport, err := e.sec.RunAudit(ctx, AuditOptions{
-		AuditType:   AuditTypeSecurity,
-		Profile:     opts.Profile,
-		AllProfiles: opts.AllProfiles,
-		Regions:     opts.Regions,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("security audit: %w", err)
+	var (
+		errs   []models.AuditError
+		failed int
+	)
+	sw := newStopwatch()
+	runDomain := func(name string, eng awsDomainEngine, auditOpts AuditOptions) *models.AuditReport {
+		auditOpts.Timings = opts.Timings
+		auditOpts.Profile = opts.Profile
+		auditOpts.AllProfiles = opts.AllProfiles
+		auditOpts.ProfileRegex = opts.ProfileRegex
+		auditOpts.Regions = opts.Regions
+		report, err := eng.RunAudit(ctx, auditOpts)
+		if err != nil {
+			failed++
+			errs = append(errs, collectError("aws/"+name, "", fmt.Errorf("%s audit: %w", name, err)))
+			return &models.AuditReport{}
+		}
+		// The security and data protection engines share collectors, so a
+		// failed call can be reported by both; keep it once.
+		for _, auditErr := range report.Errors {
+			if !slices.Contains(errs, auditErr) {
+				errs = append(errs, auditErr)
+			}
+		}
+		sw.merge(report)
+		return report
 	}
 
-	// -- Data protection domain --
-	dpReport, err := e.dp.RunAudit(ctx, AuditOptions{
-		AuditType:   AuditTypeDataProtection,
-		Profile:     opts.Profile,
-		AllProfiles: opts.AllProfiles,
-		Regions:     opts.Regions,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("dataprotection audit: %w", err)
+	costReport := runDomain("cost", e.cost, AuditOptions{AuditType: AuditTypeCost, DaysBack: daysBack})
+	secReport := runDomain("security", e.sec, AuditOptions{AuditType: AuditTypeSecurity, DaysBack: daysBack})
+	dpReport := runDomain("dataprotection", e.dp, AuditOptions{AuditType: AuditTypeDataProtection})
+	if failed == 3 {
+		return nil, nil, fmt.Errorf("all AWS domains failed: %s", errs[0].Message)
 	}
 
 	// -- Per-domain enforcement check (against domain-filtered findings) --
@@ -142,11 +185,15 @@ func (e *AllAWSDomainsEngine) RunAllAWSAudit(
 	// HIGH. Domain membership must NOT influence severity (see requirement 4).
 	//
 	// Each domain's findings are therefore concatenated as-is; per-domain
-	// severity is preserved. sortFindings provides the global ordering.
+	// severity is preserved. correlateAWSFindings instead annotates resources
+	// flagged by several domains, and sortFindings provides the global ordering.
 	var all []models.Finding
 	all = append(all, costReport.Findings...)
 	all = append(all, secReport.Findings...)
 	all = append(all, dpReport.Findings...)
+	correlationStart := time.Now()
+	correlateAWSFindings(all)
+	sw.add(timingCorrelation, time.Since(correlationStart))
 	sortFindings(all)
 
 	// -- Deduplicate region list across all three domain reports --
@@ -160,16 +207,43 @@ func (e *AllAWSDomainsEngine) RunAllAWSAudit(
 	}
 
 	report := &models.AuditReport{
-		ReportID:    fmt.Sprintf("all-%d", time.Now().UnixNano()),
-		GeneratedAt: time.Now().UTC(),
-		AuditType:   string(AuditTypeAll),
-		Profile:     costReport.Profile,
-		AccountID:   costReport.AccountID,
-		Regions:     regions,
-		Summary:     computeSummary(all),
-		Findings:    all,
-		CostSummary: costReport.CostSummary,
+		SchemaVersion: models.ReportSchemaVersion,
+		ReportID:      fmt.Sprintf("all-%d", time.Now().UnixNano()),
+		GeneratedAt:   time.Now().UTC(),
+		AuditType:     string(AuditTypeAll),
+		Provider:      "aws",
+		Profile:       firstNonEmpty(costReport.Profile, secReport.Profile, dpReport.Profile),
+		AccountID:     firstNonEmpty(costReport.AccountID, secReport.AccountID, dpReport.AccountID),
+		Regions:       regions,
+		Summary:       computeSummary(all),
+		Findings:      all,
+		CostSummary:   costReport.CostSummary,
+		Errors:        errs,
+	}
+	report.Summary.DomainRiskScores = map[string]int{
+		"cost":           domainRiskScore(costReport.Findings),
+		"security":       domainRiskScore(secReport.Findings),
+		"dataprotection": domainRiskScore(dpReport.Findings),
+	}
+	report.Summary.Compliance = mergeCompliance(
+		costReport.Summary.Compliance,
+		secReport.Summary.Compliance,
+		dpReport.Summary.Compliance,
+	)
+	assignRiskGrade(&report.Summary, e.policy)
+	if opts.Timings {
+		sw.record(report)
 	}
 
 	return report, enforcedDomains, nil
 }
+
+// firstNonEmpty returns the first non-empty string in values.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
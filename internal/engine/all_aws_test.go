@@ -2,11 +2,14 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
 )
 
 // ── test double ───────────────────────────────────────────────────────────────
@@ -124,6 +127,76 @@ func TestAuditAll_NoCrossDomainEscalation(t *testing.T) {
 	if report.AuditType != string(AuditTypeAll) {
 		t.Errorf("AuditType = %q; want %q", report.AuditType, string(AuditTypeAll))
 	}
+	if report.Provider != "aws" {
+		t.Errorf("Provider = %q; want aws", report.Provider)
+	}
+}
+
+// ── TestAuditAll_CrossDomainCorrelation ──────────────────────────────────────
+
+// inDomain returns f with its Domain set, as the real domain engines stamp it.
+func inDomain(f models.Finding, domain string) models.Finding {
+	f.Domain = domain
+	return f
+}
+
+// TestAuditAll_CrossDomainCorrelation verifies that a volume flagged as idle
+// by cost and unencrypted by dataprotection carries the cross-domain
+// annotation on both findings, without either severity changing, while a
+// resource seen by one domain only is left alone.
+func TestAuditAll_CrossDomainCorrelation(t *testing.T) {
+	costFindings := []models.Finding{
+		inDomain(newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0), "cost"),
+		inDomain(newFinding("vol-2", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 4.0), "cost"),
+	}
+	dpFindings := []models.Finding{
+		inDomain(newFinding("vol-1", "us-east-1", "EBS_UNENCRYPTED", models.SeverityHigh, 0.0), "dataprotection"),
+	}
+	eng := newAllAWSEngine(
+		domainReportWith("cost", costFindings),
+		emptyDomainReport("security", "test", "111122223333", []string{"us-east-1"}),
+		domainReportWith("dataprotection", dpFindings),
+		nil,
+	)
+
+	report, _, err := eng.RunAllAWSAudit(context.Background(), AllAWSAuditOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Findings) != 3 {
+		t.Fatalf("expected 3 findings; got %d", len(report.Findings))
+	}
+
+	byID := make(map[string]models.Finding)
+	for _, f := range report.Findings {
+		byID[f.ID] = f
+	}
+	idle, unencrypted := byID["EBS_UNATTACHED-vol-1"], byID["EBS_UNENCRYPTED-vol-1"]
+	if idle.Severity != models.SeverityMedium || unencrypted.Severity != models.SeverityHigh {
+		t.Errorf("severities = %s/%s; want MEDIUM/HIGH (correlation must not change severity)", idle.Severity, unencrypted.Severity)
+	}
+	for _, f := range []models.Finding{idle, unencrypted} {
+		domains, _ := f.Metadata["cross_domain_domains"].([]string)
+		if strings.Join(domains, ",") != "cost,dataprotection" {
+			t.Errorf("%s: cross_domain_domains = %v; want [cost dataprotection]", f.ID, f.Metadata["cross_domain_domains"])
+		}
+		// MEDIUM (2) + HIGH (5): higher than either finding on its own.
+		if f.Metadata["cross_domain_risk_score"] != 7 {
+			t.Errorf("%s: cross_domain_risk_score = %v; want 7", f.ID, f.Metadata["cross_domain_risk_score"])
+		}
+	}
+	if rules, _ := idle.Metadata["cross_domain_rules"].([]string); len(rules) != 1 || rules[0] != "EBS_UNENCRYPTED" {
+		t.Errorf("cost finding cross_domain_rules = %v; want [EBS_UNENCRYPTED]", idle.Metadata["cross_domain_rules"])
+	}
+	if note, _ := unencrypted.Metadata["cross_domain_note"].(string); !strings.Contains(note, "EBS_UNATTACHED") {
+		t.Errorf("dataprotection finding cross_domain_note = %q; want it to name EBS_UNATTACHED", note)
+	}
+	if _, ok := byID["EBS_UNATTACHED-vol-2"].Metadata["cross_domain_domains"]; ok {
+		t.Error("vol-2 is flagged by cost only and must not be annotated")
+	}
+	if _, ok := costFindings[0].Metadata["cross_domain_domains"]; ok {
+		t.Error("correlation must not modify the domain report's findings")
+	}
 }
 
 // ── TestAuditAll_PolicyRespected ─────────────────────────────────────────────
@@ -356,7 +429,7 @@ func TestAuditAll_CrossDomain_IndependentSeverities(t *testing.T) {
 	region := "ap-southeast-1"
 
 	costFindings := []models.Finding{
-		newFinding(vol, region, "EBS_GP2_LEGACY", models.SeverityMedium, 3.0),
+		newFinding(vol, region, "AWS_EBS_GP2_LEGACY", models.SeverityMedium, 3.0),
 	}
 	dpFindings := []models.Finding{
 		newFinding(vol, region, "EBS_UNENCRYPTED", models.SeverityHigh, 0.0),
@@ -385,11 +458,171 @@ func TestAuditAll_CrossDomain_IndependentSeverities(t *testing.T) {
 	}
 
 	// Cost finding: must remain MEDIUM regardless of the DP finding.
-	if sevByRule["EBS_GP2_LEGACY"] != models.SeverityMedium {
-		t.Errorf("EBS_GP2_LEGACY severity = %q; want MEDIUM (must not be escalated by DP domain)", sevByRule["EBS_GP2_LEGACY"])
+	if sevByRule["AWS_EBS_GP2_LEGACY"] != models.SeverityMedium {
+		t.Errorf("AWS_EBS_GP2_LEGACY severity = %q; want MEDIUM (must not be escalated by DP domain)", sevByRule["AWS_EBS_GP2_LEGACY"])
 	}
 	// DP finding: must remain HIGH.
 	if sevByRule["EBS_UNENCRYPTED"] != models.SeverityHigh {
 		t.Errorf("EBS_UNENCRYPTED severity = %q; want HIGH", sevByRule["EBS_UNENCRYPTED"])
 	}
 }
+
+// ── ProfileRegex ──────────────────────────────────────────────────────────────
+
+// recordingAWSEngine records the AuditOptions it was called with.
+type recordingAWSEngine struct {
+	got AuditOptions
+}
+
+func (r *recordingAWSEngine) RunAudit(_ context.Context, opts AuditOptions) (*models.AuditReport, error) {
+	r.got = opts
+	return emptyDomainReport(string(opts.AuditType), "multi", "", nil), nil
+}
+
+// TestAuditAll_ProfileRegexForwarded verifies that AllAWSAuditOptions.ProfileRegex
+// reaches every domain engine.
+func TestAuditAll_ProfileRegexForwarded(t *testing.T) {
+	cost, sec, dp := &recordingAWSEngine{}, &recordingAWSEngine{}, &recordingAWSEngine{}
+	eng := &AllAWSDomainsEngine{cost: cost, sec: sec, dp: dp}
+
+	if _, _, err := eng.RunAllAWSAudit(context.Background(), AllAWSAuditOptions{ProfileRegex: "^prod-"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, r := range map[string]*recordingAWSEngine{"cost": cost, "security": sec, "dataprotection": dp} {
+		if r.got.ProfileRegex != "^prod-" {
+			t.Errorf("%s engine ProfileRegex = %q; want ^prod-", name, r.got.ProfileRegex)
+		}
+	}
+}
+
+// TestAuditAll_DomainRiskScores verifies that the unified report carries one
+// severity-weighted score per AWS domain.
+func TestAuditAll_DomainRiskScores(t *testing.T) {
+	costReport := domainReportWith("cost", []models.Finding{
+		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 5.0),
+		newFinding("vol-2", "us-east-1", "EBS_UNATTACHED", models.SeverityLow, 1.0),
+	})
+	secReport := domainReportWith("security", []models.Finding{
+		newFinding("root", "global", "ROOT_ACCESS_KEY", models.SeverityCritical, 0),
+		newFinding("sg-1", "us-east-1", "SG_OPEN_SSH", models.SeverityHigh, 0),
+	})
+	dpReport := domainReportWith("dataprotection", nil)
+
+	report, _, err := newAllAWSEngine(costReport, secReport, dpReport, nil).
+		RunAllAWSAudit(context.Background(), AllAWSAuditOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]int{"cost": 3, "security": 15, "dataprotection": 0}
+	got := report.Summary.DomainRiskScores
+	if len(got) != len(want) {
+		t.Fatalf("DomainRiskScores = %v; want keys %v", got, want)
+	}
+	for domain, score := range want {
+		if s, ok := got[domain]; !ok || s != score {
+			t.Errorf("DomainRiskScores[%q] = %d (present=%v); want %d", domain, s, ok, score)
+		}
+	}
+}
+
+// TestDomainRiskScore_WeightsAndCap verifies the 10/5/2/1 severity weights,
+// that INFO is ignored, and the cap at 100.
+func TestDomainRiskScore_WeightsAndCap(t *testing.T) {
+	if got := domainRiskScore(nil); got != 0 {
+		t.Errorf("domainRiskScore(nil) = %d; want 0", got)
+	}
+
+	oneOfEach := []models.Finding{
+		{Severity: models.SeverityCritical},
+		{Severity: models.SeverityHigh},
+		{Severity: models.SeverityMedium},
+		{Severity: models.SeverityLow},
+		{Severity: models.SeverityInfo},
+	}
+	if got := domainRiskScore(oneOfEach); got != 18 {
+		t.Errorf("domainRiskScore(one of each) = %d; want 18", got)
+	}
+
+	capped := make([]models.Finding, 11)
+	for i := range capped {
+		capped[i].Severity = models.SeverityCritical
+	}
+	if got := domainRiskScore(capped); got != 100 {
+		t.Errorf("domainRiskScore(11 CRITICAL) = %d; want 100", got)
+	}
+}
+
+// TestFilterProfileConfigs verifies matching, empty-pattern passthrough, and
+// the no-match error path.
+func TestFilterProfileConfigs(t *testing.T) {
+	profiles := []*common.ProfileConfig{
+		{ProfileName: "default"}, {ProfileName: "prod-us"}, {ProfileName: "prod-eu"},
+	}
+
+	got, err := filterProfileConfigs(profiles, "")
+	if err != nil || len(got) != 3 {
+		t.Fatalf("empty pattern: got %d profiles, err %v; want 3, nil", len(got), err)
+	}
+
+	got, err = filterProfileConfigs(profiles, "^prod-")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0].ProfileName != "prod-us" || got[1].ProfileName != "prod-eu" {
+		t.Errorf("filtered = %v; want [prod-us prod-eu]", got)
+	}
+
+	if _, err := filterProfileConfigs(profiles, "^staging$"); err == nil {
+		t.Error("expected error when no profile matches")
+	}
+}
+
+// ── Partial failures ──────────────────────────────────────────────────────────
+
+// TestAuditAll_DomainFailureRecorded verifies that a failing domain engine is
+// recorded in report.Errors while the other domains' findings and errors are
+// still reported.
+func TestAuditAll_DomainFailureRecorded(t *testing.T) {
+	costReport := domainReportWith("cost", []models.Finding{
+		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityLow, 5.0),
+	})
+	costReport.Errors = []models.AuditError{{
+		Stage: models.AuditStageEvaluate, Provider: "aws/cost", Region: "us-east-1", Message: "rule X panicked",
+	}}
+	eng := &AllAWSDomainsEngine{
+		cost: &stubAWSEngine{report: costReport},
+		sec:  &stubAWSEngine{err: errors.New("collect security data: AccessDenied")},
+		dp:   &stubAWSEngine{report: emptyDomainReport("dataprotection", "test", "111122223333", nil)},
+	}
+
+	report, _, err := eng.RunAllAWSAudit(context.Background(), AllAWSAuditOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].RuleID != "EBS_UNATTACHED" {
+		t.Errorf("findings = %+v; want the cost finding", report.Findings)
+	}
+	if len(report.Errors) != 2 {
+		t.Fatalf("Errors = %+v; want the cost rule error and the security failure", report.Errors)
+	}
+	secErr := report.Errors[1]
+	if secErr.Stage != models.AuditStageCollect || secErr.Provider != "aws/security" ||
+		!strings.Contains(secErr.Message, "AccessDenied") {
+		t.Errorf("security error = %+v; want collect-stage aws/security AccessDenied", secErr)
+	}
+	if report.AccountID != "111122223333" {
+		t.Errorf("AccountID = %q; want it taken from a successful domain", report.AccountID)
+	}
+}
+
+// TestAuditAll_AllDomainsFail verifies that an error is returned only when no
+// domain produced a report.
+func TestAuditAll_AllDomainsFail(t *testing.T) {
+	fail := &stubAWSEngine{err: errors.New("no credentials")}
+	eng := &AllAWSDomainsEngine{cost: fail, sec: fail, dp: fail}
+
+	if _, _, err := eng.RunAllAWSAudit(context.Background(), AllAWSAuditOptions{}); err == nil {
+		t.Fatal("expected an error when every domain fails")
+	}
+}
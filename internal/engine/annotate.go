@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"path"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// resourceTags maps a resource, keyed by passedKey, to its tags (AWS, Azure)
+// or labels and annotations (Kubernetes).
+type resourceTags map[string]map[string]string
+
+// add records tags for a resource. Resources without tags are skipped; when a
+// resource is added twice the maps are combined and the later value wins.
+func (t resourceTags) add(rt models.ResourceType, profile, namespace, id string, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+	key := passedKey(rt, profile, namespace, id)
+	dst := t[key]
+	if dst == nil {
+		dst = make(map[string]string, len(tags))
+		t[key] = dst
+	}
+	for k, v := range tags {
+		dst[k] = v
+	}
+}
+
+// annotateResourceTags sets Metadata["resource_tags"] (map[string]string) on
+// every finding whose resource has tags in index. Findings are matched to
+// resources the same way as passedResources. Only keys matching one of the
+// glob patterns in keys are copied; an empty keys slice or the pattern "*"
+// copies every key. Findings whose resource has no matching key are left
+// untouched. The index maps are never shared with the findings.
+func annotateResourceTags(findings []models.Finding, index resourceTags, keys []string) {
+	if len(index) == 0 {
+		return
+	}
+	for i := range findings {
+		f := &findings[i]
+		ns, _ := f.Metadata["namespace"].(string)
+		tags := index[passedKey(f.ResourceType, f.Profile, ns, findingResourceName(f))]
+		var selected map[string]string
+		for k, v := range tags {
+			if !matchesTagKey(keys, k) {
+				continue
+			}
+			if selected == nil {
+				selected = make(map[string]string)
+			}
+			selected[k] = v
+		}
+		if selected == nil {
+			continue
+		}
+		if f.Metadata == nil {
+			f.Metadata = make(map[string]any, 1)
+		}
+		f.Metadata["resource_tags"] = selected
+	}
+}
+
+// matchesTagKey reports whether key matches any pattern in patterns. An empty
+// pattern list and the pattern "*" match every key, including keys that
+// contain "/" (e.g. "app.kubernetes.io/name").
+func matchesTagKey(patterns []string, key string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if p == "*" {
+			return true
+		}
+		if ok, err := path.Match(p, key); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// costResourceTags indexes the tags of the per-region resources evaluated by
+// the cost rules.
+func costResourceTags(regionData []models.AWSRegionData, profile string) resourceTags {
+	index := make(resourceTags)
+	for _, rd := range regionData {
+		for _, inst := range rd.EC2Instances {
+			index.add(models.ResourceAWSEC2, profile, "", inst.InstanceID, inst.Tags)
+		}
+		for _, vol := range rd.EBSVolumes {
+			index.add(models.ResourceAWSEBS, profile, "", vol.VolumeID, vol.Tags)
+		}
+		for _, ng := range rd.NATGateways {
+			index.add(models.ResourceAWSNATGateway, profile, "", ng.NATGatewayID, ng.Tags)
+		}
+		for _, db := range rd.RDSInstances {
+			index.add(models.ResourceAWSRDS, profile, "", db.DBInstanceID, db.Tags)
+		}
+		for _, lb := range rd.LoadBalancers {
+			index.add(models.ResourceAWSLoadBalancer, profile, "", lb.LoadBalancerName, lb.Tags)
+		}
+		for _, eip := range rd.ElasticIPs {
+			index.add(models.ResourceAWSElasticIP, profile, "", eip.AllocationID, eip.Tags)
+		}
+	}
+	return index
+}
+
+// dataProtectionResourceTags indexes the tags of the EBS volumes and RDS
+// instances evaluated by the data protection rules. S3 buckets and KMS keys
+// carry no collected tags.
+func dataProtectionResourceTags(regionData []models.AWSRegionData, profile string) resourceTags {
+	index := make(resourceTags)
+	for _, rd := range regionData {
+		for _, vol := range rd.EBSVolumes {
+			index.add(models.ResourceAWSEBS, profile, "", vol.VolumeID, vol.Tags)
+		}
+		for _, db := range rd.RDSInstances {
+			index.add(models.ResourceAWSRDS, profile, "", db.DBInstanceID, db.Tags)
+		}
+	}
+	return index
+}
+
+// azureCostResourceTags indexes the tags of the VMs and managed disks
+// evaluated by the Azure cost rules, keyed like Azure findings by
+// "resourceGroup/name".
+func azureCostResourceTags(data *models.AzureSubscriptionData) resourceTags {
+	index := make(resourceTags)
+	id := func(rg, name string) string {
+		if rg == "" {
+			return name
+		}
+		return rg + "/" + name
+	}
+	for _, vm := range data.VMs {
+		index.add(models.ResourceAzureVM, "", "", id(vm.ResourceGroup, vm.Name), vm.Tags)
+	}
+	for _, d := range data.Disks {
+		index.add(models.ResourceAzureDisk, "", "", id(d.ResourceGroup, d.Name), d.Tags)
+	}
+	return index
+}
+
+// kubernetesResourceTags indexes the labels and annotations of the cluster
+// objects evaluated by the Kubernetes rules: node, namespace, and pod labels,
+// and Service and ServiceAccount annotations.
+func kubernetesResourceTags(data *models.KubernetesClusterData) resourceTags {
+	index := make(resourceTags)
+	for _, n := range data.Nodes {
+		index.add(models.ResourceK8sNode, "", "", n.Name, n.Labels)
+	}
+	for _, ns := range data.Namespaces {
+		index.add(models.ResourceK8sNamespace, "", "", ns.Name, ns.Labels)
+	}
+	for _, p := range data.Pods {
+		index.add(models.ResourceK8sPod, "", p.Namespace, p.Name, p.Labels)
+	}
+	for _, svc := range data.Services {
+		index.add(models.ResourceK8sService, "", svc.Namespace, svc.Name, svc.Annotations)
+	}
+	for _, sa := range data.ServiceAccounts {
+		index.add(models.ResourceK8sServiceAccount, "", sa.Namespace, sa.Name, sa.Annotations)
+	}
+	return index
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+)
+
+func TestAnnotateResourceTags_FiltersKeys(t *testing.T) {
+	index := make(resourceTags)
+	index.add(models.ResourceAWSEC2, "prod", "", "i-1", map[string]string{
+		"team": "payments", "env": "prod", "cost-center": "42",
+	})
+	findings := []models.Finding{
+		{ResourceID: "i-1", ResourceType: models.ResourceAWSEC2, Profile: "prod"},
+		{ResourceID: "i-2", ResourceType: models.ResourceAWSEC2, Profile: "prod"},
+	}
+
+	annotateResourceTags(findings, index, []string{"team", "cost-*"})
+
+	got, ok := findings[0].Metadata["resource_tags"].(map[string]string)
+	if !ok {
+		t.Fatalf("resource_tags missing on tagged finding: %+v", findings[0].Metadata)
+	}
+	if len(got) != 2 || got["team"] != "payments" || got["cost-center"] != "42" {
+		t.Errorf("resource_tags = %v; want team and cost-center only", got)
+	}
+	if _, ok := findings[1].Metadata["resource_tags"]; ok {
+		t.Errorf("untagged resource got resource_tags: %+v", findings[1].Metadata)
+	}
+
+	// The finding must not share the index map.
+	got["team"] = "changed"
+	if index[passedKey(models.ResourceAWSEC2, "prod", "", "i-1")]["team"] != "payments" {
+		t.Error("annotateResourceTags shared the index map with the finding")
+	}
+}
+
+func TestMatchesTagKey_StarMatchesSlashedKeys(t *testing.T) {
+	if !matchesTagKey([]string{"*"}, "app.kubernetes.io/name") {
+		t.Error(`"*" should match keys containing "/"`)
+	}
+	if !matchesTagKey(nil, "anything") {
+		t.Error("empty pattern list should match every key")
+	}
+	if matchesTagKey([]string{"team"}, "owner") {
+		t.Error(`"team" should not match "owner"`)
+	}
+}
+
+func annotatedPodClient() *fake.Clientset {
+	pod := k8sPod("default", "priv-pod", true, "100m", "128Mi")
+	pod.Labels = map[string]string{"app": "checkout", "team": "payments"}
+	return fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNode("node-2", "4", "8Gi", "3800m", "7Gi"),
+		pod,
+	)
+}
+
+func privilegedFinding(t *testing.T, report *models.AuditReport) models.Finding {
+	t.Helper()
+	for _, f := range report.Findings {
+		if f.RuleID == "K8S_PRIVILEGED_CONTAINER" && f.ResourceID == "priv-pod" {
+			return f
+		}
+	}
+	t.Fatalf("no K8S_PRIVILEGED_CONTAINER finding for priv-pod in %d findings", len(report.Findings))
+	return models.Finding{}
+}
+
+func TestKubernetesEngine_AnnotateFindings_AttachesPodLabels(t *testing.T) {
+	provider := &fakeKubeProvider{clientset: annotatedPodClient(), info: kube.ClusterInfo{ContextName: "ctx"}}
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{
+		AnnotateFindings: true,
+		AnnotateKeys:     []string{"team"},
+	})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	tags, ok := privilegedFinding(t, report).Metadata["resource_tags"].(map[string]string)
+	if !ok {
+		t.Fatal("resource_tags missing with AnnotateFindings enabled")
+	}
+	if len(tags) != 1 || tags["team"] != "payments" {
+		t.Errorf("resource_tags = %v; want only team=payments", tags)
+	}
+}
+
+func TestKubernetesEngine_AnnotateFindings_OffByDefault(t *testing.T) {
+	provider := &fakeKubeProvider{clientset: annotatedPodClient(), info: kube.ClusterInfo{ContextName: "ctx"}}
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	for _, f := range report.Findings {
+		if _, ok := f.Metadata["resource_tags"]; ok {
+			t.Errorf("finding %s has resource_tags without AnnotateFindings", f.ID)
+		}
+	}
+	privilegedFinding(t, report)
+}
@@ -0,0 +1,83 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// awsResourceKey identifies one AWS resource across domains.
+type awsResourceKey struct {
+	accountID, region, resourceID string
+}
+
+// correlateAWSFindings annotates findings whose resource (same AccountID,
+// Region and ResourceID) is flagged by more than one AWS domain, e.g. an EBS
+// volume that is both idle (cost) and unencrypted (dataprotection). Each such
+// finding gets:
+//
+//   - cross_domain_domains: the sorted domains flagging the resource
+//   - cross_domain_rules: the rule IDs of the resource's findings in the other domains
+//   - cross_domain_note: a one-line summary naming those rules
+//   - cross_domain_risk_score: domainRiskScore over all of the resource's
+//     findings, so the resource ranks above any single finding on its own
+//
+// Severity is never changed: domain membership must not influence severity.
+// Metadata maps are cloned before writing so the domain reports' findings are
+// not modified. Findings without a ResourceID are ignored.
+func correlateAWSFindings(findings []models.Finding) {
+	groups := make(map[awsResourceKey][]int)
+	for i, f := range findings {
+		if f.ResourceID == "" {
+			continue
+		}
+		key := awsResourceKey{f.AccountID, f.Region, f.ResourceID}
+		groups[key] = append(groups[key], i)
+	}
+
+	for _, idx := range groups {
+		var domains []string
+		group := make([]models.Finding, 0, len(idx))
+		for _, i := range idx {
+			group = append(group, findings[i])
+			if !slices.Contains(domains, findings[i].Domain) {
+				domains = append(domains, findings[i].Domain)
+			}
+		}
+		if len(domains) < 2 {
+			continue
+		}
+		slices.Sort(domains)
+		score := domainRiskScore(group)
+
+		for _, i := range idx {
+			f := &findings[i]
+			var others []string
+			for _, g := range group {
+				if g.Domain == f.Domain {
+					continue
+				}
+				for _, id := range ruleIDsForFinding(&g) {
+					if !slices.Contains(others, id) {
+						others = append(others, id)
+					}
+				}
+			}
+
+			meta := make(map[string]any, len(f.Metadata)+4)
+			for k, v := range f.Metadata {
+				meta[k] = v
+			}
+			meta["cross_domain_domains"] = domains
+			meta["cross_domain_rules"] = others
+			meta["cross_domain_note"] = fmt.Sprintf(
+				"%s is also flagged by %s; fix the %s findings together.",
+				f.ResourceID, strings.Join(others, ", "), strings.Join(domains, " and "),
+			)
+			meta["cross_domain_risk_score"] = score
+			f.Metadata = meta
+		}
+	}
+}
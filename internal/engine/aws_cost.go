@@ -56,10 +56,25 @@ func (e *AWSCostEngine) RunAudit(ctx context.Context, opts AuditOptions) (*model
 		daysBack = 30
 	}
 
-	if opts.AllProfiles {
-		return e.runAllProfiles(ctx, opts, daysBack)
+	var (
+		report *models.AuditReport
+		err    error
+	)
+	sw := newStopwatch()
+	if opts.AllProfiles || opts.ProfileRegex != "" {
+		report, err = e.runAllProfiles(ctx, opts, daysBack, sw)
+	} else {
+		report, err = e.runSingleProfile(ctx, opts, daysBack, sw)
 	}
-	return e.runSingleProfile(ctx, opts, daysBack)
+	if err != nil {
+		return nil, err
+	}
+	report.Summary.Compliance = computeCompliance(e.registry.All(), report.Findings)
+	assignRiskGrade(&report.Summary, e.policy)
+	if opts.Timings {
+		sw.record(report)
+	}
+	return report, nil
 }
 
 // runSingleProfile executes a cost audit for one AWS profile and returns the
@@ -68,6 +83,7 @@ func (e *AWSCostEngine) runSingleProfile(
 	ctx context.Context,
 	opts AuditOptions,
 	daysBack int,
+	sw *stopwatch,
 ) (*models.AuditReport, error) {
 	profile, err := e.provider.LoadProfile(ctx, opts.Profile)
 	if err != nil {
@@ -83,9 +99,22 @@ func (e *AWSCostEngine) runSingleProfile(
 	if err != nil {
 		return nil, fmt.Errorf("collect data for profile %q: %w", profile.ProfileName, err)
 	}
+	sw.lap(timingCollection)
+
+	findings, evalErrs := e.evaluateAll(regionData, costSummary, profile.AccountID, profile.ProfileName)
+	sw.lap(timingEvaluation)
+	if opts.AnnotateFindings {
+		annotateResourceTags(findings, costResourceTags(regionData, profile.ProfileName), opts.AnnotateKeys)
+	}
 
-	findings := e.evaluateAll(regionData, costSummary, profile.AccountID, profile.ProfileName)
-	return buildReport(profile.ProfileName, profile.AccountID, regions, findings, costSummary, e.policy), nil
+	var passed []models.PassedResource
+	if opts.ShowPassed {
+		passed = passedResources(costInventory(regionData, profile.ProfileName), findings)
+	}
+	report := buildReport(profile.ProfileName, profile.AccountID, regions, findings, ruleMergeInfo(e.registry.All()), costSummary, e.policy)
+	report.PassedResources = passed
+	report.Errors = append(costCollectErrors("", regionData), evalErrs...)
+	return report, nil
 }
 
 // maxConcurrentProfiles caps the number of profiles audited in parallel.
@@ -96,11 +125,13 @@ const maxConcurrentProfiles = 3
 // parallel (max maxConcurrentProfiles at a time), and merges all findings into
 // a single report. The report-level Profile field is set to "multi"; each
 // individual Finding carries its own Profile and AccountID.
-// Fail-fast: the first profile error cancels all remaining profile goroutines.
+// A profile that fails to resolve regions or collect data is recorded in
+// report.Errors and skipped; an error is returned only when every profile fails.
 func (e *AWSCostEngine) runAllProfiles(
 	ctx context.Context,
 	opts AuditOptions,
 	daysBack int,
+	sw *stopwatch,
 ) (*models.AuditReport, error) {
 	profiles, err := e.provider.LoadAllProfiles(ctx)
 	if err != nil {
@@ -109,6 +140,10 @@ func (e *AWSCostEngine) runAllProfiles(
 	if len(profiles) == 0 {
 		return nil, fmt.Errorf("no AWS profiles found")
 	}
+	profiles, err = filterProfileConfigs(profiles, opts.ProfileRegex)
+	if err != nil {
+		return nil, err
+	}
 
 	sem := make(chan struct{}, maxConcurrentProfiles)
 	var (
@@ -117,6 +152,9 @@ func (e *AWSCostEngine) runAllProfiles(
 		allRegions       []string
 		seenRegions      = make(map[string]struct{})
 		allCostSummaries []*models.AWSCostSummary
+		allPassed        []models.PassedResource
+		allErrs          []models.AuditError
+		audited          int
 	)
 
 	g, gctx := errgroup.WithContext(ctx)
@@ -127,26 +165,49 @@ PROFILES:
 		select {
 		case sem <- struct{}{}: // acquire semaphore slot; blocks when at capacity
 		case <-gctx.Done():
-			break PROFILES // context cancelled by a prior goroutine error
+			break PROFILES // parent context cancelled
 		}
 
 		g.Go(func() error {
 			defer func() { <-sem }() // release semaphore slot on return
 
+			fail := func(err error) error {
+				mu.Lock()
+				allErrs = append(allErrs, profileCollectError("aws/cost", profile.ProfileName, err))
+				mu.Unlock()
+				return nil
+			}
+
+			collectStart := time.Now()
 			regions, err := e.resolveRegions(gctx, profile, opts.Regions)
 			if err != nil {
-				return fmt.Errorf("resolve regions for profile %q: %w", profile.ProfileName, err)
+				return fail(fmt.Errorf("resolve regions for profile %q: %w", profile.ProfileName, err))
 			}
 
 			regionData, costSummary, err := e.cost.CollectAll(gctx, profile, e.provider, regions, daysBack)
 			if err != nil {
-				return fmt.Errorf("collect data for profile %q: %w", profile.ProfileName, err)
+				return fail(fmt.Errorf("collect data for profile %q: %w", profile.ProfileName, err))
 			}
+			sw.add(timingCollection, time.Since(collectStart))
 
-			findings := e.evaluateAll(regionData, costSummary, profile.AccountID, profile.ProfileName)
+			evalStart := time.Now()
+			findings, evalErrs := e.evaluateAll(regionData, costSummary, profile.AccountID, profile.ProfileName)
+			sw.add(timingEvaluation, time.Since(evalStart))
+			if opts.AnnotateFindings {
+				annotateResourceTags(findings, costResourceTags(regionData, profile.ProfileName), opts.AnnotateKeys)
+			}
+
+			var passed []models.PassedResource
+			if opts.ShowPassed {
+				passed = passedResources(costInventory(regionData, profile.ProfileName), findings)
+			}
 
 			mu.Lock()
+			audited++
 			allFindings = append(allFindings, findings...)
+			allPassed = append(allPassed, passed...)
+			allErrs = append(allErrs, costCollectErrors(profile.ProfileName, regionData)...)
+			allErrs = append(allErrs, evalErrs...)
 			for _, r := range regions {
 				if _, seen := seenRegions[r]; !seen {
 					seenRegions[r] = struct{}{}
@@ -165,8 +226,17 @@ PROFILES:
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if audited == 0 {
+		return nil, fmt.Errorf("all profiles failed; no cost data collected")
+	}
 
-	return buildReport("multi", "", allRegions, allFindings, aggregateCostSummaries(allCostSummaries), e.policy), nil
+	report := buildReport("multi", "", allRegions, allFindings, ruleMergeInfo(e.registry.All()), aggregateCostSummaries(allCostSummaries), e.policy)
+	report.PassedResources = allPassed
+	report.Errors = allErrs
+	return report, nil
 }
 
 // aggregateCostSummaries merges cost summaries from multiple AWS profiles into
@@ -230,13 +300,17 @@ func (e *AWSCostEngine) resolveRegions(
 }
 
 // evaluateAll applies every registered rule to each region's collected data
-// and returns the merged findings slice with Domain stamped.
+// and returns the merged findings slice with Domain stamped, plus one
+// AuditError for each rule that panicked.
 func (e *AWSCostEngine) evaluateAll(
 	regionData []models.AWSRegionData,
 	costSummary *models.AWSCostSummary,
 	accountID, profile string,
-) []models.Finding {
-	var findings []models.Finding
+) ([]models.Finding, []models.AuditError) {
+	var (
+		findings []models.Finding
+		errs     []models.AuditError
+	)
 	for i := range regionData {
 		rctx := rules.RuleContext{
 			AccountID:   accountID,
@@ -245,10 +319,40 @@ func (e *AWSCostEngine) evaluateAll(
 			CostSummary: costSummary,
 			Policy:      e.policy,
 		}
-		findings = append(findings, e.registry.EvaluateAll(rctx)...)
+		out, evalErrs := evaluateRules(e.registry, rctx, "aws/cost", regionData[i].Region)
+		findings = append(findings, out...)
+		errs = append(errs, evalErrs...)
 	}
 	stampDomain(findings, "cost")
-	return findings
+	policy.ApplySeverityOverrides(findings, e.policy)
+	return findings, errs
+}
+
+// filterProfileConfigs narrows profiles to those whose ProfileName matches
+// pattern via common.FilterProfiles. An empty pattern returns profiles unchanged.
+func filterProfileConfigs(profiles []*common.ProfileConfig, pattern string) ([]*common.ProfileConfig, error) {
+	if pattern == "" {
+		return profiles, nil
+	}
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.ProfileName)
+	}
+	matched, err := common.FilterProfiles(names, pattern)
+	if err != nil {
+		return nil, err
+	}
+	keep := make(map[string]struct{}, len(matched))
+	for _, n := range matched {
+		keep[n] = struct{}{}
+	}
+	filtered := make([]*common.ProfileConfig, 0, len(matched))
+	for _, p := range profiles {
+		if _, ok := keep[p.ProfileName]; ok {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered, nil
 }
 
 // stampDomain sets the Domain field on every finding in the slice.
@@ -261,30 +365,37 @@ func stampDomain(findings []models.Finding, domain string) {
 }
 
 // buildReport assembles the final AuditReport from collected data and findings.
-// Raw findings are first merged per resource (same ResourceID+Region), then
+// Raw findings are first merged per resource (same ResourceID+Region, with
+// rule priority choosing the primary rule; see mergeFindings), then
 // sorted: CRITICAL → HIGH → MEDIUM → LOW → INFO, ties broken by
 // EstimatedMonthlySavings descending.
 func buildReport(
 	profile, accountID string,
 	regions []string,
 	findings []models.Finding,
+	info map[string]mergeInfo,
 	costSummary *models.AWSCostSummary,
 	policyCfg *policy.PolicyConfig,
 ) *models.AuditReport {
-	merged := mergeFindings(findings)
+	merged := mergeFindings(findings, info)
 	// Apply policy (if present)
 	merged = policy.ApplyPolicy(merged, "cost", policyCfg)
+	policy.ApplyLabels(merged, policyCfg)
+	stampConfidence(merged)
+	stampFingerprints(merged)
 	sortFindings(merged)
 	return &models.AuditReport{
-		ReportID:    fmt.Sprintf("audit-%d", time.Now().UnixNano()),
-		GeneratedAt: time.Now().UTC(),
-		AuditType:   string(AuditTypeCost),
-		Profile:     profile,
-		AccountID:   accountID,
-		Regions:     regions,
-		Summary:     computeSummary(merged),
-		Findings:    merged,
-		CostSummary: costSummary,
+		SchemaVersion: models.ReportSchemaVersion,
+		ReportID:      fmt.Sprintf("audit-%d", time.Now().UnixNano()),
+		GeneratedAt:   time.Now().UTC(),
+		AuditType:     string(AuditTypeCost),
+		Provider:      "aws",
+		Profile:       profile,
+		AccountID:     accountID,
+		Regions:       regions,
+		Summary:       computeSummary(merged),
+		Findings:      merged,
+		CostSummary:   costSummary,
 	}
 }
 
@@ -297,67 +408,107 @@ type findingGroupKey struct {
 // mergeFindings collapses findings that refer to the same resource
 // (same ResourceID + Region) into a single Finding:
 //   - Severity: highest (lowest severityRank) across the group
-//   - EstimatedMonthlySavings: sum across the group
-//   - Metadata["rules"]: []string of every RuleID that fired on this resource
+//   - EstimatedMonthlySavings: sum across the group, counting only the
+//     highest-priority finding of each savings component (see
+//     rules.SavingsOverlapper)
+//   - Confidence: most confident level across the group
+//   - Metadata["rules"]: []string of every RuleID that fired on this resource,
+//     primary rule first
 //
 // All other fields (ID, RuleID, ResourceType, Explanation, Recommendation,
-// DetectedAt, AccountID, Profile, Domain) are taken from the first finding in the group.
+// DetectedAt, AccountID, Profile, Domain) are taken from the primary finding:
+// the one whose RuleID has the highest priority in info (see
+// rules.Prioritizer). Rules missing from info, or a nil map, rank at
+// rules.DefaultPriority, and ties keep evaluation order, so the first finding
+// in the group wins unless a higher-priority rule also fired.
 // Additional Metadata keys from later findings are merged in without overwriting
-// keys already set by earlier findings.
+// keys already set by the primary or earlier findings.
 // Insertion order of groups is preserved so sortFindings controls final order.
-func mergeFindings(raw []models.Finding) []models.Finding {
-	type entry struct {
-		f       models.Finding
-		ruleIDs []string
-	}
-
-	index := make(map[findingGroupKey]int) // key → position in entries
-	var order []findingGroupKey
-	entries := make([]entry, 0, len(raw))
+func mergeFindings(raw []models.Finding, info map[string]mergeInfo) []models.Finding {
+	index := make(map[findingGroupKey]int) // key → position in groups
+	var groups [][]models.Finding
 
 	for _, f := range raw {
 		key := findingGroupKey{resourceID: f.ResourceID, region: f.Region}
 		pos, exists := index[key]
 		if !exists {
-			// First finding for this resource — clone metadata map and use as base.
-			meta := make(map[string]any, len(f.Metadata)+1)
-			for k, v := range f.Metadata {
-				meta[k] = v
-			}
-			f.Metadata = meta
-			entries = append(entries, entry{f: f, ruleIDs: []string{f.RuleID}})
-			index[key] = len(entries) - 1
-			order = append(order, key)
-			continue
+			groups = append(groups, nil)
+			pos = len(groups) - 1
+			index[key] = pos
 		}
+		groups[pos] = append(groups[pos], f)
+	}
+
+	result := make([]models.Finding, 0, len(groups))
+	for _, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool {
+			return info[group[i].RuleID].priority > info[group[j].RuleID].priority
+		})
+		result = append(result, mergeGroup(group, info))
+	}
+	return result
+}
+
+// mergeGroup folds one resource's findings into group[0], the primary finding.
+// The primary's metadata map is cloned so raw findings are never mutated.
+func mergeGroup(group []models.Finding, info map[string]mergeInfo) models.Finding {
+	f := group[0]
+	meta := make(map[string]any, len(f.Metadata)+1)
+	for k, v := range f.Metadata {
+		meta[k] = v
+	}
+	f.Metadata = meta
+	ruleIDs := []string{f.RuleID}
+	priced := map[string]bool{info[f.RuleID].savingsComponent: true}
 
-		e := &entries[pos]
-		e.ruleIDs = append(e.ruleIDs, f.RuleID)
+	for _, g := range group[1:] {
+		ruleIDs = append(ruleIDs, g.RuleID)
 
 		// Upgrade severity if this finding is more severe.
-		if severityRank[f.Severity] < severityRank[e.f.Severity] {
-			e.f.Severity = f.Severity
+		if severityRank[g.Severity] < severityRank[f.Severity] {
+			f.Severity = g.Severity
+		}
+
+		// Keep the most confident level: another rule corroborates the resource.
+		if confidenceRanks[g.Confidence] < confidenceRanks[f.Confidence] {
+			f.Confidence = g.Confidence
 		}
 
-		// Accumulate estimated savings.
-		e.f.EstimatedMonthlySavings += f.EstimatedMonthlySavings
+		// Accumulate estimated savings, once per savings component.
+		if c := info[g.RuleID].savingsComponent; c == "" || !priced[c] {
+			f.EstimatedMonthlySavings += g.EstimatedMonthlySavings
+			priced[c] = true
+		}
 
 		// Merge any new metadata keys from this finding; do not overwrite existing.
-		for k, v := range f.Metadata {
-			if _, alreadySet := e.f.Metadata[k]; !alreadySet {
-				e.f.Metadata[k] = v
+		for k, v := range g.Metadata {
+			if _, alreadySet := f.Metadata[k]; !alreadySet {
+				f.Metadata[k] = v
 			}
 		}
 	}
 
-	// Stamp Metadata["rules"] and collect results in group-insertion order.
-	result := make([]models.Finding, 0, len(entries))
-	for _, key := range order {
-		e := &entries[index[key]]
-		e.f.Metadata["rules"] = e.ruleIDs
-		result = append(result, e.f)
+	f.Metadata["rules"] = ruleIDs
+	return f
+}
+
+// mergeInfo is what mergeFindings needs to know about a rule.
+type mergeInfo struct {
+	priority         int
+	savingsComponent string
+}
+
+// ruleMergeInfo maps each rule ID in active to its rules.PriorityOf and
+// rules.SavingsComponentOf values, for use by mergeFindings.
+func ruleMergeInfo(active []rules.Rule) map[string]mergeInfo {
+	info := make(map[string]mergeInfo, len(active))
+	for _, r := range active {
+		info[r.ID()] = mergeInfo{
+			priority:         rules.PriorityOf(r),
+			savingsComponent: rules.SavingsComponentOf(r),
+		}
 	}
-	return result
+	return info
 }
 
 // severityRank maps Severity values to sort keys (lower = higher priority).
@@ -370,15 +521,30 @@ var severityRank = map[models.Severity]int{
 }
 
 // sortFindings sorts findings in-place: severity descending (CRITICAL first),
-// then EstimatedMonthlySavings descending within the same severity.
+// then EstimatedMonthlySavings descending within the same severity. Remaining
+// ties are broken by (RuleID, ResourceID, namespace, Region) ascending so the
+// output order is fully deterministic and baseline diffs stay stable.
 func sortFindings(findings []models.Finding) {
 	sort.SliceStable(findings, func(i, j int) bool {
-		ri := severityRank[findings[i].Severity]
-		rj := severityRank[findings[j].Severity]
+		fi, fj := &findings[i], &findings[j]
+		ri := severityRank[fi.Severity]
+		rj := severityRank[fj.Severity]
 		if ri != rj {
 			return ri < rj
 		}
-		return findings[i].EstimatedMonthlySavings > findings[j].EstimatedMonthlySavings
+		if fi.EstimatedMonthlySavings != fj.EstimatedMonthlySavings {
+			return fi.EstimatedMonthlySavings > fj.EstimatedMonthlySavings
+		}
+		if fi.RuleID != fj.RuleID {
+			return fi.RuleID < fj.RuleID
+		}
+		if fi.ResourceID != fj.ResourceID {
+			return fi.ResourceID < fj.ResourceID
+		}
+		if nsi, nsj := resolveNamespaceForFinding(fi), resolveNamespaceForFinding(fj); nsi != nsj {
+			return nsi < nsj
+		}
+		return fi.Region < fj.Region
 	})
 }
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
 )
 
 // newFinding constructs a minimal Finding for use in engine tests.
@@ -29,11 +30,11 @@ func newFinding(resourceID, region, ruleID string, sev models.Severity, savings
 // ── mergeFindings ────────────────────────────────────────────────────────────
 
 func TestMergeFindings_Empty(t *testing.T) {
-	got := mergeFindings(nil)
+	got := mergeFindings(nil, nil)
 	if len(got) != 0 {
 		t.Errorf("want 0, got %d", len(got))
 	}
-	got = mergeFindings([]models.Finding{})
+	got = mergeFindings([]models.Finding{}, nil)
 	if len(got) != 0 {
 		t.Errorf("want 0, got %d", len(got))
 	}
@@ -41,7 +42,7 @@ func TestMergeFindings_Empty(t *testing.T) {
 
 func TestMergeFindings_SingleFinding(t *testing.T) {
 	raw := []models.Finding{newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0)}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 
 	if len(got) != 1 {
 		t.Fatalf("want 1, got %d", len(got))
@@ -69,7 +70,7 @@ func TestMergeFindings_DifferentResourcesNotMerged(t *testing.T) {
 		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0),
 		newFinding("vol-2", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 4.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 2 {
 		t.Errorf("want 2 separate findings, got %d", len(got))
 	}
@@ -81,7 +82,7 @@ func TestMergeFindings_DifferentRegionsSameIDNotMerged(t *testing.T) {
 		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0),
 		newFinding("vol-1", "eu-west-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 2 {
 		t.Errorf("want 2 findings (different regions), got %d", len(got))
 	}
@@ -90,9 +91,9 @@ func TestMergeFindings_DifferentRegionsSameIDNotMerged(t *testing.T) {
 func TestMergeFindings_SameResourceSumsSavings(t *testing.T) {
 	raw := []models.Finding{
 		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0),
-		newFinding("vol-1", "us-east-1", "EBS_GP2_LEGACY", models.SeverityLow, 2.0),
+		newFinding("vol-1", "us-east-1", "AWS_EBS_GP2_LEGACY", models.SeverityLow, 2.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 1 {
 		t.Fatalf("want 1 merged finding, got %d", len(got))
 	}
@@ -104,10 +105,10 @@ func TestMergeFindings_SameResourceSumsSavings(t *testing.T) {
 func TestMergeFindings_SameResourceUpgradesSevertiy(t *testing.T) {
 	// First finding is LOW; second is MEDIUM — merged result must use MEDIUM.
 	raw := []models.Finding{
-		newFinding("vol-1", "us-east-1", "EBS_GP2_LEGACY", models.SeverityLow, 2.0),
+		newFinding("vol-1", "us-east-1", "AWS_EBS_GP2_LEGACY", models.SeverityLow, 2.0),
 		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 1 {
 		t.Fatalf("want 1 merged finding, got %d", len(got))
 	}
@@ -122,7 +123,7 @@ func TestMergeFindings_SameResourceKeepsHigherSeverity(t *testing.T) {
 		newFinding("i-1", "us-east-1", "EC2_NO_SP", models.SeverityHigh, 50.0),
 		newFinding("i-1", "us-east-1", "EC2_LOW_CPU", models.SeverityMedium, 30.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 1 {
 		t.Fatalf("want 1 merged finding, got %d", len(got))
 	}
@@ -134,9 +135,9 @@ func TestMergeFindings_SameResourceKeepsHigherSeverity(t *testing.T) {
 func TestMergeFindings_RuleIDsCollectedInMetadata(t *testing.T) {
 	raw := []models.Finding{
 		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 8.0),
-		newFinding("vol-1", "us-east-1", "EBS_GP2_LEGACY", models.SeverityLow, 2.0),
+		newFinding("vol-1", "us-east-1", "AWS_EBS_GP2_LEGACY", models.SeverityLow, 2.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 1 {
 		t.Fatalf("want 1 merged finding, got %d", len(got))
 	}
@@ -149,8 +150,8 @@ func TestMergeFindings_RuleIDsCollectedInMetadata(t *testing.T) {
 		t.Fatalf("len(Metadata[rules]) = %d; want 2", len(rules))
 	}
 	// Order must follow registration/evaluation order.
-	if rules[0] != "EBS_UNATTACHED" || rules[1] != "EBS_GP2_LEGACY" {
-		t.Errorf("Metadata[rules] = %v; want [EBS_UNATTACHED EBS_GP2_LEGACY]", rules)
+	if rules[0] != "EBS_UNATTACHED" || rules[1] != "AWS_EBS_GP2_LEGACY" {
+		t.Errorf("Metadata[rules] = %v; want [EBS_UNATTACHED AWS_EBS_GP2_LEGACY]", rules)
 	}
 }
 
@@ -163,7 +164,7 @@ func TestMergeFindings_MetadataMergedFromLaterFindings(t *testing.T) {
 	f2 := newFinding("vol-1", "us-east-1", "RULE_B", models.SeverityLow, 1.0)
 	f2.Metadata = map[string]any{"b": "second", "a": "should-not-overwrite", "src_RULE_B": true}
 
-	got := mergeFindings([]models.Finding{f1, f2})
+	got := mergeFindings([]models.Finding{f1, f2}, nil)
 	if len(got) != 1 {
 		t.Fatalf("want 1 merged finding, got %d", len(got))
 	}
@@ -183,7 +184,7 @@ func TestMergeFindings_PreservesInsertionOrder(t *testing.T) {
 		newFinding("vol-a", "us-east-1", "RULE", models.SeverityLow, 2.0),
 		newFinding("vol-b", "us-east-1", "RULE", models.SeverityLow, 3.0),
 	}
-	got := mergeFindings(raw)
+	got := mergeFindings(raw, nil)
 	if len(got) != 3 {
 		t.Fatalf("want 3, got %d", len(got))
 	}
@@ -203,7 +204,7 @@ func TestMergeFindings_DoesNotMutateInput(t *testing.T) {
 	}
 	originalMeta := raw[0].Metadata // keep reference to original map
 
-	mergeFindings(raw)
+	mergeFindings(raw, nil)
 
 	if _, found := originalMeta["rules"]; found {
 		t.Error("mergeFindings must not add 'rules' key to the original finding's Metadata map")
@@ -221,7 +222,7 @@ func TestMergeFindings_NilMetadataHandled(t *testing.T) {
 		Severity:     models.SeverityLow,
 		Metadata:     nil,
 	}
-	got := mergeFindings([]models.Finding{f})
+	got := mergeFindings([]models.Finding{f}, nil)
 	if len(got) != 1 {
 		t.Fatalf("want 1, got %d", len(got))
 	}
@@ -230,6 +231,172 @@ func TestMergeFindings_NilMetadataHandled(t *testing.T) {
 	}
 }
 
+func TestMergeFindings_DefaultPriorityKeepsFirstFinding(t *testing.T) {
+	raw := []models.Finding{
+		newFinding("vol-1", "us-east-1", "RULE_B", models.SeverityLow, 0),
+		newFinding("vol-1", "us-east-1", "RULE_A", models.SeverityHigh, 0),
+	}
+	got := mergeFindings(raw, map[string]mergeInfo{"RULE_A": {}, "RULE_B": {}})
+	if got[0].RuleID != "RULE_B" {
+		t.Errorf("RuleID = %s; want RULE_B (first in group) when priorities tie", got[0].RuleID)
+	}
+}
+
+func TestMergeFindings_HighestPriorityBecomesPrimary(t *testing.T) {
+	raw := []models.Finding{
+		newFinding("vol-1", "us-east-1", "RULE_B", models.SeverityHigh, 0),
+		newFinding("vol-1", "us-east-1", "RULE_A", models.SeverityLow, 0),
+	}
+	raw[1].Metadata["origin"] = "a"
+	raw[0].Metadata["origin"] = "b"
+
+	got := mergeFindings(raw, map[string]mergeInfo{"RULE_A": {priority: 10}})
+	f := got[0]
+	if f.RuleID != "RULE_A" || f.ID != "RULE_A-vol-1" {
+		t.Errorf("primary = %s (%s); want RULE_A", f.RuleID, f.ID)
+	}
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("Severity = %s; want HIGH (highest in group)", f.Severity)
+	}
+	if f.Metadata["origin"] != "a" {
+		t.Errorf("Metadata[origin] = %v; want the primary finding's value", f.Metadata["origin"])
+	}
+	ruleIDs, _ := f.Metadata["rules"].([]string)
+	if len(ruleIDs) != 2 || ruleIDs[0] != "RULE_A" || ruleIDs[1] != "RULE_B" {
+		t.Errorf("Metadata[rules] = %v; want [RULE_A RULE_B]", ruleIDs)
+	}
+}
+
+// TestMergeFindings_PrivilegedContainerStaysPrimary verifies that the
+// privileged-container rule's finding is primary when merged with a
+// CAP_SYS_ADMIN finding on the same pod, whatever order they were evaluated in.
+func TestMergeFindings_PrivilegedContainerStaysPrimary(t *testing.T) {
+	cluster := &models.KubernetesClusterData{
+		ContextName: "prod",
+		Pods: []models.KubernetesPodData{{
+			Name:      "web",
+			Namespace: "default",
+			Containers: []models.KubernetesContainerData{
+				{Name: "app", Privileged: true, AddedCapabilities: []string{"SYS_ADMIN"}},
+			},
+		}},
+	}
+	capSysAdmin := rules.K8SPSSCapSysAdminRule{}
+	privileged := rules.K8SPSSPrivilegedContainerRule{}
+	rctx := rules.RuleContext{ClusterData: cluster}
+
+	raw := append(capSysAdmin.Evaluate(rctx), privileged.Evaluate(rctx)...)
+	if len(raw) != 2 {
+		t.Fatalf("want 2 raw findings, got %d", len(raw))
+	}
+	got := mergeFindings(raw, ruleMergeInfo([]rules.Rule{capSysAdmin, privileged}))
+	if len(got) != 1 {
+		t.Fatalf("want 1 merged finding, got %d", len(got))
+	}
+	if got[0].RuleID != privileged.ID() {
+		t.Errorf("RuleID = %s; want %s as primary", got[0].RuleID, privileged.ID())
+	}
+	if got[0].Severity != models.SeverityCritical {
+		t.Errorf("Severity = %s; want CRITICAL", got[0].Severity)
+	}
+}
+
+func TestMergeFindings_SavingsComponentCountedOnce(t *testing.T) {
+	raw := []models.Finding{
+		newFinding("db-1", "us-east-1", "RULE_LOW", models.SeverityMedium, 30.0),
+		newFinding("db-1", "us-east-1", "RULE_HIGH", models.SeverityMedium, 50.0),
+		newFinding("db-1", "us-east-1", "RULE_OTHER", models.SeverityLow, 5.0),
+	}
+	info := map[string]mergeInfo{
+		"RULE_LOW":  {savingsComponent: "size"},
+		"RULE_HIGH": {priority: 10, savingsComponent: "size"},
+	}
+	got := mergeFindings(raw, info)
+	if len(got) != 1 {
+		t.Fatalf("want 1 merged finding, got %d", len(got))
+	}
+	if got[0].EstimatedMonthlySavings != 55.0 {
+		t.Errorf("savings = %.2f; want 55.00 (primary's 50 for the shared component + 5)", got[0].EstimatedMonthlySavings)
+	}
+}
+
+// TestMergeFindings_RDSRightSizingCountedOnce verifies that RDS_LOW_CPU and
+// AWS_RDS_OVERPROVISIONED on the same instance merge into one finding led by
+// AWS_RDS_OVERPROVISIONED, whatever order they were evaluated in, and that
+// only its downsizing estimate is counted.
+func TestMergeFindings_RDSRightSizingCountedOnce(t *testing.T) {
+	rctx := rules.RuleContext{RegionData: &models.AWSRegionData{
+		Region: "us-east-1",
+		RDSInstances: []models.AWSRDSInstance{{
+			DBInstanceID:    "idle-db",
+			DBInstanceClass: "db.m5.xlarge",
+			Region:          "us-east-1",
+			Status:          "available",
+			AvgCPUPercent:   3,
+			AvgConnections:  1,
+			MonthlyCostUSD:  400,
+		}},
+	}}
+	lowCPU := rules.AWSRDSLowCPURule{}
+	overprovisioned := rules.AWSRDSOverprovisionedRule{}
+
+	raw := append(lowCPU.Evaluate(rctx), overprovisioned.Evaluate(rctx)...)
+	if len(raw) != 2 {
+		t.Fatalf("want 2 raw findings, got %d", len(raw))
+	}
+	got := mergeFindings(raw, ruleMergeInfo([]rules.Rule{lowCPU, overprovisioned}))
+	if len(got) != 1 {
+		t.Fatalf("want 1 merged finding, got %d", len(got))
+	}
+	if got[0].RuleID != overprovisioned.ID() {
+		t.Errorf("RuleID = %s; want %s as primary", got[0].RuleID, overprovisioned.ID())
+	}
+	if got[0].EstimatedMonthlySavings != 200.0 {
+		t.Errorf("savings = %.2f; want 200.00 (xlarge → large), not the sum of both estimates", got[0].EstimatedMonthlySavings)
+	}
+}
+
+// TestMergeFindings_IdleNATGatewayCountedOnce verifies that an idle gateway
+// flagged by both NAT_LOW_TRAFFIC and AWS_NAT_GATEWAY_IDLE merges into one
+// finding led by AWS_NAT_GATEWAY_IDLE whose savings are not counted twice,
+// while a merely quiet gateway keeps its NAT_LOW_TRAFFIC finding.
+func TestMergeFindings_IdleNATGatewayCountedOnce(t *testing.T) {
+	windowStart := time.Now().UTC().AddDate(0, 0, -30)
+	gw := func(id string, gb float64) models.AWSNATGateway {
+		return models.AWSNATGateway{
+			NATGatewayID:     id,
+			Region:           "us-east-1",
+			State:            "available",
+			BytesProcessedGB: gb,
+			CreatedTime:      windowStart.AddDate(0, -6, 0),
+			MetricsStart:     windowStart,
+		}
+	}
+	rctx := rules.RuleContext{RegionData: &models.AWSRegionData{
+		Region:      "us-east-1",
+		NATGateways: []models.AWSNATGateway{gw("nat-idle", 0), gw("nat-quiet", 0.5)},
+	}}
+	lowTraffic := rules.AWSNATLowTrafficRule{}
+	idle := rules.AWSNATGatewayIdleRule{}
+
+	idleFindings := idle.Evaluate(rctx)
+	if len(idleFindings) != 1 {
+		t.Fatalf("want 1 AWS_NAT_GATEWAY_IDLE finding, got %d", len(idleFindings))
+	}
+	raw := append(lowTraffic.Evaluate(rctx), idleFindings...)
+	got := mergeFindings(raw, ruleMergeInfo([]rules.Rule{lowTraffic, idle}))
+	if len(got) != 2 {
+		t.Fatalf("want 2 merged findings, got %d", len(got))
+	}
+	byID := map[string]models.Finding{got[0].ResourceID: got[0], got[1].ResourceID: got[1]}
+	if f := byID["nat-idle"]; f.RuleID != idle.ID() || f.EstimatedMonthlySavings != idleFindings[0].EstimatedMonthlySavings {
+		t.Errorf("nat-idle = %s with savings %.2f; want %s with %.2f", f.RuleID, f.EstimatedMonthlySavings, idle.ID(), idleFindings[0].EstimatedMonthlySavings)
+	}
+	if f := byID["nat-quiet"]; f.RuleID != lowTraffic.ID() {
+		t.Errorf("nat-quiet RuleID = %s; want %s", f.RuleID, lowTraffic.ID())
+	}
+}
+
 // ── sortFindings ─────────────────────────────────────────────────────────────
 
 func TestSortFindings_DeterministicAcrossInputOrder(t *testing.T) {
@@ -275,7 +442,7 @@ func TestSortFindings_DeterministicAcrossProfiles(t *testing.T) {
 	}
 	profileB := []models.Finding{
 		newFinding("i-high-b", "eu-west-1", "EC2_NO_SP", models.SeverityHigh, 60.0),
-		newFinding("vol-low-b", "eu-west-1", "EBS_GP2_LEGACY", models.SeverityLow, 5.0),
+		newFinding("vol-low-b", "eu-west-1", "AWS_EBS_GP2_LEGACY", models.SeverityLow, 5.0),
 	}
 
 	// Expected canonical order: CRITICAL → HIGH → MEDIUM → LOW
@@ -299,6 +466,60 @@ func TestSortFindings_DeterministicAcrossProfiles(t *testing.T) {
 	}
 }
 
+func TestSortFindings_TieBreakWithinSeverity(t *testing.T) {
+	// Findings with equal severity and savings must be ordered by
+	// (RuleID, ResourceID, namespace, Region) so baseline diffs stay stable.
+	nsFinding := func(resourceID, ns, ruleID string) models.Finding {
+		f := newFinding(resourceID, "prod-cluster", ruleID, models.SeverityHigh, 0)
+		f.ResourceType = models.ResourceK8sPod
+		f.Metadata = map[string]any{"namespace": ns}
+		return f
+	}
+	base := []models.Finding{
+		newFinding("vol-b", "us-west-2", "R_B", models.SeverityHigh, 0),
+		newFinding("vol-a", "us-west-2", "R_B", models.SeverityHigh, 0),
+		newFinding("vol-a", "eu-west-1", "R_B", models.SeverityHigh, 0),
+		newFinding("vol-z", "us-east-1", "R_A", models.SeverityHigh, 0),
+		nsFinding("web", "staging", "R_C"),
+		nsFinding("web", "prod", "R_C"),
+		newFinding("i-crit", "us-east-1", "R_Z", models.SeverityCritical, 0),
+		newFinding("i-med", "us-east-1", "R_A", models.SeverityMedium, 0),
+	}
+	wantOrder := []string{
+		"i-crit",
+		"R_A-vol-z@us-east-1",
+		"R_B-vol-a@eu-west-1",
+		"R_B-vol-a@us-west-2",
+		"R_B-vol-b@us-west-2",
+		"R_C-web@prod",
+		"R_C-web@staging",
+		"i-med",
+	}
+	key := func(f models.Finding) string {
+		if f.Severity != models.SeverityHigh {
+			return f.ResourceID
+		}
+		if ns, ok := f.Metadata["namespace"].(string); ok {
+			return f.RuleID + "-" + f.ResourceID + "@" + ns
+		}
+		return f.RuleID + "-" + f.ResourceID + "@" + f.Region
+	}
+
+	// Rotate the input on every run; each run must yield the same order.
+	for run := 0; run < 5; run++ {
+		cp := make([]models.Finding, len(base))
+		for i := range base {
+			cp[(i+run*3)%len(base)] = base[i]
+		}
+		sortFindings(cp)
+		for i, want := range wantOrder {
+			if got := key(cp[i]); got != want {
+				t.Errorf("run %d: position %d got %q; want %q", run, i, got, want)
+			}
+		}
+	}
+}
+
 // ── computeSummary ──────────────────────────────────────────────────────────
 
 func TestComputeSummary_Empty(t *testing.T) {
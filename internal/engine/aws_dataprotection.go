@@ -18,7 +18,8 @@ import (
 //   - CostCollector: provides per-region EBSVolumes and RDSInstances with
 //     their Encrypted / StorageEncrypted fields populated.
 //   - SecurityCollector: provides account-level S3 bucket data with
-//     DefaultEncryptionEnabled populated by GetBucketEncryption.
+//     DefaultEncryptionEnabled populated by GetBucketEncryption and
+//     VersioningStatus populated by GetBucketVersioning.
 //
 // Rules are evaluated per-region for EBS/RDS and once globally for S3.
 // The engine never calls AWS SDK clients directly.
@@ -54,16 +55,32 @@ func (e *AWSDataProtectionEngine) RunAudit(ctx context.Context, opts AuditOption
 	if opts.AuditType != AuditTypeDataProtection {
 		return nil, fmt.Errorf("unsupported audit type: %q", opts.AuditType)
 	}
-	if opts.AllProfiles {
-		return e.runAllProfilesDP(ctx, opts)
+	var (
+		report *models.AuditReport
+		err    error
+	)
+	sw := newStopwatch()
+	if opts.AllProfiles || opts.ProfileRegex != "" {
+		report, err = e.runAllProfilesDP(ctx, opts, sw)
+	} else {
+		report, err = e.runSingleProfileDP(ctx, opts, sw)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return e.runSingleProfileDP(ctx, opts)
+	report.Summary.Compliance = computeCompliance(e.registry.All(), report.Findings)
+	assignRiskGrade(&report.Summary, e.policy)
+	if opts.Timings {
+		sw.record(report)
+	}
+	return report, nil
 }
 
 // runSingleProfileDP executes a data-protection audit for one AWS profile.
 func (e *AWSDataProtectionEngine) runSingleProfileDP(
 	ctx context.Context,
 	opts AuditOptions,
+	sw *stopwatch,
 ) (*models.AuditReport, error) {
 	profile, err := e.provider.LoadProfile(ctx, opts.Profile)
 	if err != nil {
@@ -87,17 +104,33 @@ func (e *AWSDataProtectionEngine) runSingleProfileDP(
 	if err != nil {
 		return nil, fmt.Errorf("collect security data for profile %q: %w", profile.ProfileName, err)
 	}
+	sw.lap(timingCollection)
+
+	findings, evalErrs := e.evaluateDataProtection(regionData, secData, profile.AccountID, profile.ProfileName)
+	sw.lap(timingEvaluation)
+	if opts.AnnotateFindings {
+		annotateResourceTags(findings, dataProtectionResourceTags(regionData, profile.ProfileName), opts.AnnotateKeys)
+	}
 
-	findings := e.evaluateDataProtection(regionData, secData, profile.AccountID, profile.ProfileName)
-	return buildDataProtectionReport(profile.ProfileName, profile.AccountID, regions, findings, e.policy), nil
+	var passed []models.PassedResource
+	if opts.ShowPassed {
+		passed = passedResources(dataProtectionInventory(regionData, secData, profile.ProfileName), findings)
+	}
+	report := buildDataProtectionReport(profile.ProfileName, profile.AccountID, regions, findings, e.policy)
+	report.PassedResources = passed
+	report.Errors = append(costCollectErrors("", regionData), securityCollectErrors("", secData)...)
+	report.Errors = append(report.Errors, evalErrs...)
+	return report, nil
 }
 
 // runAllProfilesDP runs a data-protection audit across every configured AWS
 // profile and merges findings into a single report. Profile failures are
-// skipped non-fatally; an error is returned only when no profile succeeds.
+// recorded in report.Errors and skipped; an error is returned only when no
+// profile succeeds.
 func (e *AWSDataProtectionEngine) runAllProfilesDP(
 	ctx context.Context,
 	opts AuditOptions,
+	sw *stopwatch,
 ) (*models.AuditReport, error) {
 	profiles, err := e.provider.LoadAllProfiles(ctx)
 	if err != nil {
@@ -106,29 +139,55 @@ func (e *AWSDataProtectionEngine) runAllProfilesDP(
 	if len(profiles) == 0 {
 		return nil, fmt.Errorf("no AWS profiles found")
 	}
+	profiles, err = filterProfileConfigs(profiles, opts.ProfileRegex)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		allFindings []models.Finding
+		allPassed   []models.PassedResource
 		allRegions  []string
 		seenRegions = make(map[string]struct{})
+		allErrs     []models.AuditError
 		audited     int
 	)
 
+	fail := func(format, profile string, err error) {
+		allErrs = append(allErrs, profileCollectError("aws/dataprotection", profile, fmt.Errorf(format, profile, err)))
+	}
 	for _, profile := range profiles {
+		collectStart := time.Now()
 		regions, err := e.resolveRegionsDP(ctx, profile, opts.Regions)
 		if err != nil {
+			fail("resolve regions for profile %q: %w", profile.ProfileName, err)
 			continue
 		}
 		regionData, _, err := e.cost.CollectAll(ctx, profile, e.provider, regions, 1)
 		if err != nil {
+			fail("collect region data for profile %q: %w", profile.ProfileName, err)
 			continue
 		}
 		secData, err := e.security.CollectAll(ctx, profile, e.provider, regions)
 		if err != nil {
+			fail("collect security data for profile %q: %w", profile.ProfileName, err)
 			continue
 		}
+		sw.add(timingCollection, time.Since(collectStart))
 		audited++
-		allFindings = append(allFindings, e.evaluateDataProtection(regionData, secData, profile.AccountID, profile.ProfileName)...)
+		evalStart := time.Now()
+		findings, evalErrs := e.evaluateDataProtection(regionData, secData, profile.AccountID, profile.ProfileName)
+		sw.add(timingEvaluation, time.Since(evalStart))
+		if opts.AnnotateFindings {
+			annotateResourceTags(findings, dataProtectionResourceTags(regionData, profile.ProfileName), opts.AnnotateKeys)
+		}
+		allFindings = append(allFindings, findings...)
+		allErrs = append(allErrs, costCollectErrors(profile.ProfileName, regionData)...)
+		allErrs = append(allErrs, securityCollectErrors(profile.ProfileName, secData)...)
+		allErrs = append(allErrs, evalErrs...)
+		if opts.ShowPassed {
+			allPassed = append(allPassed, passedResources(dataProtectionInventory(regionData, secData, profile.ProfileName), findings)...)
+		}
 		for _, r := range regions {
 			if _, seen := seenRegions[r]; !seen {
 				seenRegions[r] = struct{}{}
@@ -140,7 +199,10 @@ func (e *AWSDataProtectionEngine) runAllProfilesDP(
 	if audited == 0 {
 		return nil, fmt.Errorf("all profiles failed; no data collected")
 	}
-	return buildDataProtectionReport("multi", "", allRegions, allFindings, e.policy), nil
+	report := buildDataProtectionReport("multi", "", allRegions, allFindings, e.policy)
+	report.PassedResources = allPassed
+	report.Errors = allErrs
+	return report, nil
 }
 
 // resolveRegionsDP returns explicit regions or discovers active regions.
@@ -162,13 +224,16 @@ func (e *AWSDataProtectionEngine) resolveRegionsDP(
 //     EBS and RDS rules see empty slices and return nothing.
 //
 // Results from all contexts are merged (same ResourceID+Region deduplication)
-// before being returned.
+// before being returned, together with an AuditError for each rule that panicked.
 func (e *AWSDataProtectionEngine) evaluateDataProtection(
 	regionData []models.AWSRegionData,
 	secData *models.AWSSecurityData,
 	accountID, profile string,
-) []models.Finding {
-	var raw []models.Finding
+) ([]models.Finding, []models.AuditError) {
+	var (
+		raw  []models.Finding
+		errs []models.AuditError
+	)
 
 	// Per-region: AWSEBSUnencryptedRule and AWSRDSUnencryptedRule fire here.
 	for i := range regionData {
@@ -178,7 +243,9 @@ func (e *AWSDataProtectionEngine) evaluateDataProtection(
 			RegionData: &regionData[i],
 			Policy:     e.policy,
 		}
-		raw = append(raw, e.registry.EvaluateAll(rctx)...)
+		out, evalErrs := evaluateRules(e.registry, rctx, "aws/dataprotection", regionData[i].Region)
+		raw = append(raw, out...)
+		errs = append(errs, evalErrs...)
 	}
 
 	// Global: AWSS3DefaultEncryptionMissingRule fires here.
@@ -191,10 +258,13 @@ func (e *AWSDataProtectionEngine) evaluateDataProtection(
 		},
 		Policy: e.policy,
 	}
-	raw = append(raw, e.registry.EvaluateAll(rctx)...)
+	out, evalErrs := evaluateRules(e.registry, rctx, "aws/dataprotection", "global")
+	raw = append(raw, out...)
+	errs = append(errs, evalErrs...)
 
 	stampDomain(raw, "dataprotection")
-	return mergeFindings(raw)
+	policy.ApplySeverityOverrides(raw, e.policy)
+	return mergeFindings(raw, ruleMergeInfo(e.registry.All())), errs
 }
 
 // buildDataProtectionReport assembles the final AuditReport for a data
@@ -206,15 +276,20 @@ func buildDataProtectionReport(
 	policyCfg *policy.PolicyConfig,
 ) *models.AuditReport {
 	findings = policy.ApplyPolicy(findings, "dataprotection", policyCfg)
+	policy.ApplyLabels(findings, policyCfg)
+	stampConfidence(findings)
+	stampFingerprints(findings)
 	sortFindings(findings)
 	return &models.AuditReport{
-		ReportID:    fmt.Sprintf("audit-%d", time.Now().UnixNano()),
-		GeneratedAt: time.Now().UTC(),
-		AuditType:   string(AuditTypeDataProtection),
-		Profile:     profile,
-		AccountID:   accountID,
-		Regions:     regions,
-		Summary:     computeSummary(findings),
-		Findings:    findings,
+		SchemaVersion: models.ReportSchemaVersion,
+		ReportID:      fmt.Sprintf("audit-%d", time.Now().UnixNano()),
+		GeneratedAt:   time.Now().UTC(),
+		AuditType:     string(AuditTypeDataProtection),
+		Provider:      "aws",
+		Profile:       profile,
+		AccountID:     accountID,
+		Regions:       regions,
+		Summary:       computeSummary(findings),
+		Findings:      findings,
 	}
 }
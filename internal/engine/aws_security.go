@@ -44,16 +44,32 @@ func (e *AWSSecurityEngine) RunAudit(ctx context.Context, opts AuditOptions) (*m
 	if opts.AuditType != AuditTypeSecurity {
 		return nil, fmt.Errorf("unsupported audit type: %q", opts.AuditType)
 	}
-	if opts.AllProfiles {
-		return e.runAllProfilesSec(ctx, opts)
+	var (
+		report *models.AuditReport
+		err    error
+	)
+	sw := newStopwatch()
+	if opts.AllProfiles || opts.ProfileRegex != "" {
+		report, err = e.runAllProfilesSec(ctx, opts, sw)
+	} else {
+		report, err = e.runSingleProfileSec(ctx, opts, sw)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return e.runSingleProfileSec(ctx, opts)
+	report.Summary.Compliance = computeCompliance(e.registry.All(), report.Findings)
+	assignRiskGrade(&report.Summary, e.policy)
+	if opts.Timings {
+		sw.record(report)
+	}
+	return report, nil
 }
 
 // runSingleProfileSec executes a security audit for one AWS profile.
 func (e *AWSSecurityEngine) runSingleProfileSec(
 	ctx context.Context,
 	opts AuditOptions,
+	sw *stopwatch,
 ) (*models.AuditReport, error) {
 	profile, err := e.provider.LoadProfile(ctx, opts.Profile)
 	if err != nil {
@@ -69,17 +85,30 @@ func (e *AWSSecurityEngine) runSingleProfileSec(
 	if err != nil {
 		return nil, fmt.Errorf("collect security data for profile %q: %w", profile.ProfileName, err)
 	}
+	secData = withinLookback(secData, opts.DaysBack, time.Now())
+	sw.lap(timingCollection)
+
+	findings, evalErrs := e.evaluateSecurity(secData, profile.AccountID, profile.ProfileName)
+	sw.lap(timingEvaluation)
 
-	findings := e.evaluateSecurity(secData, profile.AccountID, profile.ProfileName)
-	return buildSecurityReport(profile.ProfileName, profile.AccountID, regions, findings, e.policy), nil
+	var passed []models.PassedResource
+	if opts.ShowPassed {
+		passed = passedResources(securityInventory(secData, profile.AccountID, profile.ProfileName), findings)
+	}
+	report := buildSecurityReport(profile.ProfileName, profile.AccountID, regions, findings, e.policy)
+	report.PassedResources = passed
+	report.Errors = append(securityCollectErrors("", secData), evalErrs...)
+	return report, nil
 }
 
 // runAllProfilesSec runs a security audit across every configured AWS profile
-// and merges findings into a single report. Profile failures are skipped
-// non-fatally; an error is returned only when no profile can be audited.
+// and merges findings into a single report. Profile failures are recorded in
+// report.Errors and skipped; an error is returned only when no profile can be
+// audited.
 func (e *AWSSecurityEngine) runAllProfilesSec(
 	ctx context.Context,
 	opts AuditOptions,
+	sw *stopwatch,
 ) (*models.AuditReport, error) {
 	profiles, err := e.provider.LoadAllProfiles(ctx)
 	if err != nil {
@@ -88,25 +117,46 @@ func (e *AWSSecurityEngine) runAllProfilesSec(
 	if len(profiles) == 0 {
 		return nil, fmt.Errorf("no AWS profiles found")
 	}
+	profiles, err = filterProfileConfigs(profiles, opts.ProfileRegex)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		allFindings []models.Finding
+		allPassed   []models.PassedResource
 		allRegions  []string
 		seenRegions = make(map[string]struct{})
+		allErrs     []models.AuditError
 		audited     int
 	)
 
 	for _, profile := range profiles {
+		collectStart := time.Now()
 		regions, err := e.resolveRegionsSec(ctx, profile, opts.Regions)
 		if err != nil {
+			allErrs = append(allErrs, profileCollectError("aws/security", profile.ProfileName,
+				fmt.Errorf("resolve regions for profile %q: %w", profile.ProfileName, err)))
 			continue
 		}
 		secData, err := e.collector.CollectAll(ctx, profile, e.provider, regions)
 		if err != nil {
+			allErrs = append(allErrs, profileCollectError("aws/security", profile.ProfileName,
+				fmt.Errorf("collect security data for profile %q: %w", profile.ProfileName, err)))
 			continue
 		}
+		secData = withinLookback(secData, opts.DaysBack, time.Now())
+		sw.add(timingCollection, time.Since(collectStart))
 		audited++
-		allFindings = append(allFindings, e.evaluateSecurity(secData, profile.AccountID, profile.ProfileName)...)
+		evalStart := time.Now()
+		findings, evalErrs := e.evaluateSecurity(secData, profile.AccountID, profile.ProfileName)
+		sw.add(timingEvaluation, time.Since(evalStart))
+		allFindings = append(allFindings, findings...)
+		allErrs = append(allErrs, securityCollectErrors(profile.ProfileName, secData)...)
+		allErrs = append(allErrs, evalErrs...)
+		if opts.ShowPassed {
+			allPassed = append(allPassed, passedResources(securityInventory(secData, profile.AccountID, profile.ProfileName), findings)...)
+		}
 		for _, r := range regions {
 			if _, seen := seenRegions[r]; !seen {
 				seenRegions[r] = struct{}{}
@@ -118,7 +168,10 @@ func (e *AWSSecurityEngine) runAllProfilesSec(
 	if audited == 0 {
 		return nil, fmt.Errorf("all profiles failed; no security data collected")
 	}
-	return buildSecurityReport("multi", "", allRegions, allFindings, e.policy), nil
+	report := buildSecurityReport("multi", "", allRegions, allFindings, e.policy)
+	report.PassedResources = allPassed
+	report.Errors = allErrs
+	return report, nil
 }
 
 // resolveRegionsSec returns the explicit region list or discovers active regions.
@@ -133,14 +186,34 @@ func (e *AWSSecurityEngine) resolveRegionsSec(
 	return e.provider.GetActiveRegions(ctx, profile)
 }
 
+// withinLookback returns a copy of secData whose ECR images are limited to
+// those pushed within the last daysBack days (30 when zero) before now.
+// secData itself is never modified because collectors may cache and share it.
+func withinLookback(secData *models.AWSSecurityData, daysBack int, now time.Time) *models.AWSSecurityData {
+	if daysBack <= 0 {
+		daysBack = 30
+	}
+	cutoff := now.AddDate(0, 0, -daysBack)
+
+	scoped := *secData
+	scoped.ECRImages = nil
+	for _, img := range secData.ECRImages {
+		if !img.PushedAt.Before(cutoff) {
+			scoped.ECRImages = append(scoped.ECRImages, img)
+		}
+	}
+	return &scoped
+}
+
 // evaluateSecurity builds a synthetic RegionData carrying the full security
 // snapshot and evaluates all registered security rules against it.
 // A single RuleContext is used because security data is account-level: IAM,
 // root, and S3 are global; SG rules carry their own region via the Region field.
+// Rules that panic are returned as AuditErrors.
 func (e *AWSSecurityEngine) evaluateSecurity(
 	secData *models.AWSSecurityData,
 	accountID, profile string,
-) []models.Finding {
+) ([]models.Finding, []models.AuditError) {
 	rctx := rules.RuleContext{
 		AccountID: accountID,
 		Profile:   profile,
@@ -150,9 +223,10 @@ func (e *AWSSecurityEngine) evaluateSecurity(
 		},
 		Policy: e.policy,
 	}
-	raw := e.registry.EvaluateAll(rctx)
+	raw, errs := evaluateRules(e.registry, rctx, "aws/security", "global")
 	stampDomain(raw, "security")
-	return mergeFindings(raw)
+	policy.ApplySeverityOverrides(raw, e.policy)
+	return mergeFindings(raw, ruleMergeInfo(e.registry.All())), errs
 }
 
 // buildSecurityReport assembles the final AuditReport for a security audit.
@@ -163,15 +237,20 @@ func buildSecurityReport(
 	policyCfg *policy.PolicyConfig,
 ) *models.AuditReport {
 	findings = policy.ApplyPolicy(findings, "security", policyCfg)
+	policy.ApplyLabels(findings, policyCfg)
+	stampConfidence(findings)
+	stampFingerprints(findings)
 	sortFindings(findings)
 	return &models.AuditReport{
-		ReportID:    fmt.Sprintf("audit-%d", time.Now().UnixNano()),
-		GeneratedAt: time.Now().UTC(),
-		AuditType:   string(AuditTypeSecurity),
-		Profile:     profile,
-		AccountID:   accountID,
-		Regions:     regions,
-		Summary:     computeSummary(findings),
-		Findings:    findings,
+		SchemaVersion: models.ReportSchemaVersion,
+		ReportID:      fmt.Sprintf("audit-%d", time.Now().UnixNano()),
+		GeneratedAt:   time.Now().UTC(),
+		AuditType:     string(AuditTypeSecurity),
+		Provider:      "aws",
+		Profile:       profile,
+		AccountID:     accountID,
+		Regions:       regions,
+		Summary:       computeSummary(findings),
+		Findings:      findings,
 	}
 }
@@ -0,0 +1,34 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestWithinLookback_DropsImagesPushedBeforeWindow(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	sec := &models.AWSSecurityData{
+		IAMUsers: []models.AWSIAMUser{{UserName: "alice"}},
+		ECRImages: []models.AWSECRImageScan{
+			{Repository: "fresh", PushedAt: now.AddDate(0, 0, -3)},
+			{Repository: "stale", PushedAt: now.AddDate(0, 0, -45)},
+		},
+	}
+
+	got := withinLookback(sec, 0, now)
+	if len(got.ECRImages) != 1 || got.ECRImages[0].Repository != "fresh" {
+		t.Errorf("ECRImages = %+v; want only fresh with the default 30-day window", got.ECRImages)
+	}
+	if len(got.IAMUsers) != 1 {
+		t.Errorf("IAMUsers = %+v; want other security data preserved", got.IAMUsers)
+	}
+	if len(sec.ECRImages) != 2 {
+		t.Errorf("input ECRImages modified: %+v", sec.ECRImages)
+	}
+
+	if got := withinLookback(sec, 60, now); len(got.ECRImages) != 2 {
+		t.Errorf("ECRImages = %+v; want both images with a 60-day window", got.ECRImages)
+	}
+}
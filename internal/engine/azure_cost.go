@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+	azcommon "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+	azurecost "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/cost"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// AzureCostEngine runs cost audits against a single Azure subscription.
+// It mirrors AWSCostEngine: collection is delegated to an Azure CostCollector
+// and findings flow through the same merge, policy and sort pipeline, so the
+// resulting report is rendered exactly like an AWS cost report.
+type AzureCostEngine struct {
+	provider azcommon.AzureClientProvider
+	cost     azurecost.CostCollector
+	registry rules.RuleRegistry
+	policy   *policy.PolicyConfig
+}
+
+// NewAzureCostEngine constructs an AzureCostEngine wired to the supplied
+// provider, cost collector, and rule registry.
+func NewAzureCostEngine(
+	provider azcommon.AzureClientProvider,
+	costCollector azurecost.CostCollector,
+	registry rules.RuleRegistry,
+	policyCfg *policy.PolicyConfig,
+) *AzureCostEngine {
+	return &AzureCostEngine{
+		provider: provider,
+		cost:     costCollector,
+		registry: registry,
+		policy:   policyCfg,
+	}
+}
+
+// RunAudit implements Engine. Only AuditTypeCost is supported. The report's
+// AccountID is the subscription ID and Regions lists the Azure locations that
+// held at least one collected resource.
+func (e *AzureCostEngine) RunAudit(ctx context.Context, opts AuditOptions) (*models.AuditReport, error) {
+	if opts.AuditType != AuditTypeCost {
+		return nil, fmt.Errorf("unsupported audit type: %q", opts.AuditType)
+	}
+
+	sw := newStopwatch()
+	sub, err := e.provider.LoadSubscription(ctx, opts.Subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := e.cost.CollectAll(ctx, sub, opts.DaysBack)
+	if err != nil {
+		return nil, fmt.Errorf("collect data for subscription %q: %w", sub.SubscriptionID, err)
+	}
+	sw.lap(timingCollection)
+
+	rctx := rules.RuleContext{
+		AccountID: sub.SubscriptionID,
+		AzureData: data,
+		Policy:    e.policy,
+	}
+	findings, evalErrs := evaluateRules(e.registry, rctx, "azure", "")
+	stampDomain(findings, "cost")
+	policy.ApplySeverityOverrides(findings, e.policy)
+	sw.lap(timingEvaluation)
+	if opts.AnnotateFindings {
+		annotateResourceTags(findings, azureCostResourceTags(data), opts.AnnotateKeys)
+	}
+
+	report := buildReport("", sub.SubscriptionID, azureLocations(data), findings, ruleMergeInfo(e.registry.All()), nil, e.policy)
+	report.Provider = "azure"
+	if opts.ShowPassed {
+		report.PassedResources = passedResources(azureCostInventory(data), findings)
+	}
+	report.Summary.Compliance = computeCompliance(e.registry.All(), report.Findings)
+	assignRiskGrade(&report.Summary, e.policy)
+	report.Errors = evalErrs
+	if opts.Timings {
+		sw.record(report)
+	}
+	return report, nil
+}
+
+// azureLocations returns the sorted, de-duplicated locations of every VM and
+// disk in data.
+func azureLocations(data *models.AzureSubscriptionData) []string {
+	seen := make(map[string]struct{})
+	for _, vm := range data.VMs {
+		seen[vm.Location] = struct{}{}
+	}
+	for _, d := range data.Disks {
+		seen[d.Location] = struct{}{}
+	}
+	delete(seen, "")
+	locations := make([]string, 0, len(seen))
+	for l := range seen {
+		locations = append(locations, l)
+	}
+	sort.Strings(locations)
+	return locations
+}
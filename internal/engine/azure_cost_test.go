@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	azcommon "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+	azurecostpack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/azure_cost"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// fakeAzureProvider resolves every request to subscription sub-1 (or err)
+// and records the subscription ID it was asked for.
+type fakeAzureProvider struct {
+	requested string
+	err       error
+}
+
+func (p *fakeAzureProvider) LoadSubscription(_ context.Context, subscriptionID string) (*azcommon.SubscriptionConfig, error) {
+	p.requested = subscriptionID
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &azcommon.SubscriptionConfig{SubscriptionID: "sub-1"}, nil
+}
+
+// fakeAzureCostCollector returns canned subscription data and records the
+// lookback window it was called with.
+type fakeAzureCostCollector struct {
+	data     *models.AzureSubscriptionData
+	daysBack int
+}
+
+func (c *fakeAzureCostCollector) CollectAll(_ context.Context, _ *azcommon.SubscriptionConfig, daysBack int) (*models.AzureSubscriptionData, error) {
+	c.daysBack = daysBack
+	return c.data, nil
+}
+
+func newAzureCostTestEngine(collector *fakeAzureCostCollector) (*AzureCostEngine, *fakeAzureProvider) {
+	registry := rules.NewDefaultRuleRegistry()
+	for _, r := range azurecostpack.New() {
+		registry.Register(r)
+	}
+	provider := &fakeAzureProvider{}
+	return NewAzureCostEngine(provider, collector, registry, nil), provider
+}
+
+func azureTestData() *models.AzureSubscriptionData {
+	return &models.AzureSubscriptionData{
+		SubscriptionID: "sub-1",
+		VMs: []models.AzureVM{
+			{Name: "idle-vm", ResourceGroup: "rg", Location: "eastus", PowerState: "running", AvgCPUPercent: 1, MonthlyCostUSD: 50},
+			{Name: "busy-vm", ResourceGroup: "rg", Location: "eastus", PowerState: "running", AvgCPUPercent: 60, MonthlyCostUSD: 50},
+		},
+		Disks: []models.AzureDisk{
+			{Name: "orphan", ResourceGroup: "rg", Location: "westeurope", SKU: "Standard_LRS", SizeGB: 200, DiskState: "Unattached"},
+			{Name: "os-disk", ResourceGroup: "rg", Location: "eastus", SKU: "Premium_LRS", SizeGB: 64, DiskState: "Attached", ManagedBy: "vm"},
+		},
+	}
+}
+
+func TestAzureCostEngine_RunAudit(t *testing.T) {
+	collector := &fakeAzureCostCollector{data: azureTestData()}
+	eng, provider := newAzureCostTestEngine(collector)
+
+	report, err := eng.RunAudit(context.Background(), AuditOptions{AuditType: AuditTypeCost, Subscription: "sub-1", DaysBack: 14})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provider.requested != "sub-1" || collector.daysBack != 14 {
+		t.Errorf("provider got %q, collector got %d days; want sub-1 and 14", provider.requested, collector.daysBack)
+	}
+	if report.AuditType != "cost" || report.AccountID != "sub-1" {
+		t.Errorf("report = %s/%s; want cost/sub-1", report.AuditType, report.AccountID)
+	}
+	if report.Provider != "azure" {
+		t.Errorf("Provider = %q; want azure", report.Provider)
+	}
+	if len(report.Regions) != 2 || report.Regions[0] != "eastus" || report.Regions[1] != "westeurope" {
+		t.Errorf("Regions = %v; want [eastus westeurope]", report.Regions)
+	}
+
+	got := make(map[string]string)
+	for _, f := range report.Findings {
+		got[f.RuleID] = f.ResourceID
+		if f.Domain != "cost" {
+			t.Errorf("%s Domain = %q; want cost", f.RuleID, f.Domain)
+		}
+	}
+	if len(report.Findings) != 2 || got["AZURE_VM_IDLE"] != "rg/idle-vm" || got["AZURE_DISK_UNATTACHED"] != "rg/orphan" {
+		t.Errorf("findings = %v; want AZURE_VM_IDLE on rg/idle-vm and AZURE_DISK_UNATTACHED on rg/orphan", got)
+	}
+	if report.PassedResources != nil {
+		t.Errorf("PassedResources = %v; want nil without ShowPassed", report.PassedResources)
+	}
+}
+
+func TestAzureCostEngine_ShowPassed(t *testing.T) {
+	eng, _ := newAzureCostTestEngine(&fakeAzureCostCollector{data: azureTestData()})
+
+	report, err := eng.RunAudit(context.Background(), AuditOptions{AuditType: AuditTypeCost, ShowPassed: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var ids []string
+	for _, p := range report.PassedResources {
+		ids = append(ids, p.ResourceID)
+	}
+	if len(ids) != 2 || ids[0] != "rg/busy-vm" || ids[1] != "rg/os-disk" {
+		t.Errorf("passed = %v; want [rg/busy-vm rg/os-disk]", ids)
+	}
+}
+
+func TestAzureCostEngine_Errors(t *testing.T) {
+	eng, provider := newAzureCostTestEngine(&fakeAzureCostCollector{data: azureTestData()})
+
+	if _, err := eng.RunAudit(context.Background(), AuditOptions{AuditType: AuditTypeSecurity}); err == nil {
+		t.Error("expected an error for a non-cost audit type")
+	}
+	provider.err = errors.New("no Azure subscription")
+	if _, err := eng.RunAudit(context.Background(), AuditOptions{AuditType: AuditTypeCost}); err == nil {
+		t.Error("expected the provider error to be returned")
+	}
+}
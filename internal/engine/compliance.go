@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// computeCompliance aggregates rule outcomes by control framework. A rule
+// mapped to a framework (via rules.FrameworkMapper) fails that framework when
+// at least one finding in findings carries its ID — directly or through a
+// merged rule list — and passes otherwise. Rules without frameworks are
+// ignored. findings must be the final, post-policy set so that suppressed
+// findings do not count as failures.
+func computeCompliance(active []rules.Rule, findings []models.Finding) []models.FrameworkCompliance {
+	failed := make(map[string]bool)
+	for i := range findings {
+		for _, id := range ruleIDsForFinding(&findings[i]) {
+			failed[id] = true
+		}
+	}
+
+	byFramework := make(map[string]*models.FrameworkCompliance)
+	for _, r := range active {
+		for _, fw := range rules.FrameworksOf(r) {
+			fc, ok := byFramework[fw]
+			if !ok {
+				fc = &models.FrameworkCompliance{Framework: fw}
+				byFramework[fw] = fc
+			}
+			if failed[r.ID()] {
+				fc.RulesFailed++
+			} else {
+				fc.RulesPassed++
+			}
+		}
+	}
+	return sortedCompliance(byFramework)
+}
+
+// mergeCompliance sums per-framework counts from several domain summaries.
+// Domains evaluate disjoint rule sets, so summing never double-counts a rule.
+func mergeCompliance(parts ...[]models.FrameworkCompliance) []models.FrameworkCompliance {
+	byFramework := make(map[string]*models.FrameworkCompliance)
+	for _, part := range parts {
+		for _, c := range part {
+			fc, ok := byFramework[c.Framework]
+			if !ok {
+				fc = &models.FrameworkCompliance{Framework: c.Framework}
+				byFramework[c.Framework] = fc
+			}
+			fc.RulesPassed += c.RulesPassed
+			fc.RulesFailed += c.RulesFailed
+		}
+	}
+	return sortedCompliance(byFramework)
+}
+
+// sortedCompliance flattens byFramework into a slice ordered by framework name.
+// Returns nil when the map is empty so the JSON field is omitted.
+func sortedCompliance(byFramework map[string]*models.FrameworkCompliance) []models.FrameworkCompliance {
+	if len(byFramework) == 0 {
+		return nil
+	}
+	out := make([]models.FrameworkCompliance, 0, len(byFramework))
+	for _, fc := range byFramework {
+		out = append(out, *fc)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Framework < out[j].Framework })
+	return out
+}
@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// taggedRule is a no-op rule that declares the given frameworks.
+type taggedRule struct {
+	id         string
+	frameworks []string
+}
+
+func (r taggedRule) ID() string                                  { return r.id }
+func (r taggedRule) Name() string                                { return r.id }
+func (r taggedRule) Evaluate(rules.RuleContext) []models.Finding { return nil }
+func (r taggedRule) Frameworks() []string                        { return r.frameworks }
+
+// untaggedRule is a no-op rule that does not implement rules.FrameworkMapper.
+type untaggedRule struct{ id string }
+
+func (r untaggedRule) ID() string                                  { return r.id }
+func (r untaggedRule) Name() string                                { return r.id }
+func (r untaggedRule) Evaluate(rules.RuleContext) []models.Finding { return nil }
+
+func TestComputeCompliance_AggregatesPassFailPerFramework(t *testing.T) {
+	active := []rules.Rule{
+		taggedRule{id: "RULE_A", frameworks: []string{"CIS-1.4", "PCI-DSS"}},
+		taggedRule{id: "RULE_B", frameworks: []string{"CIS-1.4"}},
+		taggedRule{id: "RULE_C", frameworks: []string{"PCI-DSS"}},
+		untaggedRule{id: "RULE_D"},
+	}
+	findings := []models.Finding{
+		newFinding("r-1", "us-east-1", "RULE_A", models.SeverityHigh, 0),
+		newFinding("r-2", "us-east-1", "RULE_A", models.SeverityHigh, 0), // same rule twice: one failure
+		newFinding("r-3", "us-east-1", "RULE_D", models.SeverityLow, 0),  // untagged: ignored
+	}
+
+	got := computeCompliance(active, findings)
+	want := []models.FrameworkCompliance{
+		{Framework: "CIS-1.4", RulesPassed: 1, RulesFailed: 1},
+		{Framework: "PCI-DSS", RulesPassed: 1, RulesFailed: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d frameworks; want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("[%d] = %+v; want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestComputeCompliance_MergedRuleIDsCountAsFailures(t *testing.T) {
+	active := []rules.Rule{
+		taggedRule{id: "RULE_A", frameworks: []string{"CIS-1.4"}},
+		taggedRule{id: "RULE_B", frameworks: []string{"CIS-1.4"}},
+	}
+	merged := mergeFindings([]models.Finding{
+		newFinding("r-1", "us-east-1", "RULE_A", models.SeverityHigh, 0),
+		newFinding("r-1", "us-east-1", "RULE_B", models.SeverityMedium, 0),
+	}, nil)
+
+	got := computeCompliance(active, merged)
+	if len(got) != 1 || got[0].RulesFailed != 2 || got[0].RulesPassed != 0 {
+		t.Errorf("got %+v; want CIS-1.4 with 2 failed, 0 passed", got)
+	}
+}
+
+func TestComputeCompliance_NoTaggedRulesReturnsNil(t *testing.T) {
+	active := []rules.Rule{untaggedRule{id: "RULE_A"}}
+	if got := computeCompliance(active, nil); got != nil {
+		t.Errorf("got %+v; want nil when no rule declares a framework", got)
+	}
+}
+
+func TestMergeCompliance_SumsAcrossDomains(t *testing.T) {
+	got := mergeCompliance(
+		[]models.FrameworkCompliance{{Framework: "PCI-DSS", RulesPassed: 1, RulesFailed: 2}},
+		nil,
+		[]models.FrameworkCompliance{
+			{Framework: "CIS-1.4", RulesPassed: 3},
+			{Framework: "PCI-DSS", RulesPassed: 1},
+		},
+	)
+	want := []models.FrameworkCompliance{
+		{Framework: "CIS-1.4", RulesPassed: 3},
+		{Framework: "PCI-DSS", RulesPassed: 2, RulesFailed: 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d frameworks; want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("[%d] = %+v; want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// confidenceRanks maps Confidence values to sort keys (lower = more
+// confident). An empty Confidence ranks as ConfidenceHigh.
+var confidenceRanks = map[models.Confidence]int{
+	"":                      0,
+	models.ConfidenceHigh:   0,
+	models.ConfidenceMedium: 1,
+	models.ConfidenceLow:    2,
+}
+
+// ParseConfidence returns the Confidence named by s (case-insensitive).
+// Used to validate the CLI --min-confidence flag.
+func ParseConfidence(s string) (models.Confidence, error) {
+	c := models.Confidence(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := confidenceRanks[c]; !ok || c == "" {
+		return "", fmt.Errorf("invalid confidence %q: must be high, medium, or low", s)
+	}
+	return c, nil
+}
+
+// stampConfidence sets ConfidenceHigh on every finding whose rule did not
+// report a confidence. Called next to stampFingerprints so every report
+// carries an explicit level.
+func stampConfidence(findings []models.Finding) {
+	for i := range findings {
+		if findings[i].Confidence == "" {
+			findings[i].Confidence = models.ConfidenceHigh
+		}
+	}
+}
+
+// FilterByConfidence removes findings less confident than min from report
+// and, when any were removed, recomputes the summary using policyCfg (see
+// recomputeSummary). ConfidenceLow keeps every finding. It returns the number
+// of findings removed.
+func FilterByConfidence(report *models.AuditReport, min models.Confidence, policyCfg *policy.PolicyConfig) int {
+	limit := confidenceRanks[min]
+	kept := report.Findings[:0]
+	for _, f := range report.Findings {
+		if confidenceRanks[f.Confidence] <= limit {
+			kept = append(kept, f)
+		}
+	}
+	removed := len(report.Findings) - len(kept)
+	report.Findings = kept
+	if removed > 0 {
+		recomputeSummary(report, policyCfg)
+	}
+	return removed
+}
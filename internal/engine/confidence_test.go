@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// confidenceTestReport returns a built report with one rule-default (high)
+// finding and one low-confidence idle finding.
+func confidenceTestReport() *models.AuditReport {
+	idle := newFinding("i-idle", "us-east-1", "EC2_LOW_CPU", models.SeverityMedium, 40.0)
+	idle.Confidence = models.ConfidenceLow
+	findings := []models.Finding{
+		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityHigh, 8.0),
+		idle,
+	}
+	return buildReport("test", "111122223333", []string{"us-east-1"}, findings, nil, nil, nil)
+}
+
+func TestParseConfidence(t *testing.T) {
+	for in, want := range map[string]models.Confidence{"high": models.ConfidenceHigh, "Medium": models.ConfidenceMedium, " low ": models.ConfidenceLow} {
+		if got, err := ParseConfidence(in); err != nil || got != want {
+			t.Errorf("ParseConfidence(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "certain"} {
+		if _, err := ParseConfidence(in); err == nil {
+			t.Errorf("ParseConfidence(%q): want error", in)
+		}
+	}
+}
+
+func TestBuildReport_DefaultsConfidenceToHigh(t *testing.T) {
+	report := confidenceTestReport()
+	for _, f := range report.Findings {
+		want := models.ConfidenceHigh
+		if f.RuleID == "EC2_LOW_CPU" {
+			want = models.ConfidenceLow
+		}
+		if f.Confidence != want {
+			t.Errorf("%s: Confidence = %q; want %q", f.RuleID, f.Confidence, want)
+		}
+	}
+}
+
+func TestFilterByConfidence_DefaultKeepsLowConfidence(t *testing.T) {
+	report := confidenceTestReport()
+	if removed := FilterByConfidence(report, models.ConfidenceLow, nil); removed != 0 {
+		t.Errorf("removed = %d; want 0", removed)
+	}
+	if len(report.Findings) != 2 || report.Summary.TotalFindings != 2 {
+		t.Errorf("findings/total = %d/%d; want 2/2", len(report.Findings), report.Summary.TotalFindings)
+	}
+}
+
+func TestFilterByConfidence_MediumDropsLowConfidence(t *testing.T) {
+	report := confidenceTestReport()
+	if removed := FilterByConfidence(report, models.ConfidenceMedium, nil); removed != 1 {
+		t.Errorf("removed = %d; want 1", removed)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].RuleID != "EBS_UNATTACHED" {
+		t.Fatalf("findings = %+v; want only EBS_UNATTACHED", report.Findings)
+	}
+	s := report.Summary
+	if s.TotalFindings != 1 || s.MediumFindings != 0 || s.HighFindings != 1 || s.TotalEstimatedMonthlySavings != 8.0 {
+		t.Errorf("summary = %+v; want 1 HIGH finding saving 8.0", s)
+	}
+}
+
+func TestMergeFindings_KeepsMostConfidentLevel(t *testing.T) {
+	low := newFinding("db-1", "us-east-1", "AWS_RDS_OVERPROVISIONED", models.SeverityMedium, 20.0)
+	low.Confidence = models.ConfidenceLow
+	medium := newFinding("db-1", "us-east-1", "RDS_LOW_CPU", models.SeverityMedium, 10.0)
+	medium.Confidence = models.ConfidenceMedium
+
+	merged := mergeFindings([]models.Finding{low, medium}, nil)
+	if len(merged) != 1 || merged[0].Confidence != models.ConfidenceMedium {
+		t.Errorf("merged = %+v; want one finding with medium confidence", merged)
+	}
+}
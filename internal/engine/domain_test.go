@@ -60,7 +60,7 @@ func TestMergeFindings_PreservesDomainFromFirstFinding(t *testing.T) {
 	f2 := newFinding("vol-1", "us-east-1", "EBS_UNENCRYPTED", models.SeverityHigh, 0.0)
 	f2.Domain = "dataprotection"
 
-	merged := mergeFindings([]models.Finding{f1, f2})
+	merged := mergeFindings([]models.Finding{f1, f2}, nil)
 
 	if len(merged) != 1 {
 		t.Fatalf("want 1 merged finding; got %d", len(merged))
@@ -80,7 +80,7 @@ func TestMergeFindings_DifferentResourcesDomainKept(t *testing.T) {
 	f2 := newFinding("rds-1", "us-east-1", "RDS_UNENCRYPTED", models.SeverityCritical, 0.0)
 	f2.Domain = "dataprotection"
 
-	merged := mergeFindings([]models.Finding{f1, f2})
+	merged := mergeFindings([]models.Finding{f1, f2}, nil)
 
 	if len(merged) != 2 {
 		t.Fatalf("want 2 findings; got %d", len(merged))
@@ -33,9 +33,18 @@ type AuditOptions struct {
 	// Profile is the named AWS profile to use. Empty means the default profile.
 	Profile string
 
+	// Subscription is the Azure subscription ID for Azure audits. Empty means
+	// the AZURE_SUBSCRIPTION_ID environment variable. Ignored by AWS engines.
+	Subscription string
+
 	// AllProfiles, when true, runs the audit across every configured AWS profile.
 	AllProfiles bool
 
+	// ProfileRegex, when non-empty, restricts a multi-profile audit to the
+	// configured profiles whose names match this regular expression. Setting it
+	// implies AllProfiles.
+	ProfileRegex string
+
 	// Regions is an explicit list of AWS regions to audit.
 	// When empty the engine discovers and iterates all active regions.
 	Regions []string
@@ -44,8 +53,30 @@ type AuditOptions struct {
 	ReportFormat ReportFormat
 
 	// DaysBack is the lookback window in days for cost and metric queries.
+	// Security audits only consider ECR images pushed within the window.
 	// Defaults to 30 when zero.
 	DaysBack int
+
+	// ShowPassed, when true, records the collected resource inventory and
+	// populates AuditReport.PassedResources with every resource that no rule
+	// produced a finding for. Used by the CLI --show-passed flag.
+	ShowPassed bool
+
+	// AnnotateFindings, when true, copies the collected tags of each finding's
+	// resource into Metadata["resource_tags"]. Only resources with collected
+	// tags are annotated (EC2, EBS, NAT gateways, RDS, load balancers, Azure
+	// VMs and disks). Used by the CLI --annotate-findings flag.
+	AnnotateFindings bool
+
+	// AnnotateKeys restricts AnnotateFindings to tag keys matching one of
+	// these glob patterns. Empty means every key. Used by the CLI
+	// --annotate-key flag.
+	AnnotateKeys []string
+
+	// Timings, when true, records per-stage wall-clock durations (collection,
+	// rule evaluation, total) in milliseconds under Metadata["timings"] as a
+	// map[string]int64. Used by the CLI --timings flag.
+	Timings bool
 }
 
 // Engine is the central orchestration interface.
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// evaluateRules runs every rule in registry against rctx like
+// RuleRegistry.EvaluateAll, but recovers a panicking rule so the remaining
+// rules still run. Each recovered panic is returned as an AuditError with
+// Stage "evaluate" attributed to provider and region.
+func evaluateRules(
+	registry rules.RuleRegistry,
+	rctx rules.RuleContext,
+	provider, region string,
+) ([]models.Finding, []models.AuditError) {
+	var (
+		findings []models.Finding
+		errs     []models.AuditError
+	)
+	for _, rule := range registry.All() {
+		out, err := evaluateRule(rule, rctx)
+		if err != nil {
+			errs = append(errs, models.AuditError{
+				Stage:    models.AuditStageEvaluate,
+				Provider: provider,
+				Region:   region,
+				Message:  err.Error(),
+			})
+			continue
+		}
+		findings = append(findings, out...)
+	}
+	return findings, errs
+}
+
+// evaluateRule calls rule.Evaluate and converts a panic into an error.
+func evaluateRule(rule rules.Rule, rctx rules.RuleContext) (findings []models.Finding, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			findings = nil
+			err = fmt.Errorf("rule %s panicked: %v", rule.ID(), r)
+		}
+	}()
+	return rule.Evaluate(rctx), nil
+}
+
+// collectError builds a Stage "collect" AuditError for a failed collector.
+func collectError(provider, region string, err error) models.AuditError {
+	return models.AuditError{
+		Stage:    models.AuditStageCollect,
+		Provider: provider,
+		Region:   region,
+		Message:  err.Error(),
+	}
+}
+
+// profileCollectError is collectError for a failure confined to one AWS
+// profile of a multi-profile audit.
+func profileCollectError(provider, profile string, err error) models.AuditError {
+	e := collectError(provider, "", err)
+	e.Profile = profile
+	return e
+}
+
+// costCollectErrors returns the failed calls the cost collector recorded in
+// regionData as Stage "collect" AuditErrors. profile is set for a
+// multi-profile audit and empty otherwise.
+func costCollectErrors(profile string, regionData []models.AWSRegionData) []models.AuditError {
+	var errs []models.AuditError
+	for _, rd := range regionData {
+		errs = append(errs, awsCollectErrors("aws/cost", profile, rd.CollectErrors)...)
+	}
+	return errs
+}
+
+// securityCollectErrors is costCollectErrors for the security collector.
+func securityCollectErrors(profile string, data *models.AWSSecurityData) []models.AuditError {
+	if data == nil {
+		return nil
+	}
+	return awsCollectErrors("aws/security", profile, data.CollectErrors)
+}
+
+func awsCollectErrors(provider, profile string, failed []models.AWSCollectError) []models.AuditError {
+	var errs []models.AuditError
+	for _, f := range failed {
+		e := collectError(provider, f.Region, f.Err)
+		e.Profile = profile
+		errs = append(errs, e)
+	}
+	return errs
+}
@@ -0,0 +1,202 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// ── test doubles ──────────────────────────────────────────────────────────────
+
+// panicRule panics on every evaluation.
+type panicRule struct{}
+
+func (panicRule) ID() string                                  { return "PANIC_RULE" }
+func (panicRule) Name() string                                { return "panics" }
+func (panicRule) Evaluate(rules.RuleContext) []models.Finding { panic("nil map access") }
+
+// iamUserRule returns one HIGH finding per IAM user in the security snapshot.
+type iamUserRule struct{}
+
+func (iamUserRule) ID() string   { return "IAM_USER_SEEN" }
+func (iamUserRule) Name() string { return "IAM user seen" }
+func (iamUserRule) Evaluate(ctx rules.RuleContext) []models.Finding {
+	var out []models.Finding
+	for _, u := range ctx.RegionData.Security.IAMUsers {
+		out = append(out, models.Finding{
+			ID:           "IAM_USER_SEEN-" + u.UserName,
+			RuleID:       "IAM_USER_SEEN",
+			ResourceID:   u.UserName,
+			ResourceType: models.ResourceAWSIAMUser,
+			Region:       "global",
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityHigh,
+		})
+	}
+	return out
+}
+
+// fakeAWSProfiles is an AWSClientProvider serving a fixed profile list.
+type fakeAWSProfiles struct {
+	profiles []*common.ProfileConfig
+}
+
+func (f *fakeAWSProfiles) LoadProfile(_ context.Context, name string) (*common.ProfileConfig, error) {
+	for _, p := range f.profiles {
+		if p.ProfileName == name {
+			return p, nil
+		}
+	}
+	return nil, errors.New("profile not found")
+}
+
+func (f *fakeAWSProfiles) LoadAllProfiles(context.Context) ([]*common.ProfileConfig, error) {
+	return f.profiles, nil
+}
+
+func (f *fakeAWSProfiles) GetActiveRegions(context.Context, *common.ProfileConfig) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func (f *fakeAWSProfiles) ConfigForRegion(*common.ProfileConfig, string) aws.Config {
+	return aws.Config{}
+}
+
+// failingSecurityCollector fails for the profiles named in fail and returns
+// one IAM user for every other profile, together with failedCalls as its
+// CollectErrors.
+type failingSecurityCollector struct {
+	fail        map[string]bool
+	failedCalls []models.AWSCollectError
+}
+
+func (c *failingSecurityCollector) CollectAll(
+	_ context.Context,
+	profile *common.ProfileConfig,
+	_ common.AWSClientProvider,
+	_ []string,
+) (*models.AWSSecurityData, error) {
+	if c.fail[profile.ProfileName] {
+		return nil, errors.New("AccessDenied: iam:ListUsers")
+	}
+	return &models.AWSSecurityData{
+		IAMUsers:      []models.AWSIAMUser{{UserName: "user-" + profile.ProfileName}},
+		CollectErrors: c.failedCalls,
+	}, nil
+}
+
+func (c *failingSecurityCollector) Plan([]string) []common.APICall { return nil }
+
+// ── tests ─────────────────────────────────────────────────────────────────────
+
+func TestEvaluateRules_RecoversPanickingRule(t *testing.T) {
+	registry := rules.NewDefaultRuleRegistry()
+	registry.Register(panicRule{})
+	registry.Register(iamUserRule{})
+
+	rctx := rules.RuleContext{RegionData: &models.AWSRegionData{
+		Security: models.AWSSecurityData{IAMUsers: []models.AWSIAMUser{{UserName: "alice"}}},
+	}}
+	findings, errs := evaluateRules(registry, rctx, "aws/security", "global")
+
+	if len(findings) != 1 || findings[0].ResourceID != "alice" {
+		t.Errorf("findings = %+v; want the non-panicking rule's finding for alice", findings)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("errs = %+v; want 1 error", errs)
+	}
+	e := errs[0]
+	if e.Stage != models.AuditStageEvaluate || e.Provider != "aws/security" || e.Region != "global" {
+		t.Errorf("error = %+v; want stage evaluate, provider aws/security, region global", e)
+	}
+	if !strings.Contains(e.Message, "PANIC_RULE") || !strings.Contains(e.Message, "nil map access") {
+		t.Errorf("Message = %q; want rule ID and panic value", e.Message)
+	}
+}
+
+func TestAWSSecurityEngine_AllProfiles_RecordsFailingCollector(t *testing.T) {
+	provider := &fakeAWSProfiles{profiles: []*common.ProfileConfig{
+		{ProfileName: "prod", AccountID: "111111111111"},
+		{ProfileName: "staging", AccountID: "222222222222"},
+	}}
+	registry := rules.NewDefaultRuleRegistry()
+	registry.Register(iamUserRule{})
+	collector := &failingSecurityCollector{fail: map[string]bool{"staging": true}}
+
+	eng := NewAWSSecurityEngine(provider, collector, registry, nil)
+	report, err := eng.RunAudit(context.Background(), AuditOptions{
+		AuditType:   AuditTypeSecurity,
+		AllProfiles: true,
+	})
+	if err != nil {
+		t.Fatalf("RunAudit: %v", err)
+	}
+
+	if len(report.Findings) != 1 || report.Findings[0].Profile != "prod" {
+		t.Errorf("findings = %+v; want the prod profile's finding", report.Findings)
+	}
+	if len(report.Errors) != 1 {
+		t.Fatalf("Errors = %+v; want 1 error for staging", report.Errors)
+	}
+	e := report.Errors[0]
+	if e.Stage != models.AuditStageCollect || e.Provider != "aws/security" || e.Profile != "staging" {
+		t.Errorf("error = %+v; want stage collect, provider aws/security, profile staging", e)
+	}
+	if !strings.Contains(e.Message, `"staging"`) || !strings.Contains(e.Message, "AccessDenied") {
+		t.Errorf("Message = %q; want profile name and collector error", e.Message)
+	}
+}
+
+func TestAWSSecurityEngine_AllProfiles_AllFailIsError(t *testing.T) {
+	provider := &fakeAWSProfiles{profiles: []*common.ProfileConfig{{ProfileName: "prod"}}}
+	collector := &failingSecurityCollector{fail: map[string]bool{"prod": true}}
+
+	eng := NewAWSSecurityEngine(provider, collector, rules.NewDefaultRuleRegistry(), nil)
+	_, err := eng.RunAudit(context.Background(), AuditOptions{
+		AuditType:   AuditTypeSecurity,
+		AllProfiles: true,
+	})
+	if err == nil {
+		t.Fatal("expected an error when every profile fails")
+	}
+}
+
+func TestAWSSecurityEngine_RecordsFailedCollectorCalls(t *testing.T) {
+	provider := &fakeAWSProfiles{profiles: []*common.ProfileConfig{{ProfileName: "prod", AccountID: "111111111111"}}}
+	registry := rules.NewDefaultRuleRegistry()
+	registry.Register(iamUserRule{})
+	collector := &failingSecurityCollector{failedCalls: []models.AWSCollectError{
+		{Region: "eu-west-1", Err: errors.New("collect KMS keys: AccessDeniedException")},
+	}}
+
+	eng := NewAWSSecurityEngine(provider, collector, registry, nil)
+	for name, opts := range map[string]AuditOptions{
+		"single profile": {AuditType: AuditTypeSecurity, Profile: "prod"},
+		"all profiles":   {AuditType: AuditTypeSecurity, AllProfiles: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			report, err := eng.RunAudit(context.Background(), opts)
+			if err != nil {
+				t.Fatalf("RunAudit: %v", err)
+			}
+			if len(report.Findings) != 1 {
+				t.Errorf("findings = %+v; want the finding from the partial data", report.Findings)
+			}
+			if len(report.Errors) != 1 {
+				t.Fatalf("Errors = %+v; want the failed KMS call", report.Errors)
+			}
+			e := report.Errors[0]
+			if e.Stage != models.AuditStageCollect || e.Provider != "aws/security" || e.Region != "eu-west-1" || !strings.Contains(e.Message, "KMS") {
+				t.Errorf("error = %+v; want stage collect, provider aws/security, region eu-west-1 and the KMS message", e)
+			}
+		})
+	}
+}
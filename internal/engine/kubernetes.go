@@ -96,10 +96,70 @@ type KubernetesAuditOptions struct {
 	// Used by the CLI --show-risk-chains flag and included in JSON output.
 	// Default false — Summary.RiskChains is nil/empty.
 	ShowRiskChains bool
+
+	// CollapsePaths, when true, merges attack paths with the same score and
+	// description (one per qualifying namespace by default) into a single
+	// entry whose Namespaces field lists every contributing namespace.
+	// Used by the CLI --collapse-paths flag. Default false — one entry per
+	// namespace.
+	CollapsePaths bool
+
+	// Since, when > 0, retains only pod, service, ingress, and service-account findings
+	// whose resource was created within this duration before the audit ran.
+	// Cluster-scoped findings (cluster, node, namespace, EKS) and findings whose
+	// resource creation time is unknown are always retained.
+	// Used by the CLI --since flag. Default 0 — no age filtering.
+	Since time.Duration
+
+	// ShowPassed, when true, populates AuditReport.PassedResources with every
+	// collected cluster resource that no rule produced a finding for. The
+	// comparison uses the merged findings before --exclude-system,
+	// --min-risk-score, --since, and policy filtering.
+	// Used by the CLI --show-passed flag. Default false.
+	ShowPassed bool
+
+	// SystemNamespaces lists extra namespaces treated as system namespaces,
+	// in addition to the policy's system_namespaces (or the built-in default
+	// set when the policy sets none). Affects namespace_type annotation and
+	// therefore ExcludeSystem. Used by the CLI --system-namespace flag.
+	SystemNamespaces []string
+
+	// Timings, when true, records per-stage wall-clock durations (collection,
+	// rule evaluation, correlation, total) in milliseconds under
+	// Metadata["timings"] as a map[string]int64.
+	// Used by the CLI --timings flag for performance debugging.
+	Timings bool
+
+	// Concurrency bounds the collector's per-namespace lookups and pod
+	// processing workers. Values <= 0 use kube.DefaultCollectConcurrency.
+	// Used by the CLI --concurrency flag.
+	Concurrency int
+
+	// AnnotateFindings, when true, copies the labels (nodes, namespaces, pods)
+	// or annotations (Services, ServiceAccounts) of each finding's resource
+	// into Metadata["resource_tags"]. Used by the CLI --annotate-findings
+	// flag. Default false.
+	AnnotateFindings bool
+
+	// AnnotateKeys restricts AnnotateFindings to label/annotation keys
+	// matching one of these glob patterns. Empty means every key.
+	// Used by the CLI --annotate-key flag.
+	AnnotateKeys []string
+
+	// ImageInventory, when true, records the distinct container images run by
+	// the cluster's pods under Metadata["images"] as []models.KubernetesImage.
+	// Used by the CLI --image-inventory flag. Default false.
+	ImageInventory bool
+
+	// GroupByOwner, when true, tags each finding with Metadata["team"] from
+	// dp.yaml namespace_owners and populates Summary.TeamRisks with per-team
+	// counts. Used by the CLI --format-findings-by owner flag. Default false.
+	GroupByOwner bool
 }
 
-// systemNamespaces is the canonical set of Kubernetes system namespaces.
-// Findings for resources in these namespaces are tagged namespace_type="system".
+// systemNamespaces is the default set of Kubernetes system namespaces.
+// Findings for resources in these namespaces are tagged namespace_type="system"
+// unless dp.yaml system_namespaces replaces the set.
 var systemNamespaces = map[string]struct{}{
 	"kube-system":     {},
 	"kube-public":     {},
@@ -110,12 +170,16 @@ var systemNamespaces = map[string]struct{}{
 // provider, optionally collects EKS control-plane data, evaluates all
 // registered rules, applies policy filtering, and returns a populated AuditReport.
 func (e *KubernetesEngine) RunAudit(ctx context.Context, opts KubernetesAuditOptions) (*models.AuditReport, error) {
+	sw := newStopwatch()
+
 	clientset, info, err := e.provider.ClientsetForContext(opts.ContextName)
 	if err != nil {
 		return nil, fmt.Errorf("connect to cluster: %w", err)
 	}
 
-	clusterData, err := kube.CollectClusterData(ctx, clientset, info)
+	clusterData, err := kube.CollectClusterDataWithOptions(ctx, clientset, info, kube.CollectOptions{
+		Concurrency: opts.Concurrency,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collect cluster data: %w", err)
 	}
@@ -125,6 +189,13 @@ func (e *KubernetesEngine) RunAudit(ctx context.Context, opts KubernetesAuditOpt
 	// ── Provider detection ────────────────────────────────────────────────────
 	k8sData.ClusterProvider = detectClusterProvider(k8sData.Nodes)
 
+	// Optional collections that failed (e.g. RBAC forbids listing roles) are
+	// reported in report.Errors; the rules that need them see no data.
+	var auditErrs []models.AuditError
+	for _, collectErr := range clusterData.CollectErrors {
+		auditErrs = append(auditErrs, collectError("kubernetes", k8sData.ContextName, collectErr))
+	}
+
 	// ── EKS-specific data collection (non-fatal) ─────────────────────────────
 	if k8sData.ClusterProvider == "eks" && e.eksCollector != nil {
 		clusterName, region := extractEKSInfo(k8sData.Nodes)
@@ -132,25 +203,46 @@ func (e *KubernetesEngine) RunAudit(ctx context.Context, opts KubernetesAuditOpt
 			eksData, eksErr := e.eksCollector.CollectEKSData(ctx, clusterName, region)
 			if eksErr == nil {
 				k8sData.EKSData = eksData
+			} else {
+				// EKS collection failure is non-fatal: EKS rules skip on nil
+				// check and the failure is reported in report.Errors.
+				auditErrs = append(auditErrs, collectError("aws/eks", region,
+					fmt.Errorf("collect EKS data for cluster %q: %w", clusterName, eksErr)))
 			}
-			// EKS collection failure is non-fatal: EKS rules skip on nil check.
 		}
 	}
 
+	sw.lap(timingCollection)
+
 	// ── Rule evaluation ───────────────────────────────────────────────────────
 	rctx := rules.RuleContext{ClusterData: k8sData}
 
-	raw := e.coreRegistry.EvaluateAll(rctx)
+	raw, evalErrs := evaluateRules(e.coreRegistry, rctx, "kubernetes", k8sData.ContextName)
+	auditErrs = append(auditErrs, evalErrs...)
+	activeRules := e.coreRegistry.All()
 
 	if k8sData.ClusterProvider == "eks" && e.eksRegistry != nil {
-		eksRaw := e.eksRegistry.EvaluateAll(rctx)
+		eksRaw, eksErrs := evaluateRules(e.eksRegistry, rctx, "kubernetes", k8sData.ContextName)
 		raw = append(raw, eksRaw...)
+		auditErrs = append(auditErrs, eksErrs...)
+		activeRules = append(activeRules[:len(activeRules):len(activeRules)], e.eksRegistry.All()...)
 	}
 
 	stampDomain(raw, "kubernetes")
+	policy.ApplySeverityOverrides(raw, e.policy) // before correlation: chains key on severity
 
-	merged := mergeFindings(raw)
-	annotateNamespaceType(merged)
+	merged := mergeFindings(raw, ruleMergeInfo(activeRules))
+	sw.lap(timingEvaluation)
+
+	var passed []models.PassedResource
+	if opts.ShowPassed {
+		passed = passedResources(kubernetesInventory(k8sData), merged)
+	}
+	if opts.AnnotateFindings {
+		annotateResourceTags(merged, kubernetesResourceTags(k8sData), opts.AnnotateKeys)
+	}
+
+	annotateNamespaceType(merged, e.systemNamespaceSet(opts.SystemNamespaces))
 	if opts.ExcludeSystem {
 		merged = excludeSystemFindings(merged)
 	}
@@ -159,6 +251,11 @@ func (e *KubernetesEngine) RunAudit(ctx context.Context, opts KubernetesAuditOpt
 	// Phase 6: detect multi-layer attack paths from the merged finding set.
 	// Must run after correlateRiskChains so that all findings are fully annotated.
 	attackPaths := buildAttackPaths(merged)
+	annotateAttackPathMembers(merged, attackPaths)
+	if opts.CollapsePaths {
+		attackPaths = collapseAttackPaths(attackPaths, merged)
+	}
+	sw.lap(timingCorrelation)
 
 	// Compute the highest risk score before policy filtering so the summary
 	// reflects the full pre-policy risk picture.
@@ -184,11 +281,26 @@ func (e *KubernetesEngine) RunAudit(ctx context.Context, opts KubernetesAuditOpt
 		merged = filterByMinRiskScore(merged, opts.MinRiskScore)
 	}
 
+	// Age filter: like --min-risk-score, applied after correlation so chains
+	// and attack paths still reflect the whole cluster.
+	if opts.Since > 0 {
+		merged = filterBySince(merged, k8sData, time.Now().Add(-opts.Since))
+	}
+
 	filtered := policy.ApplyPolicy(merged, "kubernetes", e.policy)
+	policy.ApplyLabels(filtered, e.policy)
+	stampConfidence(filtered)
+	stampFingerprints(filtered)
 	sortFindings(filtered)
 
 	summary := computeSummary(filtered)
 	summary.RiskScore = maxRiskScore
+	summary.Compliance = computeCompliance(activeRules, filtered)
+	assignRiskGrade(&summary, e.policy)
+	if opts.GroupByOwner {
+		stampOwners(filtered, e.policy)
+		summary.TeamRisks = computeTeamRisks(filtered)
+	}
 
 	// Phase 5D/6: populate risk chain and attack path groupings when requested.
 	if opts.ShowRiskChains {
@@ -196,18 +308,30 @@ func (e *KubernetesEngine) RunAudit(ctx context.Context, opts KubernetesAuditOpt
 		summary.RiskChains = buildRiskChains(filtered)
 	}
 
+	metadata := map[string]any{
+		"cluster_provider": k8sData.ClusterProvider,
+	}
+	if opts.ImageInventory {
+		metadata["images"] = buildImageInventory(k8sData.Pods)
+	}
+	if opts.Timings {
+		metadata["timings"] = sw.timings()
+	}
+
 	return &models.AuditReport{
-		ReportID:    fmt.Sprintf("k8s-%d", time.Now().UnixNano()),
-		GeneratedAt: time.Now().UTC(),
-		AuditType:   "kubernetes",
-		Profile:     info.ContextName,
-		AccountID:   "",
-		Regions:     []string{info.ContextName},
-		Summary:     summary,
-		Findings:    filtered,
-		Metadata: map[string]any{
-			"cluster_provider": k8sData.ClusterProvider,
-		},
+		SchemaVersion:   models.ReportSchemaVersion,
+		ReportID:        fmt.Sprintf("k8s-%d", time.Now().UnixNano()),
+		GeneratedAt:     time.Now().UTC(),
+		AuditType:       "kubernetes",
+		Provider:        k8sData.ClusterProvider,
+		Profile:         info.ContextName,
+		AccountID:       "",
+		Regions:         []string{info.ContextName},
+		Summary:         summary,
+		Findings:        filtered,
+		PassedResources: passed,
+		Metadata:        metadata,
+		Errors:          auditErrs,
 	}, nil
 }
 
@@ -267,8 +391,33 @@ func extractEKSInfo(nodes []models.KubernetesNodeData) (clusterName, region stri
 	return
 }
 
+// systemNamespaceSet returns the namespaces classified as "system": the
+// policy's system_namespaces when set, otherwise the default systemNamespaces,
+// plus every namespace in extra.
+func (e *KubernetesEngine) systemNamespaceSet(extra []string) map[string]struct{} {
+	base := systemNamespaces
+	if e.policy != nil && len(e.policy.SystemNamespaces) > 0 {
+		base = make(map[string]struct{}, len(e.policy.SystemNamespaces))
+		for _, ns := range e.policy.SystemNamespaces {
+			base[ns] = struct{}{}
+		}
+	}
+	if len(extra) == 0 {
+		return base
+	}
+	set := make(map[string]struct{}, len(base)+len(extra))
+	for ns := range base {
+		set[ns] = struct{}{}
+	}
+	for _, ns := range extra {
+		set[ns] = struct{}{}
+	}
+	return set
+}
+
 // annotateNamespaceType stamps each finding with Metadata["namespace_type"]:
-//   - "system"   — finding belongs to a system namespace (kube-system, kube-public, kube-node-lease)
+//   - "system"   — finding belongs to a namespace in systemNS (by default
+//     kube-system, kube-public, kube-node-lease)
 //   - "workload" — finding belongs to a user namespace
 //   - "cluster"  — finding is cluster-scoped (nodes, cluster-level, EKS rules)
 //
@@ -279,7 +428,7 @@ func extractEKSInfo(nodes []models.KubernetesNodeData) (clusterName, region stri
 //
 // Must be called after mergeFindings (merged Metadata is available) and before
 // policy.ApplyPolicy so policy rules can filter on namespace_type in future.
-func annotateNamespaceType(findings []models.Finding) {
+func annotateNamespaceType(findings []models.Finding, systemNS map[string]struct{}) {
 	for i := range findings {
 		f := &findings[i]
 		if f.Metadata == nil {
@@ -290,7 +439,7 @@ func annotateNamespaceType(findings []models.Finding) {
 			f.Metadata["namespace_type"] = "cluster"
 			continue
 		}
-		if _, isSystem := systemNamespaces[ns]; isSystem {
+		if _, isSystem := systemNS[ns]; isSystem {
 			f.Metadata["namespace_type"] = "system"
 		} else {
 			f.Metadata["namespace_type"] = "workload"
@@ -327,6 +476,54 @@ func excludeSystemFindings(findings []models.Finding) []models.Finding {
 	return out
 }
 
+// filterBySince drops pod, service, ingress, service-account, and workload
+// findings whose resource was created before cutoff. Resources are matched on
+// (ResourceType, namespace, name) against the creation timestamps in
+// data. All other findings, and findings whose resource has no recorded
+// creation time, are retained.
+func filterBySince(findings []models.Finding, data *models.KubernetesClusterData, cutoff time.Time) []models.Finding {
+	type resourceKey struct {
+		kind      models.ResourceType
+		namespace string
+		name      string
+	}
+	created := make(map[resourceKey]time.Time)
+	for _, p := range data.Pods {
+		created[resourceKey{models.ResourceK8sPod, p.Namespace, p.Name}] = p.CreatedAt
+	}
+	for _, svc := range data.Services {
+		created[resourceKey{models.ResourceK8sService, svc.Namespace, svc.Name}] = svc.CreatedAt
+	}
+	for _, ing := range data.Ingresses {
+		created[resourceKey{models.ResourceK8sIngress, ing.Namespace, ing.Name}] = ing.CreatedAt
+	}
+	for _, sa := range data.ServiceAccounts {
+		created[resourceKey{models.ResourceK8sServiceAccount, sa.Namespace, sa.Name}] = sa.CreatedAt
+	}
+	for _, wl := range data.Workloads {
+		created[resourceKey{workloadResourceType(wl.Kind), wl.Namespace, wl.Name}] = wl.CreatedAt
+	}
+
+	out := make([]models.Finding, 0, len(findings))
+	for _, f := range findings {
+		ts, ok := created[resourceKey{f.ResourceType, resolveNamespaceForFinding(&f), findingResourceName(&f)}]
+		if ok && !ts.IsZero() && ts.Before(cutoff) {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// workloadResourceType maps a KubernetesWorkloadData kind to the resource
+// type used by workload findings.
+func workloadResourceType(kind string) models.ResourceType {
+	if kind == "StatefulSet" {
+		return models.ResourceK8sStatefulSet
+	}
+	return models.ResourceK8sDeployment
+}
+
 // convertClusterData translates the provider-layer ClusterData into the
 // engine-layer KubernetesClusterData used by rule evaluation.
 func convertClusterData(data *kube.ClusterData) *models.KubernetesClusterData {
@@ -353,35 +550,35 @@ func convertClusterData(data *kube.ClusterData) *models.KubernetesClusterData {
 			nsLabels[key] = val
 		}
 		k.Namespaces = append(k.Namespaces, models.KubernetesNamespaceData{
-			Name:          ns.Name,
-			HasLimitRange: ns.HasLimitRange,
-			Labels:        nsLabels,
+			Name:             ns.Name,
+			HasLimitRange:    ns.HasLimitRange,
+			HasResourceQuota: ns.HasResourceQuota,
+			Labels:           nsLabels,
+			PSAEnforce:       nsLabels["pod-security.kubernetes.io/enforce"],
 		})
 	}
 	for _, pod := range data.Pods {
 		pd := models.KubernetesPodData{
-			Name:               pod.Name,
-			Namespace:          pod.Namespace,
-			HostNetwork:        pod.HostNetwork,
-			HostPID:            pod.HostPID,
-			HostIPC:            pod.HostIPC,
-			ServiceAccountName: pod.ServiceAccountName,
+			Name:                         pod.Name,
+			Namespace:                    pod.Namespace,
+			HostNetwork:                  pod.HostNetwork,
+			HostPID:                      pod.HostPID,
+			HostIPC:                      pod.HostIPC,
+			ServiceAccountName:           pod.ServiceAccountName,
+			AutomountServiceAccountToken: pod.AutomountServiceAccountToken,
+			CreatedAt:                    pod.CreationTimestamp,
 		}
 		for _, c := range pod.Containers {
-			var addedCaps []string
-			if len(c.AddedCapabilities) > 0 {
-				addedCaps = append(addedCaps, c.AddedCapabilities...)
+			pd.Containers = append(pd.Containers, toContainerData(c))
+		}
+		for _, c := range pod.InitContainers {
+			pd.InitContainers = append(pd.InitContainers, toContainerData(c))
+		}
+		if len(pod.Labels) > 0 {
+			pd.Labels = make(map[string]string, len(pod.Labels))
+			for key, val := range pod.Labels {
+				pd.Labels[key] = val
 			}
-			pd.Containers = append(pd.Containers, models.KubernetesContainerData{
-				Name:               c.Name,
-				Privileged:         c.Privileged,
-				HasCPURequest:      c.HasCPURequest,
-				HasMemoryRequest:   c.HasMemoryRequest,
-				RunAsNonRoot:       c.RunAsNonRoot,
-				RunAsUser:          c.RunAsUser,
-				AddedCapabilities:  addedCaps,
-				SeccompProfileType: c.SeccompProfileType,
-			})
 		}
 		k.Pods = append(k.Pods, pd)
 	}
@@ -395,8 +592,51 @@ func convertClusterData(data *kube.ClusterData) *models.KubernetesClusterData {
 			Namespace:   svc.Namespace,
 			Type:        svc.Type,
 			Annotations: annotations,
+			CreatedAt:   svc.CreationTimestamp,
 		})
 	}
+	for _, ing := range data.Ingresses {
+		k.Ingresses = append(k.Ingresses, models.KubernetesIngressData{
+			Name:      ing.Name,
+			Namespace: ing.Namespace,
+			Hosts:     append([]string(nil), ing.Hosts...),
+			TLSHosts:  append([]string(nil), ing.TLSHosts...),
+			HasTLS:    ing.HasTLS,
+			CreatedAt: ing.CreationTimestamp,
+		})
+	}
+	for _, wl := range data.Workloads {
+		labels := make(map[string]string, len(wl.PodLabels))
+		for key, val := range wl.PodLabels {
+			labels[key] = val
+		}
+		k.Workloads = append(k.Workloads, models.KubernetesWorkloadData{
+			Kind:      wl.Kind,
+			Name:      wl.Name,
+			Namespace: wl.Namespace,
+			Replicas:  wl.Replicas,
+			PodLabels: labels,
+			CreatedAt: wl.CreationTimestamp,
+		})
+	}
+	for _, pdb := range data.PDBs {
+		pd := models.KubernetesPDBData{
+			Name:        pdb.Name,
+			Namespace:   pdb.Namespace,
+			MatchLabels: make(map[string]string, len(pdb.MatchLabels)),
+		}
+		for key, val := range pdb.MatchLabels {
+			pd.MatchLabels[key] = val
+		}
+		for _, req := range pdb.MatchExpressions {
+			pd.MatchExpressions = append(pd.MatchExpressions, models.KubernetesLabelSelectorRequirement{
+				Key:      req.Key,
+				Operator: req.Operator,
+				Values:   append([]string(nil), req.Values...),
+			})
+		}
+		k.PodDisruptionBudgets = append(k.PodDisruptionBudgets, pd)
+	}
 	for _, sa := range data.ServiceAccounts {
 		saAnnotations := make(map[string]string, len(sa.Annotations))
 		for key, val := range sa.Annotations {
@@ -407,7 +647,69 @@ func convertClusterData(data *kube.ClusterData) *models.KubernetesClusterData {
 			Namespace:                    sa.Namespace,
 			AutomountServiceAccountToken: sa.AutomountServiceAccountToken,
 			Annotations:                  saAnnotations,
+			CreatedAt:                    sa.CreationTimestamp,
 		})
 	}
+	for _, crb := range data.ClusterRoleBindings {
+		bd := models.KubernetesClusterRoleBindingData{
+			Name:     crb.Name,
+			RoleName: crb.RoleName,
+		}
+		for _, s := range crb.Subjects {
+			bd.Subjects = append(bd.Subjects, models.KubernetesRBACSubjectData{
+				Kind:      s.Kind,
+				Name:      s.Name,
+				Namespace: s.Namespace,
+			})
+		}
+		k.ClusterRoleBindings = append(k.ClusterRoleBindings, bd)
+	}
+	for _, role := range data.Roles {
+		rd := models.KubernetesRoleData{
+			Kind:      role.Kind,
+			Name:      role.Name,
+			Namespace: role.Namespace,
+		}
+		for _, pr := range role.Rules {
+			rd.Rules = append(rd.Rules, models.KubernetesPolicyRuleData{
+				APIGroups: pr.APIGroups,
+				Resources: pr.Resources,
+				Verbs:     pr.Verbs,
+			})
+		}
+		k.Roles = append(k.Roles, rd)
+	}
 	return k
 }
+
+// toContainerData converts a provider ContainerInfo into the rule-facing
+// KubernetesContainerData, copying the capability and secret reference slices.
+func toContainerData(c kube.ContainerInfo) models.KubernetesContainerData {
+	var addedCaps []string
+	if len(c.AddedCapabilities) > 0 {
+		addedCaps = append(addedCaps, c.AddedCapabilities...)
+	}
+	var secretEnvRefs []models.KubernetesSecretEnvRef
+	for _, ref := range c.SecretEnvRefs {
+		secretEnvRefs = append(secretEnvRefs, models.KubernetesSecretEnvRef{
+			SecretName: ref.SecretName,
+			EnvVar:     ref.EnvVar,
+			Key:        ref.Key,
+		})
+	}
+	return models.KubernetesContainerData{
+		Name:                   c.Name,
+		Image:                  c.Image,
+		Privileged:             c.Privileged,
+		HasCPURequest:          c.HasCPURequest,
+		HasMemoryRequest:       c.HasMemoryRequest,
+		RunAsNonRoot:           c.RunAsNonRoot,
+		RunAsUser:              c.RunAsUser,
+		AddedCapabilities:      addedCaps,
+		SeccompProfileType:     c.SeccompProfileType,
+		ReadOnlyRootFilesystem: c.ReadOnlyRootFilesystem,
+		HasLivenessProbe:       c.HasLivenessProbe,
+		HasReadinessProbe:      c.HasReadinessProbe,
+		SecretEnvRefs:          secretEnvRefs,
+	}
+}
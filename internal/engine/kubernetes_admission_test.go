@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -21,7 +22,7 @@ func admissionEngine(cs *fake.Clientset) *KubernetesEngine {
 		info:      kube.ClusterInfo{ContextName: "admission-cluster", Server: "https://fake"},
 	}
 	registry := rules.NewDefaultRuleRegistry()
-	for _, r := range k8scorepack.New() {
+	for _, r := range k8scorepack.New(nil) {
 		registry.Register(r)
 	}
 	return NewKubernetesEngine(provider, registry, nil)
@@ -246,3 +247,29 @@ func TestAdmission_SAAndPodRulesInteract(t *testing.T) {
 		t.Errorf("expected K8S_DEFAULT_SERVICEACCOUNT_USED in report; got %v", ruleIDs)
 	}
 }
+
+// TestAdmission_NamespaceNoPSA_UsesCollectedEnforceLevel verifies that the
+// engine populates PSAEnforce from the namespace label so that
+// K8S_NAMESPACE_NO_PSA fires only for the unlabeled workload namespace, not
+// for a privileged-level namespace or kube-system.
+func TestAdmission_NamespaceNoPSA_UsesCollectedEnforceLevel(t *testing.T) {
+	cs := fake.NewSimpleClientset(
+		nsWithoutPSA("default"),
+		nsWithPSA("legacy", "privileged"),
+		nsWithoutPSA("kube-system"),
+	)
+	report, err := admissionEngine(cs).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	var fired []string
+	for _, f := range report.Findings {
+		ruleIDs, _ := f.Metadata["rules"].([]string)
+		if f.RuleID == "K8S_NAMESPACE_NO_PSA" || slices.Contains(ruleIDs, "K8S_NAMESPACE_NO_PSA") {
+			fired = append(fired, f.ResourceID)
+		}
+	}
+	if len(fired) != 1 || fired[0] != "default" {
+		t.Errorf("K8S_NAMESPACE_NO_PSA fired for %v; want [default]", fired)
+	}
+}
@@ -2,6 +2,8 @@ package engine
 
 import (
 	"context"
+	"regexp"
+	"slices"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -255,13 +257,35 @@ func TestBuildAttackPaths_Path2_Incomplete(t *testing.T) {
 	}
 }
 
+// TestBuildAttackPaths_Path3_MultiNodeDescription verifies that PATH 3 names
+// the node count when K8S_CLUSTER_INSUFFICIENT_NODES fired for a cluster with
+// more than one node.
+func TestBuildAttackPaths_Path3_MultiNodeDescription(t *testing.T) {
+	findings := []models.Finding{
+		{ID: "f1", RuleID: "EKS_ENCRYPTION_DISABLED", Severity: models.SeverityCritical},
+		{ID: "f2", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
+		{ID: "f3", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh,
+			Metadata: map[string]any{"node_count": 2, "min_nodes": 3}},
+	}
+	p, ok := findPathByScore(buildAttackPaths(findings), 90)
+	if !ok {
+		t.Fatal("expected PATH 3 (score 90)")
+	}
+	if want := "Cluster governance protections disabled on a 2-node cluster (min_nodes 3)."; p.Description != want {
+		t.Errorf("Description = %q; want %q", p.Description, want)
+	}
+	if len(p.Layers) != 3 || p.Layers[2] != "Low Redundancy" {
+		t.Errorf("Layers = %v; want last layer Low Redundancy", p.Layers)
+	}
+}
+
 // TestBuildAttackPaths_Path3_Full verifies PATH 3 (score 90) triggers when
 // all three cluster-scoped governance rules are present.
 func TestBuildAttackPaths_Path3_Full(t *testing.T) {
 	findings := []models.Finding{
 		{ID: "f1", RuleID: "EKS_ENCRYPTION_DISABLED", Severity: models.SeverityCritical},
 		{ID: "f2", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
-		{ID: "f3", RuleID: "K8S_CLUSTER_SINGLE_NODE", Severity: models.SeverityHigh},
+		{ID: "f3", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
 	}
 	paths := buildAttackPaths(findings)
 
@@ -297,7 +321,7 @@ func TestBuildAttackPaths_Path3_Full(t *testing.T) {
 func TestBuildAttackPaths_Path3_MissingEncryption(t *testing.T) {
 	findings := []models.Finding{
 		{ID: "f2", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
-		{ID: "f3", RuleID: "K8S_CLUSTER_SINGLE_NODE", Severity: models.SeverityHigh},
+		{ID: "f3", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
 		// missing EKS_ENCRYPTION_DISABLED
 	}
 	paths := buildAttackPaths(findings)
@@ -321,7 +345,7 @@ func TestBuildAttackPaths_DescendingScore(t *testing.T) {
 		// Cluster-scoped governance findings (no namespace).
 		{ID: "f4", RuleID: "EKS_ENCRYPTION_DISABLED", Severity: models.SeverityCritical},
 		{ID: "f5", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
-		{ID: "f6", RuleID: "K8S_CLUSTER_SINGLE_NODE", Severity: models.SeverityHigh},
+		{ID: "f6", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
 	}
 	paths := buildAttackPaths(findings)
 	if len(paths) < 2 {
@@ -598,12 +622,12 @@ func TestBuildAttackPaths_StrictFilter_Path3_OnlyAllowedRules(t *testing.T) {
 	allowedPath3 := map[string]bool{
 		"EKS_ENCRYPTION_DISABLED":           true,
 		"EKS_CONTROL_PLANE_LOGGING_DISABLED": true,
-		"K8S_CLUSTER_SINGLE_NODE":            true,
+		"K8S_CLUSTER_INSUFFICIENT_NODES":            true,
 	}
 	findings := []models.Finding{
 		{ID: "f-enc", RuleID: "EKS_ENCRYPTION_DISABLED", Severity: models.SeverityCritical},
 		{ID: "f-log", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
-		{ID: "f-sn", RuleID: "K8S_CLUSTER_SINGLE_NODE", Severity: models.SeverityHigh},
+		{ID: "f-sn", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
 		// Unrelated cluster-scoped finding — must NOT appear in PATH 3.
 		{ID: "f-oidc", RuleID: "EKS_OIDC_PROVIDER_NOT_ASSOCIATED", Severity: models.SeverityHigh},
 	}
@@ -885,7 +909,7 @@ func TestBuildAttackPaths_SortStable(t *testing.T) {
 		// PATH 3: cluster-scoped.
 		{ID: "enc", RuleID: "EKS_ENCRYPTION_DISABLED", Severity: models.SeverityCritical},
 		{ID: "log", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
-		{ID: "sn", RuleID: "K8S_CLUSTER_SINGLE_NODE", Severity: models.SeverityHigh},
+		{ID: "sn", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
 	}
 	paths := buildAttackPaths(findings)
 
@@ -991,7 +1015,7 @@ func TestKubernetesEngine_AttackPaths_NotPopulatedWhenFlagOff(t *testing.T) {
 }
 
 // TestKubernetesEngine_AttackPath3_GovernanceCollapse verifies PATH 3 triggers
-// when EKS_ENCRYPTION_DISABLED + EKS_CONTROL_PLANE_LOGGING_DISABLED + K8S_CLUSTER_SINGLE_NODE.
+// when EKS_ENCRYPTION_DISABLED + EKS_CONTROL_PLANE_LOGGING_DISABLED + K8S_CLUSTER_INSUFFICIENT_NODES.
 func TestKubernetesEngine_AttackPath3_GovernanceCollapse(t *testing.T) {
 	node := eksNode("node1", "us-east-1a")
 	cs := fake.NewSimpleClientset(node)
@@ -1487,3 +1511,215 @@ func TestBuildAttackPaths_Path5_SortingOrder(t *testing.T) {
 		t.Errorf("expected PATH 4 (score 94) in sorted results; got %v", paths)
 	}
 }
+
+// ── Unit tests: annotateAttackPathMembers ─────────────────────────────────────
+
+// TestAnnotateAttackPathMembers_MembersMarked verifies that findings in a path
+// receive in_attack_path/attack_path_score while others are left untouched,
+// and that Severity is not modified.
+func TestAnnotateAttackPathMembers_MembersMarked(t *testing.T) {
+	findings := []models.Finding{
+		{ID: "f1", RuleID: "K8S_SERVICE_PUBLIC_LOADBALANCER", Severity: models.SeverityHigh,
+			Metadata: nsMeta("prod")},
+		{ID: "f2", RuleID: "K8S_POD_RUN_AS_ROOT", Severity: models.SeverityHigh,
+			Metadata: nsMeta("prod")},
+		{ID: "f3", RuleID: "K8S_DEFAULT_SERVICEACCOUNT_USED", Severity: models.SeverityMedium,
+			Metadata: nsMeta("prod")},
+		{ID: "f4", RuleID: "K8S_NAMESPACE_WITHOUT_LIMITS", Severity: models.SeverityMedium},
+	}
+	annotateAttackPathMembers(findings, buildAttackPaths(findings))
+
+	for _, f := range findings[:3] {
+		if in, _ := f.Metadata["in_attack_path"].(bool); !in {
+			t.Errorf("%s: in_attack_path not set", f.ID)
+		}
+		if score, _ := f.Metadata["attack_path_score"].(int); score != 98 {
+			t.Errorf("%s: attack_path_score = %v; want 98", f.ID, f.Metadata["attack_path_score"])
+		}
+	}
+	if _, ok := findings[3].Metadata["in_attack_path"]; ok {
+		t.Error("f4: in_attack_path set on a finding outside any path")
+	}
+	if findings[2].Severity != models.SeverityMedium {
+		t.Errorf("f3 severity = %q; annotation must not change severity", findings[2].Severity)
+	}
+}
+
+// TestAnnotateAttackPathMembers_HighestScoreWins verifies that a finding in
+// several paths carries the highest path score.
+func TestAnnotateAttackPathMembers_HighestScoreWins(t *testing.T) {
+	findings := []models.Finding{{ID: "shared"}}
+	paths := []models.AttackPath{
+		{Score: 90, FindingIDs: []string{"shared"}},
+		{Score: 96, FindingIDs: []string{"shared"}},
+	}
+	annotateAttackPathMembers(findings, paths)
+	if score, _ := findings[0].Metadata["attack_path_score"].(int); score != 96 {
+		t.Errorf("attack_path_score = %v; want 96", findings[0].Metadata["attack_path_score"])
+	}
+}
+
+// TestAnnotateAttackPathMembers_NoPaths verifies that no metadata is added
+// when no attack paths were detected.
+func TestAnnotateAttackPathMembers_NoPaths(t *testing.T) {
+	findings := []models.Finding{{ID: "f1"}}
+	annotateAttackPathMembers(findings, nil)
+	if findings[0].Metadata != nil {
+		t.Errorf("Metadata = %v; want nil when no paths", findings[0].Metadata)
+	}
+}
+
+// ── Unit tests: collapseAttackPaths ───────────────────────────────────────────
+
+// twoNamespacePath1Findings returns findings that satisfy PATH 1 in both
+// "ns-b" and "ns-a", plus a cluster-scoped node role finding shared by both.
+func twoNamespacePath1Findings() []models.Finding {
+	return []models.Finding{
+		{ID: "b-lb", RuleID: "K8S_SERVICE_PUBLIC_LOADBALANCER", Severity: models.SeverityHigh, Metadata: nsMeta("ns-b")},
+		{ID: "b-priv", RuleID: "K8S_POD_CAP_SYS_ADMIN", Severity: models.SeverityHigh, Metadata: nsMeta("ns-b")},
+		{ID: "b-sa", RuleID: "EKS_SERVICEACCOUNT_NO_IRSA", Severity: models.SeverityHigh, Metadata: nsMeta("ns-b")},
+		{ID: "a-lb", RuleID: "K8S_SERVICE_PUBLIC_LOADBALANCER", Severity: models.SeverityHigh, Metadata: nsMeta("ns-a")},
+		{ID: "a-priv", RuleID: "K8S_POD_RUN_AS_ROOT", Severity: models.SeverityHigh, Metadata: nsMeta("ns-a")},
+		{ID: "a-sa", RuleID: "K8S_DEFAULT_SERVICEACCOUNT_USED", Severity: models.SeverityMedium, Metadata: nsMeta("ns-a")},
+		{ID: "node-role", RuleID: "EKS_NODE_ROLE_OVERPERMISSIVE", Severity: models.SeverityCritical},
+	}
+}
+
+// TestCollapseAttackPaths_TwoNamespaces verifies that two PATH 1 entries are
+// merged into one with both namespaces listed and FindingIDs deduplicated
+// (the shared cluster-scoped finding appears once).
+func TestCollapseAttackPaths_TwoNamespaces(t *testing.T) {
+	findings := twoNamespacePath1Findings()
+	paths := buildAttackPaths(findings)
+	if n := len(findAllPathsByScore(paths, 98)); n != 2 {
+		t.Fatalf("precondition: expected 2 PATH 1 entries before collapsing; got %d", n)
+	}
+
+	collapsed := collapseAttackPaths(paths, findings)
+
+	p1 := findAllPathsByScore(collapsed, 98)
+	if len(p1) != 1 {
+		t.Fatalf("expected 1 collapsed PATH 1 entry; got %d: %v", len(p1), collapsed)
+	}
+	if got, want := p1[0].Namespaces, []string{"ns-a", "ns-b"}; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Namespaces = %v; want %v", got, want)
+	}
+	if len(p1[0].FindingIDs) != 7 {
+		t.Errorf("FindingIDs = %v; want 7 unique IDs", p1[0].FindingIDs)
+	}
+	seen := make(map[string]int)
+	for _, id := range p1[0].FindingIDs {
+		seen[id]++
+	}
+	for _, f := range findings {
+		if seen[f.ID] != 1 {
+			t.Errorf("finding %q appears %d times in collapsed FindingIDs; want 1", f.ID, seen[f.ID])
+		}
+	}
+	if len(p1[0].Layers) == 0 || p1[0].Description == "" {
+		t.Errorf("collapsed path lost Layers/Description: %+v", p1[0])
+	}
+
+	// PATH 5 (96) also fires in both namespaces and must collapse too.
+	if p5 := findAllPathsByScore(collapsed, 96); len(p5) != 1 {
+		t.Errorf("expected 1 collapsed PATH 5 entry; got %d", len(p5))
+	}
+	// Descending score order is preserved.
+	for i := 1; i < len(collapsed); i++ {
+		if collapsed[i].Score > collapsed[i-1].Score {
+			t.Errorf("collapsed paths not in descending score order: %v", collapsed)
+		}
+	}
+}
+
+// TestCollapseAttackPaths_ClusterScopedUnchanged verifies that a
+// cluster-scoped path passes through with nil Namespaces.
+func TestCollapseAttackPaths_ClusterScopedUnchanged(t *testing.T) {
+	findings := []models.Finding{
+		{ID: "enc", RuleID: "EKS_ENCRYPTION_DISABLED", Severity: models.SeverityHigh},
+		{ID: "log", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED", Severity: models.SeverityHigh},
+		{ID: "single", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
+	}
+	collapsed := collapseAttackPaths(buildAttackPaths(findings), findings)
+	if len(collapsed) != 1 {
+		t.Fatalf("expected 1 path; got %d", len(collapsed))
+	}
+	if collapsed[0].Namespaces != nil {
+		t.Errorf("Namespaces = %v; want nil for cluster-scoped path", collapsed[0].Namespaces)
+	}
+	if len(collapsed[0].FindingIDs) != 3 {
+		t.Errorf("FindingIDs = %v; want 3", collapsed[0].FindingIDs)
+	}
+}
+
+// ── MITRE ATT&CK techniques ──────────────────────────────────────────────────
+
+// attackTechniqueID matches ATT&CK technique and sub-technique IDs.
+var attackTechniqueID = regexp.MustCompile(`^T[0-9]{4}(\.[0-9]{3})?$`)
+
+// TestBuildAttackPaths_EveryPathCarriesTechniques triggers all five attack
+// paths and verifies each one carries its mapped, well-formed technique IDs.
+func TestBuildAttackPaths_EveryPathCarriesTechniques(t *testing.T) {
+	findings := []models.Finding{
+		{ID: "lb", RuleID: "K8S_SERVICE_PUBLIC_LOADBALANCER", Metadata: nsMeta("prod")},
+		{ID: "root", RuleID: "K8S_POD_RUN_AS_ROOT", Metadata: nsMeta("prod")},
+		{ID: "sa", RuleID: "K8S_DEFAULT_SERVICEACCOUNT_USED", Metadata: nsMeta("prod")},
+		{ID: "token", RuleID: "K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT", Metadata: nsMeta("prod")},
+		{ID: "irsa", RuleID: "EKS_SERVICEACCOUNT_NO_IRSA", Metadata: nsMeta("prod")},
+		{ID: "node-role", RuleID: "EKS_NODE_ROLE_OVERPERMISSIVE"},
+		{ID: "oidc", RuleID: "EKS_OIDC_PROVIDER_NOT_ASSOCIATED"},
+		{ID: "endpoint", RuleID: "EKS_PUBLIC_ENDPOINT_ENABLED"},
+		{ID: "enc", RuleID: "EKS_ENCRYPTION_DISABLED"},
+		{ID: "log", RuleID: "EKS_CONTROL_PLANE_LOGGING_DISABLED"},
+		{ID: "single", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES"},
+	}
+	paths := buildAttackPaths(findings)
+
+	for _, score := range []int{98, 96, 94, 92, 90} {
+		p, ok := findPathByScore(paths, score)
+		if !ok {
+			t.Errorf("PATH with score %d not detected", score)
+			continue
+		}
+		if len(p.Techniques) == 0 {
+			t.Errorf("PATH %d has no ATT&CK techniques", score)
+		}
+		for _, id := range p.Techniques {
+			if !attackTechniqueID.MatchString(id) {
+				t.Errorf("PATH %d technique %q is not an ATT&CK technique ID", score, id)
+			}
+		}
+		if !slices.Equal(p.Techniques, attackPathTechniques[score]) {
+			t.Errorf("PATH %d Techniques = %v; want %v", score, p.Techniques, attackPathTechniques[score])
+		}
+	}
+
+	p1, _ := findPathByScore(paths, 98)
+	if !slices.Contains(p1.Techniques, "T1190") || !slices.Contains(p1.Techniques, "T1078") {
+		t.Errorf("PATH 1 Techniques = %v; want T1190 and T1078", p1.Techniques)
+	}
+}
+
+// TestBuildAttackPaths_TechniquesNotShared verifies each path gets its own
+// copy of the technique slice, so mutating one report cannot alter the table.
+func TestBuildAttackPaths_TechniquesNotShared(t *testing.T) {
+	paths := buildAttackPaths(twoNamespacePath1Findings())
+	p1 := findAllPathsByScore(paths, 98)
+	if len(p1) != 2 {
+		t.Fatalf("precondition: expected 2 PATH 1 entries; got %d", len(p1))
+	}
+	p1[0].Techniques[0] = "changed"
+	if p1[1].Techniques[0] == "changed" || attackPathTechniques[98][0] == "changed" {
+		t.Error("Techniques slice is shared between paths or with attackPathTechniques")
+	}
+}
+
+func TestCollapseAttackPaths_KeepsTechniques(t *testing.T) {
+	findings := twoNamespacePath1Findings()
+	collapsed := collapseAttackPaths(buildAttackPaths(findings), findings)
+	for _, p := range collapsed {
+		if len(p.Techniques) == 0 {
+			t.Errorf("collapsed PATH %d lost its Techniques", p.Score)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -80,6 +82,22 @@ func filterByMinRiskScore(findings []models.Finding, min int) []models.Finding {
 	return out
 }
 
+// FilterChainedFindings returns a new slice containing only findings that are
+// part of a risk chain (risk_chain_score > 0) or an attack path
+// (Metadata["in_attack_path"] == true). Order is preserved and the original
+// slice is not modified. Used by the CLI --only-chains flag, which filters the
+// rendered findings while leaving the summary untouched.
+func FilterChainedFindings(findings []models.Finding) []models.Finding {
+	out := make([]models.Finding, 0, len(findings))
+	for _, f := range findings {
+		inPath, _ := f.Metadata["in_attack_path"].(bool)
+		if inPath || getRiskScore(f) > 0 {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
 // getRiskScore returns the risk_chain_score stored in f.Metadata, or 0 if the
 // key is absent or not an int. Used to compute the report-level summary score.
 func getRiskScore(f models.Finding) int {
@@ -96,7 +114,8 @@ func getRiskScore(f models.Finding) int {
 // patterns with Metadata["risk_chain_score"] (int) and
 // Metadata["risk_chain_reason"] (string).
 //
-// Six risk chains are detected:
+// Eight risk chains are detected. Chain numbers follow the order the chains
+// were introduced in; there is no chain 7.
 //
 //	Chain 1 (score 80): A public LoadBalancer service
 //	  (K8S_SERVICE_PUBLIC_LOADBALANCER) and a pod with K8S_POD_RUN_AS_ROOT or
@@ -108,9 +127,12 @@ func getRiskScore(f models.Finding) int {
 //	  automount enabled (K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT) in the same namespace.
 //	  Reason: "Default service account with auto-mounted token"
 //
-//	Chain 3 (score 50): The cluster has a single node (K8S_CLUSTER_SINGLE_NODE)
+//	Chain 3 (score 50): The cluster has fewer nodes than the configured minimum,
+//	  a single node by default (K8S_CLUSTER_INSUFFICIENT_NODES)
 //	  and at least one CRITICAL severity finding exists.
-//	  Reason: "Single-node cluster with critical pod security violation"
+//	  Reason: "Single-node cluster with critical pod security violation", or
+//	  e.g. "2-node cluster (min_nodes 3) with critical pod security violation"
+//	  when min_nodes is raised (see insufficientNodesSubject)
 //
 //	Chain 4 (score 90): EKS node group IAM role is overpermissive
 //	  (EKS_NODE_ROLE_OVERPERMISSIVE) and a public LoadBalancer service exists
@@ -127,6 +149,16 @@ func getRiskScore(f models.Finding) int {
 //	  exists in the cluster.
 //	  Reason: "Cluster lacks OIDC provider and has high-risk workload findings."
 //
+//	Chain 8 (score 88): GKE Workload Identity is disabled
+//	  (GKE_WORKLOAD_IDENTITY_DISABLED) and the default ServiceAccount is used
+//	  (K8S_DEFAULT_SERVICEACCOUNT_USED) in the same namespace.
+//	  Reason: "Default SA used without Workload Identity federation"
+//
+//	Chain 9 (score 82): EKS node group IAM role is overpermissive
+//	  (EKS_NODE_ROLE_OVERPERMISSIVE) and a pod uses the default ServiceAccount
+//	  (K8S_DEFAULT_SERVICEACCOUNT_USED) in any namespace.
+//	  Reason: "Over-permissive node role reachable via default service account"
+//
 // When multiple chains apply to the same finding, the highest score is kept.
 // Severity and sort order are not affected.
 //
@@ -142,16 +174,18 @@ func correlateRiskChains(findings []models.Finding) {
 	nsIndex := buildNamespaceRuleIndex(findings)
 
 	hasSingleNode := false
+	nodesSubject := insufficientNodesSubject(findings)
 	hasCritical := false
 	hasNodeRoleOverpermissive := false
 	hasPublicLB := false
 	hasOIDCNotAssociated := false
 	hasHighSeverity := false
+	hasDefaultSAUsed := false
 
 	for i := range findings {
 		f := &findings[i]
 		ids := ruleIDsForFinding(f)
-		if idsContain(ids, "K8S_CLUSTER_SINGLE_NODE") {
+		if idsContain(ids, "K8S_CLUSTER_INSUFFICIENT_NODES") {
 			hasSingleNode = true
 		}
 		if f.Severity == models.SeverityCritical {
@@ -169,6 +203,9 @@ func correlateRiskChains(findings []models.Finding) {
 		if f.Severity == models.SeverityHigh {
 			hasHighSeverity = true
 		}
+		if idsContain(ids, "K8S_DEFAULT_SERVICEACCOUNT_USED") {
+			hasDefaultSAUsed = true
+		}
 	}
 
 	// ── Phase 2: annotate participating findings ───────────────────────────────
@@ -212,14 +249,14 @@ func correlateRiskChains(findings []models.Finding) {
 			}
 		}
 
-		// Chain 3: K8S_CLUSTER_SINGLE_NODE + any CRITICAL severity finding.
+		// Chain 3: K8S_CLUSTER_INSUFFICIENT_NODES + any CRITICAL severity finding.
 		{
-			isSingleNode := idsContain(ids, "K8S_CLUSTER_SINGLE_NODE")
+			isSingleNode := idsContain(ids, "K8S_CLUSTER_INSUFFICIENT_NODES")
 			isCritical := f.Severity == models.SeverityCritical
 			if (isSingleNode && hasCritical) || (isCritical && hasSingleNode) {
 				if 50 > bestScore {
 					bestScore = 50
-					bestReason = "Single-node cluster with critical pod security violation"
+					bestReason = capitalize(nodesSubject) + " with critical pod security violation"
 				}
 			}
 		}
@@ -265,6 +302,34 @@ func correlateRiskChains(findings []models.Finding) {
 			}
 		}
 
+		// Chain 8 (GKE): GKE_WORKLOAD_IDENTITY_DISABLED + K8S_DEFAULT_SERVICEACCOUNT_USED
+		// in the same namespace. Score 88. The GKE counterpart of chain 5.
+		if ns != "" {
+			isNoWI := idsContain(ids, "GKE_WORKLOAD_IDENTITY_DISABLED")
+			isDefaultSAUsed := idsContain(ids, "K8S_DEFAULT_SERVICEACCOUNT_USED")
+			nsHasNoWI := nsIndexHas(nsIndex, ns, "GKE_WORKLOAD_IDENTITY_DISABLED")
+			nsHasDefaultSAUsed := nsIndexHas(nsIndex, ns, "K8S_DEFAULT_SERVICEACCOUNT_USED")
+			if (isNoWI && nsHasDefaultSAUsed) || (isDefaultSAUsed && nsHasNoWI) {
+				if 88 > bestScore {
+					bestScore = 88
+					bestReason = "Default SA used without Workload Identity federation"
+				}
+			}
+		}
+
+		// Chain 9: EKS_NODE_ROLE_OVERPERMISSIVE (cluster-scoped) + K8S_DEFAULT_SERVICEACCOUNT_USED
+		// in any namespace. Score 82.
+		{
+			isNodeRole := idsContain(ids, "EKS_NODE_ROLE_OVERPERMISSIVE")
+			isDefaultSAUsed := idsContain(ids, "K8S_DEFAULT_SERVICEACCOUNT_USED")
+			if (isNodeRole || isDefaultSAUsed) && hasNodeRoleOverpermissive && hasDefaultSAUsed {
+				if 82 > bestScore {
+					bestScore = 82
+					bestReason = "Over-permissive node role reachable via default service account"
+				}
+			}
+		}
+
 		if bestScore > 0 {
 			if f.Metadata == nil {
 				f.Metadata = make(map[string]any)
@@ -275,6 +340,25 @@ func correlateRiskChains(findings []models.Finding) {
 	}
 }
 
+// attackPathTechniques maps each attack path score to the MITRE ATT&CK
+// technique IDs the path enables, in attack order. buildAttackPaths copies
+// them into AttackPath.Techniques so SOC teams can map paths to ATT&CK.
+var attackPathTechniques = map[int][]string{
+	// PATH 1: Exploit Public-Facing Application, Escape to Host, Valid Accounts.
+	98: {"T1190", "T1611", "T1078"},
+	// PATH 5: Exploit Public-Facing Application, Escape to Host,
+	// Cloud Instance Metadata API, Valid Accounts: Cloud Accounts.
+	96: {"T1190", "T1611", "T1552.005", "T1078.004"},
+	// PATH 4: External Remote Services, Valid Accounts: Cloud Accounts,
+	// Impair Defenses: Disable or Modify Cloud Logs.
+	94: {"T1133", "T1078.004", "T1562.008"},
+	// PATH 2: Steal Application Access Token, Valid Accounts.
+	92: {"T1528", "T1078"},
+	// PATH 3: Unsecured Credentials: Container API, Impair Defenses: Disable
+	// or Modify Cloud Logs, Endpoint Denial of Service.
+	90: {"T1552.007", "T1562.008", "T1499"},
+}
+
 // buildAttackPaths detects multi-layer compound attack paths across the full
 // finding set and returns one models.AttackPath per triggered scenario, ordered
 // by descending score.
@@ -317,9 +401,12 @@ func correlateRiskChains(findings []models.Finding) {
 //	PATH 3 (score 90) — Governance Collapse (cluster-scoped):
 //	  Requires: EKS_ENCRYPTION_DISABLED
 //	          + EKS_CONTROL_PLANE_LOGGING_DISABLED
-//	          + K8S_CLUSTER_SINGLE_NODE
+//	          + K8S_CLUSTER_INSUFFICIENT_NODES
 //	  Description: "Cluster governance protections disabled with no redundancy."
 //
+// Every path carries the ATT&CK technique IDs listed for its score in
+// attackPathTechniques.
+//
 // When attack paths are present, the caller should use the highest path score as
 // Summary.RiskScore (overriding the chain-based score). If no paths are detected,
 // the chain-based score is used as the fallback.
@@ -359,7 +446,7 @@ func buildAttackPaths(findings []models.Finding) []models.AttackPath {
 	//   PATH 2: K8S_DEFAULT_SERVICEACCOUNT_USED, K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT,
 	//           EKS_SERVICEACCOUNT_NO_IRSA, EKS_OIDC_PROVIDER_NOT_ASSOCIATED
 	//   PATH 3: EKS_ENCRYPTION_DISABLED, EKS_CONTROL_PLANE_LOGGING_DISABLED,
-	//           K8S_CLUSTER_SINGLE_NODE
+	//           K8S_CLUSTER_INSUFFICIENT_NODES
 
 	// Detection index — namespace-scoped (expanded via ruleIDsForFinding).
 	detectNS := buildNamespaceRuleIndex(findings)
@@ -522,19 +609,24 @@ func buildAttackPaths(findings []models.Finding) []models.AttackPath {
 	// All three rules are cluster-scoped: no namespace dimension.
 	if clusterHas("EKS_ENCRYPTION_DISABLED") &&
 		clusterHas("EKS_CONTROL_PLANE_LOGGING_DISABLED") &&
-		clusterHas("K8S_CLUSTER_SINGLE_NODE") {
+		clusterHas("K8S_CLUSTER_INSUFFICIENT_NODES") {
 		seen := make(map[string]struct{})
 		var fids []string
 		fids = appendClusterIDs(seen, fids,
 			"EKS_ENCRYPTION_DISABLED",
 			"EKS_CONTROL_PLANE_LOGGING_DISABLED",
-			"K8S_CLUSTER_SINGLE_NODE",
+			"K8S_CLUSTER_INSUFFICIENT_NODES",
 		)
+		redundancy, description := "No Redundancy", "Cluster governance protections disabled with no redundancy."
+		if subject := insufficientNodesSubject(findings); subject != singleNodeSubject {
+			redundancy = "Low Redundancy"
+			description = "Cluster governance protections disabled on a " + subject + "."
+		}
 		paths = append(paths, models.AttackPath{
-			Score:      90,
-			Layers:     []string{"Encryption Disabled", "Logging Disabled", "No Redundancy"},
-			FindingIDs: fids,
-			Description: "Cluster governance protections disabled with no redundancy.",
+			Score:       90,
+			Layers:      []string{"Encryption Disabled", "Logging Disabled", redundancy},
+			FindingIDs:  fids,
+			Description: description,
 		})
 	}
 
@@ -613,6 +705,10 @@ func buildAttackPaths(findings []models.Finding) []models.AttackPath {
 		}
 	}
 
+	for i := range paths {
+		paths[i].Techniques = slices.Clone(attackPathTechniques[paths[i].Score])
+	}
+
 	// Order by descending score.
 	sort.Slice(paths, func(i, j int) bool {
 		return paths[i].Score > paths[j].Score
@@ -620,6 +716,101 @@ func buildAttackPaths(findings []models.Finding) []models.AttackPath {
 	return paths
 }
 
+// annotateAttackPathMembers marks every finding whose ID appears in any
+// AttackPath.FindingIDs with Metadata["in_attack_path"]=true and
+// Metadata["attack_path_score"]=<highest containing path score>.
+//
+// Severity is intentionally left unchanged so sort order is unaffected; the
+// annotation is surfaced by the table renderer and carried in JSON metadata.
+// Must run after buildAttackPaths on the same merged slice.
+func annotateAttackPathMembers(findings []models.Finding, paths []models.AttackPath) {
+	if len(paths) == 0 {
+		return
+	}
+	bestScore := make(map[string]int)
+	for _, ap := range paths {
+		for _, id := range ap.FindingIDs {
+			if ap.Score > bestScore[id] {
+				bestScore[id] = ap.Score
+			}
+		}
+	}
+	for i := range findings {
+		score, ok := bestScore[findings[i].ID]
+		if !ok {
+			continue
+		}
+		if findings[i].Metadata == nil {
+			findings[i].Metadata = make(map[string]any)
+		}
+		findings[i].Metadata["in_attack_path"] = true
+		findings[i].Metadata["attack_path_score"] = score
+	}
+}
+
+// collapseAttackPaths merges attack paths that share the same Score and
+// Description (e.g. PATH 1 matched in several namespaces) into a single entry.
+// FindingIDs are concatenated without duplicates and Namespaces lists the
+// sorted set of namespaces of the member findings. Paths with no
+// namespace-scoped members keep a nil Namespaces slice.
+//
+// Called by RunAudit when KubernetesAuditOptions.CollapsePaths is true. The
+// first occurrence of each (score, description) pair determines output order.
+func collapseAttackPaths(paths []models.AttackPath, findings []models.Finding) []models.AttackPath {
+	if len(paths) == 0 {
+		return paths
+	}
+
+	nsByID := make(map[string]string, len(findings))
+	for i := range findings {
+		nsByID[findings[i].ID] = resolveNamespaceForFinding(&findings[i])
+	}
+
+	type pathKey struct {
+		score       int
+		description string
+	}
+	index := make(map[pathKey]int)
+	seenIDs := make(map[pathKey]map[string]struct{})
+	seenNS := make(map[pathKey]map[string]struct{})
+	var collapsed []models.AttackPath
+
+	for _, ap := range paths {
+		k := pathKey{score: ap.Score, description: ap.Description}
+		i, ok := index[k]
+		if !ok {
+			i = len(collapsed)
+			index[k] = i
+			seenIDs[k] = make(map[string]struct{})
+			seenNS[k] = make(map[string]struct{})
+			collapsed = append(collapsed, models.AttackPath{
+				Score:       ap.Score,
+				Layers:      ap.Layers,
+				Description: ap.Description,
+				Techniques:  ap.Techniques,
+			})
+		}
+		for _, id := range ap.FindingIDs {
+			if _, dup := seenIDs[k][id]; dup {
+				continue
+			}
+			seenIDs[k][id] = struct{}{}
+			collapsed[i].FindingIDs = append(collapsed[i].FindingIDs, id)
+			if ns := nsByID[id]; ns != "" {
+				if _, dup := seenNS[k][ns]; !dup {
+					seenNS[k][ns] = struct{}{}
+					collapsed[i].Namespaces = append(collapsed[i].Namespaces, ns)
+				}
+			}
+		}
+	}
+
+	for i := range collapsed {
+		sort.Strings(collapsed[i].Namespaces)
+	}
+	return collapsed
+}
+
 // buildRiskChains groups findings by their (risk_chain_score, risk_chain_reason)
 // pair and returns one models.RiskChain per unique pair, ordered by descending
 // score. Only findings with risk_chain_score > 0 are included.
@@ -662,3 +853,36 @@ func buildRiskChains(findings []models.Finding) []models.RiskChain {
 	})
 	return chains
 }
+
+// singleNodeSubject is insufficientNodesSubject's result for a cluster with
+// one node, the K8S_CLUSTER_INSUFFICIENT_NODES default.
+const singleNodeSubject = "single-node cluster"
+
+// insufficientNodesSubject describes the cluster flagged by
+// K8S_CLUSTER_INSUFFICIENT_NODES for chain reasons and attack path
+// descriptions: singleNodeSubject for one node, or e.g. "2-node cluster
+// (min_nodes 3)" when min_nodes is raised above 2. The result is lower-case. Findings without a
+// node_count are described as single-node, the rule's default trigger.
+func insufficientNodesSubject(findings []models.Finding) string {
+	for i := range findings {
+		f := &findings[i]
+		if f.RuleID != "K8S_CLUSTER_INSUFFICIENT_NODES" {
+			continue
+		}
+		count, _ := f.Metadata["node_count"].(int)
+		if count <= 1 {
+			return singleNodeSubject
+		}
+		minimum, _ := f.Metadata["min_nodes"].(int)
+		return fmt.Sprintf("%d-node cluster (min_nodes %d)", count, minimum)
+	}
+	return singleNodeSubject
+}
+
+// capitalize upper-cases the first byte of an ASCII string.
+func capitalize(s string) string {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
@@ -9,7 +9,10 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
 	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+	k8scorepack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/kubernetes_core"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
 )
 
 // ── Test helpers ──────────────────────────────────────────────────────────────
@@ -136,7 +139,7 @@ func TestBuildNamespaceRuleIndex_BasicMapping(t *testing.T) {
 			Metadata:     map[string]any{"namespace": "apps"},
 		},
 		{
-			RuleID:       "K8S_CLUSTER_SINGLE_NODE",
+			RuleID:       "K8S_CLUSTER_INSUFFICIENT_NODES",
 			ResourceType: models.ResourceK8sCluster,
 			// No namespace — cluster-scoped; must not appear in index.
 		},
@@ -355,12 +358,12 @@ func TestCorrelateRiskChains_Chain2_NegativeNoDefaultSA(t *testing.T) {
 }
 
 // TestCorrelateRiskChains_Chain3_DirectUnit verifies that chain 3 annotates
-// both the K8S_CLUSTER_SINGLE_NODE finding and the CRITICAL pod finding with
+// both the K8S_CLUSTER_INSUFFICIENT_NODES finding and the CRITICAL pod finding with
 // score=50.
 func TestCorrelateRiskChains_Chain3_DirectUnit(t *testing.T) {
 	findings := []models.Finding{
 		{
-			RuleID:       "K8S_CLUSTER_SINGLE_NODE",
+			RuleID:       "K8S_CLUSTER_INSUFFICIENT_NODES",
 			ResourceType: models.ResourceK8sCluster,
 			ResourceID:   "test-cluster",
 			Severity:     models.SeverityHigh,
@@ -389,12 +392,42 @@ func TestCorrelateRiskChains_Chain3_DirectUnit(t *testing.T) {
 	}
 }
 
+// TestCorrelateRiskChains_Chain3_ReasonNamesNodeCount verifies that when
+// min_nodes is raised, the chain 3 reason names the actual node count instead
+// of calling the cluster single-node.
+func TestCorrelateRiskChains_Chain3_ReasonNamesNodeCount(t *testing.T) {
+	findings := []models.Finding{
+		{
+			RuleID:       "K8S_CLUSTER_INSUFFICIENT_NODES",
+			ResourceType: models.ResourceK8sCluster,
+			ResourceID:   "test-cluster",
+			Severity:     models.SeverityHigh,
+			Metadata:     map[string]any{"node_count": 2, "min_nodes": 3},
+		},
+		{
+			RuleID:       "K8S_POD_PRIVILEGED_CONTAINER",
+			ResourceType: models.ResourceK8sPod,
+			ResourceID:   "priv-pod",
+			Severity:     models.SeverityCritical,
+			Metadata:     map[string]any{"namespace": "production"},
+		},
+	}
+	correlateRiskChains(findings)
+
+	want := "2-node cluster (min_nodes 3) with critical pod security violation"
+	for _, f := range findings {
+		if reason, _ := f.Metadata["risk_chain_reason"].(string); reason != want {
+			t.Errorf("finding %q: risk_chain_reason = %q; want %q", f.ResourceID, reason, want)
+		}
+	}
+}
+
 // TestCorrelateRiskChains_Chain3_NegativeNoCritical verifies that chain 3 does
 // NOT fire when the cluster has a single node but no CRITICAL finding exists.
 func TestCorrelateRiskChains_Chain3_NegativeNoCritical(t *testing.T) {
 	findings := []models.Finding{
 		{
-			RuleID:       "K8S_CLUSTER_SINGLE_NODE",
+			RuleID:       "K8S_CLUSTER_INSUFFICIENT_NODES",
 			ResourceType: models.ResourceK8sCluster,
 			ResourceID:   "test-cluster",
 			Severity:     models.SeverityHigh, // HIGH, not CRITICAL
@@ -438,7 +471,7 @@ func TestCorrelateRiskChains_HighestScoreWins_DirectUnit(t *testing.T) {
 			Metadata:     map[string]any{"namespace": "apps"},
 		},
 		{
-			RuleID:       "K8S_CLUSTER_SINGLE_NODE",
+			RuleID:       "K8S_CLUSTER_INSUFFICIENT_NODES",
 			ResourceType: models.ResourceK8sCluster,
 			ResourceID:   "test-cluster",
 			Severity:     models.SeverityHigh,
@@ -585,11 +618,11 @@ func TestCorrelationEngine_Chain2_NoDefaultSA(t *testing.T) {
 }
 
 // TestCorrelationEngine_Chain3_BothFindingsAnnotated verifies that both the
-// K8S_CLUSTER_SINGLE_NODE finding and the CRITICAL pod finding receive
+// K8S_CLUSTER_INSUFFICIENT_NODES finding and the CRITICAL pod finding receive
 // risk_chain_score=50.
 func TestCorrelationEngine_Chain3_BothFindingsAnnotated(t *testing.T) {
 	cs := fake.NewSimpleClientset(
-		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"), // single node — fires K8S_CLUSTER_SINGLE_NODE
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"), // single node — fires K8S_CLUSTER_INSUFFICIENT_NODES
 		pssPrivilegedPod("priv-pod", "production"),      // CRITICAL
 	)
 	report, err := correlationEngine(cs, "chain3-ctx").RunAudit(context.Background(), KubernetesAuditOptions{})
@@ -601,7 +634,7 @@ func TestCorrelationEngine_Chain3_BothFindingsAnnotated(t *testing.T) {
 	for i := range report.Findings {
 		f := &report.Findings[i]
 		ids := ruleIDsForFinding(f)
-		if idsContain(ids, "K8S_CLUSTER_SINGLE_NODE") {
+		if idsContain(ids, "K8S_CLUSTER_INSUFFICIENT_NODES") {
 			score, ok := f.Metadata["risk_chain_score"].(int)
 			singleNodeAnnotated = ok && score == 50
 		}
@@ -611,15 +644,98 @@ func TestCorrelationEngine_Chain3_BothFindingsAnnotated(t *testing.T) {
 		}
 	}
 	if !singleNodeAnnotated {
-		t.Error("K8S_CLUSTER_SINGLE_NODE finding should have risk_chain_score=50")
+		t.Error("K8S_CLUSTER_INSUFFICIENT_NODES finding should have risk_chain_score=50")
 	}
 	if !criticalAnnotated {
 		t.Error("CRITICAL finding should have risk_chain_score=50")
 	}
 }
 
+// TestCorrelationEngine_Chain3_SeverityOverrideRemovesParticipation verifies
+// that severity_overrides are applied before correlateRiskChains: downgrading
+// the privileged-container rules from CRITICAL to MEDIUM leaves no CRITICAL
+// finding, so chain 3 no longer fires on a single-node cluster.
+func TestCorrelationEngine_Chain3_SeverityOverrideRemovesParticipation(t *testing.T) {
+	cs := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"), // single node — fires K8S_CLUSTER_INSUFFICIENT_NODES
+		pssPrivilegedPod("priv-pod", "production"),      // CRITICAL before override
+	)
+	provider := &fakeKubeProvider{
+		clientset: cs,
+		info:      kube.ClusterInfo{ContextName: "chain3-override-ctx", Server: "https://fake"},
+	}
+	policyCfg := &policy.PolicyConfig{
+		Version: 1,
+		SeverityOverrides: map[string]string{
+			"K8S_PRIVILEGED_CONTAINER":     "MEDIUM",
+			"K8S_POD_PRIVILEGED_CONTAINER": "MEDIUM",
+		},
+	}
+	report, err := newK8sEngine(provider, policyCfg).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	for _, f := range report.Findings {
+		if f.Severity == models.SeverityCritical {
+			t.Errorf("finding %q (rule %q) still CRITICAL after override", f.ResourceID, f.RuleID)
+		}
+		if _, ok := f.Metadata["risk_chain_score"]; ok {
+			t.Errorf("no chain annotation expected after override; got risk_chain_score on %q (rule %q)",
+				f.ResourceID, f.RuleID)
+		}
+	}
+	if report.Summary.CriticalFindings != 0 {
+		t.Errorf("Summary.CriticalFindings = %d; want 0 after override", report.Summary.CriticalFindings)
+	}
+}
+
+// TestCorrelationEngine_Chain3_SeverityOverrideAddsParticipation verifies the
+// inverse: upgrading K8S_POD_NO_SECCOMP to CRITICAL on a single-node cluster
+// with no natively CRITICAL finding makes chain 3 fire.
+func TestCorrelationEngine_Chain3_SeverityOverrideAddsParticipation(t *testing.T) {
+	cs := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		pssRunAsRootPod("root-pod", "production"), // HIGH run-as-root + MEDIUM no-seccomp
+	)
+	provider := &fakeKubeProvider{
+		clientset: cs,
+		info:      kube.ClusterInfo{ContextName: "chain3-upgrade-ctx", Server: "https://fake"},
+	}
+
+	// Baseline: without the override chain 3 must not fire.
+	base, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	for _, f := range base.Findings {
+		if getRiskScore(f) == 50 {
+			t.Fatalf("baseline: unexpected chain 3 annotation on %q (rule %q)", f.ResourceID, f.RuleID)
+		}
+	}
+
+	policyCfg := &policy.PolicyConfig{
+		Version:           1,
+		SeverityOverrides: map[string]string{"K8S_POD_NO_SECCOMP": "CRITICAL"},
+	}
+	report, err := newK8sEngine(provider, policyCfg).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	var singleNodeAnnotated bool
+	for i := range report.Findings {
+		f := &report.Findings[i]
+		if idsContain(ruleIDsForFinding(f), "K8S_CLUSTER_INSUFFICIENT_NODES") {
+			singleNodeAnnotated = getRiskScore(*f) == 50
+		}
+	}
+	if !singleNodeAnnotated {
+		t.Error("K8S_CLUSTER_INSUFFICIENT_NODES finding should have risk_chain_score=50 once an override makes a finding CRITICAL")
+	}
+}
+
 // TestCorrelationEngine_Chain3_MultipleNodes verifies that chain 3 does NOT fire
-// when the cluster has multiple nodes (no K8S_CLUSTER_SINGLE_NODE finding).
+// when the cluster has multiple nodes (no K8S_CLUSTER_INSUFFICIENT_NODES finding).
 func TestCorrelationEngine_Chain3_MultipleNodes(t *testing.T) {
 	cs := fake.NewSimpleClientset(
 		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
@@ -642,7 +758,7 @@ func TestCorrelationEngine_Chain3_MultipleNodes(t *testing.T) {
 // TestCorrelationEngine_Chain3_NoCritical verifies that chain 3 does NOT fire
 // when the cluster has a single node but no CRITICAL findings exist.
 func TestCorrelationEngine_Chain3_NoCritical(t *testing.T) {
-	// 1 node fires K8S_CLUSTER_SINGLE_NODE (HIGH) only; no pods = no CRITICAL.
+	// 1 node fires K8S_CLUSTER_INSUFFICIENT_NODES (HIGH) only; no pods = no CRITICAL.
 	cs := fake.NewSimpleClientset(
 		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
 	)
@@ -924,6 +1040,28 @@ func TestFilterByMinRiskScore_ZeroMinReturnsAll(t *testing.T) {
 	}
 }
 
+// ── Unit tests: FilterChainedFindings ────────────────────────────────────────
+
+// TestFilterChainedFindings_KeepsChainedAndAttackPath verifies that findings
+// with a risk_chain_score or in_attack_path=true are kept in order, and that
+// findings with neither (including nil metadata) are dropped.
+func TestFilterChainedFindings_KeepsChainedAndAttackPath(t *testing.T) {
+	findings := []models.Finding{
+		{RuleID: "A", Metadata: map[string]any{"risk_chain_score": 80}},
+		{RuleID: "B", Metadata: map[string]any{"namespace": "prod"}},
+		{RuleID: "C", Metadata: map[string]any{"in_attack_path": true}},
+		{RuleID: "D", Metadata: nil},
+		{RuleID: "E", Metadata: map[string]any{"in_attack_path": false}},
+	}
+	got := FilterChainedFindings(findings)
+	if len(got) != 2 || got[0].RuleID != "A" || got[1].RuleID != "C" {
+		t.Errorf("FilterChainedFindings returned %v; want [A C]", got)
+	}
+	if len(findings) != 5 || findings[1].RuleID != "B" {
+		t.Errorf("FilterChainedFindings modified its input: %v", findings)
+	}
+}
+
 // ── Engine-level integration tests: MinRiskScore ─────────────────────────────
 
 // TestCorrelationEngine_MinRiskScore_Chain1_PassesAt60 verifies that with
@@ -1162,6 +1300,70 @@ func TestCorrelateRiskChains_Chain5_Negative_DifferentNamespace(t *testing.T) {
 	}
 }
 
+// TestCorrelateRiskChains_Chain8_DirectUnit verifies that chain 8 annotates
+// GKE_WORKLOAD_IDENTITY_DISABLED and K8S_DEFAULT_SERVICEACCOUNT_USED findings
+// in the same namespace with score=88.
+func TestCorrelateRiskChains_Chain8_DirectUnit(t *testing.T) {
+	findings := []models.Finding{
+		{
+			RuleID:       "GKE_WORKLOAD_IDENTITY_DISABLED",
+			ResourceType: models.ResourceK8sServiceAccount,
+			ResourceID:   "app-sa",
+			Severity:     models.SeverityHigh,
+			Metadata:     map[string]any{"namespace": "prod"},
+		},
+		{
+			RuleID:       "K8S_DEFAULT_SERVICEACCOUNT_USED",
+			ResourceType: models.ResourceK8sPod,
+			ResourceID:   "app-pod",
+			Severity:     models.SeverityMedium,
+			Metadata:     map[string]any{"namespace": "prod"},
+		},
+	}
+	correlateRiskChains(findings)
+
+	for _, f := range findings {
+		score, ok := f.Metadata["risk_chain_score"].(int)
+		if !ok || score != 88 {
+			t.Errorf("finding %q: risk_chain_score = %v; want 88 (chain 8)",
+				f.RuleID, f.Metadata["risk_chain_score"])
+		}
+		reason, _ := f.Metadata["risk_chain_reason"].(string)
+		if reason != "Default SA used without Workload Identity federation" {
+			t.Errorf("finding %q: risk_chain_reason = %q; want chain 8 reason", f.RuleID, reason)
+		}
+	}
+}
+
+// TestCorrelateRiskChains_Chain8_Negative_DifferentNamespace verifies that chain 8
+// does NOT fire when GKE_WORKLOAD_IDENTITY_DISABLED and K8S_DEFAULT_SERVICEACCOUNT_USED
+// are in different namespaces.
+func TestCorrelateRiskChains_Chain8_Negative_DifferentNamespace(t *testing.T) {
+	findings := []models.Finding{
+		{
+			RuleID:       "GKE_WORKLOAD_IDENTITY_DISABLED",
+			ResourceType: models.ResourceK8sServiceAccount,
+			ResourceID:   "app-sa",
+			Severity:     models.SeverityHigh,
+			Metadata:     map[string]any{"namespace": "team-a"},
+		},
+		{
+			RuleID:       "K8S_DEFAULT_SERVICEACCOUNT_USED",
+			ResourceType: models.ResourceK8sPod,
+			ResourceID:   "app-pod",
+			Severity:     models.SeverityMedium,
+			Metadata:     map[string]any{"namespace": "team-b"},
+		},
+	}
+	correlateRiskChains(findings)
+	for _, f := range findings {
+		if _, ok := f.Metadata["risk_chain_score"]; ok {
+			t.Errorf("finding %q in different namespace should not have chain 8 annotation; got %v",
+				f.RuleID, f.Metadata["risk_chain_score"])
+		}
+	}
+}
+
 // TestCorrelateRiskChains_Chain6_DirectUnit verifies that chain 6 annotates
 // EKS_OIDC_PROVIDER_NOT_ASSOCIATED and any HIGH severity findings with score=95
 // when both conditions exist (global scope).
@@ -1390,6 +1592,98 @@ func TestCorrelationEngine_Chain5_NoIRSAAndDefaultSA(t *testing.T) {
 	}
 }
 
+// gkeWorkloadIdentityStubRule stands in for the GKE pack's
+// GKE_WORKLOAD_IDENTITY_DISABLED rule: on a cluster detected as GKE it reports
+// every ServiceAccount in namespace.
+type gkeWorkloadIdentityStubRule struct{ namespace string }
+
+func (gkeWorkloadIdentityStubRule) ID() string   { return "GKE_WORKLOAD_IDENTITY_DISABLED" }
+func (gkeWorkloadIdentityStubRule) Name() string { return "GKE Workload Identity Disabled" }
+func (r gkeWorkloadIdentityStubRule) Evaluate(ctx rules.RuleContext) []models.Finding {
+	if ctx.ClusterData == nil || ctx.ClusterData.ClusterProvider != "gke" {
+		return nil
+	}
+	var findings []models.Finding
+	for _, sa := range ctx.ClusterData.ServiceAccounts {
+		if sa.Namespace != r.namespace {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:           "GKE_WORKLOAD_IDENTITY_DISABLED:" + sa.Namespace + "/" + sa.Name,
+			RuleID:       r.ID(),
+			ResourceID:   sa.Name,
+			ResourceType: models.ResourceK8sServiceAccount,
+			Severity:     models.SeverityHigh,
+			Metadata:     map[string]any{"namespace": sa.Namespace},
+		})
+	}
+	return findings
+}
+
+// TestCorrelationEngine_Chain8_NoWorkloadIdentityAndDefaultSA verifies that at
+// engine level, on a GKE cluster, GKE_WORKLOAD_IDENTITY_DISABLED and
+// K8S_DEFAULT_SERVICEACCOUNT_USED findings in the same namespace both receive
+// risk_chain_score=88.
+func TestCorrelationEngine_Chain8_NoWorkloadIdentityAndDefaultSA(t *testing.T) {
+	gkeNode := k8sNode("gke-node-1", "4", "8Gi", "3800m", "7Gi")
+	gkeNode.Labels = map[string]string{"cloud.google.com/gke-nodepool": "default-pool"}
+	gkeNode.Spec.ProviderID = "gce://proj/us-central1-a/gke-node-1"
+	gkeNode2 := gkeNode.DeepCopy()
+	gkeNode2.Name = "gke-node-2"
+
+	noAutomount := false
+	appSA := &corev1.ServiceAccount{
+		ObjectMeta:                   metav1.ObjectMeta{Name: "app-sa", Namespace: "prod"},
+		AutomountServiceAccountToken: &noAutomount, // disable to isolate chain 8 from chain 2
+	}
+	defaultSAPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-pod", Namespace: "prod"},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "default", // fires K8S_DEFAULT_SERVICEACCOUNT_USED
+			Containers:         []corev1.Container{{Name: "app", Image: "nginx"}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(gkeNode, gkeNode2, k8sNamespace("prod"), appSA, defaultSAPod)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "chain8-ctx"},
+	}
+	registry := rules.NewDefaultRuleRegistry()
+	for _, r := range k8scorepack.New(nil) {
+		registry.Register(r)
+	}
+	registry.Register(gkeWorkloadIdentityStubRule{namespace: "prod"})
+
+	report, err := NewKubernetesEngine(provider, registry, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	if got := report.Metadata["cluster_provider"]; got != "gke" {
+		t.Fatalf("cluster_provider = %v; want gke", got)
+	}
+
+	var noWIAnnotated, defaultSAAnnotated bool
+	for i := range report.Findings {
+		f := &report.Findings[i]
+		ids := ruleIDsForFinding(f)
+		if idsContain(ids, "GKE_WORKLOAD_IDENTITY_DISABLED") {
+			score, _ := f.Metadata["risk_chain_score"].(int)
+			noWIAnnotated = score == 88
+		}
+		if idsContain(ids, "K8S_DEFAULT_SERVICEACCOUNT_USED") {
+			score, _ := f.Metadata["risk_chain_score"].(int)
+			defaultSAAnnotated = score == 88
+		}
+	}
+	if !noWIAnnotated {
+		t.Error("GKE_WORKLOAD_IDENTITY_DISABLED finding should have risk_chain_score=88 (chain 8)")
+	}
+	if !defaultSAAnnotated {
+		t.Error("K8S_DEFAULT_SERVICEACCOUNT_USED finding should have risk_chain_score=88 (chain 8)")
+	}
+}
+
 // TestCorrelationEngine_Chain6_OIDCMissingAndHighFinding verifies that at engine
 // level, EKS_OIDC_PROVIDER_NOT_ASSOCIATED and any co-existing HIGH finding both
 // receive risk_chain_score=95.
@@ -1487,6 +1781,165 @@ func TestCorrelationEngine_Chain6Beats4_HighestScoreWins(t *testing.T) {
 	}
 }
 
+// ── Chain 9: over-permissive node role + default SA ──────────────────────────
+
+// TestCorrelateRiskChains_Chain9_DirectUnit verifies that chain 9 annotates
+// both EKS_NODE_ROLE_OVERPERMISSIVE (cluster-scoped) and
+// K8S_DEFAULT_SERVICEACCOUNT_USED (any namespace) with score=82.
+func TestCorrelateRiskChains_Chain9_DirectUnit(t *testing.T) {
+	findings := []models.Finding{
+		{
+			RuleID:       "EKS_NODE_ROLE_OVERPERMISSIVE",
+			ResourceType: models.ResourceK8sCluster,
+			ResourceID:   "eks-cluster",
+			Severity:     models.SeverityCritical,
+		},
+		{
+			RuleID:       "K8S_DEFAULT_SERVICEACCOUNT_USED",
+			ResourceType: models.ResourceK8sPod,
+			ResourceID:   "app-pod",
+			Severity:     models.SeverityMedium,
+			Metadata:     map[string]any{"namespace": "team-a"},
+		},
+	}
+	correlateRiskChains(findings)
+
+	for _, f := range findings {
+		score, ok := f.Metadata["risk_chain_score"].(int)
+		if !ok || score != 82 {
+			t.Errorf("finding %q: risk_chain_score = %v; want 82 (chain 9)",
+				f.RuleID, f.Metadata["risk_chain_score"])
+		}
+		reason, _ := f.Metadata["risk_chain_reason"].(string)
+		if reason != "Over-permissive node role reachable via default service account" {
+			t.Errorf("finding %q: risk_chain_reason = %q; want chain 9 reason", f.RuleID, reason)
+		}
+	}
+}
+
+// TestCorrelateRiskChains_Chain9_Negative_NoDefaultSA verifies that chain 9 does
+// NOT fire when the node role is over-permissive but no pod uses the default SA.
+func TestCorrelateRiskChains_Chain9_Negative_NoDefaultSA(t *testing.T) {
+	findings := []models.Finding{
+		{
+			RuleID:       "EKS_NODE_ROLE_OVERPERMISSIVE",
+			ResourceType: models.ResourceK8sCluster,
+			ResourceID:   "eks-cluster",
+			Severity:     models.SeverityCritical,
+		},
+		{
+			RuleID:       "K8S_POD_NO_SECCOMP",
+			ResourceType: models.ResourceK8sPod,
+			ResourceID:   "app-pod",
+			Severity:     models.SeverityMedium,
+			Metadata:     map[string]any{"namespace": "team-a"},
+		},
+	}
+	correlateRiskChains(findings)
+	for _, f := range findings {
+		if _, ok := f.Metadata["risk_chain_score"]; ok {
+			t.Errorf("finding %q should not have a chain annotation without default SA usage; got %v",
+				f.RuleID, f.Metadata["risk_chain_score"])
+		}
+	}
+}
+
+// TestCorrelateRiskChains_Chain9_Chain5_HighestScoreWins verifies that when
+// chain 5 (85) also applies to the default-SA finding, the higher score wins,
+// while the node-role finding (not part of chain 5) keeps chain 9's 82.
+func TestCorrelateRiskChains_Chain9_Chain5_HighestScoreWins(t *testing.T) {
+	findings := []models.Finding{
+		{
+			RuleID:       "EKS_NODE_ROLE_OVERPERMISSIVE",
+			ResourceType: models.ResourceK8sCluster,
+			ResourceID:   "eks-cluster",
+			Severity:     models.SeverityCritical,
+		},
+		{
+			RuleID:       "K8S_DEFAULT_SERVICEACCOUNT_USED",
+			ResourceType: models.ResourceK8sPod,
+			ResourceID:   "app-pod",
+			Severity:     models.SeverityMedium,
+			Metadata:     map[string]any{"namespace": "prod"},
+		},
+		{
+			RuleID:       "EKS_SERVICEACCOUNT_NO_IRSA",
+			ResourceType: models.ResourceK8sServiceAccount,
+			ResourceID:   "app-sa",
+			Severity:     models.SeverityMedium,
+			Metadata:     map[string]any{"namespace": "prod"},
+		},
+	}
+	correlateRiskChains(findings)
+
+	want := map[string]int{
+		"EKS_NODE_ROLE_OVERPERMISSIVE":    82,
+		"K8S_DEFAULT_SERVICEACCOUNT_USED": 85,
+		"EKS_SERVICEACCOUNT_NO_IRSA":      85,
+	}
+	for _, f := range findings {
+		if got := getRiskScore(f); got != want[f.RuleID] {
+			t.Errorf("finding %q: risk_chain_score = %d; want %d", f.RuleID, got, want[f.RuleID])
+		}
+	}
+}
+
+// TestCorrelationEngine_Chain9_NodeRoleAndDefaultSA verifies that at engine
+// level, EKS_NODE_ROLE_OVERPERMISSIVE and a K8S_DEFAULT_SERVICEACCOUNT_USED
+// finding in an unrelated namespace both receive risk_chain_score=82.
+func TestCorrelationEngine_Chain9_NodeRoleAndDefaultSA(t *testing.T) {
+	eksData := &models.KubernetesEKSData{
+		ClusterName:          "chain9-cluster",
+		Region:               "us-east-1",
+		EndpointPublicAccess: false,
+		LoggingTypes:         []string{"api", "audit", "authenticator"},
+		EncryptionEnabled:    true,
+		OIDCProviderARN:      "arn:aws:iam::123:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OK",
+		NodeRolePolicies:     []string{"AdministratorAccess"}, // fires EKS_NODE_ROLE_OVERPERMISSIVE
+	}
+	defaultSAPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch-pod", Namespace: "batch"},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "default", // fires K8S_DEFAULT_SERVICEACCOUNT_USED
+			Containers:         []corev1.Container{{Name: "job", Image: "busybox"}},
+		},
+	}
+	fakeClient := fake.NewSimpleClientset(
+		eksNode("node-1", "us-east-1a"),
+		eksNode("node-2", "us-east-1b"),
+		k8sNamespace("batch"),
+		defaultSAPod,
+	)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "chain9-ctx"},
+	}
+
+	eng := newEKSEngine(provider, &fakeEKSCollector{data: eksData})
+	report, err := eng.RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	var nodeRoleAnnotated, defaultSAAnnotated bool
+	for i := range report.Findings {
+		f := &report.Findings[i]
+		ids := ruleIDsForFinding(f)
+		if idsContain(ids, "EKS_NODE_ROLE_OVERPERMISSIVE") {
+			nodeRoleAnnotated = getRiskScore(*f) == 82
+		}
+		if idsContain(ids, "K8S_DEFAULT_SERVICEACCOUNT_USED") {
+			defaultSAAnnotated = getRiskScore(*f) == 82
+		}
+	}
+	if !nodeRoleAnnotated {
+		t.Error("EKS_NODE_ROLE_OVERPERMISSIVE finding should have risk_chain_score=82 (chain 9)")
+	}
+	if !defaultSAAnnotated {
+		t.Error("K8S_DEFAULT_SERVICEACCOUNT_USED finding should have risk_chain_score=82 (chain 9)")
+	}
+}
+
 // ── Phase 5D: buildRiskChains unit tests ──────────────────────────────────────
 
 // TestBuildRiskChains_Empty verifies that an empty finding slice yields nil chains.
@@ -1501,7 +1954,7 @@ func TestBuildRiskChains_Empty(t *testing.T) {
 // risk_chain_score produce no chains.
 func TestBuildRiskChains_NoChainFindings(t *testing.T) {
 	findings := []models.Finding{
-		{ID: "f1", RuleID: "K8S_CLUSTER_SINGLE_NODE", Severity: models.SeverityHigh},
+		{ID: "f1", RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
 		{ID: "f2", RuleID: "K8S_NAMESPACE_WITHOUT_LIMITS", Severity: models.SeverityMedium},
 	}
 	chains := buildRiskChains(findings)
@@ -1588,7 +2041,7 @@ func TestBuildRiskChains_MultipleChains(t *testing.T) {
 		},
 		{
 			ID:     "unchained",
-			RuleID: "K8S_CLUSTER_SINGLE_NODE",
+			RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES",
 		},
 	}
 	chains := buildRiskChains(findings)
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// DiffEntry identifies one (rule, namespace, resource) combination that was
+// found in only one of two compared reports. Namespace is empty for
+// cluster-scoped findings, and ResourceID is empty for findings on the
+// cluster itself (K8S_CLUSTER), whose resource ID names the cluster and would
+// never match across two clusters.
+type DiffEntry struct {
+	RuleID     string `json:"rule_id"`
+	Namespace  string `json:"namespace,omitempty"`
+	ResourceID string `json:"resource_id"`
+}
+
+// ReportDiff is the result of comparing two audit reports. A and B name the
+// compared reports (the kubeconfig context for Kubernetes audits).
+type ReportDiff struct {
+	A       string      `json:"a"`
+	B       string      `json:"b"`
+	OnlyInA []DiffEntry `json:"only_in_a"`
+	OnlyInB []DiffEntry `json:"only_in_b"`
+}
+
+// DiffReports compares the findings of a and b keyed by
+// (RuleID, namespace, ResourceID) and returns the entries present in one
+// report but not the other. Every rule ID of a merged finding
+// (Metadata["rules"]) is compared, so a rule that fires on the same resource
+// in both reports is not reported even when it is merged under a different
+// primary rule. Both entry lists are sorted by rule ID, namespace, and
+// resource ID.
+func DiffReports(a, b *models.AuditReport) ReportDiff {
+	keysA := diffKeys(a)
+	keysB := diffKeys(b)
+
+	d := ReportDiff{A: a.Profile, B: b.Profile}
+	for k := range keysA {
+		if !keysB[k] {
+			d.OnlyInA = append(d.OnlyInA, k)
+		}
+	}
+	for k := range keysB {
+		if !keysA[k] {
+			d.OnlyInB = append(d.OnlyInB, k)
+		}
+	}
+	sortDiffEntries(d.OnlyInA)
+	sortDiffEntries(d.OnlyInB)
+	return d
+}
+
+// RunContextDiff audits opts.ContextName and diffContext with the same
+// options and returns the findings present in only one of the two clusters.
+func (e *KubernetesEngine) RunContextDiff(ctx context.Context, opts KubernetesAuditOptions, diffContext string) (ReportDiff, error) {
+	a, err := e.RunAudit(ctx, opts)
+	if err != nil {
+		return ReportDiff{}, err
+	}
+	diffOpts := opts
+	diffOpts.ContextName = diffContext
+	b, err := e.RunAudit(ctx, diffOpts)
+	if err != nil {
+		return ReportDiff{}, fmt.Errorf("audit context %q: %w", diffContext, err)
+	}
+	return DiffReports(a, b), nil
+}
+
+// diffKeys returns the set of (RuleID, namespace, ResourceID) keys produced
+// by report's findings.
+func diffKeys(report *models.AuditReport) map[DiffEntry]bool {
+	keys := make(map[DiffEntry]bool)
+	for i := range report.Findings {
+		f := &report.Findings[i]
+		ns := resolveNamespaceForFinding(f)
+		resourceID := f.ResourceID
+		if f.ResourceType == models.ResourceK8sCluster {
+			resourceID = ""
+		}
+		for _, id := range ruleIDsForFinding(f) {
+			keys[DiffEntry{RuleID: id, Namespace: ns, ResourceID: resourceID}] = true
+		}
+	}
+	return keys
+}
+
+func sortDiffEntries(entries []DiffEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].RuleID != entries[j].RuleID {
+			return entries[i].RuleID < entries[j].RuleID
+		}
+		if entries[i].Namespace != entries[j].Namespace {
+			return entries[i].Namespace < entries[j].Namespace
+		}
+		return entries[i].ResourceID < entries[j].ResourceID
+	})
+}
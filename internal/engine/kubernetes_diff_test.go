@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// newDiffProvider returns two clusters with identical nodes; "prod" also runs
+// one privileged pod that "staging" does not.
+func newDiffProvider() *fakeMultiContextProvider {
+	return &fakeMultiContextProvider{
+		contexts: []string{"prod", "staging"},
+		clientsets: map[string]k8sclient.Interface{
+			"prod": fake.NewSimpleClientset(
+				k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+				pssPrivilegedPod("debug", "payments"),
+			),
+			"staging": fake.NewSimpleClientset(
+				k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+			),
+		},
+	}
+}
+
+func TestRunContextDiff_PrivilegedPodOnlyInA(t *testing.T) {
+	diff, err := newK8sEngine(newDiffProvider(), nil).
+		RunContextDiff(context.Background(), KubernetesAuditOptions{ContextName: "prod"}, "staging")
+	if err != nil {
+		t.Fatalf("RunContextDiff error: %v", err)
+	}
+	if diff.A != "prod" || diff.B != "staging" {
+		t.Errorf("diff sides = (%q, %q); want (prod, staging)", diff.A, diff.B)
+	}
+	if len(diff.OnlyInB) != 0 {
+		t.Errorf("OnlyInB = %v; want empty", diff.OnlyInB)
+	}
+
+	want := DiffEntry{RuleID: "K8S_POD_PRIVILEGED_CONTAINER", Namespace: "payments", ResourceID: "debug"}
+	found := false
+	for _, e := range diff.OnlyInA {
+		if e.ResourceID != "debug" || e.Namespace != "payments" {
+			t.Errorf("unexpected OnlyInA entry %+v; only the privileged pod should differ", e)
+		}
+		if e == want {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("OnlyInA = %v; want it to contain %+v", diff.OnlyInA, want)
+	}
+
+	// The single-node finding fires in both clusters on the same node name and
+	// must not appear in the diff.
+	for _, e := range diff.OnlyInA {
+		if e.RuleID == "K8S_CLUSTER_INSUFFICIENT_NODES" {
+			t.Errorf("shared finding %+v reported as a difference", e)
+		}
+	}
+}
+
+func TestRunContextDiff_Reversed(t *testing.T) {
+	diff, err := newK8sEngine(newDiffProvider(), nil).
+		RunContextDiff(context.Background(), KubernetesAuditOptions{ContextName: "staging"}, "prod")
+	if err != nil {
+		t.Fatalf("RunContextDiff error: %v", err)
+	}
+	if len(diff.OnlyInA) != 0 {
+		t.Errorf("OnlyInA = %v; want empty", diff.OnlyInA)
+	}
+	if len(diff.OnlyInB) == 0 {
+		t.Error("OnlyInB is empty; want the privileged pod findings")
+	}
+}
+
+func TestRunContextDiff_UnreachableDiffContext(t *testing.T) {
+	provider := newDiffProvider()
+	provider.unreachable = map[string]bool{"staging": true}
+	if _, err := newK8sEngine(provider, nil).
+		RunContextDiff(context.Background(), KubernetesAuditOptions{ContextName: "prod"}, "staging"); err == nil {
+		t.Error("expected error when the diff context is unreachable")
+	}
+}
+
+func TestDiffReports_MergedRulesCompared(t *testing.T) {
+	// In A the two rules merge under RUN_AS_ROOT; in B only CAP_SYS_ADMIN
+	// fires. CAP_SYS_ADMIN is shared, so only RUN_AS_ROOT differs.
+	a := &models.AuditReport{Profile: "a", Findings: []models.Finding{{
+		RuleID: "K8S_POD_RUN_AS_ROOT", ResourceID: "web",
+		Metadata: map[string]any{
+			"namespace": "prod",
+			"rules":     []string{"K8S_POD_RUN_AS_ROOT", "K8S_POD_CAP_SYS_ADMIN"},
+		},
+	}}}
+	b := &models.AuditReport{Profile: "b", Findings: []models.Finding{{
+		RuleID: "K8S_POD_CAP_SYS_ADMIN", ResourceID: "web",
+		Metadata: map[string]any{"namespace": "prod"},
+	}}}
+
+	diff := DiffReports(a, b)
+	want := DiffEntry{RuleID: "K8S_POD_RUN_AS_ROOT", Namespace: "prod", ResourceID: "web"}
+	if len(diff.OnlyInA) != 1 || diff.OnlyInA[0] != want {
+		t.Errorf("OnlyInA = %v; want [%+v]", diff.OnlyInA, want)
+	}
+	if len(diff.OnlyInB) != 0 {
+		t.Errorf("OnlyInB = %v; want empty", diff.OnlyInB)
+	}
+}
+
+func TestDiffReports_NamespaceDistinguishesResources(t *testing.T) {
+	a := &models.AuditReport{Findings: []models.Finding{{
+		RuleID: "K8S_DEFAULT_SERVICEACCOUNT_USED", ResourceID: "api",
+		Metadata: map[string]any{"namespace": "team-a"},
+	}}}
+	b := &models.AuditReport{Findings: []models.Finding{{
+		RuleID: "K8S_DEFAULT_SERVICEACCOUNT_USED", ResourceID: "api",
+		Metadata: map[string]any{"namespace": "team-b"},
+	}}}
+
+	diff := DiffReports(a, b)
+	if len(diff.OnlyInA) != 1 || len(diff.OnlyInB) != 1 {
+		t.Errorf("diff = %+v; want one entry on each side", diff)
+	}
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -91,7 +92,7 @@ func regionFromAZ(az string) string {
 // fake EKS collector.
 func newEKSEngine(provider kube.KubeClientProvider, eksCollector EKSDataCollector) *KubernetesEngine {
 	coreReg := rules.NewDefaultRuleRegistry()
-	for _, r := range k8scorepack.New() {
+	for _, r := range k8scorepack.New(nil) {
 		coreReg.Register(r)
 	}
 	eksReg := rules.NewDefaultRuleRegistry()
@@ -299,6 +300,51 @@ func TestKubernetesEngine_GKE_ProviderDetected(t *testing.T) {
 	}
 }
 
+// TestKubernetesEngine_ReportProvider verifies that the detected cluster
+// provider is promoted to the top-level "provider" field of the JSON report.
+func TestKubernetesEngine_ReportProvider(t *testing.T) {
+	aksNode := k8sNode("aks-node-1", "4", "8Gi", "3800m", "7Gi")
+	aksNode.Spec.ProviderID = "azure:///subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/aks-node-1"
+
+	tests := []struct {
+		name string
+		node *corev1.Node
+		want string
+	}{
+		{"eks", eksNode("node-1", "us-east-1a"), "eks"},
+		{"gke", gkeNode("gke-node-1"), "gke"},
+		{"aks", aksNode, "aks"},
+		{"unknown", k8sNode("kind-node-1", "4", "8Gi", "3800m", "7Gi"), "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &fakeKubeProvider{
+				clientset: fake.NewSimpleClientset(tt.node),
+				info:      kube.ClusterInfo{ContextName: tt.name + "-test"},
+			}
+			report, err := newEKSEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+			if err != nil {
+				t.Fatalf("RunAudit error: %v", err)
+			}
+			if report.Provider != tt.want {
+				t.Errorf("Provider = %q; want %q", report.Provider, tt.want)
+			}
+
+			data, err := json.Marshal(report)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			var top map[string]any
+			if err := json.Unmarshal(data, &top); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if top["provider"] != tt.want {
+				t.Errorf(`JSON "provider" = %v; want %q`, top["provider"], tt.want)
+			}
+		})
+	}
+}
+
 // TestKubernetesEngine_EKS_RulesFire verifies that Phase 5A EKS-specific rules
 // fire for a cluster with a public endpoint, no required log types, and no encryption.
 func TestKubernetesEngine_EKS_RulesFire(t *testing.T) {
@@ -421,6 +467,12 @@ func TestKubernetesEngine_EKS_CollectorFailure(t *testing.T) {
 	if prov := report.Metadata["cluster_provider"]; prov != "eks" {
 		t.Errorf("cluster_provider = %q; want eks", prov)
 	}
+
+	// The failure is surfaced in report.Errors.
+	if len(report.Errors) != 1 || report.Errors[0].Provider != "aws/eks" ||
+		report.Errors[0].Stage != models.AuditStageCollect {
+		t.Errorf("Errors = %+v; want one aws/eks collect error", report.Errors)
+	}
 }
 
 // TestKubernetesEngine_NonEKS_EKSRulesNotEvaluated verifies that EKS rules
@@ -513,6 +565,62 @@ func TestKubernetesEngine_EKS_EncryptionDisabled_IsCritical(t *testing.T) {
 	}
 }
 
+// TestKubernetesEngine_EKS_SecretUnencrypted_MergedWithEncryptionDisabled
+// verifies that K8S_SECRET_UNENCRYPTED_ETCD and EKS_ENCRYPTION_DISABLED are
+// reported as a single cluster finding, so the cluster is not counted twice.
+func TestKubernetesEngine_EKS_SecretUnencrypted_MergedWithEncryptionDisabled(t *testing.T) {
+	eksData := &models.KubernetesEKSData{
+		ClusterName:  "no-enc-cluster",
+		Region:       "us-east-1",
+		LoggingTypes: []string{"api", "audit", "authenticator"},
+	}
+	fakeClient := fake.NewSimpleClientset(
+		eksNode("node-1", "us-east-1a"),
+		eksNode("node-2", "us-east-1b"),
+	)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "eks-no-enc"},
+	}
+
+	eng := newEKSEngine(provider, &fakeEKSCollector{data: eksData})
+	report, err := eng.RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	var carriers []models.Finding
+	for _, f := range report.Findings {
+		ids := ruleIDsForFinding(&f)
+		if idsContain(ids, "K8S_SECRET_UNENCRYPTED_ETCD") || idsContain(ids, "EKS_ENCRYPTION_DISABLED") {
+			carriers = append(carriers, f)
+		}
+	}
+	if len(carriers) != 1 {
+		t.Fatalf("expected 1 finding carrying the encryption rules; got %d", len(carriers))
+	}
+	ids := ruleIDsForFinding(&carriers[0])
+	if !idsContain(ids, "K8S_SECRET_UNENCRYPTED_ETCD") || !idsContain(ids, "EKS_ENCRYPTION_DISABLED") {
+		t.Errorf("merged rule IDs = %v; want both encryption rules", ids)
+	}
+	if carriers[0].Severity != models.SeverityCritical {
+		t.Errorf("merged severity = %q; want CRITICAL", carriers[0].Severity)
+	}
+
+	// With envelope encryption on secrets, neither rule fires.
+	eksData.EncryptionEnabled = true
+	eksData.EncryptedResources = []string{"secrets"}
+	report, err = eng.RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	for _, f := range report.Findings {
+		if idsContain(ruleIDsForFinding(&f), "K8S_SECRET_UNENCRYPTED_ETCD") {
+			t.Errorf("unexpected K8S_SECRET_UNENCRYPTED_ETCD with secrets encrypted")
+		}
+	}
+}
+
 // TestKubernetesEngine_EKS_PartialLogging_Fires verifies that
 // EKS_CONTROL_PLANE_LOGGING_DISABLED fires when only some required log types
 // are enabled (e.g. "api" and "audit" but not "authenticator").
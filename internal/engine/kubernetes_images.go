@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// buildImageInventory lists the distinct container images run by pods, with
+// the number of pods and containers using each image and the namespaces they
+// run in. Init containers are included. Entries are sorted by image
+// reference; containers with an empty image are skipped.
+func buildImageInventory(pods []models.KubernetesPodData) []models.KubernetesImage {
+	type acc struct {
+		pods       int
+		containers int
+		namespaces map[string]struct{}
+	}
+	byImage := make(map[string]*acc)
+	for _, p := range pods {
+		seen := make(map[string]struct{})
+		containers := append(p.InitContainers[:len(p.InitContainers):len(p.InitContainers)], p.Containers...)
+		for _, c := range containers {
+			if c.Image == "" {
+				continue
+			}
+			a := byImage[c.Image]
+			if a == nil {
+				a = &acc{namespaces: make(map[string]struct{})}
+				byImage[c.Image] = a
+			}
+			a.containers++
+			if _, ok := seen[c.Image]; !ok {
+				seen[c.Image] = struct{}{}
+				a.pods++
+				a.namespaces[p.Namespace] = struct{}{}
+			}
+		}
+	}
+
+	images := make([]models.KubernetesImage, 0, len(byImage))
+	for image, a := range byImage {
+		images = append(images, models.KubernetesImage{
+			Image:      image,
+			Pods:       a.pods,
+			Containers: a.containers,
+			Namespaces: sortedKeys(a.namespaces),
+		})
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].Image < images[j].Image })
+	return images
+}
+
+// mergeImageInventories combines per-cluster image inventories, summing pod
+// and container counts per image and taking the union of namespaces.
+func mergeImageInventories(inventories ...[]models.KubernetesImage) []models.KubernetesImage {
+	var (
+		order      []string
+		byImage    = make(map[string]*models.KubernetesImage)
+		namespaces = make(map[string]map[string]struct{})
+	)
+	for _, inv := range inventories {
+		for _, img := range inv {
+			m := byImage[img.Image]
+			if m == nil {
+				m = &models.KubernetesImage{Image: img.Image}
+				byImage[img.Image] = m
+				namespaces[img.Image] = make(map[string]struct{})
+				order = append(order, img.Image)
+			}
+			m.Pods += img.Pods
+			m.Containers += img.Containers
+			for _, ns := range img.Namespaces {
+				namespaces[img.Image][ns] = struct{}{}
+			}
+		}
+	}
+	sort.Strings(order)
+	merged := make([]models.KubernetesImage, 0, len(order))
+	for _, image := range order {
+		m := byImage[image]
+		m.Namespaces = sortedKeys(namespaces[image])
+		merged = append(merged, *m)
+	}
+	return merged
+}
+
+// sortedKeys returns the keys of set in ascending order.
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
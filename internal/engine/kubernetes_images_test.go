@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+)
+
+func imagePod(namespace, name string, images ...string) models.KubernetesPodData {
+	p := models.KubernetesPodData{Name: name, Namespace: namespace}
+	for _, img := range images {
+		p.Containers = append(p.Containers, models.KubernetesContainerData{Name: img, Image: img})
+	}
+	return p
+}
+
+func TestBuildImageInventory_DedupsAndCounts(t *testing.T) {
+	sidecar := imagePod("web", "api-1", "app:1.0", "envoy:1.29")
+	sidecar.InitContainers = []models.KubernetesContainerData{{Name: "init", Image: "envoy:1.29"}}
+	pods := []models.KubernetesPodData{
+		imagePod("default", "nginx-1", "nginx:1.25"),
+		imagePod("web", "nginx-2", "nginx:1.25"),
+		imagePod("web", "nginx-3", "nginx:1.25"),
+		sidecar,
+		imagePod("web", "no-image", ""),
+	}
+
+	got := buildImageInventory(pods)
+	want := []models.KubernetesImage{
+		{Image: "app:1.0", Pods: 1, Containers: 1, Namespaces: []string{"web"}},
+		{Image: "envoy:1.29", Pods: 1, Containers: 2, Namespaces: []string{"web"}},
+		{Image: "nginx:1.25", Pods: 3, Containers: 3, Namespaces: []string{"default", "web"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("buildImageInventory =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestMergeImageInventories_SumsAcrossClusters(t *testing.T) {
+	a := []models.KubernetesImage{
+		{Image: "nginx:1.25", Pods: 2, Containers: 2, Namespaces: []string{"web"}},
+	}
+	b := []models.KubernetesImage{
+		{Image: "app:1.0", Pods: 1, Containers: 1, Namespaces: []string{"api"}},
+		{Image: "nginx:1.25", Pods: 1, Containers: 1, Namespaces: []string{"default", "web"}},
+	}
+
+	got := mergeImageInventories(a, b)
+	want := []models.KubernetesImage{
+		{Image: "app:1.0", Pods: 1, Containers: 1, Namespaces: []string{"api"}},
+		{Image: "nginx:1.25", Pods: 3, Containers: 3, Namespaces: []string{"default", "web"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeImageInventories =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func imageK8sPod(namespace, name, image string) *corev1.Pod {
+	p := k8sPod(namespace, name, false, "100m", "128Mi")
+	p.Spec.Containers[0].Image = image
+	return p
+}
+
+func TestKubernetesEngine_ImageInventory(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		imageK8sPod("default", "a", "nginx:1.25"),
+		imageK8sPod("web", "b", "nginx:1.25"),
+		imageK8sPod("web", "c", "redis:7"),
+	)
+	provider := &fakeKubeProvider{clientset: clientset, info: kube.ClusterInfo{ContextName: "ctx"}}
+
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{ImageInventory: true})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	images, ok := report.Metadata["images"].([]models.KubernetesImage)
+	if !ok {
+		t.Fatalf("Metadata[images] = %T; want []models.KubernetesImage", report.Metadata["images"])
+	}
+	if len(images) != 2 {
+		t.Fatalf("images = %+v; want 2 distinct images", images)
+	}
+	if images[0].Image != "nginx:1.25" || images[0].Pods != 2 ||
+		!reflect.DeepEqual(images[0].Namespaces, []string{"default", "web"}) {
+		t.Errorf("images[0] = %+v; want nginx:1.25 in 2 pods across default,web", images[0])
+	}
+	if images[1].Image != "redis:7" || images[1].Pods != 1 {
+		t.Errorf("images[1] = %+v; want redis:7 in 1 pod", images[1])
+	}
+}
+
+func TestKubernetesEngine_ImageInventory_OffByDefault(t *testing.T) {
+	clientset := fake.NewSimpleClientset(imageK8sPod("default", "a", "nginx:1.25"))
+	provider := &fakeKubeProvider{clientset: clientset, info: kube.ClusterInfo{ContextName: "ctx"}}
+
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	if _, ok := report.Metadata["images"]; ok {
+		t.Error("Metadata[images] set without ImageInventory")
+	}
+}
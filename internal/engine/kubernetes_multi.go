@@ -0,0 +1,169 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+)
+
+// RunAuditAllContexts audits every context in the provider's kubeconfig and
+// returns one merged report. opts.ContextName is ignored; every other option
+// applies to each cluster. Each finding is tagged with Metadata["cluster"]
+// set to its context name.
+//
+// Contexts that cannot be reached (connection or collection failure) are
+// skipped, listed in Metadata["unreachable_contexts"] and recorded in
+// report.Errors with Region set to the context name. An error is
+// returned only when the provider cannot list contexts or no context can be
+// audited.
+func (e *KubernetesEngine) RunAuditAllContexts(ctx context.Context, opts KubernetesAuditOptions) (*models.AuditReport, error) {
+	lister, ok := e.provider.(kube.KubeContextLister)
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig provider does not support listing contexts")
+	}
+	contexts, err := lister.ListContexts()
+	if err != nil {
+		return nil, fmt.Errorf("list kubeconfig contexts: %w", err)
+	}
+	if len(contexts) == 0 {
+		return nil, fmt.Errorf("no kubeconfig contexts found")
+	}
+
+	var (
+		reports     []*models.AuditReport
+		unreachable []string
+		errs        []models.AuditError
+		sw          = newStopwatch()
+	)
+	for _, name := range contexts {
+		ctxOpts := opts
+		ctxOpts.ContextName = name
+		report, err := e.RunAudit(ctx, ctxOpts)
+		if err != nil {
+			unreachable = append(unreachable, name)
+			errs = append(errs, collectError("kubernetes", name, err))
+			continue
+		}
+		for i := range report.Findings {
+			if report.Findings[i].Metadata == nil {
+				report.Findings[i].Metadata = make(map[string]any)
+			}
+			report.Findings[i].Metadata["cluster"] = name
+		}
+		sw.merge(report)
+		reports = append(reports, report)
+	}
+	if len(reports) == 0 {
+		return nil, fmt.Errorf("no reachable kubeconfig contexts (%d tried)", len(contexts))
+	}
+
+	merged := MergeReports(reports)
+	assignRiskGrade(&merged.Summary, e.policy)
+	if len(unreachable) > 0 {
+		merged.Metadata["unreachable_contexts"] = unreachable
+	}
+	merged.Errors = append(merged.Errors, errs...)
+	if opts.Timings {
+		sw.record(merged)
+	}
+	return merged, nil
+}
+
+// MergeReports combines per-cluster Kubernetes reports into one report.
+// Findings are concatenated without cross-report deduplication (each cluster's
+// resources are distinct) and re-sorted; the summary is recomputed, keeping
+// the highest RiskScore and concatenating attack paths and risk chains.
+// Compliance counts are summed, so each rule counts once per cluster.
+// Metadata["clusters"] lists the merged contexts and
+// Metadata["cluster_providers"] maps each context to its detected provider;
+// Provider is the shared provider, or "mixed" when the contexts differ.
+// Image inventories (Metadata["images"]) are combined per image, and
+// TeamRisks is recomputed from the merged findings when any input has it.
+// Errors are concatenated in report order.
+func MergeReports(reports []*models.AuditReport) *models.AuditReport {
+	var (
+		findings    []models.Finding
+		passed      []models.PassedResource
+		regions     []string
+		attackPaths []models.AttackPath
+		compliance  [][]models.FrameworkCompliance
+		riskScore   int
+		errs        []models.AuditError
+		images      [][]models.KubernetesImage
+		showChains  bool
+		byOwner     bool
+		providers   = make(map[string]any)
+		provider    string
+	)
+	for _, r := range reports {
+		findings = append(findings, r.Findings...)
+		passed = append(passed, r.PassedResources...)
+		regions = append(regions, r.Regions...)
+		attackPaths = append(attackPaths, r.Summary.AttackPaths...)
+		compliance = append(compliance, r.Summary.Compliance)
+		errs = append(errs, r.Errors...)
+		if r.Summary.RiskScore > riskScore {
+			riskScore = r.Summary.RiskScore
+		}
+		if r.Summary.RiskChains != nil || r.Summary.AttackPaths != nil {
+			showChains = true
+		}
+		if r.Summary.TeamRisks != nil {
+			byOwner = true
+		}
+		if p, ok := r.Metadata["cluster_provider"]; ok {
+			providers[r.Profile] = p
+		}
+		switch {
+		case provider == "":
+			provider = r.Provider
+		case r.Provider != provider:
+			provider = "mixed"
+		}
+		if inv, ok := r.Metadata["images"].([]models.KubernetesImage); ok {
+			images = append(images, inv)
+		}
+	}
+	sortFindings(findings)
+	sort.SliceStable(attackPaths, func(i, j int) bool {
+		return attackPaths[i].Score > attackPaths[j].Score
+	})
+
+	summary := computeSummary(findings)
+	summary.RiskScore = riskScore
+	summary.Compliance = mergeCompliance(compliance...)
+	if showChains {
+		summary.AttackPaths = attackPaths
+		summary.RiskChains = buildRiskChains(findings)
+	}
+	if byOwner {
+		summary.TeamRisks = computeTeamRisks(findings)
+	}
+
+	metadata := map[string]any{
+		"clusters":          regions,
+		"cluster_providers": providers,
+	}
+	if images != nil {
+		metadata["images"] = mergeImageInventories(images...)
+	}
+
+	return &models.AuditReport{
+		SchemaVersion:   models.ReportSchemaVersion,
+		ReportID:        fmt.Sprintf("k8s-%d", time.Now().UnixNano()),
+		GeneratedAt:     time.Now().UTC(),
+		AuditType:       "kubernetes",
+		Provider:        provider,
+		Profile:         "multi",
+		Regions:         regions,
+		Summary:         summary,
+		Findings:        findings,
+		PassedResources: passed,
+		Metadata:        metadata,
+		Errors:          errs,
+	}
+}
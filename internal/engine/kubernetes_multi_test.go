@@ -0,0 +1,170 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+)
+
+// fakeMultiContextProvider is a test double for kube.KubeClientProvider and
+// kube.KubeContextLister that serves one fake clientset per context. Contexts
+// listed in unreachable return a connection error.
+type fakeMultiContextProvider struct {
+	contexts    []string
+	clientsets  map[string]k8sclient.Interface
+	unreachable map[string]bool
+}
+
+func (f *fakeMultiContextProvider) ListContexts() ([]string, error) {
+	return f.contexts, nil
+}
+
+func (f *fakeMultiContextProvider) ClientsetForContext(name string) (k8sclient.Interface, kube.ClusterInfo, error) {
+	if f.unreachable[name] {
+		return nil, kube.ClusterInfo{}, errors.New("dial tcp: connection refused")
+	}
+	return f.clientsets[name], kube.ClusterInfo{ContextName: name, Server: "https://" + name}, nil
+}
+
+// newMultiContextProvider returns a provider with two single-node clusters
+// ("prod", "staging") and one unreachable context ("dead").
+func newMultiContextProvider() *fakeMultiContextProvider {
+	return &fakeMultiContextProvider{
+		contexts: []string{"dead", "prod", "staging"},
+		clientsets: map[string]k8sclient.Interface{
+			"prod":    fake.NewSimpleClientset(k8sNode("prod-node", "4", "8Gi", "3800m", "7Gi")),
+			"staging": fake.NewSimpleClientset(k8sNode("staging-node", "4", "8Gi", "3800m", "7Gi")),
+		},
+		unreachable: map[string]bool{"dead": true},
+	}
+}
+
+func TestRunAuditAllContexts_MergesReachableClusters(t *testing.T) {
+	report, err := newK8sEngine(newMultiContextProvider(), nil).
+		RunAuditAllContexts(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAuditAllContexts error: %v", err)
+	}
+
+	singleNodeByCluster := make(map[string]int)
+	for _, f := range report.Findings {
+		cluster, ok := f.Metadata["cluster"].(string)
+		if !ok {
+			t.Fatalf("finding %q (rule %q) missing cluster metadata", f.ResourceID, f.RuleID)
+		}
+		if f.RuleID == "K8S_CLUSTER_INSUFFICIENT_NODES" {
+			singleNodeByCluster[cluster]++
+		}
+	}
+	for _, c := range []string{"prod", "staging"} {
+		if singleNodeByCluster[c] != 1 {
+			t.Errorf("cluster %q: K8S_CLUSTER_INSUFFICIENT_NODES count = %d; want 1", c, singleNodeByCluster[c])
+		}
+	}
+	if report.Summary.TotalFindings != len(report.Findings) {
+		t.Errorf("Summary.TotalFindings = %d; want %d", report.Summary.TotalFindings, len(report.Findings))
+	}
+	if got := report.Metadata["clusters"].([]string); len(got) != 2 || got[0] != "prod" || got[1] != "staging" {
+		t.Errorf("Metadata[clusters] = %v; want [prod staging]", got)
+	}
+}
+
+func TestRunAuditAllContexts_RecordsUnreachableContexts(t *testing.T) {
+	report, err := newK8sEngine(newMultiContextProvider(), nil).
+		RunAuditAllContexts(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAuditAllContexts error: %v", err)
+	}
+	got, ok := report.Metadata["unreachable_contexts"].([]string)
+	if !ok || len(got) != 1 || got[0] != "dead" {
+		t.Errorf("Metadata[unreachable_contexts] = %v; want [dead]", report.Metadata["unreachable_contexts"])
+	}
+	if len(report.Errors) != 1 || report.Errors[0].Region != "dead" {
+		t.Errorf("Errors = %+v; want one error for context dead", report.Errors)
+	}
+	for _, f := range report.Findings {
+		if f.Metadata["cluster"] == "dead" {
+			t.Errorf("unexpected finding from unreachable context: %q", f.ID)
+		}
+	}
+}
+
+func TestRunAuditAllContexts_AllUnreachableReturnsError(t *testing.T) {
+	provider := &fakeMultiContextProvider{
+		contexts:    []string{"a", "b"},
+		unreachable: map[string]bool{"a": true, "b": true},
+	}
+	if _, err := newK8sEngine(provider, nil).RunAuditAllContexts(context.Background(), KubernetesAuditOptions{}); err == nil {
+		t.Error("expected error when no context is reachable")
+	}
+}
+
+func TestRunAuditAllContexts_ProviderWithoutListerReturnsError(t *testing.T) {
+	provider := &fakeKubeProvider{clientset: fake.NewSimpleClientset()}
+	if _, err := newK8sEngine(provider, nil).RunAuditAllContexts(context.Background(), KubernetesAuditOptions{}); err == nil {
+		t.Error("expected error when provider cannot list contexts")
+	}
+}
+
+func TestMergeReports_KeepsHighestRiskScoreAndResorts(t *testing.T) {
+	a := &models.AuditReport{
+		Profile:  "a",
+		Regions:  []string{"a"},
+		Summary:  models.AuditSummary{RiskScore: 50},
+		Findings: []models.Finding{{ID: "f1", RuleID: "R1", ResourceID: "x", Severity: models.SeverityMedium}},
+		Metadata: map[string]any{"cluster_provider": "eks"},
+	}
+	b := &models.AuditReport{
+		Profile:  "b",
+		Regions:  []string{"b"},
+		Summary:  models.AuditSummary{RiskScore: 80},
+		Findings: []models.Finding{{ID: "f2", RuleID: "R2", ResourceID: "y", Severity: models.SeverityCritical}},
+		Metadata: map[string]any{"cluster_provider": "gke"},
+	}
+
+	merged := MergeReports([]*models.AuditReport{a, b})
+	if merged.Summary.RiskScore != 80 {
+		t.Errorf("RiskScore = %d; want 80", merged.Summary.RiskScore)
+	}
+	if len(merged.Findings) != 2 || merged.Findings[0].ID != "f2" {
+		t.Errorf("findings not re-sorted by severity: %+v", merged.Findings)
+	}
+	if merged.Summary.CriticalFindings != 1 || merged.Summary.MediumFindings != 1 {
+		t.Errorf("summary = %+v; want 1 critical, 1 medium", merged.Summary)
+	}
+	providers := merged.Metadata["cluster_providers"].(map[string]any)
+	if providers["a"] != "eks" || providers["b"] != "gke" {
+		t.Errorf("cluster_providers = %v; want a=eks b=gke", providers)
+	}
+}
+
+func TestMergeReports_Provider(t *testing.T) {
+	tests := []struct {
+		providers []string
+		want      string
+	}{
+		{[]string{"eks", "eks"}, "eks"},
+		{[]string{"eks", "gke"}, "mixed"},
+		{[]string{"aks", "aks", "unknown"}, "mixed"},
+	}
+	for _, tt := range tests {
+		var reports []*models.AuditReport
+		for i, p := range tt.providers {
+			reports = append(reports, &models.AuditReport{
+				Profile:  fmt.Sprintf("ctx-%d", i),
+				Provider: p,
+				Metadata: map[string]any{"cluster_provider": p},
+			})
+		}
+		if got := MergeReports(reports).Provider; got != tt.want {
+			t.Errorf("providers %v: Provider = %q; want %q", tt.providers, got, tt.want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
 	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
 )
 
@@ -23,7 +24,7 @@ func TestAnnotateNamespaceType_SystemNamespace(t *testing.T) {
 			Metadata:     map[string]any{"namespace": "kube-system"},
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "system" {
 		t.Errorf("namespace_type = %q; want system", got)
 	}
@@ -37,7 +38,7 @@ func TestAnnotateNamespaceType_KubePublic(t *testing.T) {
 			Metadata:     map[string]any{"namespace": "kube-public"},
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "system" {
 		t.Errorf("namespace_type = %q; want system", got)
 	}
@@ -51,7 +52,7 @@ func TestAnnotateNamespaceType_KubeNodeLease(t *testing.T) {
 			Metadata:     map[string]any{"namespace": "kube-node-lease"},
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "system" {
 		t.Errorf("namespace_type = %q; want system", got)
 	}
@@ -66,7 +67,7 @@ func TestAnnotateNamespaceType_WorkloadNamespace(t *testing.T) {
 			Metadata:     map[string]any{"namespace": "production"},
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "workload" {
 		t.Errorf("namespace_type = %q; want workload", got)
 	}
@@ -81,7 +82,7 @@ func TestAnnotateNamespaceType_ClusterScoped(t *testing.T) {
 			// No Metadata set — cluster-scoped finding.
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "cluster" {
 		t.Errorf("namespace_type = %q; want cluster", got)
 	}
@@ -95,7 +96,7 @@ func TestAnnotateNamespaceType_NodeScoped(t *testing.T) {
 			Metadata:     map[string]any{},
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "cluster" {
 		t.Errorf("namespace_type = %q; want cluster", got)
 	}
@@ -111,7 +112,7 @@ func TestAnnotateNamespaceType_NamespaceFinding_System(t *testing.T) {
 			ResourceID:   "kube-system",
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "system" {
 		t.Errorf("namespace_type = %q; want system", got)
 	}
@@ -127,7 +128,7 @@ func TestAnnotateNamespaceType_NamespaceFinding_Workload(t *testing.T) {
 			ResourceID:   "staging",
 		},
 	}
-	annotateNamespaceType(findings)
+	annotateNamespaceType(findings, systemNamespaces)
 	if got := findings[0].Metadata["namespace_type"]; got != "workload" {
 		t.Errorf("namespace_type = %q; want workload", got)
 	}
@@ -142,7 +143,7 @@ func TestAnnotateNamespaceType_NilMetadata(t *testing.T) {
 			Metadata:     nil, // explicitly nil
 		},
 	}
-	annotateNamespaceType(findings) // must not panic
+	annotateNamespaceType(findings, systemNamespaces) // must not panic
 	if findings[0].Metadata == nil {
 		t.Fatal("Metadata was not initialised")
 	}
@@ -261,7 +262,7 @@ func TestEngine_WorkloadNamespace_TaggedWorkload(t *testing.T) {
 }
 
 // TestEngine_ClusterScoped_TaggedCluster verifies that a cluster-scoped finding
-// (K8S_CLUSTER_SINGLE_NODE) receives namespace_type="cluster".
+// (K8S_CLUSTER_INSUFFICIENT_NODES) receives namespace_type="cluster".
 func TestEngine_ClusterScoped_TaggedCluster(t *testing.T) {
 	cs := fake.NewSimpleClientset(
 		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"), // single node → fires
@@ -277,15 +278,15 @@ func TestEngine_ClusterScoped_TaggedCluster(t *testing.T) {
 	}
 
 	for _, f := range report.Findings {
-		if f.RuleID == "K8S_CLUSTER_SINGLE_NODE" {
+		if f.RuleID == "K8S_CLUSTER_INSUFFICIENT_NODES" {
 			nst, ok := f.Metadata["namespace_type"].(string)
 			if !ok || nst != "cluster" {
-				t.Errorf("K8S_CLUSTER_SINGLE_NODE namespace_type = %q; want cluster", nst)
+				t.Errorf("K8S_CLUSTER_INSUFFICIENT_NODES namespace_type = %q; want cluster", nst)
 			}
 			return
 		}
 	}
-	t.Error("expected K8S_CLUSTER_SINGLE_NODE finding; got none")
+	t.Error("expected K8S_CLUSTER_INSUFFICIENT_NODES finding; got none")
 }
 
 // TestEngine_NamespaceFinding_SystemTagged verifies that a namespace finding for
@@ -359,6 +360,94 @@ func TestEngine_ExcludeSystem_RemovesSystemFindings(t *testing.T) {
 	}
 }
 
+// podResourceIDs returns the ResourceIDs of the pod findings in report.
+func podResourceIDs(report *models.AuditReport) map[string]bool {
+	ids := make(map[string]bool)
+	for _, f := range report.Findings {
+		if f.ResourceType == models.ResourceK8sPod {
+			ids[f.ResourceID] = true
+		}
+	}
+	return ids
+}
+
+// systemNSClientset returns a two-node cluster with one privileged pod in each
+// of kube-system, istio-system, and production.
+func systemNSClientset() *fake.Clientset {
+	return fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNode("node-2", "4", "8Gi", "3800m", "7Gi"),
+		k8sPod("kube-system", "sys-priv", true, "100m", "128Mi"),
+		k8sPod("istio-system", "mesh-priv", true, "100m", "128Mi"),
+		k8sPod("production", "app-priv", true, "100m", "128Mi"),
+	)
+}
+
+// TestEngine_ExcludeSystem_ExtraSystemNamespace verifies that a namespace
+// passed via SystemNamespaces is excluded alongside the defaults.
+func TestEngine_ExcludeSystem_ExtraSystemNamespace(t *testing.T) {
+	provider := &fakeKubeProvider{clientset: systemNSClientset(), info: kube.ClusterInfo{ContextName: "extra-ctx"}}
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{
+		ExcludeSystem:    true,
+		SystemNamespaces: []string{"istio-system"},
+	})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	ids := podResourceIDs(report)
+	if ids["mesh-priv"] {
+		t.Error("istio-system finding should be excluded when listed in SystemNamespaces")
+	}
+	if ids["sys-priv"] {
+		t.Error("kube-system finding should still be excluded by the default set")
+	}
+	if !ids["app-priv"] {
+		t.Error("production finding should be retained")
+	}
+}
+
+// TestEngine_ExcludeSystem_PolicySystemNamespacesReplaceDefaults verifies that
+// dp.yaml system_namespaces replaces the default set.
+func TestEngine_ExcludeSystem_PolicySystemNamespacesReplaceDefaults(t *testing.T) {
+	policyCfg := &policy.PolicyConfig{Version: 1, SystemNamespaces: []string{"istio-system"}}
+	provider := &fakeKubeProvider{clientset: systemNSClientset(), info: kube.ClusterInfo{ContextName: "policy-ctx"}}
+	report, err := newK8sEngine(provider, policyCfg).RunAudit(context.Background(), KubernetesAuditOptions{
+		ExcludeSystem: true,
+	})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	ids := podResourceIDs(report)
+	if ids["mesh-priv"] {
+		t.Error("istio-system finding should be excluded when listed in system_namespaces")
+	}
+	if !ids["sys-priv"] {
+		t.Error("kube-system finding should be retained once system_namespaces replaces the defaults")
+	}
+}
+
+// TestEngine_ExcludeSystem_DefaultsWhenUnset verifies that without any
+// configuration only the default system namespaces are excluded.
+func TestEngine_ExcludeSystem_DefaultsWhenUnset(t *testing.T) {
+	provider := &fakeKubeProvider{clientset: systemNSClientset(), info: kube.ClusterInfo{ContextName: "unset-ctx"}}
+	report, err := newK8sEngine(provider, &policy.PolicyConfig{Version: 1}).RunAudit(context.Background(), KubernetesAuditOptions{
+		ExcludeSystem: true,
+	})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	ids := podResourceIDs(report)
+	if ids["sys-priv"] {
+		t.Error("kube-system finding should be excluded by default")
+	}
+	if !ids["mesh-priv"] || !ids["app-priv"] {
+		t.Errorf("istio-system and production findings should be retained; got %v", ids)
+	}
+}
+
 // TestEngine_ExcludeSystem_DefaultShowsAll verifies that the default (ExcludeSystem=false)
 // includes both system and workload findings.
 func TestEngine_ExcludeSystem_DefaultShowsAll(t *testing.T) {
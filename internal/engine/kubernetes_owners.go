@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// stampOwners sets Metadata["team"] on every finding to the team owning its
+// namespace under dp.yaml namespace_owners. Cluster-scoped findings and
+// namespaces without an owner get policy.UnassignedTeam.
+func stampOwners(findings []models.Finding, cfg *policy.PolicyConfig) {
+	for i := range findings {
+		f := &findings[i]
+		if f.Metadata == nil {
+			f.Metadata = make(map[string]any)
+		}
+		f.Metadata["team"] = policy.OwnerForNamespace(resolveNamespaceForFinding(f), cfg)
+	}
+}
+
+// computeTeamRisks aggregates findings by their Metadata["team"] (as set by
+// stampOwners; findings without one count as unassigned). Teams are sorted
+// by name with policy.UnassignedTeam last.
+func computeTeamRisks(findings []models.Finding) []models.TeamRisk {
+	byTeam := make(map[string]*models.TeamRisk)
+	namespaces := make(map[string]map[string]struct{})
+	for i := range findings {
+		f := &findings[i]
+		team, _ := f.Metadata["team"].(string)
+		if team == "" {
+			team = policy.UnassignedTeam
+		}
+		tr := byTeam[team]
+		if tr == nil {
+			tr = &models.TeamRisk{Team: team}
+			byTeam[team] = tr
+			namespaces[team] = make(map[string]struct{})
+		}
+		tr.TotalFindings++
+		switch f.Severity {
+		case models.SeverityCritical:
+			tr.CriticalFindings++
+		case models.SeverityHigh:
+			tr.HighFindings++
+		case models.SeverityMedium:
+			tr.MediumFindings++
+		case models.SeverityLow:
+			tr.LowFindings++
+		}
+		if ns := resolveNamespaceForFinding(f); ns != "" {
+			namespaces[team][ns] = struct{}{}
+		}
+	}
+
+	out := make([]models.TeamRisk, 0, len(byTeam))
+	for team, tr := range byTeam {
+		for ns := range namespaces[team] {
+			tr.Namespaces = append(tr.Namespaces, ns)
+		}
+		sort.Strings(tr.Namespaces)
+		out = append(out, *tr)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ui, uj := out[i].Team == policy.UnassignedTeam, out[j].Team == policy.UnassignedTeam
+		if ui != uj {
+			return uj
+		}
+		return out[i].Team < out[j].Team
+	})
+	return out
+}
@@ -0,0 +1,125 @@
+package engine
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+)
+
+func ownersPolicy() *policy.PolicyConfig {
+	return &policy.PolicyConfig{Version: 1, NamespaceOwners: map[string]string{
+		"payments-*": "payments",
+		"web":        "frontend",
+	}}
+}
+
+func TestStampOwnersAndComputeTeamRisks(t *testing.T) {
+	findings := []models.Finding{
+		{ResourceType: models.ResourceK8sPod, Severity: models.SeverityCritical, Metadata: map[string]any{"namespace": "payments-api"}},
+		{ResourceType: models.ResourceK8sPod, Severity: models.SeverityHigh, Metadata: map[string]any{"namespace": "payments-jobs"}},
+		{ResourceType: models.ResourceK8sNamespace, ResourceID: "web", Severity: models.SeverityMedium},
+		{ResourceType: models.ResourceK8sPod, Severity: models.SeverityLow, Metadata: map[string]any{"namespace": "billing"}},
+		{ResourceType: models.ResourceK8sNode, ResourceID: "node-1", Severity: models.SeverityHigh},
+	}
+	stampOwners(findings, ownersPolicy())
+
+	wantTeams := []string{"payments", "payments", "frontend", "unassigned", "unassigned"}
+	for i, want := range wantTeams {
+		if got := findings[i].Metadata["team"]; got != want {
+			t.Errorf("findings[%d] team = %v; want %s", i, got, want)
+		}
+	}
+
+	got := computeTeamRisks(findings)
+	want := []models.TeamRisk{
+		{Team: "frontend", Namespaces: []string{"web"}, TotalFindings: 1, MediumFindings: 1},
+		{Team: "payments", Namespaces: []string{"payments-api", "payments-jobs"}, TotalFindings: 2, CriticalFindings: 1, HighFindings: 1},
+		{Team: "unassigned", Namespaces: []string{"billing"}, TotalFindings: 2, HighFindings: 1, LowFindings: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("computeTeamRisks =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestStampOwners_NoMappingIsUnassigned(t *testing.T) {
+	findings := []models.Finding{{ResourceType: models.ResourceK8sPod, Metadata: map[string]any{"namespace": "payments-api"}}}
+	stampOwners(findings, nil)
+	if got := findings[0].Metadata["team"]; got != policy.UnassignedTeam {
+		t.Errorf("team = %v; want %s without namespace_owners", got, policy.UnassignedTeam)
+	}
+}
+
+func TestKubernetesEngine_GroupByOwner(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sPod("payments-api", "pay", true, "100m", "128Mi"),
+		k8sPod("billing", "bill", true, "100m", "128Mi"),
+	)
+	provider := &fakeKubeProvider{clientset: clientset, info: kube.ClusterInfo{ContextName: "ctx"}}
+
+	report, err := newK8sEngine(provider, ownersPolicy()).RunAudit(context.Background(), KubernetesAuditOptions{GroupByOwner: true})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	teams := make(map[string]models.TeamRisk)
+	for _, tr := range report.Summary.TeamRisks {
+		teams[tr.Team] = tr
+	}
+	if tr, ok := teams["payments"]; !ok || !reflect.DeepEqual(tr.Namespaces, []string{"payments-api"}) {
+		t.Errorf("payments team = %+v; want findings from payments-api", tr)
+	}
+	if tr, ok := teams["unassigned"]; !ok || tr.TotalFindings == 0 {
+		t.Errorf("unassigned team = %+v; want billing and cluster-scoped findings", tr)
+	}
+	last := report.Summary.TeamRisks[len(report.Summary.TeamRisks)-1]
+	if last.Team != policy.UnassignedTeam {
+		t.Errorf("last team = %q; want unassigned last", last.Team)
+	}
+	total := 0
+	for _, tr := range report.Summary.TeamRisks {
+		total += tr.TotalFindings
+	}
+	if total != report.Summary.TotalFindings {
+		t.Errorf("team totals = %d; want %d (every finding counted once)", total, report.Summary.TotalFindings)
+	}
+	for _, f := range report.Findings {
+		if _, ok := f.Metadata["team"].(string); !ok {
+			t.Errorf("finding %s has no team metadata", f.ID)
+		}
+	}
+}
+
+func TestKubernetesEngine_GroupByOwner_OffByDefault(t *testing.T) {
+	clientset := fake.NewSimpleClientset(k8sPod("payments-api", "pay", true, "100m", "128Mi"))
+	provider := &fakeKubeProvider{clientset: clientset, info: kube.ClusterInfo{ContextName: "ctx"}}
+
+	report, err := newK8sEngine(provider, ownersPolicy()).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	if report.Summary.TeamRisks != nil {
+		t.Errorf("TeamRisks = %+v; want nil without GroupByOwner", report.Summary.TeamRisks)
+	}
+}
+
+func TestMergeReports_RecomputesTeamRisks(t *testing.T) {
+	finding := func(team string, sev models.Severity) models.Finding {
+		return models.Finding{ResourceType: models.ResourceK8sPod, Severity: sev, Metadata: map[string]any{"namespace": team + "-ns", "team": team}}
+	}
+	a := &models.AuditReport{Regions: []string{"a"}, Findings: []models.Finding{finding("payments", models.SeverityHigh)},
+		Summary: models.AuditSummary{TeamRisks: []models.TeamRisk{{Team: "payments", TotalFindings: 1}}}}
+	b := &models.AuditReport{Regions: []string{"b"}, Findings: []models.Finding{finding("payments", models.SeverityLow)},
+		Summary: models.AuditSummary{TeamRisks: []models.TeamRisk{{Team: "payments", TotalFindings: 1}}}}
+
+	merged := MergeReports([]*models.AuditReport{a, b})
+	want := []models.TeamRisk{{Team: "payments", Namespaces: []string{"payments-ns"}, TotalFindings: 2, HighFindings: 1, LowFindings: 1}}
+	if !reflect.DeepEqual(merged.Summary.TeamRisks, want) {
+		t.Errorf("TeamRisks = %+v; want %+v", merged.Summary.TeamRisks, want)
+	}
+}
@@ -23,7 +23,7 @@ func pssEngine(cs *fake.Clientset) *KubernetesEngine {
 		info:      kube.ClusterInfo{ContextName: "pss-cluster", Server: "https://fake"},
 	}
 	registry := rules.NewDefaultRuleRegistry()
-	for _, r := range k8scorepack.New() {
+	for _, r := range k8scorepack.New(nil) {
 		registry.Register(r)
 	}
 	return NewKubernetesEngine(provider, registry, nil)
@@ -309,6 +309,55 @@ func TestPSSEngine_CapSysAdmin_Fires(t *testing.T) {
 	}
 }
 
+// TestPSSEngine_DangerousCapability_MergesWithCapSysAdmin verifies that a
+// container adding SYS_ADMIN and NET_ADMIN yields one merged pod finding in
+// which SYS_ADMIN is reported only by K8S_POD_CAP_SYS_ADMIN and NET_ADMIN only
+// by K8S_POD_DANGEROUS_CAPABILITY.
+func TestPSSEngine_DangerousCapability_MergesWithCapSysAdmin(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "caps-pod", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					SecurityContext: &corev1.SecurityContext{
+						Capabilities: &corev1.Capabilities{
+							Add: []corev1.Capability{"SYS_ADMIN", "NET_ADMIN"},
+						},
+					},
+				},
+			},
+		},
+	}
+	cs := fake.NewSimpleClientset(pod)
+	report, err := pssEngine(cs).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	var podFindings []models.Finding
+	for _, f := range report.Findings {
+		if f.ResourceID == "caps-pod" {
+			podFindings = append(podFindings, f)
+		}
+	}
+	if len(podFindings) != 1 {
+		t.Fatalf("expected 1 merged finding for caps-pod; got %d", len(podFindings))
+	}
+	merged, _ := podFindings[0].Metadata["rules"].([]string)
+	counts := make(map[string]int)
+	for _, id := range merged {
+		counts[id]++
+	}
+	if counts["K8S_POD_CAP_SYS_ADMIN"] != 1 {
+		t.Errorf("K8S_POD_CAP_SYS_ADMIN count = %d; want 1", counts["K8S_POD_CAP_SYS_ADMIN"])
+	}
+	if counts["K8S_POD_DANGEROUS_CAPABILITY"] != 1 {
+		t.Errorf("K8S_POD_DANGEROUS_CAPABILITY count = %d; want 1 (NET_ADMIN only, SYS_ADMIN not duplicated)",
+			counts["K8S_POD_DANGEROUS_CAPABILITY"])
+	}
+}
+
 // TestPSSEngine_MergeDoesNotCollapseAcrossRules verifies that findings for
 // different pods are not merged together even when they have the same rule.
 func TestPSSEngine_MergeDoesNotCollapseAcrossRules(t *testing.T) {
@@ -361,7 +410,7 @@ func TestPSSEngine_PolicyFiltering(t *testing.T) {
 		info:      kube.ClusterInfo{ContextName: "pss-cluster"},
 	}
 	registry := rules.NewDefaultRuleRegistry()
-	for _, r := range k8scorepack.New() {
+	for _, r := range k8scorepack.New(nil) {
 		registry.Register(r)
 	}
 	eng := NewKubernetesEngine(provider, registry, policyCfg)
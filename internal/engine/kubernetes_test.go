@@ -2,13 +2,22 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8sclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
@@ -57,18 +66,25 @@ func k8sLimitRange(namespace, name string) *corev1.LimitRange {
 	}
 }
 
+// k8sResourceQuota builds a corev1.ResourceQuota in the given namespace.
+func k8sResourceQuota(namespace, name string) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
+
 // newK8sEngine builds a KubernetesEngine backed by the full rule pack and the
 // supplied fake provider.
 func newK8sEngine(provider kube.KubeClientProvider, policyCfg *policy.PolicyConfig) *KubernetesEngine {
 	registry := rules.NewDefaultRuleRegistry()
-	for _, r := range k8scorepack.New() {
+	for _, r := range k8scorepack.New(policyCfg) {
 		registry.Register(r)
 	}
 	return NewKubernetesEngine(provider, registry, policyCfg)
 }
 
 // TestKubernetesEngine_SingleNodeCluster verifies that a single-node cluster
-// triggers K8S_CLUSTER_SINGLE_NODE (HIGH) and that namespaces without
+// triggers K8S_CLUSTER_INSUFFICIENT_NODES (HIGH) and that namespaces without
 // LimitRanges trigger K8S_NAMESPACE_WITHOUT_LIMITS (MEDIUM).
 func TestKubernetesEngine_SingleNodeCluster(t *testing.T) {
 	fakeClient := fake.NewSimpleClientset(
@@ -87,7 +103,7 @@ func TestKubernetesEngine_SingleNodeCluster(t *testing.T) {
 		t.Fatalf("RunAudit error: %v", err)
 	}
 
-	// Expect: K8S_CLUSTER_SINGLE_NODE (HIGH) + K8S_NAMESPACE_WITHOUT_LIMITS (MEDIUM)
+	// Expect: K8S_CLUSTER_INSUFFICIENT_NODES (HIGH) + K8S_NAMESPACE_WITHOUT_LIMITS (MEDIUM)
 	if report.Summary.TotalFindings < 2 {
 		t.Errorf("expected at least 2 findings; got %d", report.Summary.TotalFindings)
 	}
@@ -111,7 +127,7 @@ func TestKubernetesEngine_SingleNodeCluster(t *testing.T) {
 // TestKubernetesEngine_SortingDeterministic verifies that findings are sorted
 // HIGH before MEDIUM regardless of rule evaluation order.
 func TestKubernetesEngine_SortingDeterministic(t *testing.T) {
-	// 1 node (→ K8S_CLUSTER_SINGLE_NODE HIGH), 2 namespaces without limits (→ MEDIUM each)
+	// 1 node (→ K8S_CLUSTER_INSUFFICIENT_NODES HIGH), 2 namespaces without limits (→ MEDIUM each)
 	fakeClient := fake.NewSimpleClientset(
 		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
 		k8sNamespace("ns-a"),
@@ -183,7 +199,7 @@ func TestKubernetesEngine_PolicyRuleDisabled(t *testing.T) {
 	policyCfg := &policy.PolicyConfig{
 		Version: 1,
 		Rules: map[string]policy.RuleConfig{
-			"K8S_CLUSTER_SINGLE_NODE": {Enabled: &disabled},
+			"K8S_CLUSTER_INSUFFICIENT_NODES": {Enabled: &disabled},
 		},
 	}
 
@@ -202,10 +218,10 @@ func TestKubernetesEngine_PolicyRuleDisabled(t *testing.T) {
 		t.Fatalf("RunAudit error: %v", err)
 	}
 
-	// K8S_CLUSTER_SINGLE_NODE should be suppressed; only MEDIUM namespace finding remains.
+	// K8S_CLUSTER_INSUFFICIENT_NODES should be suppressed; only MEDIUM namespace finding remains.
 	for _, f := range report.Findings {
-		if f.RuleID == "K8S_CLUSTER_SINGLE_NODE" {
-			t.Errorf("K8S_CLUSTER_SINGLE_NODE finding present despite rule being disabled")
+		if f.RuleID == "K8S_CLUSTER_INSUFFICIENT_NODES" {
+			t.Errorf("K8S_CLUSTER_INSUFFICIENT_NODES finding present despite rule being disabled")
 		}
 	}
 }
@@ -240,6 +256,87 @@ func TestKubernetesEngine_NodeOverallocated(t *testing.T) {
 	}
 }
 
+// TestKubernetesEngine_NodeOverallocated_PolicyThreshold verifies that the
+// node_allocatable_min_pct param is threaded into the rule via the pack.
+func TestKubernetesEngine_NodeOverallocated_PolicyThreshold(t *testing.T) {
+	// 1000m / 4000m = 25%: compliant at the default 20%, fires at 30%.
+	newProvider := func() *fakeKubeProvider {
+		return &fakeKubeProvider{
+			clientset: fake.NewSimpleClientset(
+				k8sNode("node-1", "4", "8Gi", "1000m", "7Gi"),
+				k8sNode("node-2", "4", "8Gi", "1000m", "7Gi"),
+			),
+			info: kube.ClusterInfo{ContextName: "threshold-ctx"},
+		}
+	}
+	countOverallocated := func(policyCfg *policy.PolicyConfig) int {
+		report, err := newK8sEngine(newProvider(), policyCfg).RunAudit(context.Background(), KubernetesAuditOptions{})
+		if err != nil {
+			t.Fatalf("RunAudit error: %v", err)
+		}
+		var n int
+		for _, f := range report.Findings {
+			if f.RuleID == "K8S_NODE_OVERALLOCATED" {
+				n++
+			}
+		}
+		return n
+	}
+
+	if got := countOverallocated(nil); got != 0 {
+		t.Errorf("default threshold: expected 0 K8S_NODE_OVERALLOCATED findings; got %d", got)
+	}
+	cfg := &policy.PolicyConfig{
+		Version: 1,
+		Rules: map[string]policy.RuleConfig{
+			"K8S_NODE_OVERALLOCATED": {Params: map[string]float64{"node_allocatable_min_pct": 30}},
+		},
+	}
+	if got := countOverallocated(cfg); got != 2 {
+		t.Errorf("30%% threshold: expected 2 K8S_NODE_OVERALLOCATED findings; got %d", got)
+	}
+}
+
+// TestKubernetesEngine_InsufficientNodes_PolicyMinNodes verifies that the
+// min_nodes param is threaded into K8S_CLUSTER_INSUFFICIENT_NODES via the pack.
+func TestKubernetesEngine_InsufficientNodes_PolicyMinNodes(t *testing.T) {
+	fires := func(policyCfg *policy.PolicyConfig) bool {
+		provider := &fakeKubeProvider{
+			clientset: fake.NewSimpleClientset(
+				k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+				k8sNode("node-2", "4", "8Gi", "3800m", "7Gi"),
+			),
+			info: kube.ClusterInfo{ContextName: "pair-ctx"},
+		}
+		report, err := newK8sEngine(provider, policyCfg).RunAudit(context.Background(), KubernetesAuditOptions{})
+		if err != nil {
+			t.Fatalf("RunAudit error: %v", err)
+		}
+		for _, f := range report.Findings {
+			if f.RuleID == "K8S_CLUSTER_INSUFFICIENT_NODES" {
+				return true
+			}
+			if ids, _ := f.Metadata["rules"].([]string); idsContain(ids, "K8S_CLUSTER_INSUFFICIENT_NODES") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if fires(nil) {
+		t.Error("default minimum: 2-node cluster must not fire K8S_CLUSTER_INSUFFICIENT_NODES")
+	}
+	cfg := &policy.PolicyConfig{
+		Version: 1,
+		Rules: map[string]policy.RuleConfig{
+			"K8S_CLUSTER_INSUFFICIENT_NODES": {Params: map[string]float64{"min_nodes": 3}},
+		},
+	}
+	if !fires(cfg) {
+		t.Error("min_nodes=3: expected 2-node cluster to fire K8S_CLUSTER_INSUFFICIENT_NODES")
+	}
+}
+
 // TestKubernetesEngine_NamespaceWithLimitRange verifies that a namespace that
 // has a LimitRange does NOT trigger K8S_NAMESPACE_WITHOUT_LIMITS.
 func TestKubernetesEngine_NamespaceWithLimitRange(t *testing.T) {
@@ -400,6 +497,36 @@ func TestKubernetesEngine_InternalLoadBalancer(t *testing.T) {
 	}
 }
 
+// TestKubernetesEngine_PolicyInternalLBAnnotation verifies that dp.yaml
+// internal_lb_annotations reaches K8S_SERVICE_PUBLIC_LOADBALANCER through the
+// core rule pack.
+func TestKubernetesEngine_PolicyInternalLBAnnotation(t *testing.T) {
+	annotations := map[string]string{"lb.example.com/scope": "private"}
+	fakeClient := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNode("node-2", "4", "8Gi", "3800m", "7Gi"),
+		k8sService("default", "private-lb", corev1.ServiceTypeLoadBalancer, annotations),
+	)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "policy-lb-ctx"},
+	}
+	policyCfg := &policy.PolicyConfig{
+		Version:               1,
+		InternalLBAnnotations: map[string]string{"lb.example.com/scope": "private"},
+	}
+
+	report, err := newK8sEngine(provider, policyCfg).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	for _, f := range report.Findings {
+		if f.RuleID == "K8S_SERVICE_PUBLIC_LOADBALANCER" {
+			t.Errorf("K8S_SERVICE_PUBLIC_LOADBALANCER fired for a Service with a configured internal annotation")
+		}
+	}
+}
+
 // TestKubernetesEngine_MixedFindings verifies that all three new rules fire
 // together in a cluster with privileged pods, public LBs, and pods missing requests.
 func TestKubernetesEngine_MixedFindings(t *testing.T) {
@@ -441,3 +568,359 @@ func TestKubernetesEngine_MixedFindings(t *testing.T) {
 		t.Errorf("findings[0].Severity = %q; want CRITICAL (privileged container)", report.Findings[0].Severity)
 	}
 }
+
+// TestKubernetesEngine_Timings_RecordedWhenEnabled verifies that per-stage
+// timings are written to Metadata["timings"] with non-negative values when
+// KubernetesAuditOptions.Timings is set.
+func TestKubernetesEngine_Timings_RecordedWhenEnabled(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNamespace("default"),
+	)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "timings-ctx"},
+	}
+
+	eng := newK8sEngine(provider, nil)
+	report, err := eng.RunAudit(context.Background(), KubernetesAuditOptions{Timings: true})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	timings, ok := report.Metadata["timings"].(map[string]int64)
+	if !ok {
+		t.Fatalf("Metadata[timings] = %T; want map[string]int64", report.Metadata["timings"])
+	}
+	for _, key := range []string{timingCollection, timingEvaluation, timingCorrelation, timingTotal} {
+		v, ok := timings[key]
+		if !ok {
+			t.Errorf("timings missing key %q", key)
+			continue
+		}
+		if v < 0 {
+			t.Errorf("timings[%q] = %d; want >= 0", key, v)
+		}
+	}
+}
+
+// TestKubernetesEngine_Timings_AbsentByDefault verifies that Metadata carries
+// no timings entry when the option is not set.
+func TestKubernetesEngine_Timings_AbsentByDefault(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"))
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "no-timings-ctx"},
+	}
+
+	eng := newK8sEngine(provider, nil)
+	report, err := eng.RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	if _, ok := report.Metadata["timings"]; ok {
+		t.Error("Metadata[timings] present; want absent when Timings is false")
+	}
+}
+
+// TestKubernetesEngine_ForbiddenRBACListIsReported verifies that a cluster
+// where RBAC cannot be listed still produces a report, with the failure
+// recorded in report.Errors.
+func TestKubernetesEngine_ForbiddenRBACListIsReported(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"))
+	fakeClient.PrependReactor("list", "clusterrolebindings", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("clusterrolebindings is forbidden")
+	})
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "restricted-ctx"},
+	}
+
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v; want the audit to continue", err)
+	}
+	if len(report.Errors) != 1 {
+		t.Fatalf("report.Errors = %+v; want 1 entry", report.Errors)
+	}
+	got := report.Errors[0]
+	if got.Stage != models.AuditStageCollect || got.Provider != "kubernetes" || got.Region != "restricted-ctx" {
+		t.Errorf("error = %+v; want collect stage for kubernetes/restricted-ctx", got)
+	}
+	if report.Summary.HighFindings < 1 {
+		t.Error("expected the single-node finding from the rest of the inventory")
+	}
+}
+
+// k8sServiceCreatedAt builds a public LoadBalancer Service created at ts.
+func k8sServiceCreatedAt(namespace, name string, ts time.Time) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(ts),
+		},
+		Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+}
+
+// sinceEngine returns an engine over a cluster with one old and one recent
+// public LoadBalancer Service.
+func sinceEngine() *KubernetesEngine {
+	now := time.Now()
+	fakeClient := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNamespace("default"),
+		k8sServiceCreatedAt("default", "old-svc", now.Add(-48*time.Hour)),
+		k8sServiceCreatedAt("default", "new-svc", now.Add(-1*time.Hour)),
+	)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "since-ctx"},
+	}
+	return newK8sEngine(provider, nil)
+}
+
+// TestKubernetesEngine_Since_KeepsOnlyRecentResources verifies that only the
+// recently created Service survives a 24h --since window while cluster-scoped
+// and namespace findings are retained.
+func TestKubernetesEngine_Since_KeepsOnlyRecentResources(t *testing.T) {
+	report, err := sinceEngine().RunAudit(context.Background(), KubernetesAuditOptions{Since: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+
+	var sawNew, sawOld, sawCluster, sawNamespace bool
+	for _, f := range report.Findings {
+		switch {
+		case f.ResourceID == "new-svc":
+			sawNew = true
+		case f.ResourceID == "old-svc":
+			sawOld = true
+		case f.ResourceType == models.ResourceK8sCluster:
+			sawCluster = true
+		case f.ResourceType == models.ResourceK8sNamespace:
+			sawNamespace = true
+		}
+	}
+	if !sawNew {
+		t.Error("expected finding for recently created new-svc")
+	}
+	if sawOld {
+		t.Error("old-svc finding must be filtered out by --since 24h")
+	}
+	if !sawCluster {
+		t.Error("cluster-scoped finding must be retained by --since")
+	}
+	if !sawNamespace {
+		t.Error("namespace finding must be retained by --since")
+	}
+}
+
+// TestKubernetesEngine_Since_ZeroDisablesFilter verifies that a zero Since
+// keeps findings for resources of every age.
+func TestKubernetesEngine_Since_ZeroDisablesFilter(t *testing.T) {
+	report, err := sinceEngine().RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	found := map[string]bool{}
+	for _, f := range report.Findings {
+		found[f.ResourceID] = true
+	}
+	if !found["old-svc"] || !found["new-svc"] {
+		t.Errorf("expected findings for both services with Since=0; got %v", found)
+	}
+}
+
+// sameNameWorkloadEngine returns an engine over a cluster whose default
+// namespace holds a three-replica Deployment "web" without a PDB, created 48h
+// ago, and a public LoadBalancer Service "web" created 1h ago.
+func sameNameWorkloadEngine() *KubernetesEngine {
+	now := time.Now()
+	replicas := int32(3)
+	fakeClient := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNamespace("default"),
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour))},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}}},
+			},
+		},
+		k8sServiceCreatedAt("default", "web", now.Add(-1*time.Hour)),
+	)
+	provider := &fakeKubeProvider{clientset: fakeClient, info: kube.ClusterInfo{ContextName: "web-ctx"}}
+	return newK8sEngine(provider, nil)
+}
+
+// TestKubernetesEngine_WorkloadAndServiceWithSameName verifies that a
+// Deployment and a Service sharing a name keep separate findings through
+// merging, and that --show-passed and --since still match the Deployment
+// finding to its workload.
+func TestKubernetesEngine_WorkloadAndServiceWithSameName(t *testing.T) {
+	report, err := sameNameWorkloadEngine().RunAudit(context.Background(), KubernetesAuditOptions{ShowPassed: true})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	byRule := map[string]models.Finding{}
+	for _, f := range report.Findings {
+		byRule[f.RuleID] = f
+	}
+	pdb, ok := byRule["K8S_DEPLOYMENT_NO_PDB"]
+	if !ok || pdb.ResourceID != "Deployment/default/web" {
+		t.Errorf("K8S_DEPLOYMENT_NO_PDB finding = %+v; want ResourceID Deployment/default/web", pdb)
+	}
+	if svc, ok := byRule["K8S_SERVICE_PUBLIC_LOADBALANCER"]; !ok || svc.ResourceID != "web" {
+		t.Errorf("K8S_SERVICE_PUBLIC_LOADBALANCER finding = %+v; want ResourceID web", svc)
+	}
+	for _, r := range report.PassedResources {
+		if r.ResourceType == models.ResourceK8sDeployment && r.ResourceID == "web" {
+			t.Error("Deployment web has a finding and must not be listed as passed")
+		}
+	}
+
+	report, err = sameNameWorkloadEngine().RunAudit(context.Background(), KubernetesAuditOptions{Since: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	var sawPDB, sawService bool
+	for _, f := range report.Findings {
+		switch f.RuleID {
+		case "K8S_DEPLOYMENT_NO_PDB":
+			sawPDB = true
+		case "K8S_SERVICE_PUBLIC_LOADBALANCER":
+			sawService = true
+		}
+	}
+	if sawPDB {
+		t.Error("finding for the 48h-old Deployment must be filtered out by --since 24h")
+	}
+	if !sawService {
+		t.Error("expected finding for the recently created Service")
+	}
+}
+
+// TestKubernetesEngine_FindingsDeterministicAcrossConcurrency verifies that
+// the collector's worker count does not change the order of findings.
+func TestKubernetesEngine_FindingsDeterministicAcrossConcurrency(t *testing.T) {
+	objs := []runtime.Object{
+		k8sNode("node-1", "4", "8Gi", "4", "8Gi"),
+		k8sNode("node-2", "4", "8Gi", "4", "8Gi"),
+	}
+	for i := 299; i >= 0; i-- {
+		objs = append(objs, k8sPod(fmt.Sprintf("ns-%d", i%6), fmt.Sprintf("pod-%03d", i), i%3 == 0, "", ""))
+	}
+	provider := &fakeKubeProvider{
+		clientset: fake.NewSimpleClientset(objs...),
+		info:      kube.ClusterInfo{ContextName: "big"},
+	}
+	eng := newK8sEngine(provider, nil)
+
+	findingIDs := func(concurrency int) []string {
+		report, err := eng.RunAudit(context.Background(), KubernetesAuditOptions{Concurrency: concurrency})
+		if err != nil {
+			t.Fatalf("RunAudit(concurrency=%d): %v", concurrency, err)
+		}
+		ids := make([]string, len(report.Findings))
+		for i, f := range report.Findings {
+			ids[i] = f.ID
+		}
+		return ids
+	}
+
+	want := findingIDs(1)
+	if len(want) == 0 {
+		t.Fatal("expected findings for privileged pods")
+	}
+	for _, c := range []int{4, 32} {
+		got := findingIDs(c)
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("concurrency %d: finding order differs from concurrency 1", c)
+		}
+	}
+}
+
+// k8sClusterAdminBinding builds a ClusterRoleBinding granting cluster-admin to
+// the given "namespace/name" ServiceAccounts.
+func k8sClusterAdminBinding(name string, serviceAccounts ...[2]string) *rbacv1.ClusterRoleBinding {
+	crb := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "cluster-admin"},
+	}
+	for _, sa := range serviceAccounts {
+		crb.Subjects = append(crb.Subjects, rbacv1.Subject{Kind: "ServiceAccount", Namespace: sa[0], Name: sa[1]})
+	}
+	return crb
+}
+
+// TestKubernetesEngine_ClusterAdminFindingsSurviveMerge verifies that
+// same-named ServiceAccounts in different namespaces, and one ServiceAccount
+// bound twice, each keep their own CRITICAL finding after mergeFindings.
+func TestKubernetesEngine_ClusterAdminFindingsSurviveMerge(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sClusterAdminBinding("b1", [2]string{"a", "default"}, [2]string{"b", "default"}),
+		k8sClusterAdminBinding("b2", [2]string{"a", "default"}),
+	)
+	provider := &fakeKubeProvider{
+		clientset: fakeClient,
+		info:      kube.ClusterInfo{ContextName: "rbac-ctx"},
+	}
+
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	got := map[string]bool{}
+	for _, f := range report.Findings {
+		if f.RuleID != "K8S_SERVICEACCOUNT_CLUSTER_ADMIN" {
+			continue
+		}
+		if f.Severity != models.SeverityCritical {
+			t.Errorf("%s: Severity = %s; want CRITICAL", f.ResourceID, f.Severity)
+		}
+		got[fmt.Sprintf("%v/%v@%v", f.Metadata["namespace"], f.Metadata["service_account"], f.Metadata["binding_name"])] = true
+	}
+	for _, want := range []string{"a/default@b1", "b/default@b1", "a/default@b2"} {
+		if !got[want] {
+			t.Errorf("missing cluster-admin finding for %s; got %v", want, got)
+		}
+	}
+}
+
+// TestKubernetesEngine_IngressNoTLSKeepsEveryHost verifies that an Ingress
+// serving several hosts in plaintext yields one K8S_INGRESS_NO_TLS finding in
+// the report that still lists every uncovered host after merging.
+func TestKubernetesEngine_IngressNoTLSKeepsEveryHost(t *testing.T) {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "shop.example.com"}, {Host: "api.example.com"}, {Host: "admin.example.com"}},
+			TLS:   []networkingv1.IngressTLS{{Hosts: []string{"shop.example.com"}}},
+		},
+	}
+	provider := &fakeKubeProvider{
+		clientset: fake.NewSimpleClientset(k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"), ing),
+		info:      kube.ClusterInfo{ContextName: "ingress-ctx"},
+	}
+
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("RunAudit error: %v", err)
+	}
+	var matched []models.Finding
+	for _, f := range report.Findings {
+		if f.RuleID == "K8S_INGRESS_NO_TLS" {
+			matched = append(matched, f)
+		}
+	}
+	if len(matched) != 1 {
+		t.Fatalf("got %d K8S_INGRESS_NO_TLS findings; want 1", len(matched))
+	}
+	hosts, _ := matched[0].Metadata["hosts"].([]string)
+	if !slices.Equal(hosts, []string{"api.example.com", "admin.example.com"}) {
+		t.Errorf("hosts = %v; want [api.example.com admin.example.com]", matched[0].Metadata["hosts"])
+	}
+}
@@ -0,0 +1,217 @@
+package engine
+
+import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+
+// passedResources returns the entries of inventory that no finding refers to.
+// A finding refers to an inventory entry when they share ResourceType,
+// resource name (see findingResourceName), Profile and, for namespaced
+// Kubernetes resources, namespace.
+// findings should be the evaluated findings before policy and display
+// filtering so that a resource hidden by --min-risk-score or min_severity is
+// not reported as passed. Inventory order is preserved.
+func passedResources(inventory []models.PassedResource, findings []models.Finding) []models.PassedResource {
+	failed := make(map[string]struct{}, len(findings))
+	for _, f := range findings {
+		ns, _ := f.Metadata["namespace"].(string)
+		failed[passedKey(f.ResourceType, f.Profile, ns, findingResourceName(&f))] = struct{}{}
+	}
+
+	var passed []models.PassedResource
+	for _, r := range inventory {
+		if _, ok := failed[passedKey(r.ResourceType, r.Profile, r.Namespace, r.ResourceID)]; !ok {
+			passed = append(passed, r)
+		}
+	}
+	return passed
+}
+
+// passedKey builds the lookup key used by passedResources. Cluster-level
+// Kubernetes findings use either the context name or the EKS cluster name
+// (plus an add-on suffix) as ResourceID, so every cluster-scoped finding keys
+// to the single cluster entry of a one-cluster audit. Namespace findings
+// carry their own name in ResourceID, so the namespace part is dropped.
+func passedKey(rt models.ResourceType, profile, namespace, id string) string {
+	switch rt {
+	case models.ResourceK8sCluster:
+		id, namespace = "", ""
+	case models.ResourceK8sNamespace:
+		namespace = ""
+	}
+	return string(rt) + "|" + profile + "|" + namespace + "|" + id
+}
+
+// findingResourceName returns the name f's resource has in an inventory.
+// Rules whose ResourceID carries more than the resource name, so that each
+// finding on the resource keeps its own merge group (one per ClusterRoleBinding,
+// say), record the bare name in Metadata["resource_name"].
+func findingResourceName(f *models.Finding) string {
+	if name, ok := f.Metadata["resource_name"].(string); ok && name != "" {
+		return name
+	}
+	return f.ResourceID
+}
+
+// costInventory lists the per-region resources evaluated by the cost rules.
+func costInventory(regionData []models.AWSRegionData, profile string) []models.PassedResource {
+	var inv []models.PassedResource
+	add := func(id string, rt models.ResourceType, region string) {
+		inv = append(inv, models.PassedResource{
+			ResourceID: id, ResourceType: rt, Region: region, Profile: profile, Domain: "cost",
+		})
+	}
+	for _, rd := range regionData {
+		for _, inst := range rd.EC2Instances {
+			add(inst.InstanceID, models.ResourceAWSEC2, inst.Region)
+		}
+		for _, vol := range rd.EBSVolumes {
+			add(vol.VolumeID, models.ResourceAWSEBS, vol.Region)
+		}
+		for _, ng := range rd.NATGateways {
+			add(ng.NATGatewayID, models.ResourceAWSNATGateway, ng.Region)
+		}
+		for _, db := range rd.RDSInstances {
+			add(db.DBInstanceID, models.ResourceAWSRDS, db.Region)
+		}
+		for _, lb := range rd.LoadBalancers {
+			add(lb.LoadBalancerName, models.ResourceAWSLoadBalancer, lb.Region)
+		}
+		for _, lg := range rd.LogGroups {
+			add(lg.LogGroupName, models.ResourceAWSLogGroup, lg.Region)
+		}
+		for _, eip := range rd.ElasticIPs {
+			add(eip.AllocationID, models.ResourceAWSElasticIP, eip.Region)
+		}
+	}
+	return inv
+}
+
+// securityInventory lists the resources evaluated by the security rules.
+// The account itself is included because root, CloudTrail, GuardDuty and
+// Config findings are raised against the account ID.
+func securityInventory(sec *models.AWSSecurityData, accountID, profile string) []models.PassedResource {
+	var inv []models.PassedResource
+	add := func(id string, rt models.ResourceType, region string) {
+		inv = append(inv, models.PassedResource{
+			ResourceID: id, ResourceType: rt, Region: region, Profile: profile, Domain: "security",
+		})
+	}
+	if accountID != "" {
+		add(accountID, models.ResourceAWSRootAccount, "global")
+	}
+	for _, u := range sec.IAMUsers {
+		add(u.UserName, models.ResourceAWSIAMUser, "global")
+	}
+	for _, b := range sec.Buckets {
+		add(b.Name, models.ResourceAWSS3Bucket, "global")
+	}
+	seenGroups := make(map[string]struct{})
+	for _, sg := range sec.SecurityGroupRules {
+		if _, ok := seenGroups[sg.GroupID]; ok {
+			continue
+		}
+		seenGroups[sg.GroupID] = struct{}{}
+		add(sg.GroupID, models.ResourceAWSSecurityGroup, sg.Region)
+	}
+	for _, inst := range sec.EC2Instances {
+		add(inst.InstanceID, models.ResourceAWSEC2, inst.Region)
+	}
+	for _, img := range sec.ECRImages {
+		add(img.Repository, models.ResourceAWSECRRepository, img.Region)
+	}
+	for _, vpc := range sec.VPCs {
+		add(vpc.VPCID, models.ResourceAWSVPC, vpc.Region)
+	}
+	for _, cert := range sec.ACMCertificates {
+		add(cert.CertificateARN, models.ResourceAWSACMCert, cert.Region)
+	}
+	return inv
+}
+
+// dataProtectionInventory lists the EBS volumes, RDS instances, S3 buckets and
+// customer-managed symmetric KMS keys evaluated by the data protection rules.
+func dataProtectionInventory(regionData []models.AWSRegionData, sec *models.AWSSecurityData, profile string) []models.PassedResource {
+	var inv []models.PassedResource
+	add := func(id string, rt models.ResourceType, region string) {
+		inv = append(inv, models.PassedResource{
+			ResourceID: id, ResourceType: rt, Region: region, Profile: profile, Domain: "dataprotection",
+		})
+	}
+	for _, rd := range regionData {
+		for _, vol := range rd.EBSVolumes {
+			add(vol.VolumeID, models.ResourceAWSEBS, vol.Region)
+		}
+		for _, db := range rd.RDSInstances {
+			add(db.DBInstanceID, models.ResourceAWSRDS, db.Region)
+		}
+	}
+	if sec != nil {
+		for _, b := range sec.Buckets {
+			add(b.Name, models.ResourceAWSS3Bucket, "global")
+		}
+		for _, k := range sec.KMSKeys {
+			if k.KeyManager == "CUSTOMER" && k.KeySpec == "SYMMETRIC_DEFAULT" {
+				add(k.KeyID, models.ResourceAWSKMSKey, k.Region)
+			}
+		}
+	}
+	return inv
+}
+
+// azureCostInventory lists the VMs and managed disks evaluated by the Azure
+// cost rules, keyed like Azure findings by "resourceGroup/name".
+func azureCostInventory(data *models.AzureSubscriptionData) []models.PassedResource {
+	var inv []models.PassedResource
+	add := func(rg, name string, rt models.ResourceType, location string) {
+		id := name
+		if rg != "" {
+			id = rg + "/" + name
+		}
+		inv = append(inv, models.PassedResource{
+			ResourceID: id, ResourceType: rt, Region: location, Domain: "cost",
+		})
+	}
+	for _, vm := range data.VMs {
+		add(vm.ResourceGroup, vm.Name, models.ResourceAzureVM, vm.Location)
+	}
+	for _, d := range data.Disks {
+		add(d.ResourceGroup, d.Name, models.ResourceAzureDisk, d.Location)
+	}
+	return inv
+}
+
+// kubernetesInventory lists the cluster, its nodes and namespaces, and the
+// namespaced workloads evaluated by the Kubernetes rules. Region is the
+// kubeconfig context name, matching Kubernetes findings.
+func kubernetesInventory(data *models.KubernetesClusterData) []models.PassedResource {
+	ctxName := data.ContextName
+	inv := []models.PassedResource{{
+		ResourceID: ctxName, ResourceType: models.ResourceK8sCluster, Region: ctxName, Domain: "kubernetes",
+	}}
+	add := func(id string, rt models.ResourceType, namespace string) {
+		inv = append(inv, models.PassedResource{
+			ResourceID: id, ResourceType: rt, Region: ctxName, Namespace: namespace, Domain: "kubernetes",
+		})
+	}
+	for _, n := range data.Nodes {
+		add(n.Name, models.ResourceK8sNode, "")
+	}
+	for _, ns := range data.Namespaces {
+		add(ns.Name, models.ResourceK8sNamespace, "")
+	}
+	for _, p := range data.Pods {
+		add(p.Name, models.ResourceK8sPod, p.Namespace)
+	}
+	for _, svc := range data.Services {
+		add(svc.Name, models.ResourceK8sService, svc.Namespace)
+	}
+	for _, ing := range data.Ingresses {
+		add(ing.Name, models.ResourceK8sIngress, ing.Namespace)
+	}
+	for _, wl := range data.Workloads {
+		add(wl.Name, workloadResourceType(wl.Kind), wl.Namespace)
+	}
+	for _, sa := range data.ServiceAccounts {
+		add(sa.Name, models.ResourceK8sServiceAccount, sa.Namespace)
+	}
+	return inv
+}
@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	kube "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/kubernetes"
+)
+
+// passedIDs returns the ResourceIDs of passed in order.
+func passedIDs(passed []models.PassedResource) []string {
+	ids := make([]string, 0, len(passed))
+	for _, r := range passed {
+		ids = append(ids, r.ResourceID)
+	}
+	return ids
+}
+
+func TestPassedResources_ExcludesResourcesWithFindings(t *testing.T) {
+	regionData := []models.AWSRegionData{{
+		Region: "us-east-1",
+		EC2Instances: []models.AWSEC2Instance{
+			{InstanceID: "i-idle", Region: "us-east-1"},
+			{InstanceID: "i-busy", Region: "us-east-1"},
+		},
+		EBSVolumes: []models.AWSEBSVolume{{VolumeID: "vol-1", Region: "us-east-1"}},
+	}}
+	findings := []models.Finding{{
+		ResourceID:   "i-idle",
+		ResourceType: models.ResourceAWSEC2,
+		Region:       "us-east-1",
+		Profile:      "prod",
+	}}
+
+	got := passedResources(costInventory(regionData, "prod"), findings)
+	ids := passedIDs(got)
+	if len(ids) != 2 || ids[0] != "i-busy" || ids[1] != "vol-1" {
+		t.Fatalf("passed = %v; want [i-busy vol-1]", ids)
+	}
+	if got[0].Domain != "cost" || got[0].Profile != "prod" || got[0].ResourceType != models.ResourceAWSEC2 {
+		t.Errorf("passed[0] = %+v; want cost EC2 resource for profile prod", got[0])
+	}
+}
+
+func TestPassedResources_FindingInOtherProfileDoesNotExclude(t *testing.T) {
+	inv := securityInventory(&models.AWSSecurityData{
+		IAMUsers: []models.AWSIAMUser{{UserName: "deploy"}},
+	}, "", "staging")
+	findings := []models.Finding{{
+		ResourceID:   "deploy",
+		ResourceType: models.ResourceAWSIAMUser,
+		Profile:      "prod",
+	}}
+
+	if ids := passedIDs(passedResources(inv, findings)); len(ids) != 1 || ids[0] != "deploy" {
+		t.Errorf("passed = %v; want [deploy] (finding belongs to another profile)", ids)
+	}
+}
+
+func TestPassedResources_KubernetesMatchesNamespace(t *testing.T) {
+	data := &models.KubernetesClusterData{
+		ContextName: "kind",
+		Pods: []models.KubernetesPodData{
+			{Name: "api", Namespace: "payments"},
+			{Name: "api", Namespace: "search"},
+		},
+	}
+	findings := []models.Finding{
+		{ResourceID: "api", ResourceType: models.ResourceK8sPod, Metadata: map[string]any{"namespace": "payments"}},
+		{ResourceID: "eks-prod", ResourceType: models.ResourceK8sCluster},
+	}
+
+	got := passedResources(kubernetesInventory(data), findings)
+	if len(got) != 1 || got[0].Namespace != "search" || got[0].ResourceID != "api" {
+		t.Errorf("passed = %+v; want only search/api (cluster has an EKS finding)", got)
+	}
+}
+
+func TestKubernetesEngine_ShowPassed(t *testing.T) {
+	// "limited" satisfies every namespace rule; "unlimited" lacks a LimitRange
+	// and a ResourceQuota.
+	limited := k8sNamespace("limited")
+	limited.Labels = map[string]string{"pod-security.kubernetes.io/enforce": "restricted"}
+	unlimited := k8sNamespace("unlimited")
+	unlimited.Labels = map[string]string{"pod-security.kubernetes.io/enforce": "restricted"}
+	fakeClient := fake.NewSimpleClientset(
+		k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"),
+		k8sNode("node-2", "4", "8Gi", "3800m", "7Gi"),
+		limited,
+		k8sLimitRange("limited", "defaults"),
+		k8sResourceQuota("limited", "quota"),
+		unlimited,
+	)
+	provider := &fakeKubeProvider{clientset: fakeClient, info: kube.ClusterInfo{ContextName: "test"}}
+	eng := newK8sEngine(provider, nil)
+
+	report, err := eng.RunAudit(context.Background(), KubernetesAuditOptions{ShowPassed: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	passed := make(map[string]bool)
+	for _, r := range report.PassedResources {
+		passed[string(r.ResourceType)+"/"+r.ResourceID] = true
+	}
+	if !passed["K8S_NAMESPACE/limited"] {
+		t.Errorf("namespace with a LimitRange should be listed as passed; got %+v", report.PassedResources)
+	}
+	if passed["K8S_NAMESPACE/unlimited"] {
+		t.Error("namespace without a LimitRange has a finding and must not be listed as passed")
+	}
+	if !passed["K8S_NODE/node-1"] {
+		t.Errorf("healthy node should be listed as passed; got %+v", report.PassedResources)
+	}
+}
+
+func TestKubernetesEngine_ShowPassedDisabled(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(k8sNode("node-1", "4", "8Gi", "3800m", "7Gi"))
+	provider := &fakeKubeProvider{clientset: fakeClient, info: kube.ClusterInfo{ContextName: "test"}}
+
+	report, err := newK8sEngine(provider, nil).RunAudit(context.Background(), KubernetesAuditOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.PassedResources != nil {
+		t.Errorf("PassedResources = %+v; want nil without ShowPassed", report.PassedResources)
+	}
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// LoadReport reads a JSON audit report written by --file or --output json and
+// checks that it uses the current report format (see ParseReport).
+func LoadReport(path string) (*models.AuditReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read report %q: %w", path, err)
+	}
+	report, err := ParseReport(data)
+	if err != nil {
+		return nil, fmt.Errorf("report %q: %w", path, err)
+	}
+	return report, nil
+}
+
+// ParseReport decodes a JSON audit report and checks its schema_version
+// against models.ReportSchemaVersion. Reports written by an older or newer dp
+// (including reports written before schema_version existed) are rejected
+// with an error naming both versions, rather than being read with missing or
+// misinterpreted fields.
+func ParseReport(data []byte) (*models.AuditReport, error) {
+	var report models.AuditReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("parse report: %w", err)
+	}
+	if err := CheckReportSchemaVersion(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// CheckReportSchemaVersion returns an error when report.SchemaVersion differs
+// from models.ReportSchemaVersion.
+func CheckReportSchemaVersion(report *models.AuditReport) error {
+	switch report.SchemaVersion {
+	case models.ReportSchemaVersion:
+		return nil
+	case "":
+		return fmt.Errorf("report has no schema_version (written before versioned reports); "+
+			"regenerate it with this dp release, which writes schema_version %q", models.ReportSchemaVersion)
+	default:
+		return fmt.Errorf("unsupported report schema_version %q (this dp reads %q); "+
+			"regenerate the report with this dp release", report.SchemaVersion, models.ReportSchemaVersion)
+	}
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestLoadReport_MatchingVersion(t *testing.T) {
+	findings := []models.Finding{newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityHigh, 8.0)}
+	report := buildReport("default", "111122223333", []string{"us-east-1"}, findings, nil, nil, nil)
+	if report.SchemaVersion != models.ReportSchemaVersion {
+		t.Fatalf("buildReport SchemaVersion = %q; want %q", report.SchemaVersion, models.ReportSchemaVersion)
+	}
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadReport(path)
+	if err != nil {
+		t.Fatalf("LoadReport: %v", err)
+	}
+	if got.ReportID != report.ReportID || len(got.Findings) != 1 {
+		t.Errorf("LoadReport = %s with %d findings; want %s with 1", got.ReportID, len(got.Findings), report.ReportID)
+	}
+}
+
+func TestAWSReports_ProviderIsAWS(t *testing.T) {
+	reports := map[string]*models.AuditReport{
+		"cost":           buildReport("default", "111122223333", nil, nil, nil, nil, nil),
+		"security":       buildSecurityReport("default", "111122223333", nil, nil, nil),
+		"dataprotection": buildDataProtectionReport("default", "111122223333", nil, nil, nil),
+	}
+	for name, report := range reports {
+		if report.Provider != "aws" {
+			t.Errorf("%s report Provider = %q; want aws", name, report.Provider)
+		}
+	}
+}
+
+func TestParseReport_MismatchedVersion(t *testing.T) {
+	cases := map[string]struct {
+		json string
+		want string
+	}{
+		"newer version": {`{"schema_version": "99", "findings": []}`, `unsupported report schema_version "99"`},
+		"unversioned":   {`{"report_id": "audit-1", "findings": []}`, "report has no schema_version"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseReport([]byte(tc.json))
+			if err == nil {
+				t.Fatal("expected an error for a mismatched schema version")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("error = %q; want it to contain %q", err, tc.want)
+			}
+		})
+	}
+}
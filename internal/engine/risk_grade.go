@@ -0,0 +1,24 @@
+package engine
+
+import (
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// riskGradeScore returns the 0–100 score behind Summary.RiskGrade: the
+// severity counts weighted like domainRiskScore (10 per CRITICAL, 5 per HIGH,
+// 2 per MEDIUM, 1 per LOW, capped at 100), or the Kubernetes RiskScore when
+// that is higher.
+func riskGradeScore(s models.AuditSummary) int {
+	weighted := s.CriticalFindings*riskWeightCritical +
+		s.HighFindings*riskWeightHigh +
+		s.MediumFindings*riskWeightMedium +
+		s.LowFindings*riskWeightLow
+	return max(min(weighted, maxDomainRiskScore), s.RiskScore)
+}
+
+// assignRiskGrade sets s.RiskGrade from riskGradeScore using the bands in
+// policyCfg. Call it once the summary's counts and RiskScore are final.
+func assignRiskGrade(s *models.AuditSummary, policyCfg *policy.PolicyConfig) {
+	s.RiskGrade = policy.RiskGrade(riskGradeScore(*s), policyCfg)
+}
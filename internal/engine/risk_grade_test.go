@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+func TestAssignRiskGrade_DefaultBands(t *testing.T) {
+	cases := []struct {
+		name    string
+		summary models.AuditSummary
+		want    string
+	}{
+		{"empty report", models.AuditSummary{}, "A"},
+		{"a few low findings", models.AuditSummary{LowFindings: 3, MediumFindings: 1}, "A"},
+		{"one critical", models.AuditSummary{CriticalFindings: 1}, "B"},
+		{"one critical and two high", models.AuditSummary{CriticalFindings: 1, HighFindings: 2}, "C"},
+		{"several high", models.AuditSummary{HighFindings: 8, MediumFindings: 5}, "D"},
+		{"many critical", models.AuditSummary{CriticalFindings: 7}, "F"},
+		{"weighted score capped at 100", models.AuditSummary{CriticalFindings: 50}, "F"},
+		{"kubernetes attack path outweighs counts", models.AuditSummary{LowFindings: 1, RiskScore: 94}, "F"},
+		{"kubernetes risk chain in D band", models.AuditSummary{RiskScore: 40}, "D"},
+		{"info findings ignored", models.AuditSummary{TotalFindings: 20}, "A"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := tc.summary
+			assignRiskGrade(&s, nil)
+			if s.RiskGrade != tc.want {
+				t.Errorf("RiskGrade = %q (score %d); want %q", s.RiskGrade, riskGradeScore(tc.summary), tc.want)
+			}
+		})
+	}
+}
+
+func TestAssignRiskGrade_PolicyBands(t *testing.T) {
+	zero := 0
+	cfg := &policy.PolicyConfig{RiskGradeThresholds: &policy.RiskGradeThresholds{A: &zero}}
+
+	s := models.AuditSummary{LowFindings: 1}
+	assignRiskGrade(&s, cfg)
+	if s.RiskGrade != "B" {
+		t.Errorf("RiskGrade = %q; want B when risk_grade_thresholds.a is 0", s.RiskGrade)
+	}
+
+	s = models.AuditSummary{}
+	assignRiskGrade(&s, cfg)
+	if s.RiskGrade != "A" {
+		t.Errorf("RiskGrade = %q; want A for an empty report", s.RiskGrade)
+	}
+}
+
+func TestAWSSecurityEngine_SetsRiskGrade(t *testing.T) {
+	provider := &fakeAWSProfiles{profiles: []*common.ProfileConfig{
+		{ProfileName: "prod"}, {ProfileName: "staging"},
+	}}
+	registry := rules.NewDefaultRuleRegistry()
+	registry.Register(iamUserRule{})
+	eng := NewAWSSecurityEngine(provider, &failingSecurityCollector{}, registry, nil)
+
+	report, err := eng.RunAudit(context.Background(), AuditOptions{AuditType: AuditTypeSecurity, AllProfiles: true})
+	if err != nil {
+		t.Fatalf("RunAudit: %v", err)
+	}
+	// One HIGH finding per profile: score 10 grades B.
+	if report.Summary.RiskGrade != "B" {
+		t.Errorf("RiskGrade = %q; want B", report.Summary.RiskGrade)
+	}
+}
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// signedTestReport returns a small report with findings and metadata.
+func signedTestReport() *models.AuditReport {
+	findings := []models.Finding{
+		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityHigh, 8.0),
+		newFinding("vol-2", "eu-west-1", "EBS_UNATTACHED", models.SeverityLow, 1.5),
+	}
+	return &models.AuditReport{
+		ReportID:    "audit-1",
+		GeneratedAt: time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		AuditType:   "cost",
+		Profile:     "prod",
+		AccountID:   "111122223333",
+		Regions:     []string{"us-east-1", "eu-west-1"},
+		Summary:     computeSummary(findings),
+		Findings:    findings,
+		Metadata:    map[string]any{"cluster_provider": "eks"},
+	}
+}
+
+func TestSignReport_RoundTripVerifies(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	report := signedTestReport()
+	if err := SignReport(report, priv); err != nil {
+		t.Fatalf("SignReport: %v", err)
+	}
+	if report.Signature == "" {
+		t.Fatal("Signature not set")
+	}
+	if err := VerifyReport(report, pub); err != nil {
+		t.Errorf("VerifyReport on signed report: %v", err)
+	}
+
+	// A report read back from its JSON file must still verify.
+	raw, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded models.AuditReport
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if err := VerifyReport(&decoded, pub); err != nil {
+		t.Errorf("VerifyReport after JSON round trip: %v", err)
+	}
+}
+
+func TestVerifyReport_TamperedReportFails(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	report := signedTestReport()
+	if err := SignReport(report, priv); err != nil {
+		t.Fatalf("SignReport: %v", err)
+	}
+
+	report.Findings[0].Severity = models.SeverityLow
+	if err := VerifyReport(report, pub); err == nil {
+		t.Error("expected verification failure after a finding was modified")
+	}
+}
+
+func TestVerifyReport_WrongKeyOrUnsignedFails(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(rand.Reader)
+	otherPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	report := signedTestReport()
+
+	if err := VerifyReport(report, otherPub); err == nil {
+		t.Error("expected an error for an unsigned report")
+	}
+	if err := SignReport(report, priv); err != nil {
+		t.Fatalf("SignReport: %v", err)
+	}
+	if err := VerifyReport(report, otherPub); err == nil {
+		t.Error("expected verification failure with a different public key")
+	}
+}
+
+func TestLoadSigningKey_PKCS8PEM(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(rand.Reader)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "sign.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	got, err := LoadSigningKey(path)
+	if err != nil {
+		t.Fatalf("LoadSigningKey: %v", err)
+	}
+	if !got.Equal(priv) {
+		t.Error("loaded key does not match the written key")
+	}
+}
+
+func TestLoadSigningKey_NotPEM(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sign.pem")
+	if err := os.WriteFile(path, []byte("not a key"), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	if _, err := LoadSigningKey(path); err == nil {
+		t.Error("expected an error for a file without a PEM block")
+	}
+}
@@ -0,0 +1,228 @@
+package engine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// findingStateVersion is the current --state-file format version.
+const findingStateVersion = 1
+
+// FindingState is the content of a --state-file: when each finding, keyed by
+// FindingFingerprint, was first and last reported. It lets consecutive runs
+// report how long a finding has been open.
+type FindingState struct {
+	Version  int                          `json:"version"`
+	Findings map[string]FindingStateEntry `json:"findings"`
+}
+
+// FindingStateEntry records one finding in a FindingState. RuleID and
+// ResourceID are informational; the map key identifies the finding. Domain
+// and Profile let ApplyState keep the entry when that part of a later audit
+// fails.
+type FindingStateEntry struct {
+	RuleID     string    `json:"rule_id"`
+	ResourceID string    `json:"resource_id"`
+	Domain     string    `json:"domain,omitempty"`
+	Profile    string    `json:"profile,omitempty"`
+	FirstSeen  time.Time `json:"first_seen"`
+	LastSeen   time.Time `json:"last_seen"`
+}
+
+// escalatedSeverity maps each severity to the next level up. CRITICAL has no
+// entry and is never escalated.
+var escalatedSeverity = map[models.Severity]models.Severity{
+	models.SeverityInfo:   models.SeverityLow,
+	models.SeverityLow:    models.SeverityMedium,
+	models.SeverityMedium: models.SeverityHigh,
+	models.SeverityHigh:   models.SeverityCritical,
+}
+
+// LoadState reads the finding state at path. A missing file is not an error:
+// it returns an empty state, so the first run with a new --state-file treats
+// every finding as new.
+func LoadState(path string) (*FindingState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &FindingState{Version: findingStateVersion, Findings: map[string]FindingStateEntry{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state file %q: %w", path, err)
+	}
+	var state FindingState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse state file %q: %w", path, err)
+	}
+	if state.Version != findingStateVersion {
+		return nil, fmt.Errorf("state file %q: unsupported version %d (want %d)", path, state.Version, findingStateVersion)
+	}
+	if state.Findings == nil {
+		state.Findings = map[string]FindingStateEntry{}
+	}
+	return &state, nil
+}
+
+// SaveState writes state to path as indented JSON, creating or overwriting
+// the file.
+func SaveState(path string, state *FindingState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write state file %q: %w", path, err)
+	}
+	return nil
+}
+
+// FindingFingerprint returns a stable identifier for f across runs: the
+// SHA-256 of its rule, profile, account, region, namespace and resource.
+// Severity, explanation and DetectedAt are not part of the fingerprint, so a
+// finding keeps its identity when a policy override changes its severity. The
+// same value is reported as Finding.Fingerprint for external deduplication.
+// Renamed rules are fingerprinted under their former ID, so state files and
+// baselines written before the rename keep matching.
+func FindingFingerprint(f models.Finding) string {
+	ns, _ := f.Metadata["namespace"].(string)
+	key := strings.Join([]string{
+		policy.FormerRuleID(f.RuleID), f.Profile, f.AccountID, f.Region, ns, string(f.ResourceType), f.ResourceID,
+	}, "\x00")
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// stampFingerprints sets Fingerprint on every finding to its
+// FindingFingerprint. Each domain engine calls it once on its final findings;
+// reports merged from several domain reports reuse their stamped findings.
+func stampFingerprints(findings []models.Finding) {
+	for i := range findings {
+		findings[i].Fingerprint = FindingFingerprint(findings[i])
+	}
+}
+
+// ApplyState sets FirstSeen, LastSeen and AgeDays on every finding in report
+// from state, then replaces state's entries with the findings of this run.
+// Findings absent from state are first seen at now; findings that are no
+// longer reported are dropped, so a finding that reappears later starts a new
+// age. Entries whose domain or profile has an error in report.Errors are
+// kept instead: a failed collector proves nothing about the finding.
+//
+// When maxAgeDays is positive, findings older than maxAgeDays are escalated
+// one severity level (CRITICAL stays CRITICAL) and Metadata["escalated_from"]
+// records the original severity. The summary severity counts, domain risk
+// scores and risk grade are then recomputed using policyCfg. It returns the
+// number of escalated findings.
+func ApplyState(report *models.AuditReport, state *FindingState, now time.Time, maxAgeDays int, policyCfg *policy.PolicyConfig) int {
+	now = now.UTC()
+	seen := make(map[string]FindingStateEntry, len(report.Findings))
+	escalated := 0
+	for i := range report.Findings {
+		f := &report.Findings[i]
+		fp := FindingFingerprint(*f)
+		entry, ok := seen[fp]
+		if !ok {
+			entry, ok = state.Findings[fp]
+		}
+		if !ok {
+			entry = FindingStateEntry{RuleID: f.RuleID, ResourceID: f.ResourceID, FirstSeen: now}
+		}
+		entry.Domain = f.Domain
+		entry.Profile = f.Profile
+		entry.LastSeen = now
+		seen[fp] = entry
+
+		f.FirstSeen = entry.FirstSeen
+		f.LastSeen = now
+		f.AgeDays = int(now.Sub(entry.FirstSeen).Hours() / 24)
+		if maxAgeDays > 0 && f.AgeDays > maxAgeDays {
+			if next, ok := escalatedSeverity[f.Severity]; ok {
+				if f.Metadata == nil {
+					f.Metadata = make(map[string]any, 1)
+				}
+				f.Metadata["escalated_from"] = string(f.Severity)
+				f.Severity = next
+				escalated++
+			}
+		}
+	}
+	for fp, entry := range state.Findings {
+		if _, ok := seen[fp]; !ok && failedScope(entry, report.Errors) {
+			seen[fp] = entry
+		}
+	}
+	state.Version = findingStateVersion
+	state.Findings = seen
+
+	if escalated > 0 {
+		recomputeSummary(report, policyCfg)
+	}
+	return escalated
+}
+
+// failedScope reports whether errs include a failure in the domain and
+// profile that produced entry, so its absence from the run is not a
+// resolution. Errors not confined to a profile cover the whole domain;
+// entries written before Domain was recorded are covered by any error.
+func failedScope(entry FindingStateEntry, errs []models.AuditError) bool {
+	for _, e := range errs {
+		if entry.Domain == "" {
+			return true
+		}
+		if auditErrorDomain(e.Provider) != entry.Domain {
+			continue
+		}
+		if e.Profile == "" || e.Profile == entry.Profile {
+			return true
+		}
+	}
+	return false
+}
+
+// auditErrorDomain returns the Finding.Domain of the findings the failed
+// provider would have produced.
+func auditErrorDomain(provider string) string {
+	switch provider {
+	case "kubernetes", "aws/eks":
+		return "kubernetes"
+	case "azure":
+		return "cost"
+	}
+	return strings.TrimPrefix(provider, "aws/")
+}
+
+// recomputeSummary refreshes the finding counts, total savings, the
+// per-domain risk scores and team risks (when present) and the risk grade
+// after findings were escalated or removed.
+func recomputeSummary(report *models.AuditReport, policyCfg *policy.PolicyConfig) {
+	counts := computeSummary(report.Findings)
+	s := &report.Summary
+	s.TotalFindings = counts.TotalFindings
+	s.TotalEstimatedMonthlySavings = counts.TotalEstimatedMonthlySavings
+	s.CriticalFindings = counts.CriticalFindings
+	s.HighFindings = counts.HighFindings
+	s.MediumFindings = counts.MediumFindings
+	s.LowFindings = counts.LowFindings
+	if s.DomainRiskScores != nil {
+		byDomain := make(map[string][]models.Finding)
+		for _, f := range report.Findings {
+			byDomain[f.Domain] = append(byDomain[f.Domain], f)
+		}
+		for domain := range s.DomainRiskScores {
+			s.DomainRiskScores[domain] = domainRiskScore(byDomain[domain])
+		}
+	}
+	if s.TeamRisks != nil {
+		s.TeamRisks = computeTeamRisks(report.Findings)
+	}
+	assignRiskGrade(s, policyCfg)
+}
@@ -0,0 +1,262 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// stateTestReport returns a report with two findings on different volumes.
+func stateTestReport() *models.AuditReport {
+	findings := []models.Finding{
+		newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityHigh, 8.0),
+		newFinding("vol-2", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 1.5),
+	}
+	return &models.AuditReport{Findings: findings, Summary: computeSummary(findings)}
+}
+
+func TestLoadState_MissingFileIsEmpty(t *testing.T) {
+	state, err := LoadState(filepath.Join(t.TempDir(), "state.json"))
+	if err != nil {
+		t.Fatalf("LoadState: %v", err)
+	}
+	if len(state.Findings) != 0 {
+		t.Errorf("expected empty state, got %d entries", len(state.Findings))
+	}
+}
+
+func TestLoadState_RejectsUnknownVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := os.WriteFile(path, []byte(`{"version": 99, "findings": {}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadState(path); err == nil {
+		t.Fatal("expected error for unsupported version")
+	}
+}
+
+func TestApplyState_FirstRun(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	state, _ := LoadState(filepath.Join(t.TempDir(), "state.json"))
+	report := stateTestReport()
+
+	ApplyState(report, state, now, 0, nil)
+
+	for _, f := range report.Findings {
+		if !f.FirstSeen.Equal(now) || !f.LastSeen.Equal(now) {
+			t.Errorf("%s: FirstSeen/LastSeen = %v/%v; want %v", f.ResourceID, f.FirstSeen, f.LastSeen, now)
+		}
+		if f.AgeDays != 0 {
+			t.Errorf("%s: AgeDays = %d; want 0", f.ResourceID, f.AgeDays)
+		}
+	}
+	if len(state.Findings) != 2 {
+		t.Errorf("state has %d entries; want 2", len(state.Findings))
+	}
+}
+
+func TestApplyState_SubsequentRunPreservesFirstSeen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	first := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	second := first.Add(10 * 24 * time.Hour)
+
+	state, _ := LoadState(path)
+	ApplyState(stateTestReport(), state, first, 0, nil)
+	if err := SaveState(path, state); err != nil {
+		t.Fatalf("SaveState: %v", err)
+	}
+
+	// vol-2 was fixed; vol-3 is new.
+	report := stateTestReport()
+	report.Findings[1] = newFinding("vol-3", "us-east-1", "EBS_UNATTACHED", models.SeverityLow, 1.0)
+	state, err := LoadState(path)
+	if err != nil {
+		t.Fatalf("LoadState: %v", err)
+	}
+	ApplyState(report, state, second, 0, nil)
+
+	got := report.Findings[0]
+	if !got.FirstSeen.Equal(first) || !got.LastSeen.Equal(second) || got.AgeDays != 10 {
+		t.Errorf("vol-1: FirstSeen=%v LastSeen=%v AgeDays=%d; want %v %v 10", got.FirstSeen, got.LastSeen, got.AgeDays, first, second)
+	}
+	if fresh := report.Findings[1]; !fresh.FirstSeen.Equal(second) || fresh.AgeDays != 0 {
+		t.Errorf("vol-3: FirstSeen=%v AgeDays=%d; want %v 0", fresh.FirstSeen, fresh.AgeDays, second)
+	}
+	if len(state.Findings) != 2 {
+		t.Errorf("state has %d entries; want 2 (resolved vol-2 dropped)", len(state.Findings))
+	}
+	if _, ok := state.Findings[FindingFingerprint(newFinding("vol-2", "us-east-1", "EBS_UNATTACHED", models.SeverityMedium, 1.5))]; ok {
+		t.Error("resolved finding vol-2 still in state")
+	}
+}
+
+func TestApplyState_KeepsEntriesOfFailedScopes(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	finding := func(id, domain, profile string) models.Finding {
+		f := newFinding(id, "us-east-1", "EBS_UNATTACHED", models.SeverityLow, 1.0)
+		f.Domain, f.Profile = domain, profile
+		return f
+	}
+	prod := finding("vol-prod", "cost", "prod")
+	staging := finding("vol-staging", "cost", "staging")
+	sec := finding("sg-1", "security", "prod")
+
+	tests := []struct {
+		name string
+		errs []models.AuditError
+		kept []models.Finding
+	}{
+		{"no errors", nil, nil},
+		{
+			"failed profile",
+			[]models.AuditError{{Stage: models.AuditStageCollect, Provider: "aws/cost", Profile: "staging"}},
+			[]models.Finding{staging},
+		},
+		{
+			"failed domain",
+			[]models.AuditError{{Stage: models.AuditStageCollect, Provider: "aws/cost"}},
+			[]models.Finding{prod, staging},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &FindingState{Findings: map[string]FindingStateEntry{}}
+			ApplyState(&models.AuditReport{Findings: []models.Finding{prod, staging, sec}}, state, now, 0, nil)
+
+			// Nothing is reported on the second run.
+			ApplyState(&models.AuditReport{Errors: tc.errs}, state, now.Add(24*time.Hour), 0, nil)
+
+			if len(state.Findings) != len(tc.kept) {
+				t.Errorf("state has %d entries; want %d", len(state.Findings), len(tc.kept))
+			}
+			for _, f := range tc.kept {
+				entry, ok := state.Findings[FindingFingerprint(f)]
+				if !ok {
+					t.Errorf("%s dropped from state", f.ResourceID)
+					continue
+				}
+				if !entry.FirstSeen.Equal(now) {
+					t.Errorf("%s: FirstSeen = %v; want %v preserved", f.ResourceID, entry.FirstSeen, now)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyState_EscalatesOldFindings(t *testing.T) {
+	first := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	state := &FindingState{Findings: map[string]FindingStateEntry{}}
+	ApplyState(stateTestReport(), state, first, 0, nil)
+
+	report := stateTestReport()
+	n := ApplyState(report, state, first.Add(31*24*time.Hour), 30, nil)
+
+	if n != 2 {
+		t.Fatalf("escalated %d findings; want 2", n)
+	}
+	if report.Findings[0].Severity != models.SeverityCritical {
+		t.Errorf("vol-1 severity = %s; want CRITICAL", report.Findings[0].Severity)
+	}
+	if report.Findings[1].Severity != models.SeverityHigh || report.Findings[1].Metadata["escalated_from"] != "MEDIUM" {
+		t.Errorf("vol-2 severity = %s escalated_from = %v; want HIGH from MEDIUM", report.Findings[1].Severity, report.Findings[1].Metadata["escalated_from"])
+	}
+	if s := report.Summary; s.CriticalFindings != 1 || s.HighFindings != 1 || s.MediumFindings != 0 {
+		t.Errorf("summary counts C/H/M = %d/%d/%d; want 1/1/0", s.CriticalFindings, s.HighFindings, s.MediumFindings)
+	}
+	if report.Summary.RiskGrade == "" {
+		t.Error("expected risk grade to be recomputed")
+	}
+}
+
+func TestApplyState_YoungFindingsNotEscalated(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	state := &FindingState{Findings: map[string]FindingStateEntry{}}
+	report := stateTestReport()
+	if n := ApplyState(report, state, now, 30, nil); n != 0 {
+		t.Errorf("escalated %d findings on first run; want 0", n)
+	}
+	if report.Findings[0].Severity != models.SeverityHigh {
+		t.Errorf("severity = %s; want HIGH", report.Findings[0].Severity)
+	}
+}
+
+func TestFindingFingerprint_DistinguishesRegionAndNamespace(t *testing.T) {
+	a := newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityHigh, 0)
+	b := newFinding("vol-1", "eu-west-1", "EBS_UNATTACHED", models.SeverityHigh, 0)
+	if FindingFingerprint(a) == FindingFingerprint(b) {
+		t.Error("findings in different regions share a fingerprint")
+	}
+	c := a
+	c.Metadata = map[string]any{"namespace": "prod"}
+	if FindingFingerprint(a) == FindingFingerprint(c) {
+		t.Error("findings in different namespaces share a fingerprint")
+	}
+	d := a
+	d.Severity = models.SeverityLow
+	if FindingFingerprint(a) != FindingFingerprint(d) {
+		t.Error("severity change altered the fingerprint")
+	}
+}
+
+func TestStampFingerprints_StableAndDistinct(t *testing.T) {
+	findings := func() []models.Finding {
+		k8s := newFinding("api", "prod-ctx", "K8S_POD_RUN_AS_ROOT", models.SeverityHigh, 0)
+		k8s.Metadata = map[string]any{"namespace": "payments"}
+		other := k8s
+		other.Metadata = map[string]any{"namespace": "default"}
+		return []models.Finding{
+			newFinding("vol-1", "us-east-1", "EBS_UNATTACHED", models.SeverityHigh, 8.0),
+			newFinding("vol-1", "eu-west-1", "EBS_UNATTACHED", models.SeverityHigh, 8.0),
+			newFinding("vol-1", "us-east-1", "AWS_EBS_GP2_LEGACY", models.SeverityLow, 1.0),
+			k8s,
+			other,
+		}
+	}
+	first, second := findings(), findings()
+	stampFingerprints(first)
+	stampFingerprints(second)
+
+	seen := make(map[string]int)
+	for i, f := range first {
+		if f.Fingerprint == "" || f.Fingerprint != FindingFingerprint(f) {
+			t.Errorf("finding %d: Fingerprint = %q; want FindingFingerprint", i, f.Fingerprint)
+		}
+		if got := second[i].Fingerprint; got != f.Fingerprint {
+			t.Errorf("finding %d: fingerprint changed between runs: %q vs %q", i, f.Fingerprint, got)
+		}
+		if prev, dup := seen[f.Fingerprint]; dup {
+			t.Errorf("findings %d and %d share a fingerprint", prev, i)
+		}
+		seen[f.Fingerprint] = i
+	}
+}
+
+func TestBuildReport_StampsFingerprints(t *testing.T) {
+	report := buildReport("test", "111122223333", []string{"us-east-1"}, stateTestReport().Findings, nil, nil, nil)
+	for _, f := range report.Findings {
+		if f.Fingerprint != FindingFingerprint(f) {
+			t.Errorf("%s: Fingerprint = %q; want %q", f.ResourceID, f.Fingerprint, FindingFingerprint(f))
+		}
+	}
+}
+
+// TestFindingFingerprint_StableAcrossRuleRename verifies that a renamed rule
+// keeps the fingerprint it had under its former ID, so state files recorded
+// before the rename keep matching.
+func TestFindingFingerprint_StableAcrossRuleRename(t *testing.T) {
+	for former, current := range map[string]string{
+		"GUARDDUTY_DISABLED":          "AWS_GUARDDUTY_DISABLED",
+		"EBS_GP2_LEGACY":              "AWS_EBS_GP2_LEGACY",
+		"CLOUDTRAIL_NOT_MULTI_REGION": "AWS_CLOUDTRAIL_NOT_MULTIREGION",
+		"K8S_CLUSTER_SINGLE_NODE":     "K8S_CLUSTER_INSUFFICIENT_NODES",
+	} {
+		old := newFinding("us-east-1", "us-east-1", former, models.SeverityHigh, 0)
+		renamed := newFinding("us-east-1", "us-east-1", current, models.SeverityHigh, 0)
+		if FindingFingerprint(old) != FindingFingerprint(renamed) {
+			t.Errorf("%s: fingerprint changed after rename from %s", current, former)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// Timing keys written to AuditReport.Metadata["timings"] when the Timings
+// audit option is set. Values are elapsed milliseconds. AWS and Azure audits
+// have no correlation stage except dp aws audit --all.
+const (
+	timingCollection  = "collection_ms"
+	timingEvaluation  = "evaluation_ms"
+	timingCorrelation = "correlation_ms"
+	timingTotal       = "total_ms"
+)
+
+// stopwatch records elapsed wall-clock time per named stage inside RunAudit.
+// lap measures from the previous lap and is not safe for concurrent use; add
+// is, so profiles audited in parallel can each add their own stage
+// durations. Stage times summed across parallel profiles can exceed the
+// total.
+type stopwatch struct {
+	mu    sync.Mutex
+	start time.Time
+	last  time.Time
+	laps  map[string]time.Duration
+}
+
+// newStopwatch returns a stopwatch started at the current time.
+func newStopwatch() *stopwatch {
+	now := time.Now()
+	return &stopwatch{start: now, last: now, laps: make(map[string]time.Duration)}
+}
+
+// lap records the time elapsed since the previous lap (or start) under stage
+// and resets the lap reference point.
+func (s *stopwatch) lap(stage string) {
+	now := time.Now()
+	s.add(stage, now.Sub(s.last))
+	s.last = now
+}
+
+// add records d under stage.
+func (s *stopwatch) add(stage string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.laps[stage] += d
+}
+
+// merge adds the stage timings recorded in report, except its total. Audits
+// that combine several reports use it to sum their stages.
+func (s *stopwatch) merge(report *models.AuditReport) {
+	t, ok := report.Metadata["timings"].(map[string]int64)
+	if !ok {
+		return
+	}
+	for stage, ms := range t {
+		if stage != timingTotal {
+			s.add(stage, time.Duration(ms)*time.Millisecond)
+		}
+	}
+}
+
+// timings returns the recorded laps in milliseconds plus the total elapsed
+// time.
+func (s *stopwatch) timings() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]int64, len(s.laps)+1)
+	for k, v := range s.laps {
+		out[k] = v.Milliseconds()
+	}
+	out[timingTotal] = time.Since(s.start).Milliseconds()
+	return out
+}
+
+// record stores the timings in report.Metadata["timings"].
+func (s *stopwatch) record(report *models.AuditReport) {
+	if report.Metadata == nil {
+		report.Metadata = make(map[string]any, 1)
+	}
+	report.Metadata["timings"] = s.timings()
+}
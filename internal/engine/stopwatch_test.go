@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// reportWithTimings returns an empty domain report carrying timings metadata.
+func reportWithTimings(auditType string, timings map[string]int64) *models.AuditReport {
+	r := emptyDomainReport(auditType, "default", "111122223333", []string{"us-east-1"})
+	r.Metadata = map[string]any{"timings": timings}
+	return r
+}
+
+func TestStopwatch_MergeSumsStagesExceptTotal(t *testing.T) {
+	sw := newStopwatch()
+	sw.merge(reportWithTimings("cost", map[string]int64{timingCollection: 10, timingEvaluation: 2, timingTotal: 5000}))
+	sw.merge(reportWithTimings("security", map[string]int64{timingCollection: 7}))
+	sw.merge(&models.AuditReport{})
+
+	got := sw.timings()
+	if got[timingCollection] != 17 || got[timingEvaluation] != 2 {
+		t.Errorf("timings = %v; want collection 17 and evaluation 2", got)
+	}
+	if got[timingTotal] >= 5000 {
+		t.Errorf("total = %d; merged totals must not be added", got[timingTotal])
+	}
+}
+
+func TestAWSSecurityEngine_Timings(t *testing.T) {
+	provider := &fakeAWSProfiles{profiles: []*common.ProfileConfig{
+		{ProfileName: "prod", AccountID: "111111111111"},
+		{ProfileName: "staging", AccountID: "222222222222"},
+	}}
+	registry := rules.NewDefaultRuleRegistry()
+	registry.Register(iamUserRule{})
+	eng := NewAWSSecurityEngine(provider, &failingSecurityCollector{}, registry, nil)
+
+	for name, opts := range map[string]AuditOptions{
+		"single profile": {AuditType: AuditTypeSecurity, Profile: "prod", Regions: []string{"us-east-1"}, Timings: true},
+		"all profiles":   {AuditType: AuditTypeSecurity, AllProfiles: true, Regions: []string{"us-east-1"}, Timings: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			report, err := eng.RunAudit(context.Background(), opts)
+			if err != nil {
+				t.Fatalf("RunAudit: %v", err)
+			}
+			timings, ok := report.Metadata["timings"].(map[string]int64)
+			if !ok {
+				t.Fatalf("Metadata[timings] = %T; want map[string]int64", report.Metadata["timings"])
+			}
+			for _, key := range []string{timingCollection, timingEvaluation, timingTotal} {
+				if v, ok := timings[key]; !ok || v < 0 {
+					t.Errorf("timings[%q] = %d, %v; want a non-negative value", key, v, ok)
+				}
+			}
+			if _, ok := timings[timingCorrelation]; ok {
+				t.Error("security audit recorded a correlation stage it does not have")
+			}
+		})
+	}
+
+	report, err := eng.RunAudit(context.Background(), AuditOptions{AuditType: AuditTypeSecurity, Profile: "prod", Regions: []string{"us-east-1"}})
+	if err != nil {
+		t.Fatalf("RunAudit: %v", err)
+	}
+	if _, ok := report.Metadata["timings"]; ok {
+		t.Error("Metadata[timings] present; want absent when Timings is false")
+	}
+}
+
+func TestAuditAll_TimingsSumDomains(t *testing.T) {
+	eng := newAllAWSEngine(
+		reportWithTimings("cost", map[string]int64{timingCollection: 10, timingEvaluation: 1, timingTotal: 11}),
+		reportWithTimings("security", map[string]int64{timingCollection: 20, timingEvaluation: 2, timingTotal: 22}),
+		reportWithTimings("dataprotection", map[string]int64{timingCollection: 30, timingEvaluation: 3, timingTotal: 33}),
+		nil,
+	)
+	report, _, err := eng.RunAllAWSAudit(context.Background(), AllAWSAuditOptions{Timings: true})
+	if err != nil {
+		t.Fatalf("RunAllAWSAudit: %v", err)
+	}
+	timings, ok := report.Metadata["timings"].(map[string]int64)
+	if !ok {
+		t.Fatalf("Metadata[timings] = %T; want map[string]int64", report.Metadata["timings"])
+	}
+	if timings[timingCollection] != 60 || timings[timingEvaluation] != 6 {
+		t.Errorf("timings = %v; want collection 60 and evaluation 6 summed over the domains", timings)
+	}
+	for _, key := range []string{timingCorrelation, timingTotal} {
+		if v, ok := timings[key]; !ok || v < 0 {
+			t.Errorf("timings[%q] = %d, %v; want a non-negative value", key, v, ok)
+		}
+	}
+}
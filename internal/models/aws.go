@@ -49,7 +49,23 @@ type AWSEBSVolume struct {
 	Tags       map[string]string `json:"tags,omitempty"`
 }
 
+// AWSElasticIP represents a single collected Elastic IP address.
+// AssociationID is empty when the address is allocated but not associated
+// with an instance or network interface.
+type AWSElasticIP struct {
+	AllocationID       string            `json:"allocation_id"`
+	PublicIP           string            `json:"public_ip"`
+	Region             string            `json:"region"`
+	AssociationID      string            `json:"association_id,omitempty"`
+	InstanceID         string            `json:"instance_id,omitempty"`
+	NetworkInterfaceID string            `json:"network_interface_id,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
 // AWSNATGateway represents a single collected NAT Gateway.
+// BytesProcessedGB is the BytesOutToDestination total over the CloudWatch
+// lookback window that began at MetricsStart; MetricsStart is zero when
+// metrics were not fetched. CreatedTime is zero when unknown.
 type AWSNATGateway struct {
 	NATGatewayID     string            `json:"nat_gateway_id"`
 	Region           string            `json:"region"`
@@ -57,6 +73,8 @@ type AWSNATGateway struct {
 	VPCID            string            `json:"vpc_id"`
 	SubnetID         string            `json:"subnet_id"`
 	BytesProcessedGB float64           `json:"bytes_processed_gb"`
+	CreatedTime      time.Time         `json:"created_time,omitzero"`
+	MetricsStart     time.Time         `json:"metrics_start,omitzero"`
 	Tags             map[string]string `json:"tags,omitempty"`
 }
 
@@ -70,11 +88,22 @@ type AWSRDSInstance struct {
 	Status           string            `json:"status"`
 	StorageEncrypted bool              `json:"storage_encrypted"`
 	AvgCPUPercent    float64           `json:"avg_cpu_percent"`
+	AvgConnections   float64           `json:"avg_connections"`
 	MonthlyCostUSD   float64           `json:"monthly_cost_usd"`
 	Tags             map[string]string `json:"tags,omitempty"`
+
+	// BackupRetentionPeriod is the number of days automated backups are
+	// kept; 0 means automated backups are disabled.
+	BackupRetentionPeriod int `json:"backup_retention_period"`
+	// ReadReplicaSourceID is the identifier of the source instance when this
+	// instance is a read replica; empty otherwise.
+	ReadReplicaSourceID string `json:"read_replica_source_id,omitempty"`
 }
 
 // AWSLoadBalancer represents a single collected Elastic Load Balancer.
+// RequestCount (ALB only) and ProcessedBytes (NLB only) are totals over the
+// CloudWatch lookback window that began at MetricsStart; MetricsStart is zero
+// when metrics were not fetched. CreatedTime is zero when unknown.
 type AWSLoadBalancer struct {
 	LoadBalancerARN  string            `json:"load_balancer_arn"`
 	LoadBalancerName string            `json:"load_balancer_name"`
@@ -82,9 +111,22 @@ type AWSLoadBalancer struct {
 	Type             string            `json:"type"` // application | network | classic
 	State            string            `json:"state"`
 	RequestCount     int64             `json:"request_count"`
+	ProcessedBytes   int64             `json:"processed_bytes"`
+	CreatedTime      time.Time         `json:"created_time,omitzero"`
+	MetricsStart     time.Time         `json:"metrics_start,omitzero"`
 	Tags             map[string]string `json:"tags,omitempty"`
 }
 
+// AWSLogGroup represents a single collected CloudWatch Logs log group.
+// RetentionInDays is 0 when no retention policy is set (events never expire).
+type AWSLogGroup struct {
+	LogGroupName    string    `json:"log_group_name"`
+	Region          string    `json:"region"`
+	RetentionInDays int32     `json:"retention_in_days"`
+	StoredBytes     int64     `json:"stored_bytes"`
+	CreatedTime     time.Time `json:"created_time,omitzero"`
+}
+
 // AWSSavingsPlanCoverage holds Savings Plan / Reserved Instance coverage data
 // for a region over the collection period.
 type AWSSavingsPlanCoverage struct {
@@ -94,6 +136,14 @@ type AWSSavingsPlanCoverage struct {
 	CoveredCostUSD  float64 `json:"covered_cost_usd"`
 }
 
+// AWSCollectError records one AWS collection call that failed and left the
+// matching data empty. Region is empty for global services (S3, IAM,
+// CloudTrail).
+type AWSCollectError struct {
+	Region string
+	Err    error
+}
+
 // AWSRegionData holds all raw resource data collected from a single AWS region.
 // It is passed to the rule engine for evaluation.
 type AWSRegionData struct {
@@ -103,9 +153,15 @@ type AWSRegionData struct {
 	NATGateways         []AWSNATGateway          `json:"nat_gateways"`
 	RDSInstances        []AWSRDSInstance         `json:"rds_instances"`
 	LoadBalancers       []AWSLoadBalancer        `json:"load_balancers"`
+	LogGroups           []AWSLogGroup            `json:"log_groups,omitempty"`
+	ElasticIPs          []AWSElasticIP           `json:"elastic_ips,omitempty"`
 	SavingsPlanCoverage []AWSSavingsPlanCoverage `json:"savings_plan_coverage"`
 	// Security holds the raw security posture data for this region and, when
 	// populated by the security collector, global account-level data (IAM, root,
 	// S3) is included in the Security field of the primary evaluation context.
 	Security AWSSecurityData `json:"security,omitempty"`
+	// CollectErrors lists the calls that failed for this region; the matching
+	// fields are empty. A region whose inventory could not be described has
+	// no other fields set.
+	CollectErrors []AWSCollectError `json:"-"`
 }
@@ -1,17 +1,29 @@
 package models
 
+import "time"
+
 // AWSSecurityData holds raw security posture data collected from an AWS account.
 // S3 buckets, IAM users, root account info, and CloudTrail are global (account-level).
-// AWSSecurityGroupRules, AWSGuardDutyStatus, and AWSConfigStatus are aggregated from
-// all audited regions; each entry carries its Region for accurate finding attribution.
+// AWSSecurityGroupRules, AWSEC2InstanceMetadata, AWSGuardDutyStatus,
+// AWSConfigStatus, AWSKMSKey, AWSECRImageScan, AWSVPC, and AWSACMCertificate are
+// aggregated from all audited regions; each entry carries its Region for accurate finding
+// attribution.
 type AWSSecurityData struct {
-	Buckets            []AWSS3Bucket          `json:"buckets"`
-	SecurityGroupRules []AWSSecurityGroupRule `json:"security_group_rules"`
-	IAMUsers           []AWSIAMUser           `json:"iam_users"`
-	Root               AWSRootAccountInfo     `json:"root"`
-	CloudTrail         AWSCloudTrailStatus    `json:"cloud_trail"`
-	GuardDuty          []AWSGuardDutyStatus   `json:"guard_duty"`
-	Config             []AWSConfigStatus      `json:"config"`
+	Buckets            []AWSS3Bucket            `json:"buckets"`
+	SecurityGroupRules []AWSSecurityGroupRule   `json:"security_group_rules"`
+	EC2Instances       []AWSEC2InstanceMetadata `json:"ec2_instances"`
+	IAMUsers           []AWSIAMUser             `json:"iam_users"`
+	Root               AWSRootAccountInfo       `json:"root"`
+	CloudTrail         AWSCloudTrailStatus      `json:"cloud_trail"`
+	GuardDuty          []AWSGuardDutyStatus     `json:"guard_duty"`
+	Config             []AWSConfigStatus        `json:"config"`
+	KMSKeys            []AWSKMSKey              `json:"kms_keys,omitempty"`
+	ECRImages          []AWSECRImageScan        `json:"ecr_images,omitempty"`
+	VPCs               []AWSVPC                 `json:"vpcs,omitempty"`
+	ACMCertificates    []AWSACMCertificate      `json:"acm_certificates,omitempty"`
+	// CollectErrors lists the calls that failed; the matching fields are
+	// empty or hold the collector's conservative defaults.
+	CollectErrors []AWSCollectError `json:"-"`
 }
 
 // AWSS3Bucket represents an S3 bucket and its security attributes.
@@ -20,10 +32,27 @@ type AWSSecurityData struct {
 // and buckets with a non-public policy have Public == false.
 // DefaultEncryptionEnabled is true when GetBucketEncryption returns a valid
 // SSE configuration; false when no configuration exists or on any error.
+// VersioningStatus is "Enabled", "Suspended", or "Disabled" (versioning was
+// never turned on), as reported by GetBucketVersioning. It is empty when the
+// status could not be read.
+// PublicAccessBlock holds the bucket-level public access block settings from
+// GetPublicAccessBlock. A bucket without a configuration has every flag false;
+// the field is nil when the settings could not be read.
 type AWSS3Bucket struct {
-	Name                     string `json:"name"`
-	Public                   bool   `json:"public"`
-	DefaultEncryptionEnabled bool   `json:"default_encryption_enabled"`
+	Name                     string                  `json:"name"`
+	Public                   bool                    `json:"public"`
+	DefaultEncryptionEnabled bool                    `json:"default_encryption_enabled"`
+	VersioningStatus         string                  `json:"versioning_status,omitempty"`
+	PublicAccessBlock        *AWSS3PublicAccessBlock `json:"public_access_block,omitempty"`
+}
+
+// AWSS3PublicAccessBlock mirrors the four S3 public access block settings.
+// A bucket is fully blocked only when all four are true.
+type AWSS3PublicAccessBlock struct {
+	BlockPublicAcls       bool `json:"block_public_acls"`
+	IgnorePublicAcls      bool `json:"ignore_public_acls"`
+	BlockPublicPolicy     bool `json:"block_public_policy"`
+	RestrictPublicBuckets bool `json:"restrict_public_buckets"`
 }
 
 // AWSSecurityGroupRule represents a single inbound rule in an EC2 security group.
@@ -36,15 +65,37 @@ type AWSSecurityGroupRule struct {
 	Region  string `json:"region"`
 }
 
+// AWSEC2InstanceMetadata captures the instance metadata service (IMDS) options
+// of a single EC2 instance. HttpTokens is "required" when only IMDSv2 is
+// accepted and "optional" when IMDSv1 is still allowed. HttpEndpoint is
+// "disabled" when the metadata service is switched off entirely.
+type AWSEC2InstanceMetadata struct {
+	InstanceID   string `json:"instance_id"`
+	Region       string `json:"region"`
+	HttpTokens   string `json:"http_tokens"`
+	HttpEndpoint string `json:"http_endpoint"`
+}
+
 // AWSIAMUser represents an IAM user and its relevant security attributes.
 // HasLoginProfile is true when the user has a console password (login profile),
 // meaning the user can sign in to the AWS Management Console.
 // API-only users that have no login profile have HasLoginProfile == false and
 // should not be flagged for missing MFA.
+// AccessKeys lists the user's access keys; it is empty when the user has none
+// or when ListAccessKeys failed.
 type AWSIAMUser struct {
-	UserName        string `json:"user_name"`
-	MFAEnabled      bool   `json:"mfa_enabled"`
-	HasLoginProfile bool   `json:"has_login_profile"`
+	UserName        string            `json:"user_name"`
+	MFAEnabled      bool              `json:"mfa_enabled"`
+	HasLoginProfile bool              `json:"has_login_profile"`
+	AccessKeys      []AWSIAMAccessKey `json:"access_keys,omitempty"`
+}
+
+// AWSIAMAccessKey describes a single IAM user access key. Status is "Active"
+// or "Inactive"; CreatedAt is the key creation time reported by IAM.
+type AWSIAMAccessKey struct {
+	AccessKeyID string    `json:"access_key_id"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 // AWSRootAccountInfo captures relevant security attributes of the AWS root account.
@@ -58,10 +109,21 @@ type AWSRootAccountInfo struct {
 }
 
 // AWSCloudTrailStatus holds the CloudTrail configuration for an AWS account.
-// HasMultiRegionTrail is true when at least one trail is configured to record
-// events across all regions (IsMultiRegionTrail == true in AWS SDK response).
+// HasMultiRegionTrail is true when at least one multi-region trail is logging
+// and records management events. Trails lists every trail owned by the account.
 type AWSCloudTrailStatus struct {
-	HasMultiRegionTrail bool `json:"has_multi_region_trail"`
+	HasMultiRegionTrail bool                 `json:"has_multi_region_trail"`
+	Trails              []AWSCloudTrailTrail `json:"trails,omitempty"`
+}
+
+// AWSCloudTrailTrail describes a single CloudTrail trail. IsLogging and
+// ManagementEvents are false when their status could not be read.
+type AWSCloudTrailTrail struct {
+	Name             string `json:"name"`
+	HomeRegion       string `json:"home_region"`
+	IsMultiRegion    bool   `json:"is_multi_region"`
+	IsLogging        bool   `json:"is_logging"`
+	ManagementEvents bool   `json:"management_events"`
 }
 
 // AWSGuardDutyStatus holds the GuardDuty detector status for a single region.
@@ -78,3 +140,61 @@ type AWSConfigStatus struct {
 	Region  string `json:"region"`
 	Enabled bool   `json:"enabled"`
 }
+
+// AWSKMSKey describes a KMS key and its automatic rotation status.
+// KeyManager is "CUSTOMER" or "AWS"; KeySpec is e.g. "SYMMETRIC_DEFAULT" or
+// "RSA_2048"; Origin is "AWS_KMS", "EXTERNAL", "AWS_CLOUDHSM", or
+// "EXTERNAL_KEY_STORE". RotationEnabled is only queried for customer-managed
+// symmetric keys and is false for every other key. RotationStatusKnown is
+// true when that query succeeded; when it is false RotationEnabled carries no
+// information.
+type AWSKMSKey struct {
+	KeyID               string `json:"key_id"`
+	ARN                 string `json:"arn"`
+	Region              string `json:"region"`
+	KeyManager          string `json:"key_manager"`
+	KeySpec             string `json:"key_spec"`
+	Origin              string `json:"origin"`
+	KeyState            string `json:"key_state"`
+	RotationEnabled     bool   `json:"rotation_enabled"`
+	RotationStatusKnown bool   `json:"rotation_status_known"`
+}
+
+// AWSECRImageScan holds the image scan summary of the most recently pushed
+// image in an ECR repository. ScanStatus is the ECR scan status ("COMPLETE",
+// "ACTIVE", "IN_PROGRESS", "FAILED", ...); SeverityCounts maps a severity
+// ("CRITICAL", "HIGH", ...) to its finding count and is only populated once
+// a scan has completed. Tag is the first image tag, empty for untagged images.
+type AWSECRImageScan struct {
+	Repository     string           `json:"repository"`
+	Region         string           `json:"region"`
+	Tag            string           `json:"tag,omitempty"`
+	Digest         string           `json:"digest"`
+	PushedAt       time.Time        `json:"pushed_at"`
+	ScanStatus     string           `json:"scan_status,omitempty"`
+	SeverityCounts map[string]int32 `json:"severity_counts,omitempty"`
+}
+
+// AWSVPC describes a VPC and whether it has flow logging. IsDefault is true
+// for the region's default VPC. FlowLogsEnabled is true when at least one
+// ACTIVE flow log is attached to the VPC itself; subnet- and ENI-level flow
+// logs do not count.
+type AWSVPC struct {
+	VPCID           string `json:"vpc_id"`
+	Region          string `json:"region"`
+	IsDefault       bool   `json:"is_default"`
+	FlowLogsEnabled bool   `json:"flow_logs_enabled"`
+}
+
+// AWSACMCertificate describes an ACM certificate and when it expires.
+// DomainName is the certificate's primary domain; Status is the ACM status
+// ("ISSUED", "EXPIRED", "PENDING_VALIDATION", ...). NotAfter is zero for
+// certificates that have not been issued.
+type AWSACMCertificate struct {
+	CertificateARN string    `json:"certificate_arn"`
+	DomainName     string    `json:"domain_name"`
+	Region         string    `json:"region"`
+	Status         string    `json:"status"`
+	InUse          bool      `json:"in_use"`
+	NotAfter       time.Time `json:"not_after"`
+}
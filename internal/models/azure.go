@@ -0,0 +1,47 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Azure raw resource models (collected by provider, consumed by rule engine)
+// ---------------------------------------------------------------------------
+
+// AzureVM represents a single collected Azure virtual machine.
+// PowerState is the code suffix of the "PowerState/..." instance view status
+// (e.g. "running", "deallocated"). AvgCPUPercent is the "Percentage CPU"
+// average over the lookback window; zero means metrics were unavailable.
+// MonthlyCostUSD is zero when no cost data was collected.
+type AzureVM struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	ResourceGroup  string            `json:"resource_group"`
+	Location       string            `json:"location"`
+	VMSize         string            `json:"vm_size"`
+	PowerState     string            `json:"power_state"`
+	AvgCPUPercent  float64           `json:"avg_cpu_percent"`
+	MonthlyCostUSD float64           `json:"monthly_cost_usd"`
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+// AzureDisk represents a single collected Azure managed disk.
+// DiskState is the Azure disk state (e.g. "Attached", "Unattached",
+// "Reserved"); ManagedBy is the resource ID of the owning VM, empty when the
+// disk is not attached.
+type AzureDisk struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	ResourceGroup string            `json:"resource_group"`
+	Location      string            `json:"location"`
+	SKU           string            `json:"sku"`
+	SizeGB        int32             `json:"size_gb"`
+	DiskState     string            `json:"disk_state"`
+	ManagedBy     string            `json:"managed_by,omitempty"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+// AzureSubscriptionData is the complete set of resources collected from one
+// Azure subscription. Unlike AWSRegionData it spans every location; each
+// resource carries its own Location.
+type AzureSubscriptionData struct {
+	SubscriptionID string      `json:"subscription_id"`
+	VMs            []AzureVM   `json:"vms"`
+	Disks          []AzureDisk `json:"disks"`
+}
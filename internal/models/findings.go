@@ -13,6 +13,18 @@ const (
 	SeverityInfo     Severity = "INFO"
 )
 
+// Confidence is how certain a rule is that its finding is real. Rules backed
+// by a deterministic configuration check report ConfidenceHigh; heuristics
+// such as idle detection from utilisation metrics report a lower level. The
+// engine sets ConfidenceHigh on findings whose rule left it empty.
+type Confidence string
+
+const (
+	ConfidenceHigh   Confidence = "high"
+	ConfidenceMedium Confidence = "medium"
+	ConfidenceLow    Confidence = "low"
+)
+
 // ResourceType identifies the kind of cloud resource a finding refers to.
 type ResourceType string
 
@@ -28,6 +40,16 @@ const (
 	ResourceAWSSecurityGroup ResourceType = "SECURITY_GROUP"
 	ResourceAWSIAMUser       ResourceType = "IAM_USER"
 	ResourceAWSRootAccount   ResourceType = "ROOT_ACCOUNT"
+	ResourceAWSLogGroup      ResourceType = "LOG_GROUP"
+	ResourceAWSKMSKey        ResourceType = "KMS_KEY"
+	ResourceAWSECRRepository ResourceType = "ECR_REPOSITORY"
+	ResourceAWSElasticIP     ResourceType = "ELASTIC_IP"
+	ResourceAWSVPC           ResourceType = "VPC"
+	ResourceAWSACMCert       ResourceType = "ACM_CERTIFICATE"
+
+	// Azure resource types
+	ResourceAzureVM   ResourceType = "AZURE_VM"
+	ResourceAzureDisk ResourceType = "AZURE_MANAGED_DISK"
 
 	// Kubernetes resource types
 	ResourceK8sNode           ResourceType = "K8S_NODE"
@@ -36,6 +58,11 @@ const (
 	ResourceK8sPod            ResourceType = "K8S_POD"
 	ResourceK8sService        ResourceType = "K8S_SERVICE"
 	ResourceK8sServiceAccount ResourceType = "K8S_SERVICEACCOUNT"
+	ResourceK8sIngress        ResourceType = "K8S_INGRESS"
+	ResourceK8sDeployment     ResourceType = "K8S_DEPLOYMENT"
+	ResourceK8sStatefulSet    ResourceType = "K8S_STATEFULSET"
+	ResourceK8sRole           ResourceType = "K8S_ROLE"
+	ResourceK8sClusterRole    ResourceType = "K8S_CLUSTERROLE"
 )
 
 // Finding is a single detected waste or inefficiency issue.
@@ -50,11 +77,23 @@ type Finding struct {
 	Profile                 string         `json:"profile"`
 	Domain                  string         `json:"domain"`
 	Severity                Severity       `json:"severity"`
+	Confidence              Confidence     `json:"confidence,omitempty"`
 	EstimatedMonthlySavings float64        `json:"estimated_monthly_savings_usd"`
 	Explanation             string         `json:"explanation"`
 	Recommendation          string         `json:"recommendation"`
 	DetectedAt              time.Time      `json:"detected_at"`
 	Metadata                map[string]any `json:"metadata,omitempty"`
+	// FirstSeen, LastSeen and AgeDays track the finding across runs that share
+	// a --state-file: when it was first and most recently reported, and the
+	// whole days between FirstSeen and this run. All three are omitted when no
+	// state file is used (AgeDays is also omitted on the first sighting).
+	FirstSeen time.Time `json:"first_seen,omitzero"`
+	LastSeen  time.Time `json:"last_seen,omitzero"`
+	AgeDays   int       `json:"age_days,omitempty"`
+	// Fingerprint is a stable key for the finding across runs: the SHA-256 of
+	// its rule, profile, account, region, namespace and resource (see
+	// engine.FindingFingerprint). External systems can use it to deduplicate.
+	Fingerprint string `json:"fingerprint,omitempty"`
 }
 
 // RiskChain groups findings that participate in the same compound risk
@@ -81,6 +120,13 @@ type AttackPath struct {
 	FindingIDs []string `json:"finding_ids"`
 	// Description is the human-readable summary of the attack scenario.
 	Description string `json:"description"`
+	// Namespaces lists the namespaces whose identical per-namespace paths were
+	// collapsed into this entry. Populated only when path collapsing is
+	// requested (--collapse-paths); len(Namespaces) is the collapsed count.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Techniques lists the MITRE ATT&CK technique IDs (e.g. "T1190") the
+	// path enables, in attack order.
+	Techniques []string `json:"techniques"`
 }
 
 // AuditSummary aggregates counts and totals across all findings.
@@ -95,16 +141,104 @@ type AuditSummary struct {
 	// chains (attack paths take precedence when present). 0 means no correlation
 	// was detected. Populated only for Kubernetes audits.
 	RiskScore int `json:"risk_score"`
+	// RiskGrade is the overall letter grade A–F derived from the
+	// severity-weighted finding counts and, for Kubernetes audits, RiskScore.
+	// Bands are configurable via dp.yaml risk_grade_thresholds.
+	RiskGrade string `json:"risk_grade,omitempty"`
+	// DomainRiskScores maps each AWS domain (cost, security, dataprotection) to
+	// a 0–100 score weighted by its finding severities. Populated only for
+	// all-domains AWS audits.
+	DomainRiskScores map[string]int `json:"domain_risk_scores,omitempty"`
 	// AttackPaths lists multi-layer compound attack paths ordered by descending
 	// score. Populated only when ShowRiskChains is requested (omitted otherwise).
 	AttackPaths []AttackPath `json:"attack_paths,omitempty"`
 	// RiskChains groups findings by compound risk chain, ordered by descending
 	// score. Populated only when ShowRiskChains is requested (omitted otherwise).
 	RiskChains []RiskChain `json:"risk_chains,omitempty"`
+	// Compliance lists pass/fail rule counts per control framework, ordered by
+	// framework name. Empty when no active rule declares a framework.
+	Compliance []FrameworkCompliance `json:"compliance,omitempty"`
+	// TeamRisks aggregates findings per owning team, ordered by team name with
+	// "unassigned" last. Populated only by dp kubernetes audit
+	// --format-findings-by owner.
+	TeamRisks []TeamRisk `json:"team_risks,omitempty"`
+	// ExitCode is the process exit code the audit command ends with: 1 when
+	// policy enforcement fired or any CRITICAL/HIGH finding exists, else 0.
+	// dp aws audit --all records 2 when policy enforcement fired, and
+	// --fail-on-errors records 3 when Errors is the only failure.
+	// Set by the command layer before the report is written or printed.
+	ExitCode int `json:"exit_code"`
+}
+
+// FrameworkCompliance summarises, for one control framework, how many of the
+// rules mapped to it produced no finding (passed) or at least one (failed).
+type FrameworkCompliance struct {
+	Framework   string `json:"framework"`
+	RulesPassed int    `json:"rules_passed"`
+	RulesFailed int    `json:"rules_failed"`
+}
+
+// TeamRisk counts the findings owned by one team, as mapped from namespaces by
+// dp.yaml namespace_owners. Namespaces lists, sorted, the namespaces those
+// findings came from; it is empty for a team holding only cluster-scoped
+// findings.
+type TeamRisk struct {
+	Team             string   `json:"team"`
+	Namespaces       []string `json:"namespaces,omitempty"`
+	TotalFindings    int      `json:"total_findings"`
+	CriticalFindings int      `json:"critical_findings"`
+	HighFindings     int      `json:"high_findings"`
+	MediumFindings   int      `json:"medium_findings"`
+	LowFindings      int      `json:"low_findings"`
 }
 
+// PassedResource identifies one collected resource that produced no finding.
+// Namespace is set only for namespaced Kubernetes resources.
+type PassedResource struct {
+	ResourceID   string       `json:"resource_id"`
+	ResourceType ResourceType `json:"resource_type"`
+	Region       string       `json:"region"`
+	Namespace    string       `json:"namespace,omitempty"`
+	Profile      string       `json:"profile,omitempty"`
+	Domain       string       `json:"domain"`
+}
+
+// Audit error stages.
+const (
+	// AuditStageCollect marks a failure while loading credentials or
+	// collecting provider data.
+	AuditStageCollect = "collect"
+	// AuditStageEvaluate marks a rule that panicked during evaluation.
+	AuditStageEvaluate = "evaluate"
+)
+
+// AuditError records one non-fatal failure that left the audit with partial
+// data. Provider names the collector or engine that failed, e.g. "aws/cost",
+// "aws/eks", "kubernetes" or "azure". Profile is set when the failure is
+// confined to one AWS profile of a multi-profile audit.
+type AuditError struct {
+	Stage    string `json:"stage"`
+	Provider string `json:"provider"`
+	Profile  string `json:"profile,omitempty"`
+	Region   string `json:"region,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ReportSchemaVersion is the AuditReport JSON format version written to
+// AuditReport.SchemaVersion. Bump it when a change to the report would break
+// consumers reading older reports (a removed or re-typed field), not when a
+// field is added.
+const ReportSchemaVersion = "1"
+
 // AuditReport is the top-level, SaaS-compatible output of any audit run.
 type AuditReport struct {
+	// SchemaVersion is the ReportSchemaVersion the report was written with.
+	SchemaVersion string `json:"schema_version"`
+	// Provider is the audited platform: "aws" for the AWS domains, "azure",
+	// or the detected Kubernetes cluster provider ("eks", "gke", "aks",
+	// "unknown"; "mixed" when a multi-context audit spans providers).
+	Provider string `json:"provider,omitempty"`
+
 	ReportID    string          `json:"report_id"`
 	GeneratedAt time.Time       `json:"generated_at"`
 	AuditType   string          `json:"audit_type"`
@@ -114,7 +248,18 @@ type AuditReport struct {
 	Summary     AuditSummary    `json:"summary"`
 	Findings    []Finding       `json:"findings"`
 	CostSummary *AWSCostSummary `json:"cost_summary,omitempty"`
+	// PassedResources lists the collected resources no rule produced a
+	// finding for. Populated only when the audit runs with --show-passed.
+	PassedResources []PassedResource `json:"passed_resources,omitempty"`
 	// Metadata carries optional, audit-type-specific key/value pairs.
-	// For Kubernetes audits this includes "cluster_provider".
+	// For Kubernetes audits this includes "cluster_provider", which is
+	// also promoted to Provider.
 	Metadata map[string]any `json:"metadata,omitempty"`
+	// Errors lists the collector and rule failures the audit recovered from.
+	// Findings from the sources that succeeded are still reported.
+	Errors []AuditError `json:"errors,omitempty"`
+	// Signature is the base64 ed25519 signature over the report's canonical
+	// JSON encoding with Signature itself empty. Set only when the audit runs
+	// with --sign-key.
+	Signature string `json:"signature,omitempty"`
 }
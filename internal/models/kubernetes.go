@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // KubernetesNodeData holds processed node resource data consumed by K8s rules.
 type KubernetesNodeData struct {
 	// Name is the Kubernetes node name.
@@ -31,9 +33,18 @@ type KubernetesNamespaceData struct {
 	// in the namespace, indicating default resource limits are configured.
 	HasLimitRange bool `json:"has_limit_range"`
 
+	// HasResourceQuota is true when at least one ResourceQuota object exists
+	// in the namespace, capping its aggregate CPU, memory and object counts.
+	HasResourceQuota bool `json:"has_resource_quota"`
+
 	// Labels is a copy of the namespace's label map, used for Pod Security
 	// Admission enforcement checks (pod-security.kubernetes.io/enforce).
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// PSAEnforce is the Pod Security Admission level enforced in the namespace
+	// ("privileged", "baseline", or "restricted"), taken from the
+	// pod-security.kubernetes.io/enforce label. Empty when the label is absent.
+	PSAEnforce string `json:"psa_enforce,omitempty"`
 }
 
 // KubernetesServiceAccountData holds processed ServiceAccount data consumed
@@ -53,6 +64,62 @@ type KubernetesServiceAccountData struct {
 	// Annotations is a copy of the ServiceAccount's annotation map.
 	// Used to check for the IRSA annotation (eks.amazonaws.com/role-arn).
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// CreatedAt is metadata.creationTimestamp. Zero when unknown.
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// KubernetesRBACSubjectData identifies a single subject of an RBAC binding.
+type KubernetesRBACSubjectData struct {
+	// Kind is the subject kind: "ServiceAccount", "User", or "Group".
+	Kind string `json:"kind"`
+
+	// Name is the subject name.
+	Name string `json:"name"`
+
+	// Namespace is the subject namespace. Only set for ServiceAccount subjects.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// KubernetesClusterRoleBindingData holds processed ClusterRoleBinding data
+// consumed by K8s RBAC rules.
+type KubernetesClusterRoleBindingData struct {
+	// Name is the ClusterRoleBinding name.
+	Name string `json:"name"`
+
+	// RoleName is the referenced ClusterRole name (roleRef.name).
+	RoleName string `json:"role_name"`
+
+	// Subjects lists every subject bound by this ClusterRoleBinding.
+	Subjects []KubernetesRBACSubjectData `json:"subjects,omitempty"`
+}
+
+// KubernetesPolicyRuleData holds one RBAC PolicyRule of a Role or ClusterRole.
+type KubernetesPolicyRuleData struct {
+	// APIGroups lists the rule's apiGroups ("" is the core group, "*" is all).
+	APIGroups []string `json:"api_groups,omitempty"`
+
+	// Resources lists the rule's resources ("*" is all).
+	Resources []string `json:"resources,omitempty"`
+
+	// Verbs lists the rule's verbs ("*" is all).
+	Verbs []string `json:"verbs,omitempty"`
+}
+
+// KubernetesRoleData holds processed Role or ClusterRole data consumed by K8s
+// RBAC rules.
+type KubernetesRoleData struct {
+	// Kind is "Role" or "ClusterRole".
+	Kind string `json:"kind"`
+
+	// Name is the Role or ClusterRole name.
+	Name string `json:"name"`
+
+	// Namespace is the Role namespace. Empty for ClusterRoles.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Rules lists the role's resource rules in declaration order.
+	Rules []KubernetesPolicyRuleData `json:"rules,omitempty"`
 }
 
 // KubernetesContainerData holds processed container data consumed by K8s rules.
@@ -60,6 +127,10 @@ type KubernetesContainerData struct {
 	// Name is the container name within the pod spec.
 	Name string `json:"name"`
 
+	// Image is the container image reference as written in the pod spec
+	// (e.g. "nginx:1.25", "registry:5000/app@sha256:...").
+	Image string `json:"image,omitempty"`
+
 	// Privileged is true when securityContext.privileged == true.
 	Privileged bool `json:"privileged"`
 
@@ -85,6 +156,34 @@ type KubernetesContainerData struct {
 	// collection time (container-level overrides pod-level).
 	// Values: "RuntimeDefault", "Localhost", "Unconfined", or "" when not set.
 	SeccompProfileType string `json:"seccomp_profile_type,omitempty"`
+
+	// ReadOnlyRootFilesystem is true when
+	// securityContext.readOnlyRootFilesystem == true.
+	ReadOnlyRootFilesystem bool `json:"read_only_root_filesystem"`
+
+	// HasLivenessProbe is true when the container declares a livenessProbe.
+	HasLivenessProbe bool `json:"has_liveness_probe"`
+
+	// HasReadinessProbe is true when the container declares a readinessProbe.
+	HasReadinessProbe bool `json:"has_readiness_probe"`
+
+	// SecretEnvRefs lists the Secrets exposed to the container as environment
+	// variables, via env[].valueFrom.secretKeyRef or envFrom[].secretRef.
+	SecretEnvRefs []KubernetesSecretEnvRef `json:"secret_env_refs,omitempty"`
+}
+
+// KubernetesSecretEnvRef is one Secret reference in a container's
+// environment. EnvVar and Key are set for env[].valueFrom.secretKeyRef and
+// empty for envFrom[].secretRef, which imports every key of the Secret.
+type KubernetesSecretEnvRef struct {
+	// SecretName is the name of the referenced Secret.
+	SecretName string `json:"secret_name"`
+
+	// EnvVar is the environment variable the Secret key is exposed as.
+	EnvVar string `json:"env_var,omitempty"`
+
+	// Key is the Secret key read into EnvVar.
+	Key string `json:"key,omitempty"`
 }
 
 // KubernetesPodData holds processed pod data consumed by K8s rules.
@@ -109,8 +208,41 @@ type KubernetesPodData struct {
 	// "default" service account for the pod's namespace.
 	ServiceAccountName string `json:"service_account_name,omitempty"`
 
+	// AutomountServiceAccountToken reflects spec.automountServiceAccountToken,
+	// which overrides the ServiceAccount's setting. Nil means the field was not
+	// set on the pod and the ServiceAccount's setting applies.
+	AutomountServiceAccountToken *bool `json:"automount_service_account_token,omitempty"`
+
 	// Containers holds per-container security and resource data.
 	Containers []KubernetesContainerData `json:"containers,omitempty"`
+
+	// InitContainers holds the same data for spec.initContainers. Only rules
+	// that explicitly opt in inspect init containers.
+	InitContainers []KubernetesContainerData `json:"init_containers,omitempty"`
+
+	// Labels is a copy of the pod's label map. Used by --annotate-findings.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// CreatedAt is metadata.creationTimestamp. Zero when unknown.
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// KubernetesImage is one entry of the running-image inventory recorded under
+// AuditReport.Metadata["images"] by --image-inventory. Init containers count
+// as running images.
+type KubernetesImage struct {
+	// Image is the image reference as written in the pod spec.
+	Image string `json:"image"`
+
+	// Pods is the number of distinct pods running the image.
+	Pods int `json:"pods"`
+
+	// Containers is the number of containers (including init containers)
+	// running the image. It exceeds Pods when a pod runs the image twice.
+	Containers int `json:"containers"`
+
+	// Namespaces lists the namespaces of those pods, sorted and de-duplicated.
+	Namespaces []string `json:"namespaces"`
 }
 
 // KubernetesServiceData holds processed Service data consumed by K8s rules.
@@ -126,6 +258,82 @@ type KubernetesServiceData struct {
 
 	// Annotations is a copy of the Service's annotation map.
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// CreatedAt is metadata.creationTimestamp. Zero when unknown.
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// KubernetesIngressData holds processed Ingress data consumed by K8s rules.
+type KubernetesIngressData struct {
+	// Name is the Ingress name.
+	Name string `json:"name"`
+
+	// Namespace is the Kubernetes namespace that owns this Ingress.
+	Namespace string `json:"namespace"`
+
+	// Hosts lists the distinct hosts served by the Ingress rules.
+	// Catch-all rules without a host are not included.
+	Hosts []string `json:"hosts,omitempty"`
+
+	// TLSHosts lists every host named in the Ingress TLS section.
+	TLSHosts []string `json:"tls_hosts,omitempty"`
+
+	// HasTLS is true when the Ingress declares a TLS section.
+	HasTLS bool `json:"has_tls"`
+
+	// CreatedAt is metadata.creationTimestamp. Zero when unknown.
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// KubernetesWorkloadData holds the replica count and pod template labels of a
+// Deployment or StatefulSet, consumed by availability rules.
+type KubernetesWorkloadData struct {
+	// Kind is "Deployment" or "StatefulSet".
+	Kind string `json:"kind"`
+
+	// Name is the workload name.
+	Name string `json:"name"`
+
+	// Namespace is the Kubernetes namespace that owns this workload.
+	Namespace string `json:"namespace"`
+
+	// Replicas is the desired replica count (1 when spec.replicas is unset).
+	Replicas int `json:"replicas"`
+
+	// PodLabels is a copy of the pod template's label map.
+	PodLabels map[string]string `json:"pod_labels,omitempty"`
+
+	// CreatedAt is metadata.creationTimestamp. Zero when unknown.
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// KubernetesLabelSelectorRequirement is one matchExpressions entry of a label
+// selector.
+type KubernetesLabelSelectorRequirement struct {
+	// Key is the label key the requirement applies to.
+	Key string `json:"key"`
+
+	// Operator is "In", "NotIn", "Exists" or "DoesNotExist".
+	Operator string `json:"operator"`
+
+	// Values lists the operand values for In and NotIn.
+	Values []string `json:"values,omitempty"`
+}
+
+// KubernetesPDBData holds the pod selector of a PodDisruptionBudget. An empty
+// selector (no labels, no expressions) selects every pod in the namespace.
+type KubernetesPDBData struct {
+	// Name is the PodDisruptionBudget name.
+	Name string `json:"name"`
+
+	// Namespace is the Kubernetes namespace that owns this PodDisruptionBudget.
+	Namespace string `json:"namespace"`
+
+	// MatchLabels is a copy of spec.selector.matchLabels.
+	MatchLabels map[string]string `json:"match_labels,omitempty"`
+
+	// MatchExpressions is spec.selector.matchExpressions.
+	MatchExpressions []KubernetesLabelSelectorRequirement `json:"match_expressions,omitempty"`
 }
 
 // KubernetesEKSData holds EKS-specific cluster configuration collected from
@@ -138,6 +346,10 @@ type KubernetesEKSData struct {
 	// Region is the AWS region where the EKS cluster runs.
 	Region string `json:"region"`
 
+	// Version is the cluster's Kubernetes minor version as reported by EKS
+	// (e.g. "1.29"). Empty when the API did not report it.
+	Version string `json:"version,omitempty"`
+
 	// EndpointPublicAccess is true when the Kubernetes API server endpoint is
 	// publicly accessible from the internet (ResourcesVpcConfig.EndpointPublicAccess).
 	EndpointPublicAccess bool `json:"endpoint_public_access"`
@@ -156,13 +368,21 @@ type KubernetesEKSData struct {
 	// When false, secrets stored in etcd are not encrypted at rest.
 	EncryptionEnabled bool `json:"encryption_enabled"`
 
+	// EncryptedResources lists the Kubernetes resource types covered by the
+	// cluster's envelope encryption (cluster.EncryptionConfig[].Resources),
+	// e.g. ["secrets"]. Empty when encryption is disabled or the resource list
+	// was not collected.
+	EncryptedResources []string `json:"encrypted_resources,omitempty"`
+
 	// OIDCIssuer is the OIDC provider issuer URL associated with the cluster
 	// (cluster.Identity.Oidc.Issuer). Empty when no OIDC provider is configured.
 	OIDCIssuer string `json:"oidc_issuer,omitempty"`
 
 	// OIDCProviderARN is the IAM OIDC provider ARN associated with the cluster.
-	// Populated by matching the OIDCIssuer URL against IAM OIDC providers.
-	// Empty when no matching IAM OIDC provider exists — fires EKS_OIDC_PROVIDER_NOT_ASSOCIATED.
+	// Populated by matching the OIDCIssuer URL against IAM OIDC providers, or
+	// with the only EKS provider in the issuer's region when none matches (its
+	// URL then differs from OIDCIssuer — fires EKS_OIDC_ISSUER_MISMATCH).
+	// Empty when no provider can be attributed — fires EKS_OIDC_PROVIDER_NOT_ASSOCIATED.
 	// Format: arn:aws:iam::{accountID}:oidc-provider/oidc.eks.{region}.amazonaws.com/id/{hash}
 	OIDCProviderARN string `json:"oidc_provider_arn,omitempty"`
 
@@ -170,6 +390,48 @@ type KubernetesEKSData struct {
 	// node group IAM role (AdministratorAccess or inline policies with Action:"*").
 	// A non-empty list fires EKS_NODE_ROLE_OVERPERMISSIVE (CRITICAL).
 	NodeRolePolicies []string `json:"node_role_policies,omitempty"`
+
+	// Addons lists the cluster's EKS managed add-ons (vpc-cni, coredns,
+	// kube-proxy, ...) with their installed and latest available versions.
+	// Evaluated by EKS_ADDON_OUTDATED.
+	Addons []KubernetesEKSAddonData `json:"addons,omitempty"`
+
+	// NodeSecurityGroupIngress lists the inbound IP rules of the node security
+	// groups: the cluster security group EKS attaches to managed nodes and the
+	// remote-access security group of each managed node group. One entry per
+	// CIDR. Evaluated by EKS_NODE_SG_OPEN_INGRESS.
+	NodeSecurityGroupIngress []KubernetesEKSIngressRule `json:"node_security_group_ingress,omitempty"`
+}
+
+// KubernetesEKSIngressRule is one inbound rule of an EKS node security group
+// for a single IPv4 or IPv6 CIDR.
+type KubernetesEKSIngressRule struct {
+	// GroupID is the security group ID (e.g. "sg-0123456789abcdef0").
+	GroupID string `json:"group_id"`
+
+	// Protocol is the IP protocol ("tcp", "udp", "icmp") or "-1" for all traffic.
+	Protocol string `json:"protocol"`
+
+	// FromPort and ToPort bound the allowed port range. Both are 0 and 65535
+	// when the rule allows all traffic.
+	FromPort int `json:"from_port"`
+	ToPort   int `json:"to_port"`
+
+	// CIDR is the allowed source range (e.g. "0.0.0.0/0" or "::/0").
+	CIDR string `json:"cidr"`
+}
+
+// KubernetesEKSAddonData describes one EKS managed add-on installed on the cluster.
+type KubernetesEKSAddonData struct {
+	// Name is the add-on name (e.g. "vpc-cni", "coredns", "kube-proxy").
+	Name string `json:"name"`
+
+	// Version is the installed add-on version (e.g. "v1.15.1-eksbuild.1").
+	Version string `json:"version"`
+
+	// LatestVersion is the newest add-on version EKS offers for the cluster's
+	// Kubernetes version. Empty when the available versions could not be listed.
+	LatestVersion string `json:"latest_version,omitempty"`
 }
 
 // KubernetesClusterData holds all cluster inventory consumed by Kubernetes rules.
@@ -197,9 +459,24 @@ type KubernetesClusterData struct {
 	// Services holds per-Service network exposure data.
 	Services []KubernetesServiceData `json:"services,omitempty"`
 
+	// Ingresses holds per-Ingress host and TLS data.
+	Ingresses []KubernetesIngressData `json:"ingresses,omitempty"`
+
+	// Workloads holds the Deployments and StatefulSets collected from the cluster.
+	Workloads []KubernetesWorkloadData `json:"workloads,omitempty"`
+
+	// PodDisruptionBudgets holds the PodDisruptionBudgets that select pods.
+	PodDisruptionBudgets []KubernetesPDBData `json:"pod_disruption_budgets,omitempty"`
+
 	// ServiceAccounts holds all ServiceAccounts collected from the cluster.
 	ServiceAccounts []KubernetesServiceAccountData `json:"service_accounts,omitempty"`
 
+	// ClusterRoleBindings holds all ClusterRoleBindings collected from the cluster.
+	ClusterRoleBindings []KubernetesClusterRoleBindingData `json:"cluster_role_bindings,omitempty"`
+
+	// Roles holds all Roles and ClusterRoles collected from the cluster.
+	Roles []KubernetesRoleData `json:"roles,omitempty"`
+
 	// EKSData holds EKS-specific control-plane configuration.
 	// Nil for non-EKS clusters or when EKS data collection is disabled.
 	EKSData *KubernetesEKSData `json:"eks_data,omitempty"`
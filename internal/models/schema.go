@@ -0,0 +1,17 @@
+package models
+
+import _ "embed"
+
+// reportSchema is the hand-maintained JSON Schema (draft 2020-12) for
+// AuditReport. Update report.schema.json whenever a JSON-tagged field on
+// AuditReport, AuditSummary, Finding, RiskChain, AttackPath,
+// FrameworkCompliance, PassedResource or AWSCostSummary changes.
+//
+//go:embed report.schema.json
+var reportSchema []byte
+
+// ReportSchemaJSON returns a copy of the JSON Schema describing the
+// AuditReport JSON produced by every audit command.
+func ReportSchemaJSON() []byte {
+	return append([]byte(nil), reportSchema...)
+}
@@ -0,0 +1,65 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// histogramBlock is the character bars are drawn with.
+const histogramBlock = "█"
+
+// SeverityHistogram returns a one-line bar chart of the CRITICAL, HIGH, MEDIUM
+// and LOW counts in s, e.g. "C██ H████ M██ L█". Bar lengths are proportional
+// to each severity's share of the four counts and sized so the line fits in
+// width columns. Every non-zero count gets at least one block.
+func SeverityHistogram(s models.AuditSummary, width int) string {
+	labels := []string{"C", "H", "M", "L"}
+	counts := []int{s.CriticalFindings, s.HighFindings, s.MediumFindings, s.LowFindings}
+
+	// One column per label plus a space between segments.
+	bars := HistogramBars(counts, width-len(labels)-(len(labels)-1))
+
+	segments := make([]string, len(labels))
+	for i, label := range labels {
+		segments[i] = label + strings.Repeat(histogramBlock, bars[i])
+	}
+	return strings.Join(segments, " ")
+}
+
+// HistogramBars scales counts into bar lengths that together fill at most
+// budget columns, each proportional to its share of the total. A non-zero
+// count never rounds down to an empty bar, so the result may exceed budget by
+// at most one column per count when budget is very small.
+func HistogramBars(counts []int, budget int) []int {
+	bars := make([]int, len(counts))
+	total := 0
+	for _, c := range counts {
+		if c > 0 {
+			total += c
+		}
+	}
+	if total == 0 {
+		return bars
+	}
+	budget = max(budget, 0)
+	for i, c := range counts {
+		if c <= 0 {
+			continue
+		}
+		bars[i] = max(c*budget/total, 1)
+	}
+	return bars
+}
+
+// RenderHistogram writes SeverityHistogram(s, width) followed by a blank line
+// to w. It writes nothing when s has no findings.
+func RenderHistogram(w io.Writer, s models.AuditSummary, width int) {
+	if s.CriticalFindings+s.HighFindings+s.MediumFindings+s.LowFindings == 0 {
+		return
+	}
+	fmt.Fprintln(w, SeverityHistogram(s, width))
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,66 @@
+package output_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/output"
+)
+
+func TestHistogramBars_Proportional(t *testing.T) {
+	got := output.HistogramBars([]int{1, 4, 2, 1}, 40)
+	want := []int{5, 20, 10, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("HistogramBars = %v; want %v", got, want)
+	}
+}
+
+func TestHistogramBars_NonZeroGetsAtLeastOneBlock(t *testing.T) {
+	got := output.HistogramBars([]int{0, 100, 0, 1}, 20)
+	want := []int{0, 19, 0, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("HistogramBars = %v; want %v", got, want)
+	}
+}
+
+func TestHistogramBars_AllZero(t *testing.T) {
+	got := output.HistogramBars([]int{0, 0, 0, 0}, 40)
+	if !slices.Equal(got, []int{0, 0, 0, 0}) {
+		t.Errorf("HistogramBars = %v; want all zero", got)
+	}
+}
+
+func TestSeverityHistogram_FitsWidth(t *testing.T) {
+	s := models.AuditSummary{CriticalFindings: 3, HighFindings: 12, MediumFindings: 7, LowFindings: 2}
+	for _, width := range []int{40, 80, 200} {
+		line := output.SeverityHistogram(s, width)
+		if n := utf8.RuneCountInString(line); n > width {
+			t.Errorf("width %d: line is %d columns: %q", width, n, line)
+		}
+		if !strings.HasPrefix(line, "C█") || !strings.Contains(line, " H█") || !strings.Contains(line, " L█") {
+			t.Errorf("width %d: unexpected layout %q", width, line)
+		}
+	}
+}
+
+func TestSeverityHistogram_Layout(t *testing.T) {
+	s := models.AuditSummary{CriticalFindings: 1, HighFindings: 2, MediumFindings: 0, LowFindings: 1}
+	// 19 columns leaves 12 for bars: 3, 6, 0 and 3 blocks.
+	got := output.SeverityHistogram(s, 19)
+	want := "C███ H██████ M L███"
+	if got != want {
+		t.Errorf("SeverityHistogram = %q; want %q", got, want)
+	}
+}
+
+func TestRenderHistogram_NoFindings(t *testing.T) {
+	var buf bytes.Buffer
+	output.RenderHistogram(&buf, models.AuditSummary{}, 80)
+	if buf.Len() != 0 {
+		t.Errorf("expected no output for an empty summary; got %q", buf.String())
+	}
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// currencySymbols maps ISO 4217 codes to the symbol printed before an amount.
+// Codes without an entry are printed as "<CODE> <amount>".
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"INR": "₹",
+}
+
+// Currency converts USD amounts into another currency for display. All
+// engine values, and therefore JSON and --file output, stay in USD; only the
+// table and summary text use Currency.
+//
+// The zero value displays US dollars.
+type Currency struct {
+	// Code is the ISO 4217 currency code (e.g. "EUR"). Empty means "USD".
+	Code string
+
+	// Rate is the number of Code units per US dollar. 0 means 1.
+	Rate float64
+}
+
+// Format converts usd at c.Rate and formats it with formatMoney.
+func (c Currency) Format(usd float64) string {
+	code := c.Code
+	if code == "" {
+		code = "USD"
+	}
+	rate := c.Rate
+	if rate == 0 {
+		rate = 1
+	}
+	return formatMoney(usd*rate, code)
+}
+
+// formatMoney formats amount in currency with two decimals and comma
+// thousands separators: "$1,234.56", "€12,000.00", "CHF 950.00".
+func formatMoney(amount float64, currency string) string {
+	currency = strings.ToUpper(currency)
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	cents := int64(math.Round(amount * 100))
+	number := groupThousands(cents/100) + fmt.Sprintf(".%02d", cents%100)
+	if sym, ok := currencySymbols[currency]; ok {
+		return sign + sym + number
+	}
+	return sign + currency + " " + number
+}
+
+// groupThousands formats n (non-negative) with a comma between each group of
+// three digits.
+func groupThousands(n int64) string {
+	digits := fmt.Sprintf("%d", n)
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		b.WriteString(digits[:lead])
+	}
+	for i := lead; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
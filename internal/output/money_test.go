@@ -0,0 +1,53 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestCurrencyFormat_USDDefault(t *testing.T) {
+	if got := (Currency{}).Format(42.5); got != "$42.50" {
+		t.Errorf("Currency{}.Format(42.5) = %q; want $42.50", got)
+	}
+}
+
+func TestCurrencyFormat_EURWithRate(t *testing.T) {
+	eur := Currency{Code: "EUR", Rate: 0.9}
+	if got := eur.Format(1500); got != "€1,350.00" {
+		t.Errorf("EUR Format(1500) = %q; want €1,350.00", got)
+	}
+}
+
+func TestFormatMoney_Grouping(t *testing.T) {
+	cases := []struct {
+		amount   float64
+		currency string
+		want     string
+	}{
+		{0, "USD", "$0.00"},
+		{999.994, "USD", "$999.99"},
+		{999.995, "USD", "$1,000.00"},
+		{1234567.891, "USD", "$1,234,567.89"},
+		{-12345, "gbp", "-£12,345.00"},
+		{950, "CHF", "CHF 950.00"},
+	}
+	for _, tc := range cases {
+		if got := formatMoney(tc.amount, tc.currency); got != tc.want {
+			t.Errorf("formatMoney(%v, %q) = %q; want %q", tc.amount, tc.currency, got, tc.want)
+		}
+	}
+}
+
+func TestRenderTable_SavingsUseCurrency(t *testing.T) {
+	var buf bytes.Buffer
+	RenderTable(&buf, []models.Finding{{ResourceID: "i-1", EstimatedMonthlySavings: 2000}}, TableOptions{
+		IncludeSavings: true,
+		Currency:       Currency{Code: "EUR", Rate: 0.5},
+	})
+	if !strings.Contains(buf.String(), "€1,000.00") {
+		t.Errorf("table missing converted savings €1,000.00:\n%s", buf.String())
+	}
+}
@@ -3,6 +3,7 @@ package output
 import (
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -34,6 +35,43 @@ type TableOptions struct {
 	// LocationLabel is the column header for the region/context column.
 	// Defaults to "REGION". Use "CONTEXT" for Kubernetes audits.
 	LocationLabel string
+
+	// Currency converts and formats the SAVINGS/MO column. The zero value
+	// prints US dollars.
+	Currency Currency
+
+	// Columns, when non-empty, lists the columns to render in order (names
+	// from TableColumns, validated by ParseTableColumns) and overrides
+	// IncludeSavings, IncludeDomain and IncludeProfile. A requested savings
+	// column is rendered even when no finding has savings.
+	Columns []string
+}
+
+// TableColumns lists the column names accepted by TableOptions.Columns.
+var TableColumns = []string{
+	"resource", "profile", "location", "severity", "domain", "rule",
+	"type", "namespace", "risk", "message", "savings", "fingerprint",
+}
+
+// ParseTableColumns validates the --columns names, lower-casing and trimming
+// them. An unknown or repeated name is an error; an empty list returns nil,
+// which keeps the default layout.
+func ParseTableColumns(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	cols := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(TableColumns, name) {
+			return nil, fmt.Errorf("unknown table column %q (valid: %s)", name, strings.Join(TableColumns, ", "))
+		}
+		if slices.Contains(cols, name) {
+			return nil, fmt.Errorf("table column %q listed more than once", name)
+		}
+		cols = append(cols, name)
+	}
+	return cols, nil
 }
 
 // ColorSeverity wraps a severity string with ANSI codes when colored is true.
@@ -117,11 +155,42 @@ func truncateField(s string, max int) string {
 	return s[:max-1] + "…"
 }
 
+// attackPathPrefix returns a "[PATH <score>] " marker for findings annotated as
+// attack path members (Metadata["in_attack_path"]), or "" otherwise.
+func attackPathPrefix(f models.Finding) string {
+	if in, _ := f.Metadata["in_attack_path"].(bool); !in {
+		return ""
+	}
+	score, _ := metadataInt(f, "attack_path_score")
+	return fmt.Sprintf("[PATH %d] ", score)
+}
+
+// metadataInt returns the integer stored under key in f.Metadata. Engines
+// store scores as int, but a report decoded from JSON (dp render, dp diff)
+// carries them as float64, so both are accepted.
+func metadataInt(f models.Finding, key string) (int, bool) {
+	switch v := f.Metadata[key].(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	}
+	return 0, false
+}
+
+// tableColumn is one RenderTable column. A width of 0 leaves the cell
+// unpadded; it is used for the trailing SAVINGS/MO column.
+type tableColumn struct {
+	header string
+	width  int
+	cell   func(f models.Finding) string
+}
+
 // RenderTable writes a formatted findings table to w.
 // Columns are dynamically selected based on opts; the separator line width is
 // derived from the header row so all rows align correctly.
 //
-// Column order:
+// Default column order (when opts.Columns is empty):
 //
 //	RESOURCE ID  [PROFILE]  LOCATION  SEVERITY  [DOMAIN]  TYPE  MESSAGE  [SAVINGS/MO]
 func RenderTable(w io.Writer, findings []models.Finding, opts TableOptions) {
@@ -134,34 +203,73 @@ func RenderTable(w io.Writer, findings []models.Finding, opts TableOptions) {
 		return
 	}
 
-	showSavings := opts.IncludeSavings && hasSavings(findings)
-
 	// Fixed column display widths.
 	const (
-		wResource = 30
-		wProfile  = 12
-		wLocation = 15
-		wSeverity = 10
-		wDomain   = 15
-		wType     = 18
-		wMessage  = 55
+		wResource    = 30
+		wProfile     = 12
+		wLocation    = 15
+		wSeverity    = 10
+		wDomain      = 15
+		wRule        = 32
+		wType        = 18
+		wNamespace   = 20
+		wRisk        = 5
+		wMessage     = 55
+		wFingerprint = 64
 	)
 
-	// Build the header row.
-	var hb strings.Builder
-	hb.WriteString(fmt.Sprintf("%-*s", wResource, "RESOURCE ID"))
-	if opts.IncludeProfile {
-		hb.WriteString(fmt.Sprintf("  %-*s", wProfile, "PROFILE"))
+	byName := map[string]tableColumn{
+		"resource": {"RESOURCE ID", wResource, func(f models.Finding) string { return truncateField(f.ResourceID, wResource) }},
+		"profile":  {"PROFILE", wProfile, func(f models.Finding) string { return truncateField(f.Profile, wProfile) }},
+		"location": {opts.LocationLabel, wLocation, func(f models.Finding) string { return truncateField(f.Region, wLocation) }},
+		"severity": {"SEVERITY", wSeverity, nil}, // rendered by severityCell
+		"domain":   {"DOMAIN", wDomain, func(f models.Finding) string { return truncateField(f.Domain, wDomain) }},
+		"rule":     {"RULE", wRule, func(f models.Finding) string { return truncateField(f.RuleID, wRule) }},
+		"type":     {"TYPE", wType, func(f models.Finding) string { return truncateField(string(f.ResourceType), wType) }},
+		"namespace": {"NAMESPACE", wNamespace, func(f models.Finding) string {
+			ns, _ := f.Metadata["namespace"].(string)
+			return truncateField(ns, wNamespace)
+		}},
+		"risk": {"RISK", wRisk, func(f models.Finding) string {
+			if score, ok := metadataInt(f, "risk_chain_score"); ok {
+				return fmt.Sprintf("%d", score)
+			}
+			return ""
+		}},
+		"message":     {"MESSAGE", wMessage, func(f models.Finding) string { return ShortenMessage(attackPathPrefix(f)+f.Explanation, wMessage) }},
+		"savings":     {"SAVINGS/MO", 0, func(f models.Finding) string { return opts.Currency.Format(f.EstimatedMonthlySavings) }},
+		"fingerprint": {"FINGERPRINT", wFingerprint, func(f models.Finding) string { return f.Fingerprint }},
 	}
-	hb.WriteString(fmt.Sprintf("  %-*s", wLocation, opts.LocationLabel))
-	hb.WriteString(fmt.Sprintf("  %-*s", wSeverity, "SEVERITY"))
-	if opts.IncludeDomain {
-		hb.WriteString(fmt.Sprintf("  %-*s", wDomain, "DOMAIN"))
+
+	names := opts.Columns
+	if len(names) == 0 {
+		names = []string{"resource"}
+		if opts.IncludeProfile {
+			names = append(names, "profile")
+		}
+		names = append(names, "location", "severity")
+		if opts.IncludeDomain {
+			names = append(names, "domain")
+		}
+		names = append(names, "type", "message")
+		if opts.IncludeSavings && hasSavings(findings) {
+			names = append(names, "savings")
+		}
 	}
-	hb.WriteString(fmt.Sprintf("  %-*s", wType, "TYPE"))
-	hb.WriteString(fmt.Sprintf("  %-*s", wMessage, "MESSAGE"))
-	if showSavings {
-		hb.WriteString("  SAVINGS/MO")
+	columns := make([]tableColumn, 0, len(names))
+	for _, name := range names {
+		if col, ok := byName[name]; ok {
+			columns = append(columns, col)
+		}
+	}
+
+	// Build the header row.
+	var hb strings.Builder
+	for i, col := range columns {
+		if i > 0 {
+			hb.WriteString("  ")
+		}
+		hb.WriteString(fmt.Sprintf("%-*s", col.width, col.header))
 	}
 	header := hb.String()
 
@@ -170,20 +278,64 @@ func RenderTable(w io.Writer, findings []models.Finding, opts TableOptions) {
 
 	for _, f := range findings {
 		var rb strings.Builder
-		rb.WriteString(fmt.Sprintf("%-*s", wResource, truncateField(f.ResourceID, wResource)))
-		if opts.IncludeProfile {
-			rb.WriteString(fmt.Sprintf("  %-*s", wProfile, truncateField(f.Profile, wProfile)))
-		}
-		rb.WriteString(fmt.Sprintf("  %-*s", wLocation, truncateField(f.Region, wLocation)))
-		rb.WriteString("  " + severityCell(f.Severity, wSeverity, opts.Colored))
-		if opts.IncludeDomain {
-			rb.WriteString(fmt.Sprintf("  %-*s", wDomain, truncateField(f.Domain, wDomain)))
-		}
-		rb.WriteString(fmt.Sprintf("  %-*s", wType, truncateField(string(f.ResourceType), wType)))
-		rb.WriteString(fmt.Sprintf("  %-*s", wMessage, ShortenMessage(f.Explanation, wMessage)))
-		if showSavings {
-			rb.WriteString(fmt.Sprintf("  $%.2f", f.EstimatedMonthlySavings))
+		for i, col := range columns {
+			if i > 0 {
+				rb.WriteString("  ")
+			}
+			if col.cell == nil {
+				rb.WriteString(severityCell(f.Severity, col.width, opts.Colored))
+				continue
+			}
+			rb.WriteString(fmt.Sprintf("%-*s", col.width, col.cell(f)))
 		}
 		fmt.Fprintln(w, rb.String())
 	}
 }
+
+// RenderPassed writes a "Passed" section listing resources that produced no
+// finding. Namespaced resources are shown as namespace/name. It writes nothing
+// when passed is empty.
+func RenderPassed(w io.Writer, passed []models.PassedResource, locationLabel string) {
+	if len(passed) == 0 {
+		return
+	}
+	if locationLabel == "" {
+		locationLabel = "REGION"
+	}
+
+	const (
+		wResource = 40
+		wLocation = 15
+	)
+
+	fmt.Fprintf(w, "Passed (%d):\n\n", len(passed))
+	header := fmt.Sprintf("%-*s  %-*s  %s", wResource, "RESOURCE ID", wLocation, locationLabel, "TYPE")
+	fmt.Fprintln(w, header)
+	fmt.Fprintln(w, strings.Repeat("-", len(header)+8))
+	for _, r := range passed {
+		id := r.ResourceID
+		if r.Namespace != "" {
+			id = r.Namespace + "/" + id
+		}
+		fmt.Fprintf(w, "%-*s  %-*s  %s\n", wResource, truncateField(id, wResource), wLocation, truncateField(r.Region, wLocation), r.ResourceType)
+	}
+}
+
+// RenderImages writes the --image-inventory "Images" table: one row per
+// distinct image with its pod count and namespaces. It writes nothing when
+// images is empty.
+func RenderImages(w io.Writer, images []models.KubernetesImage) {
+	if len(images) == 0 {
+		return
+	}
+
+	const wImage = 60
+
+	fmt.Fprintf(w, "Images (%d):\n\n", len(images))
+	header := fmt.Sprintf("%-*s  %5s  %s", wImage, "IMAGE", "PODS", "NAMESPACES")
+	fmt.Fprintln(w, header)
+	fmt.Fprintln(w, strings.Repeat("-", len(header)+8))
+	for _, img := range images {
+		fmt.Fprintf(w, "%-*s  %5d  %s\n", wImage, truncateField(img.Image, wImage), img.Pods, strings.Join(img.Namespaces, ","))
+	}
+}
@@ -2,6 +2,7 @@ package output_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -138,6 +139,52 @@ func TestRenderTable_ShortMessageIsNotTruncated(t *testing.T) {
 	}
 }
 
+// ── attack path marker ────────────────────────────────────────────────────────
+
+func TestRenderTable_AttackPathMember_PrefixesMessage(t *testing.T) {
+	f := oneFinding(func(f *models.Finding) {
+		f.Explanation = "Pod runs as root."
+		f.Metadata = map[string]any{"in_attack_path": true, "attack_path_score": 98}
+	})
+	out := renderToString([]models.Finding{f}, output.TableOptions{})
+
+	if !strings.Contains(out, "[PATH 98] Pod runs as root.") {
+		t.Errorf("attack path member must be prefixed with [PATH 98]\ngot:\n%s", out)
+	}
+}
+
+// TestRenderTable_ScoresSurviveJSONRoundTrip renders findings decoded from a
+// JSON report, where integer metadata arrives as float64 (dp render).
+func TestRenderTable_ScoresSurviveJSONRoundTrip(t *testing.T) {
+	f := oneFinding(func(f *models.Finding) {
+		f.Explanation = "Pod runs as root."
+		f.Metadata = map[string]any{"in_attack_path": true, "attack_path_score": 98, "risk_chain_score": 80}
+	})
+	data, err := json.Marshal([]models.Finding{f})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded []models.Finding
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	out := renderToString(decoded, output.TableOptions{Columns: []string{"risk", "message"}})
+	if !strings.Contains(out, "[PATH 98] Pod runs as root.") {
+		t.Errorf("decoded attack path member must be prefixed with [PATH 98]\ngot:\n%s", out)
+	}
+	if !strings.Contains(out, "80") {
+		t.Errorf("decoded risk_chain_score must render in the RISK column\ngot:\n%s", out)
+	}
+}
+
+func TestRenderTable_NonMember_NoPrefix(t *testing.T) {
+	out := renderToString([]models.Finding{oneFinding()}, output.TableOptions{})
+	if strings.Contains(out, "[PATH") {
+		t.Errorf("finding outside attack paths must not be prefixed\ngot:\n%s", out)
+	}
+}
+
 // ── empty findings ────────────────────────────────────────────────────────────
 
 func TestRenderTable_EmptyFindings_PrintsNoFindings(t *testing.T) {
@@ -271,3 +318,129 @@ func TestRenderTable_BaseColumnsOnly_NoneOptional(t *testing.T) {
 		}
 	}
 }
+
+// ── Columns ───────────────────────────────────────────────────────────────────
+
+func TestRenderTable_Columns_OnlyRequestedInOrder(t *testing.T) {
+	f := oneFinding(func(f *models.Finding) {
+		f.RuleID = "EC2_LOW_CPU"
+		f.Metadata = map[string]any{"namespace": "payments", "risk_chain_score": 80}
+	})
+	out := renderToString([]models.Finding{f}, output.TableOptions{
+		Columns: []string{"rule", "severity", "namespace", "risk", "resource", "savings"},
+	})
+	header := strings.SplitN(out, "\n", 2)[0]
+	if got := strings.Fields(header); strings.Join(got, " ") != "RULE SEVERITY NAMESPACE RISK RESOURCE ID SAVINGS/MO" {
+		t.Errorf("header = %q; want requested columns in order", header)
+	}
+	for _, want := range []string{"EC2_LOW_CPU", "payments", "80", "i-0123456789abcdef0", "$42.00"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output\ngot:\n%s", want, out)
+		}
+	}
+	for _, absent := range []string{"REGION", "TYPE", "MESSAGE", "PROFILE", "DOMAIN", "us-east-1"} {
+		if strings.Contains(out, absent) {
+			t.Errorf("column %q was not requested\ngot:\n%s", absent, out)
+		}
+	}
+}
+
+func TestRenderTable_Columns_OverrideIncludeFlags(t *testing.T) {
+	out := renderToString([]models.Finding{oneFinding()}, output.TableOptions{
+		IncludeSavings: true,
+		IncludeDomain:  true,
+		IncludeProfile: true,
+		Columns:        []string{"resource", "severity"},
+	})
+	for _, absent := range []string{"PROFILE", "DOMAIN", "SAVINGS/MO"} {
+		if strings.Contains(out, absent) {
+			t.Errorf("column %q must not appear when Columns is set\ngot:\n%s", absent, out)
+		}
+	}
+}
+
+func TestRenderTable_Columns_Fingerprint(t *testing.T) {
+	fp := strings.Repeat("ab", 32)
+	f := oneFinding(func(f *models.Finding) { f.Fingerprint = fp })
+	out := renderToString([]models.Finding{f}, output.TableOptions{Columns: []string{"resource", "fingerprint"}})
+	if !strings.Contains(out, "FINGERPRINT") || !strings.Contains(out, fp) {
+		t.Errorf("expected full fingerprint column\ngot:\n%s", out)
+	}
+	if def := renderToString([]models.Finding{f}, output.TableOptions{}); strings.Contains(def, "FINGERPRINT") {
+		t.Errorf("fingerprint column must not appear in the default layout\ngot:\n%s", def)
+	}
+}
+
+func TestParseTableColumns_NormalisesNames(t *testing.T) {
+	got, err := output.ParseTableColumns([]string{" Resource", "SEVERITY", "rule"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(got, ",") != "resource,severity,rule" {
+		t.Errorf("columns = %v; want [resource severity rule]", got)
+	}
+}
+
+func TestParseTableColumns_EmptyKeepsDefault(t *testing.T) {
+	if got, err := output.ParseTableColumns(nil); err != nil || got != nil {
+		t.Errorf("ParseTableColumns(nil) = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestParseTableColumns_RejectsUnknownAndRepeated(t *testing.T) {
+	if _, err := output.ParseTableColumns([]string{"resource", "cost"}); err == nil || !strings.Contains(err.Error(), `"cost"`) {
+		t.Errorf("expected error naming unknown column cost; got %v", err)
+	}
+	if _, err := output.ParseTableColumns([]string{"rule", "rule"}); err == nil {
+		t.Error("expected error for repeated column")
+	}
+}
+
+// ── RenderPassed ──────────────────────────────────────────────────────────────
+
+func TestRenderPassed_ListsNamespacedResources(t *testing.T) {
+	var buf bytes.Buffer
+	output.RenderPassed(&buf, []models.PassedResource{
+		{ResourceID: "api", ResourceType: models.ResourceK8sPod, Region: "kind", Namespace: "payments"},
+		{ResourceID: "node-1", ResourceType: models.ResourceK8sNode, Region: "kind"},
+	}, "CONTEXT")
+
+	out := buf.String()
+	for _, want := range []string{"Passed (2):", "CONTEXT", "payments/api", "node-1", "K8S_NODE"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderPassed_EmptyWritesNothing(t *testing.T) {
+	var buf bytes.Buffer
+	output.RenderPassed(&buf, nil, "REGION")
+	if buf.Len() != 0 {
+		t.Errorf("expected no output; got %q", buf.String())
+	}
+}
+
+// ── RenderImages ──────────────────────────────────────────────────────────────
+
+func TestRenderImages_ListsImagesWithCounts(t *testing.T) {
+	var buf bytes.Buffer
+	output.RenderImages(&buf, []models.KubernetesImage{
+		{Image: "nginx:1.25", Pods: 3, Containers: 3, Namespaces: []string{"default", "web"}},
+	})
+
+	out := buf.String()
+	for _, want := range []string{"Images (1):", "IMAGE", "nginx:1.25", "3", "default,web"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderImages_EmptyWritesNothing(t *testing.T) {
+	var buf bytes.Buffer
+	output.RenderImages(&buf, nil)
+	if buf.Len() != 0 {
+		t.Errorf("expected no output; got %q", buf.String())
+	}
+}
@@ -0,0 +1,56 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// templateFuncs are the helper functions available to --output-template files
+// in addition to the text/template builtins.
+//
+//	severityColor SEV         — SEV wrapped in its ANSI severity color
+//	count FINDINGS [SEV]      — number of findings, optionally only those of severity SEV
+var templateFuncs = template.FuncMap{
+	"severityColor": func(sev models.Severity) string {
+		return ColorSeverity(sev, true)
+	},
+	"count": func(findings []models.Finding, sev ...models.Severity) int {
+		if len(sev) == 0 {
+			return len(findings)
+		}
+		n := 0
+		for _, f := range findings {
+			if f.Severity == sev[0] {
+				n++
+			}
+		}
+		return n
+	},
+}
+
+// RenderTemplate executes the Go text/template at tmplPath against report and
+// writes the result to w. Nothing is written to w when the template cannot be
+// read, parsed, or executed.
+func RenderTemplate(w io.Writer, report *models.AuditReport, tmplPath string) error {
+	src, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return fmt.Errorf("read output template: %w", err)
+	}
+	tmpl, err := template.New(filepath.Base(tmplPath)).Funcs(templateFuncs).Parse(string(src))
+	if err != nil {
+		return fmt.Errorf("parse output template %q: %w", tmplPath, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, report); err != nil {
+		return fmt.Errorf("execute output template %q: %w", tmplPath, err)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
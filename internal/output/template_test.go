@@ -0,0 +1,77 @@
+package output_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/output"
+)
+
+// writeTemplate writes src to a temporary template file and returns its path.
+func writeTemplate(t *testing.T, src string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	return path
+}
+
+func TestRenderTemplate_RendersFindingCount(t *testing.T) {
+	report := &models.AuditReport{
+		Profile: "prod",
+		Findings: []models.Finding{
+			oneFinding(),
+			oneFinding(func(f *models.Finding) { f.Severity = models.SeverityLow }),
+		},
+	}
+	path := writeTemplate(t, `{{.Profile}}: {{count .Findings}} findings, {{count .Findings "HIGH"}} high`)
+
+	var buf bytes.Buffer
+	if err := output.RenderTemplate(&buf, report, path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "prod: 2 findings, 1 high"; got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestRenderTemplate_SeverityColor(t *testing.T) {
+	report := &models.AuditReport{Findings: []models.Finding{oneFinding()}}
+	path := writeTemplate(t, `{{range .Findings}}{{severityColor .Severity}}{{end}}`)
+
+	var buf bytes.Buffer
+	if err := output.RenderTemplate(&buf, report, path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), output.ColorSeverity(models.SeverityHigh, true); got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestRenderTemplate_ParseError(t *testing.T) {
+	path := writeTemplate(t, `{{.Profile`)
+
+	var buf bytes.Buffer
+	err := output.RenderTemplate(&buf, &models.AuditReport{}, path)
+	if err == nil {
+		t.Fatal("expected a parse error")
+	}
+	if !strings.Contains(err.Error(), "parse output template") || !strings.Contains(err.Error(), path) {
+		t.Errorf("error = %q; want it to mention parse output template and %s", err, path)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output on parse error; got %q", buf.String())
+	}
+}
+
+func TestRenderTemplate_MissingFile(t *testing.T) {
+	err := output.RenderTemplate(&bytes.Buffer{}, &models.AuditReport{}, filepath.Join(t.TempDir(), "missing.tmpl"))
+	if err == nil || !strings.Contains(err.Error(), "read output template") {
+		t.Errorf("error = %v; want read output template error", err)
+	}
+}
@@ -1,10 +1,50 @@
 package policy
 
 type PolicyConfig struct {
-	Version     int                           `yaml:"version"`
-	Domains     map[string]DomainConfig       `yaml:"domains"`
-	Rules       map[string]RuleConfig         `yaml:"rules"`
-	Enforcement map[string]EnforcementConfig  `yaml:"enforcement,omitempty"`
+	Version           int                          `yaml:"version"`
+	Domains           map[string]DomainConfig      `yaml:"domains"`
+	Rules             map[string]RuleConfig        `yaml:"rules"`
+	Enforcement       map[string]EnforcementConfig `yaml:"enforcement,omitempty"`
+	SeverityOverrides map[string]string            `yaml:"severity_overrides,omitempty"`
+	Labels            []LabelRule                  `yaml:"labels,omitempty"`
+	// NamespacePolicies maps a Kubernetes namespace glob (path.Match syntax)
+	// to the enforcement applied to findings in matching namespaces,
+	// replacing enforcement.kubernetes for those findings.
+	NamespacePolicies map[string]EnforcementConfig `yaml:"namespace_policies,omitempty"`
+	// NamespaceOwners maps a Kubernetes namespace glob (path.Match syntax) to
+	// the team that owns matching namespaces. It drives
+	// dp kubernetes audit --format-findings-by owner.
+	NamespaceOwners map[string]string `yaml:"namespace_owners,omitempty"`
+	// SystemNamespaces, when non-empty, replaces the default Kubernetes system
+	// namespace set (kube-system, kube-public, kube-node-lease) used for
+	// namespace_type annotation and --exclude-system filtering.
+	SystemNamespaces []string `yaml:"system_namespaces,omitempty"`
+	// Kubernetes holds defaults for dp kubernetes audit flags.
+	Kubernetes KubernetesConfig `yaml:"kubernetes,omitempty"`
+	// InternalLBAnnotations maps extra Service annotations to the value that
+	// marks a LoadBalancer as internal, extending the built-in AWS, GCP, and
+	// Azure annotations recognised by K8S_SERVICE_PUBLIC_LOADBALANCER.
+	InternalLBAnnotations map[string]string `yaml:"internal_lb_annotations,omitempty"`
+	// RiskGradeThresholds overrides the score bands used for
+	// Summary.RiskGrade. Unset bands keep their defaults.
+	RiskGradeThresholds *RiskGradeThresholds `yaml:"risk_grade_thresholds,omitempty"`
+}
+
+// RiskGradeThresholds holds the highest risk score (0–100) that still earns
+// each grade; scores above D grade F. Nil fields use the default band.
+type RiskGradeThresholds struct {
+	A *int `yaml:"a,omitempty"`
+	B *int `yaml:"b,omitempty"`
+	C *int `yaml:"c,omitempty"`
+	D *int `yaml:"d,omitempty"`
+}
+
+// KubernetesConfig holds the dp.yaml kubernetes section.
+type KubernetesConfig struct {
+	// ExcludeSystemDefault makes dp kubernetes audit drop system-namespace
+	// findings as if --exclude-system were passed; --include-system
+	// overrides it for a single run.
+	ExcludeSystemDefault bool `yaml:"exclude_system_default,omitempty"`
 }
 
 type DomainConfig struct {
@@ -20,4 +60,19 @@ type RuleConfig struct {
 
 type EnforcementConfig struct {
 	FailOnSeverity string `yaml:"fail_on_severity,omitempty"`
-}
\ No newline at end of file
+}
+
+// LabelRule attaches Labels to every finding matched by Match.
+type LabelRule struct {
+	Match  LabelMatch        `yaml:"match"`
+	Labels map[string]string `yaml:"labels"`
+}
+
+// LabelMatch selects findings by glob pattern (path.Match syntax). Every
+// non-empty field must match; an empty LabelMatch matches every finding.
+// Tag has the form "key=value", where value may be a glob.
+type LabelMatch struct {
+	RuleID    string `yaml:"rule_id,omitempty"`
+	Namespace string `yaml:"namespace,omitempty"`
+	Tag       string `yaml:"tag,omitempty"`
+}
@@ -0,0 +1,92 @@
+package policy
+
+import (
+	"fmt"
+	"sort"
+)
+
+// deprecatedRuleIDs maps the former ID of each renamed rule to its current
+// ID. dp.yaml keys written against a former ID keep working: Validate accepts
+// them, ApplyPolicy, GetThreshold and ApplySeverityOverrides resolve them, and
+// labels.match.rule_id matches the renamed rule. DeprecatedRuleIDWarnings
+// reports them so users can migrate.
+var deprecatedRuleIDs = map[string]string{
+	"GUARDDUTY_DISABLED":          "AWS_GUARDDUTY_DISABLED",
+	"EBS_GP2_LEGACY":              "AWS_EBS_GP2_LEGACY",
+	"CLOUDTRAIL_NOT_MULTI_REGION": "AWS_CLOUDTRAIL_NOT_MULTIREGION",
+	"K8S_CLUSTER_SINGLE_NODE":     "K8S_CLUSTER_INSUFFICIENT_NODES",
+}
+
+// CanonicalRuleID returns the current ID for a deprecated rule ID, or id
+// unchanged when it was never renamed.
+func CanonicalRuleID(id string) string {
+	if current, ok := deprecatedRuleIDs[id]; ok {
+		return current
+	}
+	return id
+}
+
+// FormerRuleID returns the ID a renamed rule had before its rename, or id
+// unchanged when it was never renamed. Finding fingerprints use it so state
+// files and baselines recorded under the former ID keep matching.
+func FormerRuleID(id string) string {
+	for former, current := range deprecatedRuleIDs {
+		if current == id {
+			return former
+		}
+	}
+	return id
+}
+
+// ruleConfig returns cfg.Rules[ruleID], falling back to the entry under the
+// rule's former ID. An entry under the current ID wins over the former one.
+func (cfg *PolicyConfig) ruleConfig(ruleID string) (RuleConfig, bool) {
+	if rc, ok := cfg.Rules[ruleID]; ok {
+		return rc, true
+	}
+	if former := FormerRuleID(ruleID); former != ruleID {
+		rc, ok := cfg.Rules[former]
+		return rc, ok
+	}
+	return RuleConfig{}, false
+}
+
+// severityOverride returns cfg.SeverityOverrides[ruleID], falling back to the
+// entry under the rule's former ID.
+func (cfg *PolicyConfig) severityOverride(ruleID string) (string, bool) {
+	if sev, ok := cfg.SeverityOverrides[ruleID]; ok {
+		return sev, true
+	}
+	if former := FormerRuleID(ruleID); former != ruleID {
+		sev, ok := cfg.SeverityOverrides[former]
+		return sev, ok
+	}
+	return "", false
+}
+
+// DeprecatedRuleIDWarnings returns one warning per rules, severity_overrides
+// or labels.match.rule_id entry in cfg that uses a deprecated rule ID, in a
+// stable order. It is safe to call with cfg == nil.
+func DeprecatedRuleIDWarnings(cfg *PolicyConfig) []string {
+	if cfg == nil {
+		return nil
+	}
+	var warnings []string
+	for id := range cfg.Rules {
+		if current := CanonicalRuleID(id); current != id {
+			warnings = append(warnings, fmt.Sprintf("rules.%s: rule ID is deprecated; use %s", id, current))
+		}
+	}
+	for id := range cfg.SeverityOverrides {
+		if current := CanonicalRuleID(id); current != id {
+			warnings = append(warnings, fmt.Sprintf("severity_overrides.%s: rule ID is deprecated; use %s", id, current))
+		}
+	}
+	sort.Strings(warnings)
+	for i, lr := range cfg.Labels {
+		if current := CanonicalRuleID(lr.Match.RuleID); current != lr.Match.RuleID {
+			warnings = append(warnings, fmt.Sprintf("labels[%d].match.rule_id: rule ID %s is deprecated; use %s", i, lr.Match.RuleID, current))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,85 @@
+package policy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// renamedRules lists each renamed rule as {former ID, current ID}.
+var renamedRules = [][2]string{
+	{"GUARDDUTY_DISABLED", "AWS_GUARDDUTY_DISABLED"},
+	{"EBS_GP2_LEGACY", "AWS_EBS_GP2_LEGACY"},
+	{"CLOUDTRAIL_NOT_MULTI_REGION", "AWS_CLOUDTRAIL_NOT_MULTIREGION"},
+	{"K8S_CLUSTER_SINGLE_NODE", "K8S_CLUSTER_INSUFFICIENT_NODES"},
+}
+
+func TestCanonicalAndFormerRuleID(t *testing.T) {
+	for _, r := range renamedRules {
+		former, current := r[0], r[1]
+		if got := policy.CanonicalRuleID(former); got != current {
+			t.Errorf("CanonicalRuleID(%s) = %s; want %s", former, got, current)
+		}
+		if got := policy.FormerRuleID(current); got != former {
+			t.Errorf("FormerRuleID(%s) = %s; want %s", current, got, former)
+		}
+	}
+	if got := policy.CanonicalRuleID("RULE_A"); got != "RULE_A" {
+		t.Errorf("CanonicalRuleID(RULE_A) = %s; want RULE_A unchanged", got)
+	}
+}
+
+func TestValidate_AcceptsDeprecatedRuleIDs(t *testing.T) {
+	for _, r := range renamedRules {
+		former, current := r[0], r[1]
+		cfg := &policy.PolicyConfig{
+			Version:           1,
+			Rules:             map[string]policy.RuleConfig{former: {Enabled: boolPtr(false)}},
+			SeverityOverrides: map[string]string{former: "LOW"},
+		}
+		if errs := policy.Validate(cfg, []string{current}); len(errs) != 0 {
+			t.Errorf("%s: expected no errors; got %v", former, errs)
+		}
+
+		warnings := policy.DeprecatedRuleIDWarnings(cfg)
+		if len(warnings) != 2 {
+			t.Fatalf("%s: expected 2 warnings; got %v", former, warnings)
+		}
+		for _, w := range warnings {
+			if !strings.Contains(w, former) || !strings.Contains(w, "use "+current) {
+				t.Errorf("warning %q must name %s and its replacement %s", w, former, current)
+			}
+		}
+	}
+}
+
+func TestApplyPolicy_DeprecatedRuleIDStillApplies(t *testing.T) {
+	for _, r := range renamedRules {
+		former, current := r[0], r[1]
+		findings := []models.Finding{{ID: "f1", RuleID: current, Severity: models.SeverityHigh}}
+
+		disabled := &policy.PolicyConfig{Rules: map[string]policy.RuleConfig{former: {Enabled: boolPtr(false)}}}
+		if got := policy.ApplyPolicy(findings, "security", disabled); len(got) != 0 {
+			t.Errorf("rules.%s.enabled=false: expected %s findings dropped; got %d", former, current, len(got))
+		}
+
+		overridden := &policy.PolicyConfig{SeverityOverrides: map[string]string{former: "low"}}
+		fs := append([]models.Finding(nil), findings...)
+		policy.ApplySeverityOverrides(fs, overridden)
+		if fs[0].Severity != models.SeverityLow {
+			t.Errorf("severity_overrides.%s: Severity = %s; want LOW", former, fs[0].Severity)
+		}
+	}
+}
+
+func TestDeprecatedRuleIDWarnings_NoneForCurrentIDs(t *testing.T) {
+	cfg := &policy.PolicyConfig{Rules: map[string]policy.RuleConfig{"RULE_A": {}}}
+	if got := policy.DeprecatedRuleIDWarnings(cfg); len(got) != 0 {
+		t.Errorf("expected no warnings; got %v", got)
+	}
+	if got := policy.DeprecatedRuleIDWarnings(nil); got != nil {
+		t.Errorf("nil config: expected nil; got %v", got)
+	}
+}
@@ -22,11 +22,7 @@ func ShouldFail(domain string, findings []models.Finding, cfg *PolicyConfig) boo
 	if cfg == nil {
 		return false
 	}
-	enfCfg, ok := cfg.Enforcement[domain]
-	if !ok || enfCfg.FailOnSeverity == "" {
-		return false
-	}
-	threshold, ok := severityRank[models.Severity(strings.ToUpper(enfCfg.FailOnSeverity))]
+	threshold, ok := failThreshold(cfg.Enforcement[domain])
 	if !ok {
 		return false
 	}
@@ -37,3 +33,65 @@ func ShouldFail(domain string, findings []models.Finding, cfg *PolicyConfig) boo
 	}
 	return false
 }
+
+// ShouldFailKubernetes is ShouldFail for the kubernetes domain with
+// per-namespace thresholds. A finding whose Metadata["namespace"] matches one
+// or more namespace_policies globs is checked against the strictest matching
+// fail_on_severity; every other finding, including cluster-scoped ones, is
+// checked against enforcement.kubernetes.
+//
+// This lets prod namespaces fail on HIGH while dev namespaces only fail on
+// CRITICAL. Entries with an empty or unrecognised fail_on_severity are
+// ignored. It returns false when cfg is nil.
+func ShouldFailKubernetes(findings []models.Finding, cfg *PolicyConfig) bool {
+	if cfg == nil {
+		return false
+	}
+	if len(cfg.NamespacePolicies) == 0 {
+		return ShouldFail("kubernetes", findings, cfg)
+	}
+	defaultThreshold, hasDefault := failThreshold(cfg.Enforcement["kubernetes"])
+	for _, f := range findings {
+		r, ok := severityRank[f.Severity]
+		if !ok {
+			continue
+		}
+		threshold, ok := namespaceThreshold(f, cfg.NamespacePolicies)
+		if !ok {
+			threshold, ok = defaultThreshold, hasDefault
+		}
+		if ok && r >= threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceThreshold returns the lowest (strictest) fail_on_severity rank
+// among the namespace_policies entries whose glob matches f's namespace.
+// ok is false when f has no namespace or no valid entry matches it.
+func namespaceThreshold(f models.Finding, policies map[string]EnforcementConfig) (threshold int, ok bool) {
+	ns, _ := f.Metadata["namespace"].(string)
+	if ns == "" {
+		return 0, false
+	}
+	for pattern, enfCfg := range policies {
+		if !globMatch(pattern, ns) {
+			continue
+		}
+		if t, valid := failThreshold(enfCfg); valid && (!ok || t < threshold) {
+			threshold, ok = t, true
+		}
+	}
+	return threshold, ok
+}
+
+// failThreshold returns the severity rank of enfCfg.FailOnSeverity. ok is
+// false when it is empty or not a recognised severity.
+func failThreshold(enfCfg EnforcementConfig) (int, bool) {
+	if enfCfg.FailOnSeverity == "" {
+		return 0, false
+	}
+	threshold, ok := severityRank[models.Severity(strings.ToUpper(enfCfg.FailOnSeverity))]
+	return threshold, ok
+}
@@ -151,3 +151,84 @@ func TestShouldFail_AllFindingsBelowThreshold(t *testing.T) {
 		t.Error("all findings below HIGH threshold must return false")
 	}
 }
+
+// nsFinding returns a finding with severity sev in namespace ns; an empty ns
+// yields a cluster-scoped finding.
+func nsFinding(ns string, sev models.Severity) models.Finding {
+	f := models.Finding{Severity: sev}
+	if ns != "" {
+		f.Metadata = map[string]any{"namespace": ns}
+	}
+	return f
+}
+
+// prodStrictDevLenient fails prod namespaces on HIGH and dev namespaces only
+// on CRITICAL, with a MEDIUM default for everything else.
+func prodStrictDevLenient() *PolicyConfig {
+	return &PolicyConfig{
+		Enforcement: map[string]EnforcementConfig{
+			"kubernetes": {FailOnSeverity: "MEDIUM"},
+		},
+		NamespacePolicies: map[string]EnforcementConfig{
+			"prod-*": {FailOnSeverity: "HIGH"},
+			"dev-*":  {FailOnSeverity: "CRITICAL"},
+		},
+	}
+}
+
+func TestShouldFailKubernetes_ProdHighFails(t *testing.T) {
+	findings := []models.Finding{nsFinding("prod-payments", models.SeverityHigh)}
+	if !ShouldFailKubernetes(findings, prodStrictDevLenient()) {
+		t.Error("HIGH finding in a prod namespace must fail")
+	}
+}
+
+func TestShouldFailKubernetes_DevBelowThresholdPasses(t *testing.T) {
+	findings := []models.Finding{
+		nsFinding("dev-payments", models.SeverityMedium),
+		nsFinding("dev-payments", models.SeverityHigh),
+		nsFinding("prod-payments", models.SeverityMedium),
+	}
+	if ShouldFailKubernetes(findings, prodStrictDevLenient()) {
+		t.Error("dev MEDIUM/HIGH and prod MEDIUM findings must not fail")
+	}
+}
+
+func TestShouldFailKubernetes_UnmatchedUsesDomainThreshold(t *testing.T) {
+	cfg := prodStrictDevLenient()
+	if !ShouldFailKubernetes([]models.Finding{nsFinding("staging", models.SeverityMedium)}, cfg) {
+		t.Error("MEDIUM finding in an unmatched namespace must fail on enforcement.kubernetes")
+	}
+	if !ShouldFailKubernetes([]models.Finding{nsFinding("", models.SeverityMedium)}, cfg) {
+		t.Error("cluster-scoped MEDIUM finding must fail on enforcement.kubernetes")
+	}
+	delete(cfg.Enforcement, "kubernetes")
+	if ShouldFailKubernetes([]models.Finding{nsFinding("staging", models.SeverityCritical)}, cfg) {
+		t.Error("unmatched namespace without enforcement.kubernetes must not fail")
+	}
+}
+
+func TestShouldFailKubernetes_StrictestMatchWins(t *testing.T) {
+	cfg := &PolicyConfig{
+		NamespacePolicies: map[string]EnforcementConfig{
+			"prod-*":        {FailOnSeverity: "CRITICAL"},
+			"prod-payments": {FailOnSeverity: "LOW"},
+		},
+	}
+	if !ShouldFailKubernetes([]models.Finding{nsFinding("prod-payments", models.SeverityLow)}, cfg) {
+		t.Error("the strictest matching namespace policy must apply")
+	}
+}
+
+func TestShouldFailKubernetes_NoNamespacePoliciesMatchesShouldFail(t *testing.T) {
+	cfg := &PolicyConfig{
+		Enforcement: map[string]EnforcementConfig{"kubernetes": {FailOnSeverity: "HIGH"}},
+	}
+	findings := []models.Finding{nsFinding("dev", models.SeverityHigh)}
+	if !ShouldFailKubernetes(findings, cfg) {
+		t.Error("without namespace_policies the kubernetes enforcement threshold must apply")
+	}
+	if ShouldFailKubernetes(findings, nil) {
+		t.Error("nil cfg must return false")
+	}
+}
@@ -0,0 +1,90 @@
+package policy
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ApplyLabels sets Metadata["labels"] (map[string]string) on every finding
+// matched by at least one entry of cfg.Labels. It is safe to call with
+// cfg == nil.
+//
+// Entries are applied in file order, so when several entries set the same
+// label key on a finding the last matching entry wins. A merged finding
+// matches rule_id when any of its rule IDs (Metadata["rules"]) matches.
+// Namespace is read from Metadata["namespace"] and tags from
+// Metadata["tags"]; findings without them never match those patterns.
+//
+// Engines call this after ApplyPolicy, so labels reflect the final finding set.
+func ApplyLabels(findings []models.Finding, cfg *PolicyConfig) {
+	if cfg == nil || len(cfg.Labels) == 0 {
+		return
+	}
+	for i := range findings {
+		f := &findings[i]
+		var labels map[string]string
+		for _, lr := range cfg.Labels {
+			if !lr.Match.matches(f) {
+				continue
+			}
+			if labels == nil {
+				labels = make(map[string]string, len(lr.Labels))
+			}
+			for k, v := range lr.Labels {
+				labels[k] = v
+			}
+		}
+		if labels == nil {
+			continue
+		}
+		if f.Metadata == nil {
+			f.Metadata = make(map[string]any, 1)
+		}
+		f.Metadata["labels"] = labels
+	}
+}
+
+// matches reports whether f satisfies every non-empty pattern in m.
+func (m LabelMatch) matches(f *models.Finding) bool {
+	if m.RuleID != "" && !matchesAnyRuleID(m.RuleID, f) {
+		return false
+	}
+	if m.Namespace != "" {
+		ns, _ := f.Metadata["namespace"].(string)
+		if ns == "" || !globMatch(m.Namespace, ns) {
+			return false
+		}
+	}
+	if m.Tag != "" {
+		key, valuePattern, _ := strings.Cut(m.Tag, "=")
+		tags, _ := f.Metadata["tags"].(map[string]string)
+		value, ok := tags[key]
+		if !ok || !globMatch(valuePattern, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesAnyRuleID(pattern string, f *models.Finding) bool {
+	pattern = CanonicalRuleID(pattern)
+	if globMatch(pattern, f.RuleID) {
+		return true
+	}
+	ids, _ := f.Metadata["rules"].([]string)
+	for _, id := range ids {
+		if globMatch(pattern, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// globMatch reports whether s matches pattern. Malformed patterns never
+// match; Validate reports them.
+func globMatch(pattern, s string) bool {
+	ok, err := path.Match(pattern, s)
+	return err == nil && ok
+}
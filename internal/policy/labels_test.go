@@ -0,0 +1,133 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// findingLabels returns f's Metadata["labels"], or nil when unset.
+func findingLabels(f models.Finding) map[string]string {
+	labels, _ := f.Metadata["labels"].(map[string]string)
+	return labels
+}
+
+func TestApplyLabels_NilConfig(t *testing.T) {
+	findings := []models.Finding{{RuleID: "RULE_A"}}
+	ApplyLabels(findings, nil)
+	if findings[0].Metadata != nil {
+		t.Errorf("Metadata = %v; want nil with no policy", findings[0].Metadata)
+	}
+}
+
+func TestApplyLabels_RuleIDMatch(t *testing.T) {
+	cfg := &PolicyConfig{Labels: []LabelRule{
+		{Match: LabelMatch{RuleID: "K8S_POD_*"}, Labels: map[string]string{"owner": "platform"}},
+	}}
+	findings := []models.Finding{
+		{RuleID: "K8S_POD_RUN_AS_ROOT"},
+		{RuleID: "EC2_LOW_CPU"},
+		// Merged finding whose primary rule does not match but a secondary one does.
+		{RuleID: "K8S_DEFAULT_SERVICEACCOUNT_USED", Metadata: map[string]any{
+			"rules": []string{"K8S_DEFAULT_SERVICEACCOUNT_USED", "K8S_POD_NO_SECCOMP"},
+		}},
+	}
+	ApplyLabels(findings, cfg)
+
+	if got := findingLabels(findings[0])["owner"]; got != "platform" {
+		t.Errorf("K8S_POD_RUN_AS_ROOT owner = %q; want platform", got)
+	}
+	if got := findingLabels(findings[1]); got != nil {
+		t.Errorf("EC2_LOW_CPU labels = %v; want none", got)
+	}
+	if got := findingLabels(findings[2])["owner"]; got != "platform" {
+		t.Errorf("merged finding owner = %q; want platform", got)
+	}
+}
+
+func TestApplyLabels_NamespaceMatch(t *testing.T) {
+	cfg := &PolicyConfig{Labels: []LabelRule{
+		{Match: LabelMatch{Namespace: "payments-*"}, Labels: map[string]string{"team": "payments"}},
+	}}
+	findings := []models.Finding{
+		{RuleID: "K8S_POD_RUN_AS_ROOT", Metadata: map[string]any{"namespace": "payments-api"}},
+		{RuleID: "K8S_POD_RUN_AS_ROOT", Metadata: map[string]any{"namespace": "search"}},
+		{RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES"}, // cluster-scoped: no namespace
+	}
+	ApplyLabels(findings, cfg)
+
+	if got := findingLabels(findings[0])["team"]; got != "payments" {
+		t.Errorf("payments-api team = %q; want payments", got)
+	}
+	if got := findingLabels(findings[1]); got != nil {
+		t.Errorf("search labels = %v; want none", got)
+	}
+	if got := findingLabels(findings[2]); got != nil {
+		t.Errorf("cluster finding labels = %v; want none", got)
+	}
+}
+
+func TestApplyLabels_TagMatch(t *testing.T) {
+	cfg := &PolicyConfig{Labels: []LabelRule{
+		{Match: LabelMatch{Tag: "env=prod*"}, Labels: map[string]string{"pager": "yes"}},
+	}}
+	findings := []models.Finding{
+		{RuleID: "EC2_LOW_CPU", Metadata: map[string]any{"tags": map[string]string{"env": "production"}}},
+		{RuleID: "EC2_LOW_CPU", Metadata: map[string]any{"tags": map[string]string{"env": "dev"}}},
+	}
+	ApplyLabels(findings, cfg)
+
+	if got := findingLabels(findings[0])["pager"]; got != "yes" {
+		t.Errorf("production pager = %q; want yes", got)
+	}
+	if got := findingLabels(findings[1]); got != nil {
+		t.Errorf("dev labels = %v; want none", got)
+	}
+}
+
+func TestApplyLabels_LaterEntryWinsOnConflict(t *testing.T) {
+	cfg := &PolicyConfig{Labels: []LabelRule{
+		{Match: LabelMatch{RuleID: "K8S_*"}, Labels: map[string]string{"owner": "platform", "tier": "k8s"}},
+		{Match: LabelMatch{RuleID: "K8S_*", Namespace: "payments"}, Labels: map[string]string{"owner": "payments"}},
+	}}
+	findings := []models.Finding{
+		{RuleID: "K8S_POD_RUN_AS_ROOT", Metadata: map[string]any{"namespace": "payments"}},
+		{RuleID: "K8S_POD_RUN_AS_ROOT", Metadata: map[string]any{"namespace": "search"}},
+	}
+	ApplyLabels(findings, cfg)
+
+	if got := findingLabels(findings[0]); got["owner"] != "payments" || got["tier"] != "k8s" {
+		t.Errorf("payments labels = %v; want owner=payments (later entry) and tier=k8s", got)
+	}
+	if got := findingLabels(findings[1])["owner"]; got != "platform" {
+		t.Errorf("search owner = %q; want platform", got)
+	}
+}
+
+func TestLoadPolicy_Labels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dp.yaml")
+	yaml := `version: 1
+labels:
+  - match:
+      rule_id: "K8S_*"
+      namespace: "payments-*"
+    labels:
+      owner: payments
+`
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadPolicy error: %v", err)
+	}
+	if len(cfg.Labels) != 1 {
+		t.Fatalf("labels = %d entries; want 1", len(cfg.Labels))
+	}
+	lr := cfg.Labels[0]
+	if lr.Match.RuleID != "K8S_*" || lr.Match.Namespace != "payments-*" || lr.Labels["owner"] != "payments" {
+		t.Errorf("label rule = %+v; want K8S_*/payments-* → owner=payments", lr)
+	}
+}
@@ -49,6 +49,26 @@ rules:
 	}
 }
 
+func TestLoadPolicy_KubernetesExcludeSystemDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dp.yaml")
+	content := `
+version: 1
+kubernetes:
+  exclude_system_default: true
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cfg.Kubernetes.ExcludeSystemDefault {
+		t.Errorf("Kubernetes.ExcludeSystemDefault = false; want true")
+	}
+}
+
 func TestLoadPolicy_InvalidVersion(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "dp.yaml")
@@ -0,0 +1,32 @@
+package policy
+
+import (
+	"strings"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ApplySeverityOverrides rewrites, in place, the Severity of every finding
+// whose RuleID (or its former ID) appears in cfg.SeverityOverrides. It is safe
+// to call with cfg == nil. Unrecognised severity values are ignored.
+//
+// Engines call this immediately after rule evaluation — before merging,
+// risk-chain correlation, and summary counting — so an override changes both
+// which chains a finding participates in (Chains 3 and 6 key on CRITICAL and
+// HIGH) and how it is counted. This differs from rules.<id>.severity, which
+// ApplyPolicy applies after correlation.
+func ApplySeverityOverrides(findings []models.Finding, cfg *PolicyConfig) {
+	if cfg == nil || len(cfg.SeverityOverrides) == 0 {
+		return
+	}
+	for i := range findings {
+		sev, ok := cfg.severityOverride(findings[i].RuleID)
+		if !ok {
+			continue
+		}
+		s := models.Severity(strings.ToUpper(sev))
+		if _, valid := severityRank[s]; valid {
+			findings[i].Severity = s
+		}
+	}
+}
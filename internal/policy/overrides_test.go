@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestApplySeverityOverrides_NilConfig(t *testing.T) {
+	findings := []models.Finding{{RuleID: "RULE_A", Severity: models.SeverityHigh}}
+	ApplySeverityOverrides(findings, nil)
+	if findings[0].Severity != models.SeverityHigh {
+		t.Errorf("severity = %s; want HIGH unchanged", findings[0].Severity)
+	}
+}
+
+func TestApplySeverityOverrides_RewritesMatchingRules(t *testing.T) {
+	cfg := &PolicyConfig{
+		SeverityOverrides: map[string]string{
+			"K8S_CLUSTER_INSUFFICIENT_NODES": "info",
+			"EC2_LOW_CPU":                    "HIGH",
+		},
+	}
+	findings := []models.Finding{
+		{RuleID: "K8S_CLUSTER_INSUFFICIENT_NODES", Severity: models.SeverityHigh},
+		{RuleID: "EC2_LOW_CPU", Severity: models.SeverityMedium},
+		{RuleID: "EBS_UNATTACHED", Severity: models.SeverityMedium},
+	}
+	ApplySeverityOverrides(findings, cfg)
+
+	want := []models.Severity{models.SeverityInfo, models.SeverityHigh, models.SeverityMedium}
+	for i, w := range want {
+		if findings[i].Severity != w {
+			t.Errorf("%s: severity = %s; want %s", findings[i].RuleID, findings[i].Severity, w)
+		}
+	}
+}
+
+func TestApplySeverityOverrides_InvalidSeverityIgnored(t *testing.T) {
+	cfg := &PolicyConfig{SeverityOverrides: map[string]string{"RULE_A": "blocker"}}
+	findings := []models.Finding{{RuleID: "RULE_A", Severity: models.SeverityLow}}
+	ApplySeverityOverrides(findings, cfg)
+	if findings[0].Severity != models.SeverityLow {
+		t.Errorf("severity = %s; want LOW unchanged for invalid override", findings[0].Severity)
+	}
+}
@@ -0,0 +1,31 @@
+package policy
+
+import "sort"
+
+// UnassignedTeam is the owner reported for findings whose namespace matches
+// no namespace_owners entry, and for cluster-scoped findings.
+const UnassignedTeam = "unassigned"
+
+// OwnerForNamespace returns the team that owns namespace ns according to
+// cfg.NamespaceOwners. An exact key wins over globs; otherwise the first
+// matching pattern in lexical order is used so the result is deterministic.
+// It returns UnassignedTeam when ns is empty, cfg is nil, or nothing matches.
+func OwnerForNamespace(ns string, cfg *PolicyConfig) string {
+	if ns == "" || cfg == nil || len(cfg.NamespaceOwners) == 0 {
+		return UnassignedTeam
+	}
+	if team, ok := cfg.NamespaceOwners[ns]; ok && team != "" {
+		return team
+	}
+	patterns := make([]string, 0, len(cfg.NamespaceOwners))
+	for pattern := range cfg.NamespaceOwners {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		if team := cfg.NamespaceOwners[pattern]; team != "" && globMatch(pattern, ns) {
+			return team
+		}
+	}
+	return UnassignedTeam
+}
@@ -0,0 +1,31 @@
+package policy
+
+import "testing"
+
+func TestOwnerForNamespace(t *testing.T) {
+	cfg := &PolicyConfig{NamespaceOwners: map[string]string{
+		"payments":   "payments-team",
+		"payments-*": "payments-platform",
+		"*-jobs":     "batch",
+		"web-*":      "frontend",
+	}}
+	tests := []struct{ ns, want string }{
+		{"payments", "payments-team"},
+		{"payments-api", "payments-platform"},
+		{"payments-jobs", "batch"}, // "*-jobs" sorts before "payments-*"
+		{"web-store", "frontend"},
+		{"billing", UnassignedTeam},
+		{"", UnassignedTeam},
+	}
+	for _, tt := range tests {
+		if got := OwnerForNamespace(tt.ns, cfg); got != tt.want {
+			t.Errorf("OwnerForNamespace(%q) = %q; want %q", tt.ns, got, tt.want)
+		}
+	}
+}
+
+func TestOwnerForNamespace_NilConfig(t *testing.T) {
+	if got := OwnerForNamespace("payments", nil); got != UnassignedTeam {
+		t.Errorf("OwnerForNamespace with nil config = %q; want %q", got, UnassignedTeam)
+	}
+}
@@ -38,7 +38,7 @@ func ApplyPolicy(findings []models.Finding, domain string, cfg *PolicyConfig) []
 	var result []models.Finding
 
 	for _, f := range findings {
-		ruleCfg, hasRule := cfg.Rules[f.RuleID]
+		ruleCfg, hasRule := cfg.ruleConfig(f.RuleID)
 
 		// Rule-level disable
 		if hasRule && ruleCfg.Enabled != nil && !*ruleCfg.Enabled {
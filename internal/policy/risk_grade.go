@@ -0,0 +1,41 @@
+package policy
+
+// Default risk grade bands: the highest risk score that still earns each
+// grade. Scores above DefaultRiskGradeD grade F.
+const (
+	DefaultRiskGradeA = 5
+	DefaultRiskGradeB = 15
+	DefaultRiskGradeC = 30
+	DefaultRiskGradeD = 60
+)
+
+// riskGrades lists the grades in band order; F has no upper bound.
+var riskGrades = []string{"A", "B", "C", "D"}
+
+// RiskGradeBands returns the A–D upper bounds in order, applying any
+// risk_grade_thresholds overrides from cfg. It is safe to call with cfg == nil.
+func RiskGradeBands(cfg *PolicyConfig) [4]int {
+	bands := [4]int{DefaultRiskGradeA, DefaultRiskGradeB, DefaultRiskGradeC, DefaultRiskGradeD}
+	if cfg == nil || cfg.RiskGradeThresholds == nil {
+		return bands
+	}
+	t := cfg.RiskGradeThresholds
+	for i, v := range []*int{t.A, t.B, t.C, t.D} {
+		if v != nil {
+			bands[i] = *v
+		}
+	}
+	return bands
+}
+
+// RiskGrade maps a 0–100 risk score to a letter grade A–F using
+// RiskGradeBands(cfg): the first grade whose upper bound is >= score wins,
+// and any score above the D bound is an F.
+func RiskGrade(score int, cfg *PolicyConfig) string {
+	for i, upper := range RiskGradeBands(cfg) {
+		if score <= upper {
+			return riskGrades[i]
+		}
+	}
+	return "F"
+}
@@ -5,14 +5,14 @@ package policy
 //
 // Lookup order:
 //  1. cfg == nil → defaultValue
-//  2. cfg.Rules[ruleID] absent → defaultValue
+//  2. cfg.Rules[ruleID] absent (also under its former ID) → defaultValue
 //  3. cfg.Rules[ruleID].Params[key] absent → defaultValue
 //  4. Otherwise → configured value
 func GetThreshold(ruleID, key string, defaultValue float64, cfg *PolicyConfig) float64 {
 	if cfg == nil {
 		return defaultValue
 	}
-	rc, ok := cfg.Rules[ruleID]
+	rc, ok := cfg.ruleConfig(ruleID)
 	if !ok {
 		return defaultValue
 	}
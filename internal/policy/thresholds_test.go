@@ -69,3 +69,26 @@ func TestGetThreshold_DifferentRuleIsolated(t *testing.T) {
 		t.Errorf("got %.1f; want 10.0 (override for different rule must not bleed over)", got)
 	}
 }
+
+func TestRiskGrade_DefaultBands(t *testing.T) {
+	cases := map[int]string{0: "A", 5: "A", 6: "B", 15: "B", 16: "C", 30: "C", 31: "D", 60: "D", 61: "F", 100: "F"}
+	for score, want := range cases {
+		if got := RiskGrade(score, nil); got != want {
+			t.Errorf("RiskGrade(%d) = %q; want %q", score, got, want)
+		}
+	}
+}
+
+func TestRiskGrade_PartialOverride(t *testing.T) {
+	b, d := 20, 80
+	cfg := &PolicyConfig{RiskGradeThresholds: &RiskGradeThresholds{B: &b, D: &d}}
+	if got := RiskGradeBands(cfg); got != [4]int{DefaultRiskGradeA, 20, DefaultRiskGradeC, 80} {
+		t.Errorf("RiskGradeBands = %v; want [5 20 30 80]", got)
+	}
+	if got := RiskGrade(18, cfg); got != "B" {
+		t.Errorf("RiskGrade(18) = %q; want B", got)
+	}
+	if got := RiskGrade(70, cfg); got != "D" {
+		t.Errorf("RiskGrade(70) = %q; want D", got)
+	}
+}
@@ -2,6 +2,8 @@ package policy
 
 import (
 	"fmt"
+	"path"
+	"sort"
 	"strings"
 )
 
@@ -29,10 +31,23 @@ var validSeverities = map[string]struct{}{
 //   - version must be 1
 //   - domain names must be one of: cost, security, dataprotection
 //   - domain min_severity must be a valid severity value if set
-//   - rule IDs must appear in availableRuleIDs
+//   - rule IDs must appear in availableRuleIDs; deprecated IDs of renamed
+//     rules are accepted (see DeprecatedRuleIDWarnings)
 //   - rule severity overrides must be valid severity values if set
 //   - enforcement domain names must be one of: cost, security, dataprotection
 //   - enforcement fail_on_severity must be a valid severity value if set
+//   - namespace_policies keys must be valid glob patterns and their
+//     fail_on_severity must be a valid severity value
+//   - namespace_owners keys must be valid glob patterns and their team names
+//     must be non-empty
+//   - severity_overrides keys must appear in availableRuleIDs and values must
+//     be valid severity values
+//   - labels entries must set at least one label, use valid glob patterns,
+//     and write match.tag as key=value
+//   - system_namespaces entries must be non-empty
+//   - internal_lb_annotations keys and values must be non-empty
+//   - risk_grade_thresholds bands must lie in 0–100 and must not decrease
+//     from a to d (after defaults are applied to unset bands)
 //
 // All errors are collected before returning; Validate never stops at the first error.
 func Validate(cfg *PolicyConfig, availableRuleIDs []string) []error {
@@ -68,7 +83,7 @@ func Validate(cfg *PolicyConfig, availableRuleIDs []string) []error {
 
 	// Rule checks.
 	for ruleID, rcfg := range cfg.Rules {
-		if _, ok := knownIDs[ruleID]; !ok {
+		if _, ok := knownIDs[CanonicalRuleID(ruleID)]; !ok {
 			errs = append(errs, fmt.Errorf("rules.%s: unknown rule ID", ruleID))
 		}
 		if rcfg.Severity != "" {
@@ -92,5 +107,103 @@ func Validate(cfg *PolicyConfig, availableRuleIDs []string) []error {
 		}
 	}
 
+	// Namespace policy checks.
+	nsPatterns := make([]string, 0, len(cfg.NamespacePolicies))
+	for pattern := range cfg.NamespacePolicies {
+		nsPatterns = append(nsPatterns, pattern)
+	}
+	sort.Strings(nsPatterns)
+	for _, pattern := range nsPatterns {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			errs = append(errs, fmt.Errorf("namespace_policies: invalid glob pattern %q", pattern))
+		}
+		sev := cfg.NamespacePolicies[pattern].FailOnSeverity
+		if _, ok := validSeverities[strings.ToUpper(sev)]; !ok {
+			errs = append(errs, fmt.Errorf("namespace_policies.%s.fail_on_severity: invalid value %q; valid values: CRITICAL, HIGH, MEDIUM, LOW, INFO", pattern, sev))
+		}
+	}
+
+	// Namespace owner checks.
+	ownerPatterns := make([]string, 0, len(cfg.NamespaceOwners))
+	for pattern := range cfg.NamespaceOwners {
+		ownerPatterns = append(ownerPatterns, pattern)
+	}
+	sort.Strings(ownerPatterns)
+	for _, pattern := range ownerPatterns {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			errs = append(errs, fmt.Errorf("namespace_owners: invalid glob pattern %q", pattern))
+		}
+		if strings.TrimSpace(cfg.NamespaceOwners[pattern]) == "" {
+			errs = append(errs, fmt.Errorf("namespace_owners.%s: team must not be empty", pattern))
+		}
+	}
+
+	// Severity override checks.
+	for ruleID, sev := range cfg.SeverityOverrides {
+		if _, ok := knownIDs[CanonicalRuleID(ruleID)]; !ok {
+			errs = append(errs, fmt.Errorf("severity_overrides.%s: unknown rule ID", ruleID))
+		}
+		if _, ok := validSeverities[strings.ToUpper(sev)]; !ok {
+			errs = append(errs, fmt.Errorf("severity_overrides.%s: invalid value %q; valid values: CRITICAL, HIGH, MEDIUM, LOW, INFO", ruleID, sev))
+		}
+	}
+
+	// Label checks.
+	for i, lr := range cfg.Labels {
+		if len(lr.Labels) == 0 {
+			errs = append(errs, fmt.Errorf("labels[%d].labels: must set at least one label", i))
+		}
+		if _, err := path.Match(lr.Match.RuleID, ""); err != nil {
+			errs = append(errs, fmt.Errorf("labels[%d].match.rule_id: invalid glob pattern %q", i, lr.Match.RuleID))
+		}
+		if _, err := path.Match(lr.Match.Namespace, ""); err != nil {
+			errs = append(errs, fmt.Errorf("labels[%d].match.namespace: invalid glob pattern %q", i, lr.Match.Namespace))
+		}
+		if lr.Match.Tag != "" {
+			key, value, ok := strings.Cut(lr.Match.Tag, "=")
+			if !ok || key == "" {
+				errs = append(errs, fmt.Errorf("labels[%d].match.tag: invalid value %q; must be key=value", i, lr.Match.Tag))
+			} else if _, err := path.Match(value, ""); err != nil {
+				errs = append(errs, fmt.Errorf("labels[%d].match.tag: invalid glob pattern %q", i, value))
+			}
+		}
+	}
+
+	// System namespace checks.
+	for i, ns := range cfg.SystemNamespaces {
+		if strings.TrimSpace(ns) == "" {
+			errs = append(errs, fmt.Errorf("system_namespaces[%d]: must not be empty", i))
+		}
+	}
+
+	// Internal load balancer annotation checks.
+	lbKeys := make([]string, 0, len(cfg.InternalLBAnnotations))
+	for key := range cfg.InternalLBAnnotations {
+		lbKeys = append(lbKeys, key)
+	}
+	sort.Strings(lbKeys)
+	for _, key := range lbKeys {
+		if strings.TrimSpace(key) == "" {
+			errs = append(errs, fmt.Errorf("internal_lb_annotations: annotation key must not be empty"))
+		} else if strings.TrimSpace(cfg.InternalLBAnnotations[key]) == "" {
+			errs = append(errs, fmt.Errorf("internal_lb_annotations.%s: value must not be empty", key))
+		}
+	}
+
+	// Risk grade threshold checks.
+	if cfg.RiskGradeThresholds != nil {
+		bands := RiskGradeBands(cfg)
+		for i, v := range bands {
+			name := strings.ToLower(riskGrades[i])
+			if v < 0 || v > 100 {
+				errs = append(errs, fmt.Errorf("risk_grade_thresholds.%s: invalid value %d; must be between 0 and 100", name, v))
+			}
+			if i > 0 && v < bands[i-1] {
+				errs = append(errs, fmt.Errorf("risk_grade_thresholds.%s: value %d is below %s (%d); bands must not decrease",
+					name, v, strings.ToLower(riskGrades[i-1]), bands[i-1]))
+			}
+		}
+	}
+
 	return errs
 }
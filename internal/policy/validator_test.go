@@ -1,6 +1,7 @@
 package policy_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
@@ -230,6 +231,144 @@ func TestValidate_UnknownEnforcementDomain(t *testing.T) {
 	}
 }
 
+// ── severity_overrides ────────────────────────────────────────────────────────
+
+func TestValidate_SeverityOverrides_Valid(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version:           1,
+		SeverityOverrides: map[string]string{"RULE_A": "info", "RULE_B": "HIGH"},
+	}
+	if errs := policy.Validate(cfg, knownRules); len(errs) != 0 {
+		t.Errorf("expected no errors; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_SeverityOverrides_UnknownRuleID(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version:           1,
+		SeverityOverrides: map[string]string{"NOT_A_RULE": "LOW"},
+	}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error for unknown rule ID; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_SeverityOverrides_InvalidSeverity(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version:           1,
+		SeverityOverrides: map[string]string{"RULE_A": "blocker"},
+	}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error for invalid severity; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_Labels_Valid(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version: 1,
+		Labels: []policy.LabelRule{
+			{Match: policy.LabelMatch{RuleID: "K8S_*", Namespace: "team-?", Tag: "env=prod*"}, Labels: map[string]string{"owner": "a"}},
+		},
+	}
+	if errs := policy.Validate(cfg, knownRules); len(errs) != 0 {
+		t.Errorf("expected no errors; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_Labels_Invalid(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version: 1,
+		Labels: []policy.LabelRule{
+			{Match: policy.LabelMatch{RuleID: "K8S_["}, Labels: map[string]string{"owner": "a"}}, // bad glob
+			{Match: policy.LabelMatch{Tag: "env"}, Labels: map[string]string{"owner": "b"}},      // missing =value
+			{Match: policy.LabelMatch{Namespace: "default"}},                                     // no labels
+		},
+	}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_NamespacePolicies(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version: 1,
+		NamespacePolicies: map[string]policy.EnforcementConfig{
+			"prod-*": {FailOnSeverity: "high"},
+			"dev-[":  {FailOnSeverity: "MEDIUM"}, // bad glob
+			"dev-*":  {FailOnSeverity: ""},       // missing severity
+		},
+	}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_NamespaceOwners(t *testing.T) {
+	cfg := &policy.PolicyConfig{
+		Version: 1,
+		NamespaceOwners: map[string]string{
+			"payments-*": "payments",
+			"web-[":      "frontend", // bad glob
+			"batch-*":    " ",        // empty team
+		},
+	}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors; got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidate_SystemNamespaces_EmptyEntry(t *testing.T) {
+	cfg := &policy.PolicyConfig{Version: 1, SystemNamespaces: []string{"istio-system", " "}}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error; got %d: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Error(), "system_namespaces[1]") {
+		t.Errorf("error = %q; want it to name system_namespaces[1]", errs[0])
+	}
+}
+
+func TestValidate_InternalLBAnnotations(t *testing.T) {
+	cfg := &policy.PolicyConfig{Version: 1, InternalLBAnnotations: map[string]string{
+		"lb.example.com/scope": "private",
+		"lb.example.com/empty": " ",
+		"":                     "true",
+	}}
+	errs := policy.Validate(cfg, knownRules)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors; got %d: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Error(), "annotation key must not be empty") {
+		t.Errorf("errs[0] = %q; want the empty-key error", errs[0])
+	}
+	if !strings.Contains(errs[1].Error(), "internal_lb_annotations.lb.example.com/empty") {
+		t.Errorf("errs[1] = %q; want it to name lb.example.com/empty", errs[1])
+	}
+}
+
+func TestValidate_RiskGradeThresholds(t *testing.T) {
+	ten, forty, over := 10, 40, 101
+	valid := &policy.PolicyConfig{Version: 1, RiskGradeThresholds: &policy.RiskGradeThresholds{A: &ten, D: &forty}}
+	if errs := policy.Validate(valid, knownRules); len(errs) != 0 {
+		t.Errorf("expected no errors; got %v", errs)
+	}
+
+	// c=10 is below the default b (15); d=101 is out of range.
+	invalid := &policy.PolicyConfig{Version: 1, RiskGradeThresholds: &policy.RiskGradeThresholds{C: &ten, D: &over}}
+	errs := policy.Validate(invalid, knownRules)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors; got %d: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Error(), "risk_grade_thresholds.c") || !strings.Contains(errs[1].Error(), "risk_grade_thresholds.d") {
+		t.Errorf("errors = %v; want risk_grade_thresholds.c then .d", errs)
+	}
+}
+
 // ── multiple errors ───────────────────────────────────────────────────────────
 
 func TestValidate_MultipleErrorsAggregated(t *testing.T) {
@@ -0,0 +1,62 @@
+package common
+
+import "sync"
+
+// CacheKey identifies one cached collection result. Region holds the audited
+// region (or a comma-joined region set for account-level collections) and
+// ResourceType names the collected dataset, including any parameter that
+// changes its contents (e.g. the cost lookback window).
+type CacheKey struct {
+	Profile      string
+	Region       string
+	ResourceType string
+}
+
+// CollectorCache is an in-memory store of collection results shared by the
+// caching collector wrappers for the lifetime of a single audit invocation.
+// It is safe for concurrent use; concurrent lookups of the same key block
+// until the first fetch completes so the underlying API is called once.
+// Failed fetches are not cached, so a later lookup retries the call.
+type CollectorCache struct {
+	mu      sync.Mutex
+	entries map[CacheKey]*cacheEntry
+}
+
+type cacheEntry struct {
+	mu    sync.Mutex
+	done  bool
+	value any
+}
+
+// NewCollectorCache returns an empty CollectorCache.
+func NewCollectorCache() *CollectorCache {
+	return &CollectorCache{entries: make(map[CacheKey]*cacheEntry)}
+}
+
+// Get returns the cached value for key, calling fetch to populate it on the
+// first lookup. A nil CollectorCache disables caching and always calls fetch.
+func (c *CollectorCache) Get(key CacheKey, fetch func() (any, error)) (any, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.done {
+		return entry.value, nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	entry.value, entry.done = value, true
+	return value, nil
+}
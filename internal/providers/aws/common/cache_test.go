@@ -0,0 +1,67 @@
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCollectorCache_FetchesOncePerKey(t *testing.T) {
+	cache := NewCollectorCache()
+	calls := 0
+	fetch := func() (any, error) { calls++; return calls, nil }
+
+	key := CacheKey{Profile: "prod", Region: "us-east-1", ResourceType: "security_data"}
+	first, _ := cache.Get(key, fetch)
+	second, _ := cache.Get(key, fetch)
+	if calls != 1 {
+		t.Errorf("fetch called %d times; want 1", calls)
+	}
+	if first != second {
+		t.Errorf("second lookup = %v; want cached %v", second, first)
+	}
+
+	other := CacheKey{Profile: "prod", Region: "eu-west-1", ResourceType: "security_data"}
+	if _, err := cache.Get(other, fetch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times after a new key; want 2", calls)
+	}
+}
+
+func TestCollectorCache_NilDisablesCaching(t *testing.T) {
+	var cache *CollectorCache
+	calls := 0
+	fetch := func() (any, error) { calls++; return nil, nil }
+
+	key := CacheKey{Profile: "prod", Region: "us-east-1", ResourceType: "security_data"}
+	cache.Get(key, fetch)
+	cache.Get(key, fetch)
+	if calls != 2 {
+		t.Errorf("fetch called %d times; want 2 with caching disabled", calls)
+	}
+}
+
+func TestCollectorCache_ErrorsAreNotCached(t *testing.T) {
+	cache := NewCollectorCache()
+	calls := 0
+	fetch := func() (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("throttled")
+		}
+		return "ok", nil
+	}
+
+	key := CacheKey{Profile: "prod", Region: "us-east-1", ResourceType: "security_data"}
+	if _, err := cache.Get(key, fetch); err == nil {
+		t.Fatal("expected first fetch error to be returned")
+	}
+	got, err := cache.Get(key, fetch)
+	if err != nil || got != "ok" {
+		t.Errorf("retry = (%v, %v); want (ok, nil)", got, err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times; want 2", calls)
+	}
+}
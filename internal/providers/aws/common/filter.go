@@ -0,0 +1,28 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// FilterProfiles returns the profile names from all that match pattern,
+// preserving input order. It returns an error when pattern is not a valid
+// regular expression or when no profile matches, so callers never silently
+// audit an empty set.
+func FilterProfiles(all []string, pattern string) ([]string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --profile-regex %q: %w", pattern, err)
+	}
+
+	var matched []string
+	for _, name := range all {
+		if re.MatchString(name) {
+			matched = append(matched, name)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no AWS profiles match --profile-regex %q (configured: %v)", pattern, all)
+	}
+	return matched, nil
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFilterProfiles_MatchingSubset(t *testing.T) {
+	all := []string{"default", "prod-us", "staging", "prod-eu"}
+	got, err := FilterProfiles(all, "^prod-")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"prod-us", "prod-eu"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterProfiles = %v; want %v", got, want)
+	}
+}
+
+func TestFilterProfiles_NoMatches(t *testing.T) {
+	_, err := FilterProfiles([]string{"default", "staging"}, "^prod-")
+	if err == nil {
+		t.Fatal("expected error when no profiles match")
+	}
+	if !strings.Contains(err.Error(), "no AWS profiles match") {
+		t.Errorf("error = %q; want it to mention no matching profiles", err)
+	}
+}
+
+func TestFilterProfiles_InvalidRegex(t *testing.T) {
+	_, err := FilterProfiles([]string{"default"}, "prod-(")
+	if err == nil {
+		t.Fatal("expected error for invalid regex")
+	}
+	if !strings.Contains(err.Error(), "invalid --profile-regex") {
+		t.Errorf("error = %q; want it to mention the invalid regex", err)
+	}
+}
@@ -0,0 +1,69 @@
+package common
+
+// APICall is one AWS API operation an audit would make, as listed by
+// --dry-run. Service is the IAM action prefix (e.g. "ec2", "ce") so that
+// Service:Operation names the permission the call needs.
+type APICall struct {
+	Region    string `json:"region"`
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+}
+
+// Region placeholders used in plans when the concrete region is not known
+// without calling AWS.
+const (
+	// ProfileRegion is the region configured for the AWS profile (us-east-1
+	// when the profile sets none).
+	ProfileRegion = "(profile region)"
+
+	// ActiveRegions stands for every region returned by ec2:DescribeRegions
+	// when --region is not set.
+	ActiveRegions = "(each active region)"
+)
+
+// ProfilePlan returns the calls made before any collector runs: resolving
+// the account ID and, when explicitRegions is empty, discovering the
+// account's active regions.
+func ProfilePlan(explicitRegions []string) []APICall {
+	plan := []APICall{{Region: ProfileRegion, Service: "sts", Operation: "GetCallerIdentity"}}
+	if len(explicitRegions) == 0 {
+		plan = append(plan, APICall{Region: ProfileRegion, Service: "ec2", Operation: "DescribeRegions"})
+	}
+	return plan
+}
+
+// PlanRegions returns the regions a plan should list regional calls for:
+// explicit when set, otherwise the ActiveRegions placeholder.
+func PlanRegions(explicit []string) []string {
+	if len(explicit) > 0 {
+		return explicit
+	}
+	return []string{ActiveRegions}
+}
+
+// PlanBuilder accumulates APICalls in insertion order, dropping duplicates.
+type PlanBuilder struct {
+	calls []APICall
+	seen  map[APICall]bool
+}
+
+// Add appends one call per operation of service in region, skipping calls
+// already in the plan.
+func (b *PlanBuilder) Add(region, service string, operations ...string) {
+	if b.seen == nil {
+		b.seen = make(map[APICall]bool)
+	}
+	for _, op := range operations {
+		c := APICall{Region: region, Service: service, Operation: op}
+		if b.seen[c] {
+			continue
+		}
+		b.seen[c] = true
+		b.calls = append(b.calls, c)
+	}
+}
+
+// Calls returns the accumulated plan.
+func (b *PlanBuilder) Calls() []APICall {
+	return b.calls
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlanBuilder_DropsDuplicates(t *testing.T) {
+	var b PlanBuilder
+	b.Add("us-east-1", "ce", "GetCostAndUsage")
+	b.Add("us-east-1", "ce", "GetCostAndUsage", "GetSavingsPlansCoverage")
+	want := []APICall{
+		{Region: "us-east-1", Service: "ce", Operation: "GetCostAndUsage"},
+		{Region: "us-east-1", Service: "ce", Operation: "GetSavingsPlansCoverage"},
+	}
+	if got := b.Calls(); !slices.Equal(got, want) {
+		t.Errorf("Calls = %+v; want %+v", got, want)
+	}
+}
+
+func TestProfilePlan(t *testing.T) {
+	discover := ProfilePlan(nil)
+	if len(discover) != 2 || discover[1].Operation != "DescribeRegions" {
+		t.Errorf("ProfilePlan(nil) = %+v; want sts:GetCallerIdentity and ec2:DescribeRegions", discover)
+	}
+	explicit := ProfilePlan([]string{"eu-west-1"})
+	if len(explicit) != 1 || explicit[0].Operation != "GetCallerIdentity" {
+		t.Errorf("ProfilePlan(explicit) = %+v; want only sts:GetCallerIdentity", explicit)
+	}
+}
+
+func TestPlanRegions(t *testing.T) {
+	if got := PlanRegions(nil); !slices.Equal(got, []string{ActiveRegions}) {
+		t.Errorf("PlanRegions(nil) = %v; want [%s]", got, ActiveRegions)
+	}
+	if got := PlanRegions([]string{"eu-west-1"}); !slices.Equal(got, []string{"eu-west-1"}) {
+		t.Errorf("PlanRegions(explicit) = %v", got)
+	}
+}
@@ -0,0 +1,45 @@
+package cost
+
+import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+
+// Resource types under which CollectRegion caches the regional inventory in a
+// common.CollectorCache. The inventory does not depend on the lookback
+// window, so the cost engine (30 days) and the data protection engine (1 day)
+// share it; CloudWatch metrics and Cost Explorer data are fetched per call.
+const (
+	cacheEC2Instances  = "ec2_instances"
+	cacheEBSVolumes    = "ebs_volumes"
+	cacheNATGateways   = "nat_gateways"
+	cacheRDSInstances  = "rds_instances"
+	cacheLoadBalancers = "load_balancers"
+	cacheLogGroups     = "log_groups"
+	cacheElasticIPs    = "elastic_ips"
+)
+
+// NewCachingCollector returns a DefaultCostCollector backed by the real AWS
+// SDK that shares the regional inventory it describes through cache, keyed by
+// (profile, region, resource type). Share one cache between the cost and
+// data-protection engines so a unified audit describes each region once. A
+// nil cache disables caching.
+func NewCachingCollector(cache *common.CollectorCache) *DefaultCostCollector {
+	return &DefaultCostCollector{factory: newDefaultCostClients, cache: cache}
+}
+
+// cachedInventory returns the inventory of resourceType in region for
+// profile, calling describe on the first lookup. Callers get their own copy of
+// the slice, so enriching it with lookback-dependent metrics never changes
+// the cached value.
+func cachedInventory[T any](
+	cache *common.CollectorCache,
+	profile, region, resourceType string,
+	describe func() ([]T, error),
+) ([]T, error) {
+	key := common.CacheKey{Profile: profile, Region: region, ResourceType: resourceType}
+	v, err := cache.Get(key, func() (any, error) {
+		return describe()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]T(nil), v.([]T)...), nil
+}
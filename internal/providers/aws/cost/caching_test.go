@@ -0,0 +1,182 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	ce "github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+)
+
+// countingAWS fakes every client in costClients and counts the calls made
+// per operation. It serves one running EC2 instance and one unencrypted EBS
+// volume; Cost Explorer is unavailable, which the collector tolerates.
+type countingAWS struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newCountingAWS() *countingAWS { return &countingAWS{calls: make(map[string]int)} }
+
+func (f *countingAWS) count(op string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[op]++
+}
+
+// Calls returns how often op was called.
+func (f *countingAWS) Calls(op string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[op]
+}
+
+func (f *countingAWS) factory(aws.Config) *costClients {
+	return &costClients{EC2: f, RDS: f, ELB: f, CE: f, CW: f, Logs: f}
+}
+
+func (f *countingAWS) DescribeInstances(context.Context, *ec2svc.DescribeInstancesInput, ...func(*ec2svc.Options)) (*ec2svc.DescribeInstancesOutput, error) {
+	f.count("DescribeInstances")
+	return &ec2svc.DescribeInstancesOutput{Reservations: []ec2types.Reservation{{Instances: []ec2types.Instance{{
+		InstanceId: aws.String("i-1"),
+		State:      &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+	}}}}}, nil
+}
+
+func (f *countingAWS) DescribeVolumes(context.Context, *ec2svc.DescribeVolumesInput, ...func(*ec2svc.Options)) (*ec2svc.DescribeVolumesOutput, error) {
+	f.count("DescribeVolumes")
+	return &ec2svc.DescribeVolumesOutput{Volumes: []ec2types.Volume{{
+		VolumeId:  aws.String("vol-1"),
+		Encrypted: aws.Bool(false),
+		State:     ec2types.VolumeStateInUse,
+	}}}, nil
+}
+
+func (f *countingAWS) DescribeNatGateways(context.Context, *ec2svc.DescribeNatGatewaysInput, ...func(*ec2svc.Options)) (*ec2svc.DescribeNatGatewaysOutput, error) {
+	f.count("DescribeNatGateways")
+	return &ec2svc.DescribeNatGatewaysOutput{}, nil
+}
+
+func (f *countingAWS) DescribeAddresses(context.Context, *ec2svc.DescribeAddressesInput, ...func(*ec2svc.Options)) (*ec2svc.DescribeAddressesOutput, error) {
+	f.count("DescribeAddresses")
+	return &ec2svc.DescribeAddressesOutput{}, nil
+}
+
+func (f *countingAWS) DescribeDBInstances(context.Context, *rds.DescribeDBInstancesInput, ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	f.count("DescribeDBInstances")
+	return &rds.DescribeDBInstancesOutput{}, nil
+}
+
+func (f *countingAWS) DescribeLoadBalancers(context.Context, *elbv2.DescribeLoadBalancersInput, ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error) {
+	f.count("DescribeLoadBalancers")
+	return &elbv2.DescribeLoadBalancersOutput{}, nil
+}
+
+func (f *countingAWS) DescribeLogGroups(context.Context, *cloudwatchlogs.DescribeLogGroupsInput, ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	f.count("DescribeLogGroups")
+	return &cloudwatchlogs.DescribeLogGroupsOutput{}, nil
+}
+
+func (f *countingAWS) GetMetricStatistics(context.Context, *cloudwatch.GetMetricStatisticsInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	f.count("GetMetricStatistics")
+	return &cloudwatch.GetMetricStatisticsOutput{}, nil
+}
+
+func (f *countingAWS) GetCostAndUsage(context.Context, *ce.GetCostAndUsageInput, ...func(*ce.Options)) (*ce.GetCostAndUsageOutput, error) {
+	f.count("GetCostAndUsage")
+	return nil, errors.New("AccessDenied")
+}
+
+func (f *countingAWS) GetSavingsPlansCoverage(context.Context, *ce.GetSavingsPlansCoverageInput, ...func(*ce.Options)) (*ce.GetSavingsPlansCoverageOutput, error) {
+	f.count("GetSavingsPlansCoverage")
+	return nil, errors.New("AccessDenied")
+}
+
+// staticProvider is a common.AWSClientProvider for a single profile whose
+// regional configs carry only the region.
+type staticProvider struct{}
+
+func (staticProvider) LoadProfile(_ context.Context, name string) (*common.ProfileConfig, error) {
+	return &common.ProfileConfig{ProfileName: name, AccountID: "111122223333"}, nil
+}
+
+func (p staticProvider) LoadAllProfiles(ctx context.Context) ([]*common.ProfileConfig, error) {
+	profile, _ := p.LoadProfile(ctx, "prod")
+	return []*common.ProfileConfig{profile}, nil
+}
+
+func (staticProvider) GetActiveRegions(context.Context, *common.ProfileConfig) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func (staticProvider) ConfigForRegion(_ *common.ProfileConfig, region string) aws.Config {
+	return aws.Config{Region: region}
+}
+
+func TestCachingCollector_SharesInventoryAcrossLookbackWindows(t *testing.T) {
+	fake := newCountingAWS()
+	c := &DefaultCostCollector{factory: fake.factory, cache: common.NewCollectorCache()}
+	profile := &common.ProfileConfig{ProfileName: "prod"}
+	regions := []string{"us-east-1", "eu-west-1"}
+
+	// The cost engine collects 30 days of metrics, the data protection engine 1.
+	for _, days := range []int{30, 1} {
+		data, _, err := c.CollectAll(context.Background(), profile, staticProvider{}, regions, days)
+		if err != nil {
+			t.Fatalf("CollectAll(%d days) error: %v", days, err)
+		}
+		if len(data) != 2 || len(data[0].EC2Instances) != 1 || len(data[0].EBSVolumes) != 1 {
+			t.Fatalf("CollectAll(%d days) = %+v; want one instance and one volume per region", days, data)
+		}
+	}
+	for _, op := range []string{"DescribeInstances", "DescribeVolumes", "DescribeDBInstances", "DescribeNatGateways", "DescribeLoadBalancers", "DescribeLogGroups", "DescribeAddresses"} {
+		if got := fake.Calls(op); got != len(regions) {
+			t.Errorf("%s called %d times; want once per region (%d)", op, got, len(regions))
+		}
+	}
+	// Metrics depend on the lookback window: one CPU lookup per call and region.
+	if got := fake.Calls("GetMetricStatistics"); got != 2*len(regions) {
+		t.Errorf("GetMetricStatistics called %d times; want %d", got, 2*len(regions))
+	}
+}
+
+func TestCachingCollector_MetricsDoNotLeakIntoCache(t *testing.T) {
+	fake := newCountingAWS()
+	c := &DefaultCostCollector{factory: fake.factory, cache: common.NewCollectorCache()}
+	opts := CollectOptions{Profile: "prod", Region: "us-east-1", DaysBack: 30}
+
+	first, err := c.CollectRegion(context.Background(), aws.Config{}, opts)
+	if err != nil {
+		t.Fatalf("CollectRegion error: %v", err)
+	}
+	first.EC2Instances[0].AvgCPUPercent = 99
+	second, err := c.CollectRegion(context.Background(), aws.Config{}, opts)
+	if err != nil {
+		t.Fatalf("CollectRegion error: %v", err)
+	}
+	if second.EC2Instances[0].AvgCPUPercent == 99 {
+		t.Error("mutation of a previous result leaked into the cached inventory")
+	}
+}
+
+func TestCachingCollector_NilCacheDescribesEveryCall(t *testing.T) {
+	fake := newCountingAWS()
+	c := &DefaultCostCollector{factory: fake.factory}
+	profile := &common.ProfileConfig{ProfileName: "prod"}
+
+	c.CollectAll(context.Background(), profile, staticProvider{}, []string{"us-east-1"}, 30)
+	c.CollectAll(context.Background(), profile, staticProvider{}, []string{"us-east-1"}, 30)
+	if got := fake.Calls("DescribeInstances"); got != 2 {
+		t.Errorf("DescribeInstances called %d times; want 2 with caching disabled", got)
+	}
+}
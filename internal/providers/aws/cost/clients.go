@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	ce "github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
@@ -20,9 +21,10 @@ import (
 // ---------------------------------------------------------------------------
 
 // costEC2Client covers the EC2 operations required for cost collection.
-// A single *ec2.Client satisfies all three embedded describe methods, which
+// A single *ec2.Client satisfies all four describe methods; the first three
 // also satisfy ec2.DescribeInstancesAPIClient, ec2.DescribeVolumesAPIClient,
 // and ec2.DescribeNatGatewaysAPIClient — enabling SDK v2 paginators.
+// DescribeAddresses is not paginated.
 type costEC2Client interface {
 	DescribeInstances(
 		ctx context.Context,
@@ -41,6 +43,12 @@ type costEC2Client interface {
 		params *ec2svc.DescribeNatGatewaysInput,
 		optFns ...func(*ec2svc.Options),
 	) (*ec2svc.DescribeNatGatewaysOutput, error)
+
+	DescribeAddresses(
+		ctx context.Context,
+		params *ec2svc.DescribeAddressesInput,
+		optFns ...func(*ec2svc.Options),
+	) (*ec2svc.DescribeAddressesOutput, error)
 }
 
 // costRDSClient covers the RDS operations required for cost collection.
@@ -74,6 +82,17 @@ type costCWClient interface {
 	) (*cloudwatch.GetMetricStatisticsOutput, error)
 }
 
+// costLogsClient covers the CloudWatch Logs operations required to list log
+// groups. Satisfies cloudwatchlogs.DescribeLogGroupsAPIClient for the SDK v2
+// paginator.
+type costLogsClient interface {
+	DescribeLogGroups(
+		ctx context.Context,
+		params *cloudwatchlogs.DescribeLogGroupsInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+}
+
 // costCEClient covers the Cost Explorer operations required for cost
 // collection. Cost Explorer is a global service; always use us-east-1.
 type costCEClient interface {
@@ -97,11 +116,12 @@ type costCEClient interface {
 // costClients holds all service clients needed for one collection run.
 // All fields are interfaces — swap any with a mock in tests.
 type costClients struct {
-	EC2 costEC2Client
-	RDS costRDSClient
-	ELB costELBv2Client
-	CE  costCEClient // always pointed at us-east-1 by the factory
-	CW  costCWClient // regional; used for CloudWatch metric queries
+	EC2  costEC2Client
+	RDS  costRDSClient
+	ELB  costELBv2Client
+	CE   costCEClient   // always pointed at us-east-1 by the factory
+	CW   costCWClient   // regional; used for CloudWatch metric queries
+	Logs costLogsClient // regional; used to list CloudWatch Logs log groups
 }
 
 // costClientFactory creates a costClients from an aws.Config.
@@ -114,10 +134,11 @@ func newDefaultCostClients(cfg aws.Config) *costClients {
 	ceCfg := cfg
 	ceCfg.Region = "us-east-1"
 	return &costClients{
-		EC2: ec2svc.NewFromConfig(cfg),
-		RDS: rds.NewFromConfig(cfg),
-		ELB: elbv2.NewFromConfig(cfg),
-		CE:  ce.NewFromConfig(ceCfg),
-		CW:  cloudwatch.NewFromConfig(cfg),
+		EC2:  ec2svc.NewFromConfig(cfg),
+		RDS:  rds.NewFromConfig(cfg),
+		ELB:  elbv2.NewFromConfig(cfg),
+		CE:   ce.NewFromConfig(ceCfg),
+		CW:   cloudwatch.NewFromConfig(cfg),
+		Logs: cloudwatchlogs.NewFromConfig(cfg),
 	}
 }
@@ -37,7 +37,9 @@ type CostCollector interface {
 	// For each region: a regional aws.Config is obtained via provider, and all
 	// resource types are collected. Savings Plan coverage is fetched once
 	// (account-level) and distributed to each RegionData.
-	// Regions that fail are skipped; Cost Explorer failure returns nil CostSummary.
+	// A region that fails is returned with only Region and CollectErrors set;
+	// an error is returned only when every region fails. Cost Explorer failure
+	// returns nil CostSummary.
 	CollectAll(
 		ctx context.Context,
 		profile *common.ProfileConfig,
@@ -55,4 +57,8 @@ type CostCollector interface {
 	// This is a global (non-regional) call; the region in cfg is overridden to
 	// us-east-1 internally. Returns a CostSummary covering the last opts.DaysBack days.
 	CollectCostExplorer(ctx context.Context, cfg aws.Config, opts CollectOptions) (*models.AWSCostSummary, error)
+
+	// Plan lists the AWS API calls CollectAll would make for regions, without
+	// making any of them. Used by --dry-run.
+	Plan(regions []string) []common.APICall
 }
@@ -20,6 +20,9 @@ import (
 // to replace real SDK clients with mocks in unit tests.
 type DefaultCostCollector struct {
 	factory costClientFactory
+	// cache, when non-nil, shares the regional inventory between calls; see
+	// NewCachingCollector.
+	cache *common.CollectorCache
 }
 
 // NewDefaultCostCollector returns a collector backed by the real AWS SDK.
@@ -49,7 +52,9 @@ const maxConcurrentRegions = 5
 //  4. For each region: obtain a regional aws.Config via provider, then call
 //     CollectRegion to gather EC2, EBS, NAT, RDS, and LB data.
 //     Regions are collected in parallel (up to maxConcurrentRegions at once)
-//     using errgroup; if any region fails the entire call fails.
+//     using errgroup. A region that fails is recorded as a RegionData with
+//     only Region and CollectErrors set, so the other regions are kept; the
+//     call fails only when every region fails.
 //  5. Attach the pre-fetched SP coverage to each RegionData.
 //
 // CE failures result in a nil CostSummary (non-fatal).
@@ -83,13 +88,14 @@ func (d *DefaultCostCollector) CollectAll(
 
 	// 3. Per-region resource collection — parallelised with a bounded errgroup.
 	// The semaphore channel limits concurrent in-flight region calls to
-	// maxConcurrentRegions. If any region fails, errgroup cancels the context
-	// and the first error is returned.
+	// maxConcurrentRegions. A failed region does not cancel the others.
 	sem := make(chan struct{}, maxConcurrentRegions)
 
 	var (
-		mu           sync.Mutex
+		mu            sync.Mutex
 		allRegionData []models.AWSRegionData
+		failed        int
+		lastErr       error
 	)
 
 	g, gctx := errgroup.WithContext(ctx)
@@ -116,7 +122,15 @@ REGIONS:
 
 			rd, err := d.CollectRegion(gctx, regionalCfg, opts)
 			if err != nil {
-				return fmt.Errorf("collect region %s: %w", region, err)
+				mu.Lock()
+				failed++
+				lastErr = fmt.Errorf("collect region %s: %w", region, err)
+				allRegionData = append(allRegionData, models.AWSRegionData{
+					Region:        region,
+					CollectErrors: []models.AWSCollectError{{Region: region, Err: err}},
+				})
+				mu.Unlock()
+				return nil
 			}
 
 			// 4. Attach Savings Plan coverage for this region.
@@ -134,13 +148,20 @@ REGIONS:
 	if err := g.Wait(); err != nil {
 		return nil, costSummary, err
 	}
+	if failed > 0 && failed == len(regions) {
+		return nil, costSummary, lastErr
+	}
 
 	return allRegionData, costSummary, nil
 }
 
 // CollectRegion gathers EC2 instances, EBS volumes, NAT Gateways, RDS instances,
-// and Load Balancers from a single AWS region. SavingsPlanCoverage is left
-// empty — CollectAll populates it centrally from a single account-level call.
+// Load Balancers, CloudWatch Logs log groups, and Elastic IPs from a single
+// AWS region. With a cache (NewCachingCollector) each resource type is
+// described once per (profile, region); the CloudWatch metrics and Cost
+// Explorer costs for opts.DaysBack are fetched on every call.
+// SavingsPlanCoverage is left empty — CollectAll populates it centrally from a
+// single account-level call.
 func (d *DefaultCostCollector) CollectRegion(
 	ctx context.Context,
 	cfg aws.Config,
@@ -151,10 +172,13 @@ func (d *DefaultCostCollector) CollectRegion(
 
 	var err error
 
-	rd.EC2Instances, err = collectEC2Instances(ctx, clients.EC2, clients.CW, opts.Region, opts.DaysBack)
+	rd.EC2Instances, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheEC2Instances, func() ([]models.AWSEC2Instance, error) {
+		return describeEC2Instances(ctx, clients.EC2, opts.Region)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collect EC2 instances in %s: %w", opts.Region, err)
 	}
+	addEC2Metrics(ctx, clients.CW, rd.EC2Instances, opts.DaysBack)
 
 	// Enrich EC2 instances with Cost Explorer per-instance monthly cost.
 	// Non-fatal: instances without cost data retain MonthlyCostUSD == 0,
@@ -167,20 +191,28 @@ func (d *DefaultCostCollector) CollectRegion(
 		}
 	}
 
-	rd.EBSVolumes, err = collectEBSVolumes(ctx, clients.EC2, opts.Region)
+	rd.EBSVolumes, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheEBSVolumes, func() ([]models.AWSEBSVolume, error) {
+		return collectEBSVolumes(ctx, clients.EC2, opts.Region)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collect EBS volumes in %s: %w", opts.Region, err)
 	}
 
-	rd.NATGateways, err = collectNATGateways(ctx, clients.EC2, clients.CW, opts.Region, opts.DaysBack)
+	rd.NATGateways, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheNATGateways, func() ([]models.AWSNATGateway, error) {
+		return describeNATGateways(ctx, clients.EC2, opts.Region)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collect NAT gateways in %s: %w", opts.Region, err)
 	}
+	addNATMetrics(ctx, clients.CW, rd.NATGateways, opts.DaysBack)
 
-	rd.RDSInstances, err = collectRDSInstances(ctx, clients.RDS, clients.CW, opts.Region, opts.DaysBack)
+	rd.RDSInstances, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheRDSInstances, func() ([]models.AWSRDSInstance, error) {
+		return describeRDSInstances(ctx, clients.RDS, opts.Region)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collect RDS instances in %s: %w", opts.Region, err)
 	}
+	addRDSMetrics(ctx, clients.CW, rd.RDSInstances, opts.DaysBack)
 
 	// Enrich RDS instances with Cost Explorer per-instance monthly cost.
 	// Non-fatal: instances without cost data retain MonthlyCostUSD == 0,
@@ -192,10 +224,38 @@ func (d *DefaultCostCollector) CollectRegion(
 		}
 	}
 
-	rd.LoadBalancers, err = collectLoadBalancers(ctx, clients.ELB, clients.CW, opts.Region, opts.DaysBack)
+	rd.LoadBalancers, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheLoadBalancers, func() ([]models.AWSLoadBalancer, error) {
+		return describeLoadBalancers(ctx, clients.ELB, opts.Region)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collect load balancers in %s: %w", opts.Region, err)
 	}
+	addLBMetrics(ctx, clients.CW, rd.LoadBalancers, opts.DaysBack)
+
+	// Log groups — non-fatal: a missing logs:DescribeLogGroups permission
+	// leaves LogGroups empty and is recorded in CollectErrors rather than
+	// failing the whole region.
+	rd.LogGroups, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheLogGroups, func() ([]models.AWSLogGroup, error) {
+		return collectLogGroups(ctx, clients.Logs, opts.Region)
+	})
+	if err != nil {
+		rd.CollectErrors = append(rd.CollectErrors, models.AWSCollectError{
+			Region: opts.Region,
+			Err:    fmt.Errorf("collect log groups in %s: %w", opts.Region, err),
+		})
+	}
+
+	// Elastic IPs — non-fatal for the same reason: a policy without
+	// ec2:DescribeAddresses leaves ElasticIPs empty.
+	rd.ElasticIPs, err = cachedInventory(d.cache, opts.Profile, opts.Region, cacheElasticIPs, func() ([]models.AWSElasticIP, error) {
+		return collectElasticIPs(ctx, clients.EC2, opts.Region)
+	})
+	if err != nil {
+		rd.CollectErrors = append(rd.CollectErrors, models.AWSCollectError{
+			Region: opts.Region,
+			Err:    fmt.Errorf("collect Elastic IPs in %s: %w", opts.Region, err),
+		})
+	}
 
 	return rd, nil
 }
@@ -217,6 +277,36 @@ func (d *DefaultCostCollector) CollectCostExplorer(
 	return collectCostSummary(ctx, clients.CE, start, end)
 }
 
+// Plan lists the calls CollectAll makes: Cost Explorer (always us-east-1) for
+// the account summary, Savings Plan coverage, and per-instance EC2 and RDS
+// costs, then the EC2, CloudWatch, RDS, ELBv2, and CloudWatch Logs calls of
+// CollectRegion in each region.
+func (d *DefaultCostCollector) Plan(regions []string) []common.APICall {
+	var b common.PlanBuilder
+	b.Add("us-east-1", "ce", "GetCostAndUsage", "GetSavingsPlansCoverage")
+	for _, region := range regions {
+		b.Add(region, "ec2", "DescribeInstances", "DescribeVolumes", "DescribeNatGateways", "DescribeAddresses")
+		b.Add(region, "cloudwatch", "GetMetricStatistics")
+		b.Add(region, "rds", "DescribeDBInstances")
+		b.Add(region, "elasticloadbalancing", "DescribeLoadBalancers")
+		b.Add(region, "logs", "DescribeLogGroups")
+	}
+	return b.Calls()
+}
+
+// PlanMetrics lists the calls of Plan that depend on the lookback window:
+// Cost Explorer and the CloudWatch metrics of each region. A caching collector
+// (NewCachingCollector) still makes these on every CollectAll; the describe
+// calls are served from the cache after the first one.
+func (d *DefaultCostCollector) PlanMetrics(regions []string) []common.APICall {
+	var b common.PlanBuilder
+	b.Add("us-east-1", "ce", "GetCostAndUsage", "GetSavingsPlansCoverage")
+	for _, region := range regions {
+		b.Add(region, "cloudwatch", "GetMetricStatistics")
+	}
+	return b.Calls()
+}
+
 // ---------------------------------------------------------------------------
 // Package-private helpers
 // ---------------------------------------------------------------------------
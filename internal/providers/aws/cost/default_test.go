@@ -0,0 +1,87 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+)
+
+func TestDefaultCostCollector_Plan(t *testing.T) {
+	plan := NewDefaultCostCollector().Plan([]string{"us-east-1", "eu-west-1"})
+
+	want := []common.APICall{
+		{Region: "us-east-1", Service: "ce", Operation: "GetCostAndUsage"},
+		{Region: "us-east-1", Service: "ce", Operation: "GetSavingsPlansCoverage"},
+		{Region: "eu-west-1", Service: "ec2", Operation: "DescribeInstances"},
+		{Region: "eu-west-1", Service: "ec2", Operation: "DescribeVolumes"},
+		{Region: "eu-west-1", Service: "ec2", Operation: "DescribeNatGateways"},
+		{Region: "eu-west-1", Service: "ec2", Operation: "DescribeAddresses"},
+		{Region: "eu-west-1", Service: "cloudwatch", Operation: "GetMetricStatistics"},
+		{Region: "eu-west-1", Service: "rds", Operation: "DescribeDBInstances"},
+		{Region: "eu-west-1", Service: "elasticloadbalancing", Operation: "DescribeLoadBalancers"},
+		{Region: "eu-west-1", Service: "logs", Operation: "DescribeLogGroups"},
+	}
+	for _, c := range want {
+		if !slices.Contains(plan, c) {
+			t.Errorf("plan missing %+v", c)
+		}
+	}
+	if got := len(plan); got != 2+2*8 {
+		t.Errorf("plan has %d calls; want %d (2 Cost Explorer + 8 per region)", got, 2+2*8)
+	}
+}
+
+func TestDefaultCostCollector_Plan_NoRegions(t *testing.T) {
+	plan := NewDefaultCostCollector().Plan(nil)
+	for _, c := range plan {
+		if c.Service != "ce" {
+			t.Errorf("plan without regions lists regional call %+v", c)
+		}
+	}
+}
+
+// deniedEC2 is a countingAWS whose DescribeInstances is denied.
+type deniedEC2 struct{ *countingAWS }
+
+func (deniedEC2) DescribeInstances(context.Context, *ec2svc.DescribeInstancesInput, ...func(*ec2svc.Options)) (*ec2svc.DescribeInstancesOutput, error) {
+	return nil, errors.New("UnauthorizedOperation")
+}
+
+func TestDefaultCostCollector_CollectAll_KeepsRegionsAfterFailure(t *testing.T) {
+	fake := newCountingAWS()
+	factory := func(cfg aws.Config) *costClients {
+		clients := fake.factory(cfg)
+		if cfg.Region == "eu-west-1" {
+			clients.EC2 = deniedEC2{fake}
+		}
+		return clients
+	}
+	c := NewDefaultCostCollectorWithFactory(factory)
+	profile := &common.ProfileConfig{ProfileName: "prod"}
+
+	data, _, err := c.CollectAll(context.Background(), profile, staticProvider{}, []string{"us-east-1", "eu-west-1"}, 30)
+	if err != nil {
+		t.Fatalf("CollectAll error: %v; want the failed region recorded instead", err)
+	}
+	byRegion := make(map[string]int)
+	for i, rd := range data {
+		byRegion[rd.Region] = i
+	}
+	if ok := data[byRegion["us-east-1"]]; len(ok.EC2Instances) != 1 || len(ok.CollectErrors) != 0 {
+		t.Errorf("us-east-1 = %+v; want its instance and no errors", ok)
+	}
+	failed := data[byRegion["eu-west-1"]]
+	if len(failed.CollectErrors) != 1 || failed.CollectErrors[0].Region != "eu-west-1" || len(failed.EBSVolumes) != 0 {
+		t.Errorf("eu-west-1 = %+v; want only its collect error", failed)
+	}
+
+	if _, _, err := c.CollectAll(context.Background(), profile, staticProvider{}, []string{"eu-west-1"}, 30); err == nil {
+		t.Error("CollectAll returned no error; want one when every region fails")
+	}
+}
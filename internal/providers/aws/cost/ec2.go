@@ -14,19 +14,13 @@ import (
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
-// collectEC2Instances pages through all running and stopped EC2 instances in
-// region, converts them to internal models, and enriches each running instance
-// with its average CPUUtilization over the lookback window from CloudWatch.
-//
-// CloudWatch failures are non-fatal: affected instances retain
-// AvgCPUPercent == 0, which the rule engine treats as "no data available"
-// rather than "truly idle", preventing false-positive findings.
-func collectEC2Instances(
+// describeEC2Instances pages through all running and stopped EC2 instances in
+// region and converts them to internal models. It makes no CloudWatch calls;
+// see addEC2Metrics.
+func describeEC2Instances(
 	ctx context.Context,
 	ec2Client costEC2Client,
-	cwClient costCWClient,
 	region string,
-	daysBack int,
 ) ([]models.AWSEC2Instance, error) {
 	input := &ec2svc.DescribeInstancesInput{
 		Filters: []ec2types.Filter{
@@ -52,8 +46,17 @@ func collectEC2Instances(
 		}
 	}
 
-	// Enrich running instances with CloudWatch CPU averages.
-	// Stopped instances have no active CPU metric; skip them to avoid noise.
+	return instances, nil
+}
+
+// addEC2Metrics enriches each running instance with its average
+// CPUUtilization over the lookback window from CloudWatch. Stopped instances
+// have no active CPU metric and are skipped to avoid noise.
+//
+// CloudWatch failures are non-fatal: affected instances retain
+// AvgCPUPercent == 0, which the rule engine treats as "no data available"
+// rather than "truly idle", preventing false-positive findings.
+func addEC2Metrics(ctx context.Context, cwClient costCWClient, instances []models.AWSEC2Instance, daysBack int) {
 	end := time.Now().UTC()
 	start := end.AddDate(0, 0, -effectiveDaysBack(daysBack))
 	for i := range instances {
@@ -62,8 +65,6 @@ func collectEC2Instances(
 		}
 		instances[i].AvgCPUPercent = fetchAvgCPU(ctx, cwClient, instances[i].InstanceID, start, end)
 	}
-
-	return instances, nil
 }
 
 // toEC2Instance converts an SDK EC2 instance to the internal model.
@@ -0,0 +1,39 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// collectElasticIPs lists all Elastic IP addresses in region and converts them
+// to internal models. DescribeAddresses returns every address in one call.
+func collectElasticIPs(ctx context.Context, client costEC2Client, region string) ([]models.AWSElasticIP, error) {
+	out, err := client.DescribeAddresses(ctx, &ec2svc.DescribeAddressesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("DescribeAddresses: %w", err)
+	}
+	eips := make([]models.AWSElasticIP, 0, len(out.Addresses))
+	for _, a := range out.Addresses {
+		eips = append(eips, toElasticIP(a, region))
+	}
+	return eips, nil
+}
+
+// toElasticIP converts an SDK Address to the internal model.
+func toElasticIP(a ec2types.Address, region string) models.AWSElasticIP {
+	return models.AWSElasticIP{
+		AllocationID:       aws.ToString(a.AllocationId),
+		PublicIP:           aws.ToString(a.PublicIp),
+		Region:             region,
+		AssociationID:      aws.ToString(a.AssociationId),
+		InstanceID:         aws.ToString(a.InstanceId),
+		NetworkInterfaceID: aws.ToString(a.NetworkInterfaceId),
+		Tags:               tagsFromEC2(a.Tags),
+	}
+}
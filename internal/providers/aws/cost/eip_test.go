@@ -0,0 +1,54 @@
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// fakeEIPEC2Client returns a fixed list of Elastic IP addresses.
+type fakeEIPEC2Client struct {
+	costEC2Client
+	addresses []ec2types.Address
+}
+
+func (f *fakeEIPEC2Client) DescribeAddresses(
+	_ context.Context,
+	_ *ec2svc.DescribeAddressesInput,
+	_ ...func(*ec2svc.Options),
+) (*ec2svc.DescribeAddressesOutput, error) {
+	return &ec2svc.DescribeAddressesOutput{Addresses: f.addresses}, nil
+}
+
+func TestCollectElasticIPs_AssociationState(t *testing.T) {
+	client := &fakeEIPEC2Client{addresses: []ec2types.Address{
+		{
+			AllocationId: aws.String("eipalloc-idle"),
+			PublicIp:     aws.String("203.0.113.10"),
+			Tags:         []ec2types.Tag{{Key: aws.String("Name"), Value: aws.String("spare")}},
+		},
+		{
+			AllocationId:  aws.String("eipalloc-used"),
+			PublicIp:      aws.String("203.0.113.11"),
+			AssociationId: aws.String("eipassoc-1"),
+			InstanceId:    aws.String("i-1"),
+		},
+	}}
+
+	eips, err := collectElasticIPs(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("collectElasticIPs error: %v", err)
+	}
+	if len(eips) != 2 {
+		t.Fatalf("got %d addresses; want 2", len(eips))
+	}
+	if idle := eips[0]; idle.AssociationID != "" || idle.Region != "us-east-1" || idle.Tags["Name"] != "spare" {
+		t.Errorf("idle EIP = %+v; want no association, region us-east-1, Name tag", idle)
+	}
+	if used := eips[1]; used.AssociationID != "eipassoc-1" || used.InstanceID != "i-1" {
+		t.Errorf("used EIP = %+v; want association eipassoc-1 on i-1", used)
+	}
+}
@@ -15,20 +15,16 @@ import (
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
-// collectLoadBalancers pages through all ELBv2 load balancers (Application,
-// Network, Gateway) in region and converts them to internal models.
-// Application Load Balancers are enriched with their CloudWatch RequestCount
-// over the lookback window. NLB and GWLB use different CloudWatch metrics and
-// are left with RequestCount == 0.
+// describeLoadBalancers pages through all ELBv2 load balancers (Application,
+// Network, Gateway) in region and converts them to internal models. It makes
+// no CloudWatch calls; see addLBMetrics.
 //
 // Classic ELB (v1) is not collected here — add the elasticloadbalancing
 // package and a separate collector if needed in a future step.
-func collectLoadBalancers(
+func describeLoadBalancers(
 	ctx context.Context,
 	elbClient costELBv2Client,
-	cwClient costCWClient,
 	region string,
-	daysBack int,
 ) ([]models.AWSLoadBalancer, error) {
 	paginator := elbv2svc.NewDescribeLoadBalancersPaginator(elbClient, &elbv2svc.DescribeLoadBalancersInput{})
 
@@ -43,18 +39,27 @@ func collectLoadBalancers(
 		}
 	}
 
-	// Enrich Application Load Balancers with CloudWatch RequestCount.
-	// RequestCount == 0 from CloudWatch means no traffic over the period.
-	// NLB and GWLB are skipped — they use different metrics.
+	return lbs, nil
+}
+
+// addLBMetrics enriches Application Load Balancers with their CloudWatch
+// RequestCount over the lookback window (read by ALB_IDLE) and Network Load
+// Balancers with ProcessedBytes (read by AWS_LB_IDLE). GWLB is left with zero
+// metrics and MetricsStart unset. A zero total from CloudWatch means no
+// traffic over the period.
+func addLBMetrics(ctx context.Context, cwClient costCWClient, lbs []models.AWSLoadBalancer, daysBack int) {
 	end := time.Now().UTC()
 	start := end.AddDate(0, 0, -effectiveDaysBack(daysBack))
 	for i := range lbs {
-		if lbs[i].Type == "application" {
+		switch lbs[i].Type {
+		case "application":
 			lbs[i].RequestCount = fetchLBRequestCount(ctx, cwClient, lbs[i].LoadBalancerARN, start, end)
+			lbs[i].MetricsStart = start
+		case "network":
+			lbs[i].ProcessedBytes = fetchLBMetricSum(ctx, cwClient, "AWS/NetworkELB", "ProcessedBytes", lbs[i].LoadBalancerARN, start, end)
+			lbs[i].MetricsStart = start
 		}
 	}
-
-	return lbs, nil
 }
 
 // toLoadBalancer converts an SDK ELBv2 LoadBalancer to the internal model.
@@ -71,6 +76,7 @@ func toLoadBalancer(lb elbv2types.LoadBalancer, region string) models.AWSLoadBal
 		Type:             string(lb.Type),
 		State:            state,
 		RequestCount:     0, // enriched by fetchLBRequestCount for ALBs
+		CreatedTime:      aws.ToTime(lb.CreatedTime),
 		// Tags require a separate DescribeTags API call (not included here).
 	}
 }
@@ -86,10 +92,25 @@ func fetchLBRequestCount(
 	cw costCWClient,
 	lbARN string,
 	start, end time.Time,
+) int64 {
+	return fetchLBMetricSum(ctx, cw, "AWS/ApplicationELB", "RequestCount", lbARN, start, end)
+}
+
+// fetchLBMetricSum returns the Sum of an ELBv2 CloudWatch metric in namespace
+// (AWS/ApplicationELB or AWS/NetworkELB) for the load balancer identified by
+// lbARN over [start, end) at 1-day granularity. Returns 0 on error or when
+// no data points exist.
+func fetchLBMetricSum(
+	ctx context.Context,
+	cw costCWClient,
+	namespace, metricName string,
+	lbARN string,
+	start, end time.Time,
 ) int64 {
 	// Extract the CloudWatch LoadBalancer dimension from the ARN.
 	// ARN format: arn:aws:elasticloadbalancing:<region>:<acct>:loadbalancer/app/<name>/<id>
-	// Dimension value: app/<name>/<id>  (everything after "loadbalancer/")
+	// Dimension value: app/<name>/<id>  (everything after "loadbalancer/";
+	// net/<name>/<id> for NLBs)
 	const marker = ":loadbalancer/"
 	idx := strings.Index(lbARN, marker)
 	if idx < 0 {
@@ -98,8 +119,8 @@ func fetchLBRequestCount(
 	lbDim := lbARN[idx+len(marker):]
 
 	out, err := cw.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
-		Namespace:  aws.String("AWS/ApplicationELB"),
-		MetricName: aws.String("RequestCount"),
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String(metricName),
 		Dimensions: []cwtypes.Dimension{
 			{
 				Name:  aws.String("LoadBalancer"),
@@ -0,0 +1,74 @@
+package cost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2svc "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// fakeELBClient returns a fixed single page of load balancers.
+type fakeELBClient struct {
+	lbs []elbv2types.LoadBalancer
+}
+
+func (f *fakeELBClient) DescribeLoadBalancers(
+	_ context.Context,
+	_ *elbv2svc.DescribeLoadBalancersInput,
+	_ ...func(*elbv2svc.Options),
+) (*elbv2svc.DescribeLoadBalancersOutput, error) {
+	return &elbv2svc.DescribeLoadBalancersOutput{LoadBalancers: f.lbs}, nil
+}
+
+func elbLB(name, typ, dim string, created time.Time) elbv2types.LoadBalancer {
+	return elbv2types.LoadBalancer{
+		LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:111122223333:loadbalancer/" + dim),
+		LoadBalancerName: aws.String(name),
+		Type:             elbv2types.LoadBalancerTypeEnum(typ),
+		State:            &elbv2types.LoadBalancerState{Code: elbv2types.LoadBalancerStateEnumActive},
+		CreatedTime:      aws.Time(created),
+	}
+}
+
+func TestAddLBMetrics_Traffic(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	elbClient := &fakeELBClient{lbs: []elbv2types.LoadBalancer{
+		elbLB("web", "application", "app/web/1", created),
+		elbLB("tcp", "network", "net/tcp/2", created),
+		elbLB("gw", "gateway", "gwy/gw/3", created),
+	}}
+	cw := &fakeCWClient{sums: map[string]map[string][]float64{
+		"app/web/1": {"RequestCount": {10, 20}, "ProcessedBytes": {1000, 500}},
+		"gwy/gw/3":  {"ProcessedBytes": {2048}},
+		"net/tcp/2": {"ProcessedBytes": {4096}},
+	}}
+
+	lbs, err := describeLoadBalancers(context.Background(), elbClient, "us-east-1")
+	if err != nil {
+		t.Fatalf("describeLoadBalancers error: %v", err)
+	}
+	addLBMetrics(context.Background(), cw, lbs, 30)
+	if len(lbs) != 3 {
+		t.Fatalf("got %d load balancers; want 3", len(lbs))
+	}
+
+	alb, nlb, gw := lbs[0], lbs[1], lbs[2]
+	if alb.RequestCount != 30 || alb.ProcessedBytes != 0 {
+		t.Errorf("ALB metrics = (%d req, %d bytes); want (30, 0): ProcessedBytes is not fetched for ALBs", alb.RequestCount, alb.ProcessedBytes)
+	}
+	if nlb.RequestCount != 0 || nlb.ProcessedBytes != 4096 {
+		t.Errorf("NLB metrics = (%d req, %d bytes); want (0, 4096)", nlb.RequestCount, nlb.ProcessedBytes)
+	}
+	if alb.MetricsStart.IsZero() || nlb.MetricsStart.IsZero() {
+		t.Error("MetricsStart must be set for ALB and NLB")
+	}
+	if !gw.MetricsStart.IsZero() || gw.ProcessedBytes != 0 {
+		t.Errorf("GWLB MetricsStart = %v, ProcessedBytes = %d; want no metrics", gw.MetricsStart, gw.ProcessedBytes)
+	}
+	if !alb.CreatedTime.Equal(created) {
+		t.Errorf("CreatedTime = %v; want %v", alb.CreatedTime, created)
+	}
+}
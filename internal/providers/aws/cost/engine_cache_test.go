@@ -0,0 +1,68 @@
+package cost_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/engine"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+	awscost "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/cost"
+	dppack "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rulepacks/aws_dataprotection"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+// emptySecurityCollector returns no security data.
+type emptySecurityCollector struct{}
+
+func (emptySecurityCollector) CollectAll(context.Context, *common.ProfileConfig, common.AWSClientProvider, []string) (*models.AWSSecurityData, error) {
+	return &models.AWSSecurityData{}, nil
+}
+
+func (emptySecurityCollector) Plan([]string) []common.APICall { return nil }
+
+// TestEngines_ShareCostInventoryThroughCache runs the cost engine (30-day
+// lookback) and then the data protection engine (1-day lookback) on one
+// caching collector, as dp aws audit --all does, and checks that the regional
+// inventory is described once while the data protection engine still sees it.
+func TestEngines_ShareCostInventoryThroughCache(t *testing.T) {
+	collector, fake := awscost.NewCountingCollector(common.NewCollectorCache())
+	provider := awscost.StaticProvider{}
+	opts := engine.AuditOptions{Profile: "prod", Regions: []string{"us-east-1"}}
+
+	costEngine := engine.NewAWSCostEngine(provider, collector, rules.NewDefaultRuleRegistry(), nil)
+	opts.AuditType = engine.AuditTypeCost
+	if _, err := costEngine.RunAudit(context.Background(), opts); err != nil {
+		t.Fatalf("cost RunAudit: %v", err)
+	}
+	metricCalls := fake.Calls("GetMetricStatistics")
+
+	dpRegistry := rules.NewDefaultRuleRegistry()
+	for _, r := range dppack.New() {
+		dpRegistry.Register(r)
+	}
+	dpEngine := engine.NewAWSDataProtectionEngine(provider, collector, emptySecurityCollector{}, dpRegistry, nil)
+	opts.AuditType = engine.AuditTypeDataProtection
+	report, err := dpEngine.RunAudit(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("data protection RunAudit: %v", err)
+	}
+
+	for _, op := range []string{"DescribeInstances", "DescribeVolumes", "DescribeDBInstances"} {
+		if got := fake.Calls(op); got != 1 {
+			t.Errorf("%s called %d times across both engines; want 1", op, got)
+		}
+	}
+	if fake.Calls("GetMetricStatistics") <= metricCalls {
+		t.Error("data protection engine made no metric calls of its own; metrics must not come from the cost engine's lookback window")
+	}
+	var sawVolume bool
+	for _, f := range report.Findings {
+		if f.RuleID == "EBS_UNENCRYPTED" && f.ResourceID == "vol-1" {
+			sawVolume = true
+		}
+	}
+	if !sawVolume {
+		t.Errorf("data protection report lacks EBS_UNENCRYPTED for the cached volume; findings = %+v", report.Findings)
+	}
+}
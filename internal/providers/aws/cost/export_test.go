@@ -0,0 +1,14 @@
+package cost
+
+import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+
+// NewCountingCollector returns a collector sharing its inventory through
+// cache, backed by a countingAWS fake that is also returned so tests outside
+// the package can count the AWS calls.
+func NewCountingCollector(cache *common.CollectorCache) (*DefaultCostCollector, *countingAWS) {
+	fake := newCountingAWS()
+	return &DefaultCostCollector{factory: fake.factory, cache: cache}, fake
+}
+
+// StaticProvider exports staticProvider to tests outside the package.
+type StaticProvider = staticProvider
@@ -0,0 +1,55 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// collectLogGroups pages through all CloudWatch Logs log groups in region and
+// converts them to internal models. A nil client yields no log groups.
+func collectLogGroups(
+	ctx context.Context,
+	logsClient costLogsClient,
+	region string,
+) ([]models.AWSLogGroup, error) {
+	if logsClient == nil {
+		return nil, nil
+	}
+
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(logsClient, &cloudwatchlogs.DescribeLogGroupsInput{})
+
+	var groups []models.AWSLogGroup
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DescribeLogGroups page: %w", err)
+		}
+		for _, lg := range page.LogGroups {
+			groups = append(groups, toLogGroup(lg, region))
+		}
+	}
+	return groups, nil
+}
+
+// toLogGroup converts an SDK LogGroup to the internal model.
+// A nil RetentionInDays (never expire) maps to 0.
+func toLogGroup(lg logstypes.LogGroup, region string) models.AWSLogGroup {
+	var created time.Time
+	if lg.CreationTime != nil {
+		created = time.UnixMilli(*lg.CreationTime).UTC()
+	}
+	return models.AWSLogGroup{
+		LogGroupName:    aws.ToString(lg.LogGroupName),
+		Region:          region,
+		RetentionInDays: aws.ToInt32(lg.RetentionInDays),
+		StoredBytes:     aws.ToInt64(lg.StoredBytes),
+		CreatedTime:     created,
+	}
+}
@@ -0,0 +1,59 @@
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+// fakeLogsClient returns a fixed single page of log groups.
+type fakeLogsClient struct {
+	groups []logstypes.LogGroup
+}
+
+func (f *fakeLogsClient) DescribeLogGroups(
+	_ context.Context,
+	_ *cloudwatchlogs.DescribeLogGroupsInput,
+	_ ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	return &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: f.groups}, nil
+}
+
+func TestCollectLogGroups_RetentionAndStoredBytes(t *testing.T) {
+	client := &fakeLogsClient{groups: []logstypes.LogGroup{
+		{LogGroupName: aws.String("/app/forever"), StoredBytes: aws.Int64(5 << 30), CreationTime: aws.Int64(1704067200000)},
+		{LogGroupName: aws.String("/app/week"), RetentionInDays: aws.Int32(7), StoredBytes: aws.Int64(1024)},
+	}}
+
+	groups, err := collectLogGroups(context.Background(), client, "eu-west-1")
+	if err != nil {
+		t.Fatalf("collectLogGroups error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("got %d log groups; want 2", len(groups))
+	}
+
+	forever, week := groups[0], groups[1]
+	if forever.RetentionInDays != 0 || forever.StoredBytes != 5<<30 {
+		t.Errorf("forever = (%d days, %d bytes); want (0, %d)", forever.RetentionInDays, forever.StoredBytes, int64(5<<30))
+	}
+	if forever.Region != "eu-west-1" {
+		t.Errorf("Region = %q; want eu-west-1", forever.Region)
+	}
+	if forever.CreatedTime.IsZero() || forever.CreatedTime.Year() != 2024 {
+		t.Errorf("CreatedTime = %v; want 2024-01-01", forever.CreatedTime)
+	}
+	if week.RetentionInDays != 7 {
+		t.Errorf("week RetentionInDays = %d; want 7", week.RetentionInDays)
+	}
+}
+
+func TestCollectLogGroups_NilClient(t *testing.T) {
+	groups, err := collectLogGroups(context.Background(), nil, "us-east-1")
+	if err != nil || groups != nil {
+		t.Errorf("collectLogGroups(nil) = (%v, %v); want (nil, nil)", groups, err)
+	}
+}
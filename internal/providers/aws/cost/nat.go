@@ -14,18 +14,13 @@ import (
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
-// collectNATGateways pages through all available NAT Gateways in region,
-// converts them to internal models, and enriches each with its total outbound
-// bytes over the lookback window from CloudWatch (BytesOutToDestination metric).
-//
-// CloudWatch failures are non-fatal: affected gateways retain
-// BytesProcessedGB == 0, which the rule engine treats as negligible traffic.
-func collectNATGateways(
+// describeNATGateways pages through all available NAT Gateways in region and
+// converts them to internal models. It makes no CloudWatch calls; see
+// addNATMetrics.
+func describeNATGateways(
 	ctx context.Context,
 	ec2Client costEC2Client,
-	cwClient costCWClient,
 	region string,
-	daysBack int,
 ) ([]models.AWSNATGateway, error) {
 	input := &ec2svc.DescribeNatGatewaysInput{
 		Filter: []ec2types.Filter{
@@ -49,14 +44,21 @@ func collectNATGateways(
 		}
 	}
 
-	// Enrich each gateway with CloudWatch BytesOutToDestination total.
+	return gateways, nil
+}
+
+// addNATMetrics enriches each gateway with its total outbound bytes over the
+// lookback window from CloudWatch (BytesOutToDestination metric).
+//
+// CloudWatch failures are non-fatal: affected gateways retain
+// BytesProcessedGB == 0, which the rule engine treats as negligible traffic.
+func addNATMetrics(ctx context.Context, cwClient costCWClient, gateways []models.AWSNATGateway, daysBack int) {
 	end := time.Now().UTC()
 	start := end.AddDate(0, 0, -effectiveDaysBack(daysBack))
 	for i := range gateways {
 		gateways[i].BytesProcessedGB = fetchNATBytesOutGB(ctx, cwClient, gateways[i].NATGatewayID, start, end)
+		gateways[i].MetricsStart = start
 	}
-
-	return gateways, nil
 }
 
 // toNATGateway converts an SDK NAT Gateway to the internal model.
@@ -68,6 +70,7 @@ func toNATGateway(ng ec2types.NatGateway, region string) models.AWSNATGateway {
 		VPCID:            aws.ToString(ng.VpcId),
 		SubnetID:         aws.ToString(ng.SubnetId),
 		BytesProcessedGB: 0, // enriched by fetchNATBytesOutGB after collection
+		CreatedTime:      aws.ToTime(ng.CreateTime),
 		Tags:             tagsFromEC2(ng.Tags),
 	}
 }
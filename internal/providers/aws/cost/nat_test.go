@@ -0,0 +1,57 @@
+package cost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// fakeNATEC2Client returns a fixed single page of NAT gateways.
+type fakeNATEC2Client struct {
+	costEC2Client
+	gateways []ec2types.NatGateway
+}
+
+func (f *fakeNATEC2Client) DescribeNatGateways(
+	_ context.Context,
+	_ *ec2svc.DescribeNatGatewaysInput,
+	_ ...func(*ec2svc.Options),
+) (*ec2svc.DescribeNatGatewaysOutput, error) {
+	return &ec2svc.DescribeNatGatewaysOutput{NatGateways: f.gateways}, nil
+}
+
+func TestDescribeNATGateways_BytesAndTimes(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ec2Client := &fakeNATEC2Client{gateways: []ec2types.NatGateway{{
+		NatGatewayId: aws.String("nat-1"),
+		State:        ec2types.NatGatewayStateAvailable,
+		VpcId:        aws.String("vpc-1"),
+		CreateTime:   aws.Time(created),
+	}}}
+	cw := &fakeCWClient{sums: map[string]map[string][]float64{
+		"nat-1": {"BytesOutToDestination": {1 << 30, 1 << 30}},
+	}}
+
+	gws, err := describeNATGateways(context.Background(), ec2Client, "us-east-1")
+	if err != nil {
+		t.Fatalf("describeNATGateways error: %v", err)
+	}
+	addNATMetrics(context.Background(), cw, gws, 30)
+	if len(gws) != 1 {
+		t.Fatalf("got %d gateways; want 1", len(gws))
+	}
+	gw := gws[0]
+	if gw.BytesProcessedGB != 2 {
+		t.Errorf("BytesProcessedGB = %v; want 2", gw.BytesProcessedGB)
+	}
+	if !gw.CreatedTime.Equal(created) {
+		t.Errorf("CreatedTime = %v; want %v", gw.CreatedTime, created)
+	}
+	if gw.MetricsStart.IsZero() {
+		t.Error("MetricsStart must be set")
+	}
+}
@@ -14,18 +14,13 @@ import (
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
-// collectRDSInstances pages through all RDS database instances in region,
-// converts them to internal models, and enriches each instance with its
-// average CPUUtilization over the lookback window from CloudWatch.
-//
-// CloudWatch failures are non-fatal: affected instances retain
-// AvgCPUPercent == 0, which the rule engine treats as "no data available".
-func collectRDSInstances(
+// describeRDSInstances pages through all RDS database instances in region and
+// converts them to internal models. It makes no CloudWatch calls; see
+// addRDSMetrics.
+func describeRDSInstances(
 	ctx context.Context,
 	client costRDSClient,
-	cwClient costCWClient,
 	region string,
-	daysBack int,
 ) ([]models.AWSRDSInstance, error) {
 	paginator := rdssvc.NewDescribeDBInstancesPaginator(client, &rdssvc.DescribeDBInstancesInput{})
 
@@ -40,7 +35,16 @@ func collectRDSInstances(
 		}
 	}
 
-	// Enrich available instances with CloudWatch CPU averages.
+	return instances, nil
+}
+
+// addRDSMetrics enriches each available instance with its average
+// CPUUtilization and DatabaseConnections over the lookback window from
+// CloudWatch.
+//
+// CloudWatch failures are non-fatal: affected instances retain
+// AvgCPUPercent == 0, which the rule engine treats as "no data available".
+func addRDSMetrics(ctx context.Context, cwClient costCWClient, instances []models.AWSRDSInstance, daysBack int) {
 	end := time.Now().UTC()
 	start := end.AddDate(0, 0, -effectiveDaysBack(daysBack))
 	for i := range instances {
@@ -48,9 +52,8 @@ func collectRDSInstances(
 			continue
 		}
 		instances[i].AvgCPUPercent = fetchRDSAvgCPU(ctx, cwClient, instances[i].DBInstanceID, start, end)
+		instances[i].AvgConnections = fetchRDSAvgConnections(ctx, cwClient, instances[i].DBInstanceID, start, end)
 	}
-
-	return instances, nil
 }
 
 // toRDSInstance converts an SDK DBInstance to the internal model.
@@ -64,7 +67,11 @@ func toRDSInstance(db rdstypes.DBInstance, region string) models.AWSRDSInstance
 		Status:           aws.ToString(db.DBInstanceStatus),
 		StorageEncrypted: aws.ToBool(db.StorageEncrypted),
 		AvgCPUPercent:    0, // enriched by fetchRDSAvgCPU after collection
+		AvgConnections:   0, // enriched by fetchRDSAvgConnections after collection
 		Tags:             tagsFromRDS(db.TagList),
+
+		BackupRetentionPeriod: int(aws.ToInt32(db.BackupRetentionPeriod)),
+		ReadReplicaSourceID:   aws.ToString(db.ReadReplicaSourceDBInstanceIdentifier),
 	}
 }
 
@@ -78,10 +85,34 @@ func fetchRDSAvgCPU(
 	cw costCWClient,
 	dbInstanceID string,
 	start, end time.Time,
+) float64 {
+	return fetchRDSMetricAvg(ctx, cw, dbInstanceID, "CPUUtilization", start, end)
+}
+
+// fetchRDSAvgConnections returns the average DatabaseConnections for
+// dbInstanceID over [start, end) at 1-day granularity, or 0 when the call
+// fails or no data points exist.
+func fetchRDSAvgConnections(
+	ctx context.Context,
+	cw costCWClient,
+	dbInstanceID string,
+	start, end time.Time,
+) float64 {
+	return fetchRDSMetricAvg(ctx, cw, dbInstanceID, "DatabaseConnections", start, end)
+}
+
+// fetchRDSMetricAvg averages the daily Average datapoints of an AWS/RDS
+// metric for dbInstanceID. Returns 0 on error or when no data exists.
+func fetchRDSMetricAvg(
+	ctx context.Context,
+	cw costCWClient,
+	dbInstanceID string,
+	metricName string,
+	start, end time.Time,
 ) float64 {
 	out, err := cw.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
 		Namespace:  aws.String("AWS/RDS"),
-		MetricName: aws.String("CPUUtilization"),
+		MetricName: aws.String(metricName),
 		Dimensions: []cwtypes.Dimension{
 			{
 				Name:  aws.String("DBInstanceIdentifier"),
@@ -0,0 +1,112 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	rdssvc "github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// fakeRDSClient returns a fixed single page of DB instances.
+type fakeRDSClient struct {
+	instances []rdstypes.DBInstance
+}
+
+func (f *fakeRDSClient) DescribeDBInstances(
+	_ context.Context,
+	_ *rdssvc.DescribeDBInstancesInput,
+	_ ...func(*rdssvc.Options),
+) (*rdssvc.DescribeDBInstancesOutput, error) {
+	return &rdssvc.DescribeDBInstancesOutput{DBInstances: f.instances}, nil
+}
+
+// fakeCWClient serves daily datapoints keyed by the first dimension value
+// and metric name: points populate Average, sums populate Sum. Missing keys
+// return no datapoints.
+type fakeCWClient struct {
+	points map[string]map[string][]float64
+	sums   map[string]map[string][]float64
+}
+
+func (f *fakeCWClient) GetMetricStatistics(
+	_ context.Context,
+	params *cloudwatch.GetMetricStatisticsInput,
+	_ ...func(*cloudwatch.Options),
+) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	id := aws.ToString(params.Dimensions[0].Value)
+	if id == "error-db" {
+		return nil, errors.New("throttled")
+	}
+	var dps []cwtypes.Datapoint
+	metric := aws.ToString(params.MetricName)
+	for _, v := range f.points[id][metric] {
+		dps = append(dps, cwtypes.Datapoint{Average: aws.Float64(v)})
+	}
+	for _, v := range f.sums[id][metric] {
+		dps = append(dps, cwtypes.Datapoint{Sum: aws.Float64(v)})
+	}
+	return &cloudwatch.GetMetricStatisticsOutput{Datapoints: dps}, nil
+}
+
+func rdsDB(id string) rdstypes.DBInstance {
+	return rdstypes.DBInstance{
+		DBInstanceIdentifier: aws.String(id),
+		DBInstanceClass:      aws.String("db.m5.xlarge"),
+		DBInstanceStatus:     aws.String("available"),
+	}
+}
+
+func TestAddRDSMetrics_Averages(t *testing.T) {
+	rdsClient := &fakeRDSClient{instances: []rdstypes.DBInstance{
+		rdsDB("idle-db"), rdsDB("busy-db"), rdsDB("nodata-db"), rdsDB("error-db"),
+	}}
+	cw := &fakeCWClient{points: map[string]map[string][]float64{
+		"idle-db": {"CPUUtilization": {2, 4}, "DatabaseConnections": {1, 3}},
+		"busy-db": {"CPUUtilization": {60, 80}, "DatabaseConnections": {120, 140}},
+	}}
+
+	instances, err := describeRDSInstances(context.Background(), rdsClient, "us-east-1")
+	if err != nil {
+		t.Fatalf("describeRDSInstances error: %v", err)
+	}
+	addRDSMetrics(context.Background(), cw, instances, 30)
+	if len(instances) != 4 {
+		t.Fatalf("got %d instances; want 4", len(instances))
+	}
+
+	want := map[string]struct{ cpu, conns float64 }{
+		"idle-db":   {3, 2},
+		"busy-db":   {70, 130},
+		"nodata-db": {0, 0},
+		"error-db":  {0, 0},
+	}
+	for _, inst := range instances {
+		w := want[inst.DBInstanceID]
+		if inst.AvgCPUPercent != w.cpu {
+			t.Errorf("%s AvgCPUPercent = %v; want %v", inst.DBInstanceID, inst.AvgCPUPercent, w.cpu)
+		}
+		if inst.AvgConnections != w.conns {
+			t.Errorf("%s AvgConnections = %v; want %v", inst.DBInstanceID, inst.AvgConnections, w.conns)
+		}
+	}
+}
+
+func TestToRDSInstance_BackupRetentionAndReplica(t *testing.T) {
+	primary := rdsDB("db-prod")
+	primary.BackupRetentionPeriod = aws.Int32(7)
+	replica := rdsDB("db-replica")
+	replica.BackupRetentionPeriod = aws.Int32(0)
+	replica.ReadReplicaSourceDBInstanceIdentifier = aws.String("db-prod")
+
+	if got := toRDSInstance(primary, "us-east-1"); got.BackupRetentionPeriod != 7 || got.ReadReplicaSourceID != "" {
+		t.Errorf("primary: BackupRetentionPeriod = %d, ReadReplicaSourceID = %q; want 7, empty", got.BackupRetentionPeriod, got.ReadReplicaSourceID)
+	}
+	if got := toRDSInstance(replica, "us-east-1"); got.BackupRetentionPeriod != 0 || got.ReadReplicaSourceID != "db-prod" {
+		t.Errorf("replica: BackupRetentionPeriod = %d, ReadReplicaSourceID = %q; want 0, db-prod", got.BackupRetentionPeriod, got.ReadReplicaSourceID)
+	}
+}
@@ -6,6 +6,7 @@ package eks
 import (
 	"context"
 
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 )
@@ -13,10 +14,15 @@ import (
 // eksAPIClient is the narrow EKS API surface consumed by this package.
 // DescribeCluster fetches cluster config; ListNodegroups + DescribeNodegroup
 // are used to resolve node group IAM roles for Phase 5B governance.
+// ListAddons, DescribeAddon, and DescribeAddonVersions resolve installed and
+// latest available managed add-on versions for EKS_ADDON_OUTDATED.
 type eksAPIClient interface {
 	DescribeCluster(ctx context.Context, params *awseks.DescribeClusterInput, optFns ...func(*awseks.Options)) (*awseks.DescribeClusterOutput, error)
 	ListNodegroups(ctx context.Context, params *awseks.ListNodegroupsInput, optFns ...func(*awseks.Options)) (*awseks.ListNodegroupsOutput, error)
 	DescribeNodegroup(ctx context.Context, params *awseks.DescribeNodegroupInput, optFns ...func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error)
+	ListAddons(ctx context.Context, params *awseks.ListAddonsInput, optFns ...func(*awseks.Options)) (*awseks.ListAddonsOutput, error)
+	DescribeAddon(ctx context.Context, params *awseks.DescribeAddonInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAddonOutput, error)
+	DescribeAddonVersions(ctx context.Context, params *awseks.DescribeAddonVersionsInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAddonVersionsOutput, error)
 }
 
 // iamAPIClient is the narrow IAM API surface consumed by EKS identity governance.
@@ -27,3 +33,9 @@ type iamAPIClient interface {
 	ListRolePolicies(ctx context.Context, params *awsiam.ListRolePoliciesInput, optFns ...func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error)
 	GetRolePolicy(ctx context.Context, params *awsiam.GetRolePolicyInput, optFns ...func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error)
 }
+
+// ec2APIClient is the narrow EC2 API surface used to read the inbound rules of
+// node security groups for EKS_NODE_SG_OPEN_INGRESS.
+type ec2APIClient interface {
+	DescribeSecurityGroups(ctx context.Context, params *awsec2.DescribeSecurityGroupsInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupsOutput, error)
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"path"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 
@@ -33,11 +36,13 @@ func (d *DefaultEKSCollector) CollectEKSData(ctx context.Context, clusterName, r
 	if err != nil {
 		return nil, fmt.Errorf("load AWS config for EKS region %q: %w", region, err)
 	}
-	return collectWithClient(ctx, awseks.NewFromConfig(cfg), awsiam.NewFromConfig(cfg), clusterName, region)
+	return collectWithClient(ctx, awseks.NewFromConfig(cfg), awsiam.NewFromConfig(cfg), awsec2.NewFromConfig(cfg), clusterName, region)
 }
 
-// collectWithClient is the testable core: it accepts injectable eksAPIClient and iamAPIClient.
-func collectWithClient(ctx context.Context, eksClient eksAPIClient, iamClient iamAPIClient, clusterName, region string) (*models.KubernetesEKSData, error) {
+// collectWithClient is the testable core: it accepts injectable eksAPIClient,
+// iamAPIClient, and ec2APIClient. A nil iamClient or ec2Client skips the data
+// that client provides.
+func collectWithClient(ctx context.Context, eksClient eksAPIClient, iamClient iamAPIClient, ec2Client ec2APIClient, clusterName, region string) (*models.KubernetesEKSData, error) {
 	out, err := eksClient.DescribeCluster(ctx, &awseks.DescribeClusterInput{
 		Name: aws.String(clusterName),
 	})
@@ -51,6 +56,7 @@ func collectWithClient(ctx context.Context, eksClient eksAPIClient, iamClient ia
 	data := &models.KubernetesEKSData{
 		ClusterName: clusterName,
 		Region:      region,
+		Version:     aws.ToString(out.Cluster.Version),
 	}
 
 	if out.Cluster.ResourcesVpcConfig != nil {
@@ -70,6 +76,9 @@ func collectWithClient(ctx context.Context, eksClient eksAPIClient, iamClient ia
 
 	if len(out.Cluster.EncryptionConfig) > 0 {
 		data.EncryptionEnabled = true
+		for _, enc := range out.Cluster.EncryptionConfig {
+			data.EncryptedResources = append(data.EncryptedResources, enc.Resources...)
+		}
 	}
 
 	if out.Cluster.Identity != nil && out.Cluster.Identity.Oidc != nil {
@@ -82,31 +91,222 @@ func collectWithClient(ctx context.Context, eksClient eksAPIClient, iamClient ia
 		data.NodeRolePolicies = collectNodeRoleOverpermissivePolicies(ctx, eksClient, iamClient, clusterName)
 	}
 
+	// Managed add-on versions (non-fatal; empty on failure).
+	data.Addons = collectAddons(ctx, eksClient, clusterName, data.Version)
+
+	// Node security group ingress (non-fatal; empty on failure).
+	if ec2Client != nil {
+		var clusterSG string
+		if out.Cluster.ResourcesVpcConfig != nil {
+			clusterSG = aws.ToString(out.Cluster.ResourcesVpcConfig.ClusterSecurityGroupId)
+		}
+		data.NodeSecurityGroupIngress = collectNodeSecurityGroupIngress(ctx, eksClient, ec2Client, clusterName, clusterSG)
+	}
+
 	return data, nil
 }
 
+// ── Node security group helpers ───────────────────────────────────────────────
+
+// collectNodeSecurityGroupIngress returns the inbound IP rules of the node
+// security groups: clusterSG (the cluster security group EKS attaches to
+// managed nodes) and the remote-access security group of every managed node
+// group. Security groups set only in launch templates or on self-managed
+// nodes are not covered. All errors are treated as non-fatal; nil is
+// returned when the groups cannot be described.
+func collectNodeSecurityGroupIngress(ctx context.Context, eksClient eksAPIClient, ec2Client ec2APIClient, clusterName, clusterSG string) []models.KubernetesEKSIngressRule {
+	var groupIDs []string
+	seen := make(map[string]bool)
+	addGroup := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			groupIDs = append(groupIDs, id)
+		}
+	}
+	addGroup(clusterSG)
+
+	if ngOut, err := eksClient.ListNodegroups(ctx, &awseks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterName),
+	}); err == nil {
+		for _, ngName := range ngOut.Nodegroups {
+			ngDesc, err := eksClient.DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String(clusterName),
+				NodegroupName: aws.String(ngName),
+			})
+			if err != nil || ngDesc.Nodegroup == nil || ngDesc.Nodegroup.Resources == nil {
+				continue
+			}
+			addGroup(aws.ToString(ngDesc.Nodegroup.Resources.RemoteAccessSecurityGroup))
+		}
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	out, err := ec2Client.DescribeSecurityGroups(ctx, &awsec2.DescribeSecurityGroupsInput{GroupIds: groupIDs})
+	if err != nil {
+		return nil
+	}
+	var rules []models.KubernetesEKSIngressRule
+	for _, sg := range out.SecurityGroups {
+		groupID := aws.ToString(sg.GroupId)
+		for _, perm := range sg.IpPermissions {
+			protocol := aws.ToString(perm.IpProtocol)
+			fromPort, toPort := 0, 65535
+			if protocol != "-1" {
+				fromPort = int(aws.ToInt32(perm.FromPort))
+				toPort = int(aws.ToInt32(perm.ToPort))
+			}
+			var cidrs []string
+			for _, r := range perm.IpRanges {
+				cidrs = append(cidrs, aws.ToString(r.CidrIp))
+			}
+			for _, r := range perm.Ipv6Ranges {
+				cidrs = append(cidrs, aws.ToString(r.CidrIpv6))
+			}
+			for _, cidr := range cidrs {
+				rules = append(rules, models.KubernetesEKSIngressRule{
+					GroupID:  groupID,
+					Protocol: protocol,
+					FromPort: fromPort,
+					ToPort:   toPort,
+					CIDR:     cidr,
+				})
+			}
+		}
+	}
+	return rules
+}
+
+// ── Add-on helpers ────────────────────────────────────────────────────────────
+
+// collectAddons lists the cluster's managed add-ons and resolves, for each, the
+// installed version and the latest version EKS offers for k8sVersion.
+// All errors are treated as non-fatal: an add-on whose description fails is
+// skipped, and LatestVersion is left empty when the available versions cannot
+// be listed.
+func collectAddons(ctx context.Context, eksClient eksAPIClient, clusterName, k8sVersion string) []models.KubernetesEKSAddonData {
+	var names []string
+	p := awseks.NewListAddonsPaginator(eksClient, &awseks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return nil
+		}
+		names = append(names, page.Addons...)
+	}
+
+	var addons []models.KubernetesEKSAddonData
+	for _, name := range names {
+		desc, err := eksClient.DescribeAddon(ctx, &awseks.DescribeAddonInput{
+			ClusterName: aws.String(clusterName),
+			AddonName:   aws.String(name),
+		})
+		if err != nil || desc.Addon == nil {
+			continue
+		}
+		addons = append(addons, models.KubernetesEKSAddonData{
+			Name:          name,
+			Version:       aws.ToString(desc.Addon.AddonVersion),
+			LatestVersion: latestAddonVersion(ctx, eksClient, name, k8sVersion),
+		})
+	}
+	return addons
+}
+
+// latestAddonVersion returns the highest version of addonName that EKS offers
+// for k8sVersion, or "" when the versions cannot be listed.
+func latestAddonVersion(ctx context.Context, eksClient eksAPIClient, addonName, k8sVersion string) string {
+	input := &awseks.DescribeAddonVersionsInput{AddonName: aws.String(addonName)}
+	if k8sVersion != "" {
+		input.KubernetesVersion = aws.String(k8sVersion)
+	}
+
+	var latest string
+	p := awseks.NewDescribeAddonVersionsPaginator(eksClient, input)
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return ""
+		}
+		for _, info := range page.Addons {
+			for _, v := range info.AddonVersions {
+				version := aws.ToString(v.AddonVersion)
+				if latest == "" || compareAddonVersions(version, latest) > 0 {
+					latest = version
+				}
+			}
+		}
+	}
+	return latest
+}
+
+// compareAddonVersions orders EKS add-on versions of the form
+// "v1.15.1-eksbuild.2" by major, minor, patch, then eksbuild number.
+// It returns -1, 0, or 1. Unparseable components compare as zero.
+func compareAddonVersions(a, b string) int {
+	pa, pb := addonVersionParts(a), addonVersionParts(b)
+	for i := range pa {
+		if pa[i] != pb[i] {
+			if pa[i] < pb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// addonVersionParts splits "v1.15.1-eksbuild.2" into [1 15 1 2].
+func addonVersionParts(v string) [4]int {
+	var parts [4]int
+	core, build, _ := strings.Cut(strings.TrimPrefix(v, "v"), "-")
+	for i, field := range strings.SplitN(core, ".", 3) {
+		parts[i], _ = strconv.Atoi(field)
+	}
+	if n, ok := strings.CutPrefix(build, "eksbuild."); ok {
+		parts[3], _ = strconv.Atoi(n)
+	}
+	return parts
+}
+
 // ── Phase 5B helpers ──────────────────────────────────────────────────────────
 
-// collectOIDCProviderARN looks up the IAM OIDC provider ARN matching the
-// cluster's OIDC issuer URL. Returns empty string when the provider does not
-// exist in IAM or the issuer URL is empty. All errors are treated as non-fatal.
+// collectOIDCProviderARN returns the IAM OIDC provider ARN for the cluster.
+// A provider whose URL equals the cluster's OIDC issuer URL is returned first.
+// Otherwise, when IAM holds exactly one EKS provider in the issuer's region
+// (typically left behind by a recreated cluster), that provider is returned so
+// EKS_OIDC_ISSUER_MISMATCH can report it; with several candidates none can be
+// attributed to the cluster. Returns empty string when the issuer URL is empty
+// or no provider qualifies. All errors are treated as non-fatal.
 func collectOIDCProviderARN(ctx context.Context, iamClient iamAPIClient, oidcIssuerURL string) string {
 	if oidcIssuerURL == "" {
 		return ""
 	}
 	// Strip https:// to get the bare URL embedded in the ARN.
 	// ARN format: arn:aws:iam::{accountID}:oidc-provider/{providerURL}
+	// providerURL format: oidc.eks.{region}.amazonaws.com/id/{hash}
 	providerURL := strings.TrimPrefix(oidcIssuerURL, "https://")
+	regionPrefix := "/" + path.Dir(providerURL) + "/"
 
 	out, err := iamClient.ListOpenIDConnectProviders(ctx, &awsiam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return ""
 	}
+	var candidates []string
 	for _, p := range out.OpenIDConnectProviderList {
 		arn := aws.ToString(p.Arn)
 		if strings.HasSuffix(arn, "/"+providerURL) {
 			return arn
 		}
+		if strings.Contains(arn, regionPrefix) {
+			candidates = append(candidates, arn)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
 	}
 	return ""
 }
@@ -0,0 +1,269 @@
+package eks
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// fakeEKSClient is an in-memory eksAPIClient. addons maps add-on name to its
+// installed version; available maps add-on name to the versions EKS offers.
+// clusterSG is the cluster security group; remoteAccessSG, when set, is the
+// remote-access security group of a single node group "ng-1".
+type fakeEKSClient struct {
+	addons         map[string]string
+	available      map[string][]string
+	versionsErr    error
+	k8sVersionIn   string
+	clusterSG      string
+	remoteAccessSG string
+}
+
+func (f *fakeEKSClient) DescribeCluster(_ context.Context, _ *awseks.DescribeClusterInput, _ ...func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+	return &awseks.DescribeClusterOutput{Cluster: &ekstypes.Cluster{
+		Version:            aws.String("1.29"),
+		ResourcesVpcConfig: &ekstypes.VpcConfigResponse{ClusterSecurityGroupId: aws.String(f.clusterSG)},
+	}}, nil
+}
+
+func (f *fakeEKSClient) ListNodegroups(_ context.Context, _ *awseks.ListNodegroupsInput, _ ...func(*awseks.Options)) (*awseks.ListNodegroupsOutput, error) {
+	if f.remoteAccessSG == "" {
+		return &awseks.ListNodegroupsOutput{}, nil
+	}
+	return &awseks.ListNodegroupsOutput{Nodegroups: []string{"ng-1"}}, nil
+}
+
+func (f *fakeEKSClient) DescribeNodegroup(_ context.Context, _ *awseks.DescribeNodegroupInput, _ ...func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+	return &awseks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{
+		Resources: &ekstypes.NodegroupResources{RemoteAccessSecurityGroup: aws.String(f.remoteAccessSG)},
+	}}, nil
+}
+
+func (f *fakeEKSClient) ListAddons(_ context.Context, _ *awseks.ListAddonsInput, _ ...func(*awseks.Options)) (*awseks.ListAddonsOutput, error) {
+	out := &awseks.ListAddonsOutput{}
+	for name := range f.addons {
+		out.Addons = append(out.Addons, name)
+	}
+	return out, nil
+}
+
+func (f *fakeEKSClient) DescribeAddon(_ context.Context, in *awseks.DescribeAddonInput, _ ...func(*awseks.Options)) (*awseks.DescribeAddonOutput, error) {
+	return &awseks.DescribeAddonOutput{Addon: &ekstypes.Addon{
+		AddonName:    in.AddonName,
+		AddonVersion: aws.String(f.addons[aws.ToString(in.AddonName)]),
+	}}, nil
+}
+
+func (f *fakeEKSClient) DescribeAddonVersions(_ context.Context, in *awseks.DescribeAddonVersionsInput, _ ...func(*awseks.Options)) (*awseks.DescribeAddonVersionsOutput, error) {
+	if f.versionsErr != nil {
+		return nil, f.versionsErr
+	}
+	f.k8sVersionIn = aws.ToString(in.KubernetesVersion)
+	info := ekstypes.AddonInfo{AddonName: in.AddonName}
+	for _, v := range f.available[aws.ToString(in.AddonName)] {
+		info.AddonVersions = append(info.AddonVersions, ekstypes.AddonVersionInfo{AddonVersion: aws.String(v)})
+	}
+	return &awseks.DescribeAddonVersionsOutput{Addons: []ekstypes.AddonInfo{info}}, nil
+}
+
+func TestCollectWithClient_PopulatesAddons(t *testing.T) {
+	client := &fakeEKSClient{
+		addons: map[string]string{"vpc-cni": "v1.15.1-eksbuild.1"},
+		available: map[string][]string{
+			"vpc-cni": {"v1.18.3-eksbuild.1", "v1.18.3-eksbuild.3", "v1.9.0-eksbuild.1", "v1.15.1-eksbuild.1"},
+		},
+	}
+	data, err := collectWithClient(context.Background(), client, nil, nil, "prod", "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Addons) != 1 {
+		t.Fatalf("expected 1 add-on; got %d", len(data.Addons))
+	}
+	got := data.Addons[0]
+	if got.Name != "vpc-cni" || got.Version != "v1.15.1-eksbuild.1" {
+		t.Errorf("add-on = %+v; want vpc-cni at v1.15.1-eksbuild.1", got)
+	}
+	if got.LatestVersion != "v1.18.3-eksbuild.3" {
+		t.Errorf("LatestVersion = %q; want v1.18.3-eksbuild.3", got.LatestVersion)
+	}
+	if client.k8sVersionIn != "1.29" {
+		t.Errorf("DescribeAddonVersions KubernetesVersion = %q; want 1.29", client.k8sVersionIn)
+	}
+}
+
+func TestCollectWithClient_AddonVersionsErrorIsNonFatal(t *testing.T) {
+	client := &fakeEKSClient{
+		addons:      map[string]string{"coredns": "v1.10.1-eksbuild.7"},
+		versionsErr: errors.New("access denied"),
+	}
+	data, err := collectWithClient(context.Background(), client, nil, nil, "prod", "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Addons) != 1 || data.Addons[0].LatestVersion != "" {
+		t.Errorf("Addons = %+v; want coredns with empty LatestVersion", data.Addons)
+	}
+}
+
+func TestCollectWithClient_PopulatesVersion(t *testing.T) {
+	data, err := collectWithClient(context.Background(), &fakeEKSClient{}, nil, nil, "prod", "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Version != "1.29" {
+		t.Errorf("Version = %q; want 1.29", data.Version)
+	}
+}
+
+// fakeEC2Client is an in-memory ec2APIClient serving groups by ID and
+// recording the IDs it was asked for.
+type fakeEC2Client struct {
+	groups      []ec2types.SecurityGroup
+	requestedID []string
+	err         error
+}
+
+func (f *fakeEC2Client) DescribeSecurityGroups(_ context.Context, in *awsec2.DescribeSecurityGroupsInput, _ ...func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupsOutput, error) {
+	f.requestedID = in.GroupIds
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &awsec2.DescribeSecurityGroupsOutput{SecurityGroups: f.groups}, nil
+}
+
+func TestCollectWithClient_PopulatesNodeSecurityGroupIngress(t *testing.T) {
+	eksClient := &fakeEKSClient{clusterSG: "sg-cluster", remoteAccessSG: "sg-remote"}
+	ec2Client := &fakeEC2Client{groups: []ec2types.SecurityGroup{
+		{
+			GroupId: aws.String("sg-cluster"),
+			IpPermissions: []ec2types.IpPermission{{
+				IpProtocol:       aws.String("-1"),
+				UserIdGroupPairs: []ec2types.UserIdGroupPair{{GroupId: aws.String("sg-cluster")}},
+			}},
+		},
+		{
+			GroupId: aws.String("sg-remote"),
+			IpPermissions: []ec2types.IpPermission{{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(22),
+				ToPort:     aws.Int32(22),
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+				Ipv6Ranges: []ec2types.Ipv6Range{{CidrIpv6: aws.String("::/0")}},
+			}},
+		},
+	}}
+
+	data, err := collectWithClient(context.Background(), eksClient, nil, ec2Client, "prod", "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(ec2Client.requestedID, []string{"sg-cluster", "sg-remote"}) {
+		t.Errorf("DescribeSecurityGroups GroupIds = %v; want [sg-cluster sg-remote]", ec2Client.requestedID)
+	}
+	want := []models.KubernetesEKSIngressRule{
+		{GroupID: "sg-remote", Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: "0.0.0.0/0"},
+		{GroupID: "sg-remote", Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: "::/0"},
+	}
+	if !reflect.DeepEqual(data.NodeSecurityGroupIngress, want) {
+		t.Errorf("NodeSecurityGroupIngress = %+v; want %+v", data.NodeSecurityGroupIngress, want)
+	}
+}
+
+func TestCollectWithClient_AllTrafficIngressCoversAllPorts(t *testing.T) {
+	ec2Client := &fakeEC2Client{groups: []ec2types.SecurityGroup{{
+		GroupId: aws.String("sg-cluster"),
+		IpPermissions: []ec2types.IpPermission{{
+			IpProtocol: aws.String("-1"),
+			IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+		}},
+	}}}
+	data, err := collectWithClient(context.Background(), &fakeEKSClient{clusterSG: "sg-cluster"}, nil, ec2Client, "prod", "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []models.KubernetesEKSIngressRule{{GroupID: "sg-cluster", Protocol: "-1", FromPort: 0, ToPort: 65535, CIDR: "0.0.0.0/0"}}
+	if !reflect.DeepEqual(data.NodeSecurityGroupIngress, want) {
+		t.Errorf("NodeSecurityGroupIngress = %+v; want %+v", data.NodeSecurityGroupIngress, want)
+	}
+}
+
+func TestCollectWithClient_SecurityGroupErrorIsNonFatal(t *testing.T) {
+	ec2Client := &fakeEC2Client{err: errors.New("access denied")}
+	data, err := collectWithClient(context.Background(), &fakeEKSClient{clusterSG: "sg-cluster"}, nil, ec2Client, "prod", "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.NodeSecurityGroupIngress != nil {
+		t.Errorf("NodeSecurityGroupIngress = %+v; want nil", data.NodeSecurityGroupIngress)
+	}
+}
+
+// fakeIAMClient is an in-memory iamAPIClient listing the given OIDC provider
+// ARNs. Role policy lookups return nothing.
+type fakeIAMClient struct {
+	providerARNs []string
+}
+
+func (f *fakeIAMClient) ListOpenIDConnectProviders(_ context.Context, _ *awsiam.ListOpenIDConnectProvidersInput, _ ...func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+	out := &awsiam.ListOpenIDConnectProvidersOutput{}
+	for _, arn := range f.providerARNs {
+		out.OpenIDConnectProviderList = append(out.OpenIDConnectProviderList, iamtypes.OpenIDConnectProviderListEntry{Arn: aws.String(arn)})
+	}
+	return out, nil
+}
+
+func (f *fakeIAMClient) ListAttachedRolePolicies(_ context.Context, _ *awsiam.ListAttachedRolePoliciesInput, _ ...func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+	return &awsiam.ListAttachedRolePoliciesOutput{}, nil
+}
+
+func (f *fakeIAMClient) ListRolePolicies(_ context.Context, _ *awsiam.ListRolePoliciesInput, _ ...func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+	return &awsiam.ListRolePoliciesOutput{}, nil
+}
+
+func (f *fakeIAMClient) GetRolePolicy(_ context.Context, _ *awsiam.GetRolePolicyInput, _ ...func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+	return &awsiam.GetRolePolicyOutput{}, nil
+}
+
+func TestCollectOIDCProviderARN(t *testing.T) {
+	const (
+		issuer  = "https://oidc.eks.us-east-1.amazonaws.com/id/CURRENT"
+		current = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/CURRENT"
+		stale   = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/STALE"
+		other   = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OTHER"
+		west    = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/WEST"
+		github  = "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com"
+	)
+	tests := []struct {
+		name      string
+		providers []string
+		issuer    string
+		want      string
+	}{
+		{"matching provider", []string{stale, current, github}, issuer, current},
+		{"only a stale provider in the region", []string{stale, west, github}, issuer, stale},
+		{"several candidates", []string{stale, other}, issuer, ""},
+		{"providers in other regions only", []string{west, github}, issuer, ""},
+		{"no issuer", []string{current}, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := collectOIDCProviderARN(context.Background(), &fakeIAMClient{providerARNs: tt.providers}, tt.issuer)
+			if got != tt.want {
+				t.Errorf("collectOIDCProviderARN = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package awssecurity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	acmsvc "github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// collectACMCertificates returns every ACM certificate in the region with its
+// expiry time. ListCertificates only returns RSA_2048 certificates unless key
+// types are requested explicitly, so every key algorithm is included.
+//
+// Returns an error when any ListCertificates page fails.
+func collectACMCertificates(ctx context.Context, client acmAPIClient, region string) ([]models.AWSACMCertificate, error) {
+	var certs []models.AWSACMCertificate
+
+	paginator := acmsvc.NewListCertificatesPaginator(client, &acmsvc.ListCertificatesInput{
+		Includes: &acmtypes.Filters{KeyTypes: acmtypes.KeyAlgorithm("").Values()},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.CertificateSummaryList {
+			certs = append(certs, models.AWSACMCertificate{
+				CertificateARN: aws.ToString(c.CertificateArn),
+				DomainName:     aws.ToString(c.DomainName),
+				Region:         region,
+				Status:         string(c.Status),
+				InUse:          aws.ToBool(c.InUse),
+				NotAfter:       aws.ToTime(c.NotAfter),
+			})
+		}
+	}
+	return certs, nil
+}
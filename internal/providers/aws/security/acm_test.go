@@ -0,0 +1,80 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	acmsvc "github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+)
+
+// fakeACMClient serves pages of certificate summaries in order, or err.
+// The input of the last call is recorded so the key type filter can be
+// checked.
+type fakeACMClient struct {
+	pages [][]acmtypes.CertificateSummary
+	err   error
+	input *acmsvc.ListCertificatesInput
+}
+
+func (f *fakeACMClient) ListCertificates(_ context.Context, in *acmsvc.ListCertificatesInput, _ ...func(*acmsvc.Options)) (*acmsvc.ListCertificatesOutput, error) {
+	f.input = in
+	if f.err != nil {
+		return nil, f.err
+	}
+	page := 0
+	if in.NextToken != nil {
+		page = 1
+	}
+	out := &acmsvc.ListCertificatesOutput{CertificateSummaryList: f.pages[page]}
+	if page+1 < len(f.pages) {
+		out.NextToken = aws.String("next")
+	}
+	return out, nil
+}
+
+func TestCollectACMCertificates(t *testing.T) {
+	notAfter := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	client := &fakeACMClient{pages: [][]acmtypes.CertificateSummary{
+		{{
+			CertificateArn: aws.String("arn:aws:acm:eu-west-1:123:certificate/a"),
+			DomainName:     aws.String("api.example.com"),
+			Status:         acmtypes.CertificateStatusIssued,
+			InUse:          aws.Bool(true),
+			NotAfter:       aws.Time(notAfter),
+		}},
+		{{
+			CertificateArn: aws.String("arn:aws:acm:eu-west-1:123:certificate/b"),
+			DomainName:     aws.String("pending.example.com"),
+			Status:         acmtypes.CertificateStatusPendingValidation,
+		}},
+	}}
+
+	certs, err := collectACMCertificates(context.Background(), client, "eu-west-1")
+	if err != nil {
+		t.Fatalf("collectACMCertificates: %v", err)
+	}
+	if len(certs) != 2 {
+		t.Fatalf("want 2 certificates across both pages, got %d", len(certs))
+	}
+	c := certs[0]
+	if c.DomainName != "api.example.com" || c.Region != "eu-west-1" || c.Status != "ISSUED" || !c.InUse || !c.NotAfter.Equal(notAfter) {
+		t.Errorf("certs[0] = %+v", c)
+	}
+	if !certs[1].NotAfter.IsZero() {
+		t.Errorf("pending certificate NotAfter = %v; want zero", certs[1].NotAfter)
+	}
+	if client.input.Includes == nil || len(client.input.Includes.KeyTypes) < 2 {
+		t.Errorf("Includes = %+v; want every key type requested", client.input.Includes)
+	}
+}
+
+func TestCollectACMCertificates_ListError(t *testing.T) {
+	client := &fakeACMClient{err: errors.New("AccessDeniedException")}
+	if _, err := collectACMCertificates(context.Background(), client, "us-east-1"); err == nil {
+		t.Error("want error when ListCertificates fails")
+	}
+}
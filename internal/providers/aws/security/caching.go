@@ -0,0 +1,50 @@
+package awssecurity
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+)
+
+// CachingSecurityCollector wraps a SecurityCollector and memoises its results
+// in a common.CollectorCache keyed by (profile, region set, resource type).
+// Share one cache between the security and data-protection engines so a
+// unified audit does not re-fetch the same posture data.
+type CachingSecurityCollector struct {
+	inner SecurityCollector
+	cache *common.CollectorCache
+}
+
+// NewCachingCollector returns a SecurityCollector that serves repeated calls
+// from cache. A nil cache disables caching and every call reaches inner.
+func NewCachingCollector(inner SecurityCollector, cache *common.CollectorCache) *CachingSecurityCollector {
+	return &CachingSecurityCollector{inner: inner, cache: cache}
+}
+
+// CollectAll delegates to the wrapped collector once per (profile, region set).
+func (c *CachingSecurityCollector) CollectAll(
+	ctx context.Context,
+	profile *common.ProfileConfig,
+	provider common.AWSClientProvider,
+	regions []string,
+) (*models.AWSSecurityData, error) {
+	key := common.CacheKey{
+		Profile:      profile.ProfileName,
+		Region:       strings.Join(regions, ","),
+		ResourceType: "security_data",
+	}
+	v, err := c.cache.Get(key, func() (any, error) {
+		return c.inner.CollectAll(ctx, profile, provider, regions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*models.AWSSecurityData), nil
+}
+
+// Plan delegates to the wrapped collector; planning makes no calls to cache.
+func (c *CachingSecurityCollector) Plan(regions []string) []common.APICall {
+	return c.inner.Plan(regions)
+}
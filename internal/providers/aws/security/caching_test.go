@@ -0,0 +1,53 @@
+package awssecurity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+)
+
+// countingSecurityCollector records how often CollectAll is called.
+type countingSecurityCollector struct{ calls int }
+
+func (c *countingSecurityCollector) CollectAll(
+	_ context.Context,
+	_ *common.ProfileConfig,
+	_ common.AWSClientProvider,
+	_ []string,
+) (*models.AWSSecurityData, error) {
+	c.calls++
+	return &models.AWSSecurityData{}, nil
+}
+
+func (c *countingSecurityCollector) Plan([]string) []common.APICall { return nil }
+
+func TestCachingSecurityCollector_CachedOncePerKey(t *testing.T) {
+	inner := &countingSecurityCollector{}
+	c := NewCachingCollector(inner, common.NewCollectorCache())
+	profile := &common.ProfileConfig{ProfileName: "prod"}
+
+	c.CollectAll(context.Background(), profile, nil, []string{"us-east-1"})
+	c.CollectAll(context.Background(), profile, nil, []string{"us-east-1"})
+	if inner.calls != 1 {
+		t.Errorf("inner CollectAll called %d times; want 1", inner.calls)
+	}
+
+	c.CollectAll(context.Background(), &common.ProfileConfig{ProfileName: "staging"}, nil, []string{"us-east-1"})
+	if inner.calls != 2 {
+		t.Errorf("inner CollectAll called %d times after new profile; want 2", inner.calls)
+	}
+}
+
+func TestCachingSecurityCollector_DisabledCallsTwice(t *testing.T) {
+	inner := &countingSecurityCollector{}
+	c := NewCachingCollector(inner, nil)
+	profile := &common.ProfileConfig{ProfileName: "prod"}
+
+	c.CollectAll(context.Background(), profile, nil, []string{"us-east-1"})
+	c.CollectAll(context.Background(), profile, nil, []string{"us-east-1"})
+	if inner.calls != 2 {
+		t.Errorf("inner CollectAll called %d times; want 2 with caching disabled", inner.calls)
+	}
+}
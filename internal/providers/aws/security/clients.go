@@ -4,26 +4,37 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	acmsvc "github.com/aws/aws-sdk-go-v2/service/acm"
 	cloudtrailsvc "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	configsvc "github.com/aws/aws-sdk-go-v2/service/configservice"
 	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ecrsvc "github.com/aws/aws-sdk-go-v2/service/ecr"
 	guardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
 	iamsvc "github.com/aws/aws-sdk-go-v2/service/iam"
+	kmssvc "github.com/aws/aws-sdk-go-v2/service/kms"
 	s3svc "github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
 // s3APIClient is the narrow S3 interface used by the security collector.
-// It covers bucket listing, policy status inspection, and encryption status.
+// It covers bucket listing, policy status inspection, encryption status,
+// versioning status, and public access block settings.
 type s3APIClient interface {
 	ListBuckets(ctx context.Context, params *s3svc.ListBucketsInput, optFns ...func(*s3svc.Options)) (*s3svc.ListBucketsOutput, error)
 	GetBucketPolicyStatus(ctx context.Context, params *s3svc.GetBucketPolicyStatusInput, optFns ...func(*s3svc.Options)) (*s3svc.GetBucketPolicyStatusOutput, error)
 	GetBucketEncryption(ctx context.Context, params *s3svc.GetBucketEncryptionInput, optFns ...func(*s3svc.Options)) (*s3svc.GetBucketEncryptionOutput, error)
+	GetBucketVersioning(ctx context.Context, params *s3svc.GetBucketVersioningInput, optFns ...func(*s3svc.Options)) (*s3svc.GetBucketVersioningOutput, error)
+	GetPublicAccessBlock(ctx context.Context, params *s3svc.GetPublicAccessBlockInput, optFns ...func(*s3svc.Options)) (*s3svc.GetPublicAccessBlockOutput, error)
 }
 
-// ec2SecurityAPIClient is the narrow EC2 interface used for security group
-// collection. Only DescribeSecurityGroups is required.
+// ec2SecurityAPIClient is the narrow EC2 interface used for security group,
+// instance metadata options, and VPC flow log collection. DescribeInstances,
+// DescribeVpcs, and DescribeFlowLogs also satisfy the matching SDK paginator
+// client interfaces so the paginators can be used directly.
 type ec2SecurityAPIClient interface {
 	DescribeSecurityGroups(ctx context.Context, params *ec2svc.DescribeSecurityGroupsInput, optFns ...func(*ec2svc.Options)) (*ec2svc.DescribeSecurityGroupsOutput, error)
+	DescribeInstances(ctx context.Context, params *ec2svc.DescribeInstancesInput, optFns ...func(*ec2svc.Options)) (*ec2svc.DescribeInstancesOutput, error)
+	DescribeVpcs(ctx context.Context, params *ec2svc.DescribeVpcsInput, optFns ...func(*ec2svc.Options)) (*ec2svc.DescribeVpcsOutput, error)
+	DescribeFlowLogs(ctx context.Context, params *ec2svc.DescribeFlowLogsInput, optFns ...func(*ec2svc.Options)) (*ec2svc.DescribeFlowLogsOutput, error)
 }
 
 // iamAPIClient is the narrow IAM interface used for user and account-level
@@ -33,13 +44,18 @@ type iamAPIClient interface {
 	iamsvc.ListUsersAPIClient
 	ListMFADevices(ctx context.Context, params *iamsvc.ListMFADevicesInput, optFns ...func(*iamsvc.Options)) (*iamsvc.ListMFADevicesOutput, error)
 	GetLoginProfile(ctx context.Context, params *iamsvc.GetLoginProfileInput, optFns ...func(*iamsvc.Options)) (*iamsvc.GetLoginProfileOutput, error)
+	ListAccessKeys(ctx context.Context, params *iamsvc.ListAccessKeysInput, optFns ...func(*iamsvc.Options)) (*iamsvc.ListAccessKeysOutput, error)
 	GetAccountSummary(ctx context.Context, params *iamsvc.GetAccountSummaryInput, optFns ...func(*iamsvc.Options)) (*iamsvc.GetAccountSummaryOutput, error)
 }
 
 // cloudTrailAPIClient is the narrow CloudTrail interface for checking trail
-// configuration. DescribeTrails returns all trails for the account.
+// configuration. DescribeTrails returns all trails for the account;
+// GetTrailStatus and GetEventSelectors report whether each one is logging and
+// which events it records.
 type cloudTrailAPIClient interface {
 	DescribeTrails(ctx context.Context, params *cloudtrailsvc.DescribeTrailsInput, optFns ...func(*cloudtrailsvc.Options)) (*cloudtrailsvc.DescribeTrailsOutput, error)
+	GetTrailStatus(ctx context.Context, params *cloudtrailsvc.GetTrailStatusInput, optFns ...func(*cloudtrailsvc.Options)) (*cloudtrailsvc.GetTrailStatusOutput, error)
+	GetEventSelectors(ctx context.Context, params *cloudtrailsvc.GetEventSelectorsInput, optFns ...func(*cloudtrailsvc.Options)) (*cloudtrailsvc.GetEventSelectorsOutput, error)
 }
 
 // guardDutyAPIClient is the narrow GuardDuty interface for checking detector
@@ -55,6 +71,27 @@ type awsConfigAPIClient interface {
 	DescribeConfigurationRecorderStatus(ctx context.Context, params *configsvc.DescribeConfigurationRecorderStatusInput, optFns ...func(*configsvc.Options)) (*configsvc.DescribeConfigurationRecorderStatusOutput, error)
 }
 
+// kmsAPIClient is the narrow KMS interface for key rotation checks. It embeds
+// ListKeysAPIClient so the SDK paginator can be used directly.
+type kmsAPIClient interface {
+	kmssvc.ListKeysAPIClient
+	DescribeKey(ctx context.Context, params *kmssvc.DescribeKeyInput, optFns ...func(*kmssvc.Options)) (*kmssvc.DescribeKeyOutput, error)
+	GetKeyRotationStatus(ctx context.Context, params *kmssvc.GetKeyRotationStatusInput, optFns ...func(*kmssvc.Options)) (*kmssvc.GetKeyRotationStatusOutput, error)
+}
+
+// ecrAPIClient is the narrow ECR interface for image scan summaries. Both
+// list calls satisfy the SDK paginator client interfaces.
+type ecrAPIClient interface {
+	ecrsvc.DescribeRepositoriesAPIClient
+	ecrsvc.DescribeImagesAPIClient
+}
+
+// acmAPIClient is the narrow ACM interface for certificate expiry checks.
+// ListCertificates satisfies the SDK paginator client interface.
+type acmAPIClient interface {
+	acmsvc.ListCertificatesAPIClient
+}
+
 // secClients bundles all AWS service clients used by the security collector.
 type secClients struct {
 	S3         s3APIClient
@@ -63,6 +100,9 @@ type secClients struct {
 	CloudTrail cloudTrailAPIClient
 	GuardDuty  guardDutyAPIClient
 	Config     awsConfigAPIClient
+	KMS        kmsAPIClient
+	ECR        ecrAPIClient
+	ACM        acmAPIClient
 }
 
 // secClientFactory creates secClients from an AWS config.
@@ -78,5 +118,8 @@ func newDefaultSecClients(cfg aws.Config) *secClients {
 		CloudTrail: cloudtrailsvc.NewFromConfig(cfg),
 		GuardDuty:  guardduty.NewFromConfig(cfg),
 		Config:     configsvc.NewFromConfig(cfg),
+		KMS:        kmssvc.NewFromConfig(cfg),
+		ECR:        ecrsvc.NewFromConfig(cfg),
+		ACM:        acmsvc.NewFromConfig(cfg),
 	}
 }
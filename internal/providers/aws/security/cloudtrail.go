@@ -2,6 +2,7 @@ package awssecurity
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	cloudtrailsvc "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
@@ -9,11 +10,15 @@ import (
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
-// collectCloudTrailStatus calls DescribeTrails to determine whether at least
-// one multi-region trail exists for the account. IncludeShadowTrails is false
-// so only trails owned by this account are returned (not shadow copies).
+// collectCloudTrailStatus calls DescribeTrails to list the account's trails,
+// then GetTrailStatus and GetEventSelectors for each one to determine whether
+// at least one multi-region trail is logging management events.
+// IncludeShadowTrails is false so only trails owned by this account are
+// returned (not shadow copies).
 //
-// Returns HasMultiRegionTrail == false on error (conservative: treat as not configured).
+// Returns HasMultiRegionTrail == false on error (conservative: treat as not
+// configured). A trail whose status or selectors cannot be read is recorded
+// as not logging or not recording management events.
 func collectCloudTrailStatus(ctx context.Context, client cloudTrailAPIClient) (models.AWSCloudTrailStatus, error) {
 	out, err := client.DescribeTrails(ctx, &cloudtrailsvc.DescribeTrailsInput{
 		IncludeShadowTrails: aws.Bool(false),
@@ -22,10 +27,52 @@ func collectCloudTrailStatus(ctx context.Context, client cloudTrailAPIClient) (m
 		return models.AWSCloudTrailStatus{}, err
 	}
 
+	var status models.AWSCloudTrailStatus
 	for _, trail := range out.TrailList {
-		if trail.IsMultiRegionTrail != nil && *trail.IsMultiRegionTrail {
-			return models.AWSCloudTrailStatus{HasMultiRegionTrail: true}, nil
+		// The ARN identifies the trail regardless of the client's region.
+		id := aws.ToString(trail.TrailARN)
+		if id == "" {
+			id = aws.ToString(trail.Name)
+		}
+		t := models.AWSCloudTrailTrail{
+			Name:          aws.ToString(trail.Name),
+			HomeRegion:    aws.ToString(trail.HomeRegion),
+			IsMultiRegion: aws.ToBool(trail.IsMultiRegionTrail),
+		}
+		if st, err := client.GetTrailStatus(ctx, &cloudtrailsvc.GetTrailStatusInput{Name: aws.String(id)}); err == nil {
+			t.IsLogging = aws.ToBool(st.IsLogging)
+		}
+		if sel, err := client.GetEventSelectors(ctx, &cloudtrailsvc.GetEventSelectorsInput{TrailName: aws.String(id)}); err == nil {
+			t.ManagementEvents = recordsManagementEvents(sel)
+		}
+		if t.IsMultiRegion && t.IsLogging && t.ManagementEvents {
+			status.HasMultiRegionTrail = true
+		}
+		status.Trails = append(status.Trails, t)
+	}
+	return status, nil
+}
+
+// recordsManagementEvents reports whether a trail's event selectors include
+// management events. A trail with no selectors records management events by
+// default; basic selectors include them unless IncludeManagementEvents is
+// false; advanced selectors include them when an eventCategory field selector
+// equals "Management".
+func recordsManagementEvents(out *cloudtrailsvc.GetEventSelectorsOutput) bool {
+	if len(out.EventSelectors) == 0 && len(out.AdvancedEventSelectors) == 0 {
+		return true
+	}
+	for _, es := range out.EventSelectors {
+		if es.IncludeManagementEvents == nil || *es.IncludeManagementEvents {
+			return true
+		}
+	}
+	for _, aes := range out.AdvancedEventSelectors {
+		for _, fs := range aes.FieldSelectors {
+			if aws.ToString(fs.Field) == "eventCategory" && slices.Contains(fs.Equals, "Management") {
+				return true
+			}
 		}
 	}
-	return models.AWSCloudTrailStatus{HasMultiRegionTrail: false}, nil
+	return false
 }
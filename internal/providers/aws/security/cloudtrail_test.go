@@ -0,0 +1,120 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudtrailsvc "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cloudtrailtypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+)
+
+// fakeCloudTrailClient returns trails from DescribeTrails, serves logging
+// status from logging and event selectors from selectors, both keyed by trail
+// ARN. Trails named in statusErr fail GetTrailStatus.
+type fakeCloudTrailClient struct {
+	trails    []cloudtrailtypes.Trail
+	logging   map[string]bool
+	selectors map[string]*cloudtrailsvc.GetEventSelectorsOutput
+	statusErr map[string]bool
+}
+
+func (f *fakeCloudTrailClient) DescribeTrails(_ context.Context, _ *cloudtrailsvc.DescribeTrailsInput, _ ...func(*cloudtrailsvc.Options)) (*cloudtrailsvc.DescribeTrailsOutput, error) {
+	return &cloudtrailsvc.DescribeTrailsOutput{TrailList: f.trails}, nil
+}
+
+func (f *fakeCloudTrailClient) GetTrailStatus(_ context.Context, in *cloudtrailsvc.GetTrailStatusInput, _ ...func(*cloudtrailsvc.Options)) (*cloudtrailsvc.GetTrailStatusOutput, error) {
+	if f.statusErr[aws.ToString(in.Name)] {
+		return nil, errors.New("AccessDeniedException")
+	}
+	return &cloudtrailsvc.GetTrailStatusOutput{IsLogging: aws.Bool(f.logging[aws.ToString(in.Name)])}, nil
+}
+
+func (f *fakeCloudTrailClient) GetEventSelectors(_ context.Context, in *cloudtrailsvc.GetEventSelectorsInput, _ ...func(*cloudtrailsvc.Options)) (*cloudtrailsvc.GetEventSelectorsOutput, error) {
+	if out, ok := f.selectors[aws.ToString(in.TrailName)]; ok {
+		return out, nil
+	}
+	return &cloudtrailsvc.GetEventSelectorsOutput{}, nil
+}
+
+func trail(name string, multiRegion bool) cloudtrailtypes.Trail {
+	return cloudtrailtypes.Trail{
+		Name:               aws.String(name),
+		TrailARN:           aws.String(trailARN(name)),
+		HomeRegion:         aws.String("us-east-1"),
+		IsMultiRegionTrail: aws.Bool(multiRegion),
+	}
+}
+
+func trailARN(name string) string {
+	return "arn:aws:cloudtrail:us-east-1:123456789012:trail/" + name
+}
+
+func TestCollectCloudTrailStatus_MultiRegionLogging(t *testing.T) {
+	client := &fakeCloudTrailClient{
+		trails:  []cloudtrailtypes.Trail{trail("app", false), trail("org", true)},
+		logging: map[string]bool{trailARN("app"): true, trailARN("org"): true},
+	}
+	got, err := collectCloudTrailStatus(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.HasMultiRegionTrail {
+		t.Error("HasMultiRegionTrail = false; want true for a logging multi-region trail")
+	}
+	if len(got.Trails) != 2 || got.Trails[1].Name != "org" || !got.Trails[1].ManagementEvents {
+		t.Errorf("Trails = %+v; want app and org, org recording management events", got.Trails)
+	}
+}
+
+func TestCollectCloudTrailStatus_MultiRegionNotQualifying(t *testing.T) {
+	noMgmt := &cloudtrailsvc.GetEventSelectorsOutput{EventSelectors: []cloudtrailtypes.EventSelector{
+		{IncludeManagementEvents: aws.Bool(false)},
+	}}
+	cases := map[string]*fakeCloudTrailClient{
+		"stopped": {
+			trails: []cloudtrailtypes.Trail{trail("org", true)},
+		},
+		"no management events": {
+			trails:    []cloudtrailtypes.Trail{trail("org", true)},
+			logging:   map[string]bool{trailARN("org"): true},
+			selectors: map[string]*cloudtrailsvc.GetEventSelectorsOutput{trailARN("org"): noMgmt},
+		},
+		"status unreadable": {
+			trails:    []cloudtrailtypes.Trail{trail("org", true)},
+			logging:   map[string]bool{trailARN("org"): true},
+			statusErr: map[string]bool{trailARN("org"): true},
+		},
+	}
+	for name, client := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := collectCloudTrailStatus(context.Background(), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.HasMultiRegionTrail {
+				t.Errorf("HasMultiRegionTrail = true; want false (trails %+v)", got.Trails)
+			}
+		})
+	}
+}
+
+func TestRecordsManagementEvents_AdvancedSelectors(t *testing.T) {
+	out := &cloudtrailsvc.GetEventSelectorsOutput{AdvancedEventSelectors: []cloudtrailtypes.AdvancedEventSelector{
+		{FieldSelectors: []cloudtrailtypes.AdvancedFieldSelector{
+			{Field: aws.String("eventCategory"), Equals: []string{"Data"}},
+		}},
+	}}
+	if recordsManagementEvents(out) {
+		t.Error("data-only advanced selector must not record management events")
+	}
+	out.AdvancedEventSelectors = append(out.AdvancedEventSelectors, cloudtrailtypes.AdvancedEventSelector{
+		FieldSelectors: []cloudtrailtypes.AdvancedFieldSelector{
+			{Field: aws.String("eventCategory"), Equals: []string{"Management"}},
+		},
+	})
+	if !recordsManagementEvents(out) {
+		t.Error("Management advanced selector must record management events")
+	}
+}
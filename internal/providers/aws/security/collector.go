@@ -13,7 +13,8 @@ import (
 //
 // Implementations must never apply business logic or produce findings.
 // Non-fatal collection failures (e.g. a single region's SGs unreachable) must
-// be silently skipped so the rest of the audit can complete.
+// be recorded in AWSSecurityData.CollectErrors, not returned, so the rest of
+// the audit can complete and the engine can report them.
 type SecurityCollector interface {
 	CollectAll(
 		ctx context.Context,
@@ -21,4 +22,8 @@ type SecurityCollector interface {
 		provider common.AWSClientProvider,
 		regions []string,
 	) (*models.AWSSecurityData, error)
+
+	// Plan lists the AWS API calls CollectAll would make for regions, without
+	// making any of them. Used by --dry-run.
+	Plan(regions []string) []common.APICall
 }
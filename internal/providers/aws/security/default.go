@@ -2,6 +2,7 @@ package awssecurity
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
@@ -9,8 +10,10 @@ import (
 
 // DefaultSecurityCollector is the production SecurityCollector.
 // It collects S3, IAM, root account, and CloudTrail data from us-east-1
-// (global AWS services) and aggregates EC2 security group rules, GuardDuty
-// status, and AWS Config status across all audited regions.
+// (global AWS services) and aggregates EC2 security group rules, EC2 instance
+// metadata options, GuardDuty status, AWS Config status, KMS keys, ECR
+// image scan summaries, VPC flow log status, and ACM certificates across all
+// audited regions.
 type DefaultSecurityCollector struct {
 	factory secClientFactory
 }
@@ -29,55 +32,154 @@ func NewDefaultSecurityCollectorWithFactory(f secClientFactory) *DefaultSecurity
 
 // CollectAll gathers account-level security data for the given profile and
 // regions. Global resources (S3, IAM, root, CloudTrail) are collected once
-// using a us-east-1 config. Security group rules, GuardDuty detector status,
-// and AWS Config recorder status are collected per region and aggregated.
-// All collection failures are silently skipped (non-fatal).
+// using a us-east-1 config. Security group rules, EC2 instance metadata
+// options, GuardDuty detector status, AWS Config recorder status, KMS keys,
+// ECR image scan summaries, VPC flow log status, and ACM certificates are
+// collected per region and aggregated.
+// A failed call is non-fatal: it is recorded in the returned CollectErrors
+// and the rest of the data is still collected. The error result is always nil.
 func (c *DefaultSecurityCollector) CollectAll(
 	ctx context.Context,
 	profile *common.ProfileConfig,
 	provider common.AWSClientProvider,
 	regions []string,
 ) (*models.AWSSecurityData, error) {
+	var collectErrs []models.AWSCollectError
+	record := func(region, what string, err error) {
+		collectErrs = append(collectErrs, models.AWSCollectError{
+			Region: region,
+			Err:    fmt.Errorf("collect %s: %w", what, err),
+		})
+	}
+
 	// Global clients: us-east-1 is the canonical region for S3, IAM, and CloudTrail.
 	globalCfg := provider.ConfigForRegion(profile, "us-east-1")
 	globalClients := c.factory(globalCfg)
 
-	buckets, _ := collectS3Buckets(ctx, globalClients.S3)
-	iamUsers, _ := collectIAMUsers(ctx, globalClients.IAM)
-	root, _ := collectRootAccountInfo(ctx, globalClients.IAM)
-	cloudTrail, _ := collectCloudTrailStatus(ctx, globalClients.CloudTrail)
+	buckets, err := collectS3Buckets(ctx, globalClients.S3)
+	if err != nil {
+		record("", "S3 buckets", err)
+	}
+	iamUsers, err := collectIAMUsers(ctx, globalClients.IAM)
+	if err != nil {
+		record("", "IAM users", err)
+	}
+	root, err := collectRootAccountInfo(ctx, globalClients.IAM)
+	if err != nil {
+		record("", "root account", err)
+	}
+	cloudTrail, err := collectCloudTrailStatus(ctx, globalClients.CloudTrail)
+	if err != nil {
+		record("", "CloudTrail status", err)
+	}
 
-	// Regional: collect security groups, GuardDuty, and Config per region.
+	// Regional: collect security groups, EC2 metadata options, GuardDuty, and
+	// Config per region.
 	var allSGRules []models.AWSSecurityGroupRule
+	var allEC2Instances []models.AWSEC2InstanceMetadata
 	var allGuardDuty []models.AWSGuardDutyStatus
 	var allConfig []models.AWSConfigStatus
+	var allKMSKeys []models.AWSKMSKey
+	var allECRImages []models.AWSECRImageScan
+	var allVPCs []models.AWSVPC
+	var allACMCerts []models.AWSACMCertificate
 
 	for _, region := range regions {
 		regCfg := provider.ConfigForRegion(profile, region)
 		regClients := c.factory(regCfg)
 
-		// Security groups — non-fatal: only skip SG data for this region on error.
-		sgRules, err := collectSecurityGroupRules(ctx, regClients.EC2, region)
-		if err == nil {
+		// Security groups — only this region's SG data is skipped on error.
+		if sgRules, err := collectSecurityGroupRules(ctx, regClients.EC2, region); err == nil {
 			allSGRules = append(allSGRules, sgRules...)
+		} else {
+			record(region, "security groups", err)
 		}
 
-		// GuardDuty detector status — non-fatal.
-		gdStatus, _ := collectGuardDutyStatus(ctx, regClients.GuardDuty, region)
-		allGuardDuty = append(allGuardDuty, gdStatus)
+		// EC2 instance metadata options.
+		if ec2Instances, err := collectEC2MetadataOptions(ctx, regClients.EC2, region); err == nil {
+			allEC2Instances = append(allEC2Instances, ec2Instances...)
+		} else {
+			record(region, "EC2 metadata options", err)
+		}
+
+		// GuardDuty detector status: a region where the API is unavailable
+		// (not opted in, unsupported, access denied) records no status rather
+		// than a disabled one.
+		if gdStatus, err := collectGuardDutyStatus(ctx, regClients.GuardDuty, region); err == nil {
+			allGuardDuty = append(allGuardDuty, gdStatus)
+		} else {
+			record(region, "GuardDuty status", err)
+		}
 
-		// AWS Config recorder status — non-fatal.
-		cfgStatus, _ := collectConfigStatus(ctx, regClients.Config, region)
+		// AWS Config recorder status: kept as not enabled on error.
+		cfgStatus, err := collectConfigStatus(ctx, regClients.Config, region)
+		if err != nil {
+			record(region, "AWS Config status", err)
+		}
 		allConfig = append(allConfig, cfgStatus)
+
+		// KMS keys and rotation status.
+		if kmsKeys, err := collectKMSKeys(ctx, regClients.KMS, region); err == nil {
+			allKMSKeys = append(allKMSKeys, kmsKeys...)
+		} else {
+			record(region, "KMS keys", err)
+		}
+
+		// Latest ECR image scan per repository.
+		if images, err := collectECRImageScans(ctx, regClients.ECR, region); err == nil {
+			allECRImages = append(allECRImages, images...)
+		} else {
+			record(region, "ECR image scans", err)
+		}
+
+		// VPCs and their flow log status: the region's VPCs are dropped when
+		// either call fails so no VPC is misreported as unlogged.
+		if vpcs, err := collectVPCFlowLogs(ctx, regClients.EC2, region); err == nil {
+			allVPCs = append(allVPCs, vpcs...)
+		} else {
+			record(region, "VPC flow logs", err)
+		}
+
+		// ACM certificates and their expiry.
+		if certs, err := collectACMCertificates(ctx, regClients.ACM, region); err == nil {
+			allACMCerts = append(allACMCerts, certs...)
+		} else {
+			record(region, "ACM certificates", err)
+		}
 	}
 
 	return &models.AWSSecurityData{
 		Buckets:            buckets,
 		SecurityGroupRules: allSGRules,
+		EC2Instances:       allEC2Instances,
 		IAMUsers:           iamUsers,
 		Root:               root,
 		CloudTrail:         cloudTrail,
 		GuardDuty:          allGuardDuty,
 		Config:             allConfig,
+		KMSKeys:            allKMSKeys,
+		ECRImages:          allECRImages,
+		VPCs:               allVPCs,
+		ACMCertificates:    allACMCerts,
+		CollectErrors:      collectErrs,
 	}, nil
 }
+
+// Plan lists the calls CollectAll makes: S3, IAM, and CloudTrail once in
+// us-east-1, then EC2, GuardDuty, AWS Config, KMS, ECR, and ACM in each
+// region.
+func (c *DefaultSecurityCollector) Plan(regions []string) []common.APICall {
+	var b common.PlanBuilder
+	b.Add("us-east-1", "s3", "ListBuckets", "GetBucketPolicyStatus", "GetBucketEncryption", "GetBucketVersioning", "GetPublicAccessBlock")
+	b.Add("us-east-1", "iam", "ListUsers", "ListMFADevices", "GetLoginProfile", "ListAccessKeys", "GetAccountSummary")
+	b.Add("us-east-1", "cloudtrail", "DescribeTrails", "GetTrailStatus", "GetEventSelectors")
+	for _, region := range regions {
+		b.Add(region, "ec2", "DescribeSecurityGroups", "DescribeInstances", "DescribeVpcs", "DescribeFlowLogs")
+		b.Add(region, "guardduty", "ListDetectors", "GetDetector")
+		b.Add(region, "config", "DescribeConfigurationRecorderStatus")
+		b.Add(region, "kms", "ListKeys", "DescribeKey", "GetKeyRotationStatus")
+		b.Add(region, "ecr", "DescribeRepositories", "DescribeImages")
+		b.Add(region, "acm", "ListCertificates")
+	}
+	return b.Calls()
+}
@@ -0,0 +1,135 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	configsvc "github.com/aws/aws-sdk-go-v2/service/configservice"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/aws/common"
+)
+
+func TestDefaultSecurityCollector_Plan(t *testing.T) {
+	plan := NewDefaultSecurityCollector().Plan([]string{"eu-west-1"})
+
+	want := []common.APICall{
+		{Region: "us-east-1", Service: "s3", Operation: "ListBuckets"},
+		{Region: "us-east-1", Service: "s3", Operation: "GetPublicAccessBlock"},
+		{Region: "us-east-1", Service: "iam", Operation: "ListUsers"},
+		{Region: "us-east-1", Service: "iam", Operation: "GetAccountSummary"},
+		{Region: "us-east-1", Service: "cloudtrail", Operation: "GetTrailStatus"},
+		{Region: "eu-west-1", Service: "ec2", Operation: "DescribeSecurityGroups"},
+		{Region: "eu-west-1", Service: "ec2", Operation: "DescribeFlowLogs"},
+		{Region: "eu-west-1", Service: "guardduty", Operation: "GetDetector"},
+		{Region: "eu-west-1", Service: "config", Operation: "DescribeConfigurationRecorderStatus"},
+		{Region: "eu-west-1", Service: "kms", Operation: "GetKeyRotationStatus"},
+		{Region: "eu-west-1", Service: "ecr", Operation: "DescribeImages"},
+		{Region: "eu-west-1", Service: "acm", Operation: "ListCertificates"},
+	}
+	for _, c := range want {
+		if !slices.Contains(plan, c) {
+			t.Errorf("plan missing %+v", c)
+		}
+	}
+}
+
+func TestDefaultSecurityCollector_Plan_GlobalCallsListedOnce(t *testing.T) {
+	plan := NewDefaultSecurityCollector().Plan([]string{"us-east-1", "eu-west-1", "ap-south-1"})
+	seen := make(map[common.APICall]bool)
+	for _, c := range plan {
+		if seen[c] {
+			t.Errorf("duplicate call %+v", c)
+		}
+		seen[c] = true
+	}
+	var iam int
+	for _, c := range plan {
+		if c.Service == "iam" {
+			iam++
+		}
+	}
+	if iam != 5 {
+		t.Errorf("iam calls = %d; want 5 (global, listed once)", iam)
+	}
+}
+
+// fakeConfigClient returns err from DescribeConfigurationRecorderStatus, or no
+// recorders when err is nil.
+type fakeConfigClient struct{ err error }
+
+func (f fakeConfigClient) DescribeConfigurationRecorderStatus(context.Context, *configsvc.DescribeConfigurationRecorderStatusInput, ...func(*configsvc.Options)) (*configsvc.DescribeConfigurationRecorderStatusOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &configsvc.DescribeConfigurationRecorderStatusOutput{}, nil
+}
+
+// regionProvider is a common.AWSClientProvider whose configs carry only the
+// region.
+type regionProvider struct{}
+
+func (regionProvider) LoadProfile(_ context.Context, name string) (*common.ProfileConfig, error) {
+	return &common.ProfileConfig{ProfileName: name}, nil
+}
+
+func (regionProvider) LoadAllProfiles(context.Context) ([]*common.ProfileConfig, error) {
+	return nil, nil
+}
+
+func (regionProvider) GetActiveRegions(context.Context, *common.ProfileConfig) ([]string, error) {
+	return nil, nil
+}
+
+func (regionProvider) ConfigForRegion(_ *common.ProfileConfig, region string) aws.Config {
+	return aws.Config{Region: region}
+}
+
+// TestDefaultSecurityCollector_CollectAll_RecordsFailedCalls verifies that a
+// failed KMS or AWS Config call is recorded in CollectErrors with its region
+// while the data of the calls that succeeded is kept.
+func TestDefaultSecurityCollector_CollectAll_RecordsFailedCalls(t *testing.T) {
+	factory := func(cfg aws.Config) *secClients {
+		clients := &secClients{
+			S3:         &fakeS3Client{versioning: map[string]s3types.BucketVersioningStatus{"logs": s3types.BucketVersioningStatusEnabled}},
+			EC2:        &fakeVPCEC2Client{vpcs: []ec2types.Vpc{{VpcId: aws.String("vpc-1")}}},
+			IAM:        &fakeIAMClient{},
+			CloudTrail: &fakeCloudTrailClient{},
+			GuardDuty:  &fakeGuardDutyClient{},
+			Config:     fakeConfigClient{},
+			KMS:        &fakeKMSClient{},
+			ECR:        &fakeECRClient{},
+			ACM:        &fakeACMClient{pages: [][]acmtypes.CertificateSummary{nil}},
+		}
+		if cfg.Region == "eu-west-1" {
+			clients.KMS = &fakeKMSClient{listErr: errors.New("AccessDeniedException")}
+			clients.Config = fakeConfigClient{err: errors.New("AccessDeniedException")}
+		}
+		return clients
+	}
+	c := NewDefaultSecurityCollectorWithFactory(factory)
+
+	data, err := c.CollectAll(context.Background(), &common.ProfileConfig{ProfileName: "prod"}, regionProvider{}, []string{"us-east-1", "eu-west-1"})
+	if err != nil {
+		t.Fatalf("CollectAll error: %v", err)
+	}
+	if len(data.Buckets) != 1 || len(data.VPCs) != 2 || len(data.Config) != 2 {
+		t.Errorf("got %d buckets, %d VPCs, %d Config statuses; want 1, 2 and 2 (partial data kept)",
+			len(data.Buckets), len(data.VPCs), len(data.Config))
+	}
+	if len(data.CollectErrors) != 2 {
+		t.Fatalf("CollectErrors = %v; want the KMS and AWS Config failures", data.CollectErrors)
+	}
+	for i, want := range []string{"collect AWS Config status: ", "collect KMS keys: "} {
+		got := data.CollectErrors[i]
+		if got.Region != "eu-west-1" || !strings.HasPrefix(got.Err.Error(), want) {
+			t.Errorf("CollectErrors[%d] = %s %q; want eu-west-1 %q...", i, got.Region, got.Err, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
@@ -48,3 +49,38 @@ func collectSecurityGroupRules(ctx context.Context, client ec2SecurityAPIClient,
 	}
 	return rules, nil
 }
+
+// collectEC2MetadataOptions pages through all EC2 instances in the given region
+// and returns their IMDS options. Terminated and shutting-down instances are
+// skipped because their metadata configuration can no longer be changed.
+func collectEC2MetadataOptions(ctx context.Context, client ec2SecurityAPIClient, region string) ([]models.AWSEC2InstanceMetadata, error) {
+	paginator := ec2svc.NewDescribeInstancesPaginator(client, &ec2svc.DescribeInstancesInput{})
+
+	var instances []models.AWSEC2InstanceMetadata
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe instances in %s: %w", region, err)
+		}
+		for _, res := range page.Reservations {
+			for _, inst := range res.Instances {
+				if inst.State != nil {
+					switch inst.State.Name {
+					case ec2types.InstanceStateNameTerminated, ec2types.InstanceStateNameShuttingDown:
+						continue
+					}
+				}
+				md := models.AWSEC2InstanceMetadata{
+					InstanceID: aws.ToString(inst.InstanceId),
+					Region:     region,
+				}
+				if inst.MetadataOptions != nil {
+					md.HttpTokens = string(inst.MetadataOptions.HttpTokens)
+					md.HttpEndpoint = string(inst.MetadataOptions.HttpEndpoint)
+				}
+				instances = append(instances, md)
+			}
+		}
+	}
+	return instances, nil
+}
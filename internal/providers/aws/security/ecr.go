@@ -0,0 +1,72 @@
+package awssecurity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrsvc "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// collectECRImageScans returns the scan summary of the most recently pushed
+// image in every ECR repository of the region. Repositories without images
+// are omitted.
+//
+// Returns an error only when DescribeRepositories fails; a repository whose
+// images cannot be listed is skipped.
+func collectECRImageScans(ctx context.Context, client ecrAPIClient, region string) ([]models.AWSECRImageScan, error) {
+	var scans []models.AWSECRImageScan
+
+	repoPaginator := ecrsvc.NewDescribeRepositoriesPaginator(client, &ecrsvc.DescribeRepositoriesInput{})
+	for repoPaginator.HasMorePages() {
+		page, err := repoPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range page.Repositories {
+			latest, ok := latestECRImage(ctx, client, aws.ToString(repo.RepositoryName))
+			if !ok {
+				continue
+			}
+			scan := models.AWSECRImageScan{
+				Repository: aws.ToString(repo.RepositoryName),
+				Region:     region,
+				Digest:     aws.ToString(latest.ImageDigest),
+				PushedAt:   aws.ToTime(latest.ImagePushedAt),
+			}
+			if len(latest.ImageTags) > 0 {
+				scan.Tag = latest.ImageTags[0]
+			}
+			if latest.ImageScanStatus != nil {
+				scan.ScanStatus = string(latest.ImageScanStatus.Status)
+			}
+			if latest.ImageScanFindingsSummary != nil {
+				scan.SeverityCounts = latest.ImageScanFindingsSummary.FindingSeverityCounts
+			}
+			scans = append(scans, scan)
+		}
+	}
+	return scans, nil
+}
+
+// latestECRImage returns the image with the newest ImagePushedAt in repo.
+// ok is false when the repository has no images or listing fails.
+func latestECRImage(ctx context.Context, client ecrAPIClient, repo string) (latest ecrtypes.ImageDetail, ok bool) {
+	paginator := ecrsvc.NewDescribeImagesPaginator(client, &ecrsvc.DescribeImagesInput{
+		RepositoryName: aws.String(repo),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return ecrtypes.ImageDetail{}, false
+		}
+		for _, img := range page.ImageDetails {
+			if !ok || aws.ToTime(img.ImagePushedAt).After(aws.ToTime(latest.ImagePushedAt)) {
+				latest, ok = img, true
+			}
+		}
+	}
+	return latest, ok
+}
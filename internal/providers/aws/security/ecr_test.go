@@ -0,0 +1,99 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ecrsvc "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+// fakeECRClient returns repos from DescribeRepositories (or reposErr) and the
+// images keyed by repository name from DescribeImages.
+type fakeECRClient struct {
+	repos    []string
+	images   map[string][]ecrtypes.ImageDetail
+	reposErr error
+}
+
+func (f *fakeECRClient) DescribeRepositories(_ context.Context, _ *ecrsvc.DescribeRepositoriesInput, _ ...func(*ecrsvc.Options)) (*ecrsvc.DescribeRepositoriesOutput, error) {
+	if f.reposErr != nil {
+		return nil, f.reposErr
+	}
+	out := &ecrsvc.DescribeRepositoriesOutput{}
+	for _, name := range f.repos {
+		out.Repositories = append(out.Repositories, ecrtypes.Repository{RepositoryName: aws.String(name)})
+	}
+	return out, nil
+}
+
+func (f *fakeECRClient) DescribeImages(_ context.Context, in *ecrsvc.DescribeImagesInput, _ ...func(*ecrsvc.Options)) (*ecrsvc.DescribeImagesOutput, error) {
+	return &ecrsvc.DescribeImagesOutput{ImageDetails: f.images[aws.ToString(in.RepositoryName)]}, nil
+}
+
+// image returns an ImageDetail pushed at pushed with the given scan state.
+func image(tag string, pushed time.Time, status ecrtypes.ScanStatus, counts map[string]int32) ecrtypes.ImageDetail {
+	img := ecrtypes.ImageDetail{
+		ImageDigest:     aws.String("sha256:" + tag),
+		ImageTags:       []string{tag},
+		ImagePushedAt:   aws.Time(pushed),
+		ImageScanStatus: &ecrtypes.ImageScanStatus{Status: status},
+	}
+	if counts != nil {
+		img.ImageScanFindingsSummary = &ecrtypes.ImageScanFindingsSummary{FindingSeverityCounts: counts}
+	}
+	return img
+}
+
+func TestCollectECRImageScans_LatestImagePerRepository(t *testing.T) {
+	now := time.Now()
+	client := &fakeECRClient{
+		repos: []string{"api", "empty"},
+		images: map[string][]ecrtypes.ImageDetail{
+			"api": {
+				image("v1", now.Add(-48*time.Hour), ecrtypes.ScanStatusComplete, map[string]int32{"CRITICAL": 4}),
+				image("v2", now.Add(-time.Hour), ecrtypes.ScanStatusComplete, map[string]int32{"HIGH": 1}),
+			},
+		},
+	}
+	got, err := collectECRImageScans(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("want 1 scan (repository without images omitted), got %d", len(got))
+	}
+	scan := got[0]
+	if scan.Repository != "api" || scan.Region != "us-east-1" || scan.Tag != "v2" || scan.Digest != "sha256:v2" {
+		t.Errorf("scan = %+v; want api:v2 in us-east-1", scan)
+	}
+	if scan.ScanStatus != "COMPLETE" || scan.SeverityCounts["HIGH"] != 1 || scan.SeverityCounts["CRITICAL"] != 0 {
+		t.Errorf("scan state = %s %v; want COMPLETE with HIGH=1 only", scan.ScanStatus, scan.SeverityCounts)
+	}
+}
+
+func TestCollectECRImageScans_ScanInProgress(t *testing.T) {
+	client := &fakeECRClient{
+		repos: []string{"api"},
+		images: map[string][]ecrtypes.ImageDetail{
+			"api": {image("v1", time.Now(), ecrtypes.ScanStatusInProgress, nil)},
+		},
+	}
+	got, err := collectECRImageScans(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ScanStatus != "IN_PROGRESS" || got[0].SeverityCounts != nil {
+		t.Errorf("scans = %+v; want one IN_PROGRESS scan without counts", got)
+	}
+}
+
+func TestCollectECRImageScans_APIUnavailableReturnsError(t *testing.T) {
+	client := &fakeECRClient{reposErr: errors.New("AccessDeniedException")}
+	if _, err := collectECRImageScans(context.Background(), client, "us-east-1"); err == nil {
+		t.Error("expected an error so CollectAll skips the region")
+	}
+}
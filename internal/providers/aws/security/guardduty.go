@@ -13,7 +13,8 @@ import (
 // the given region. It first lists detectors; if none exist, GuardDuty is not
 // enabled. If a detector exists, GetDetector verifies its status is ENABLED.
 //
-// Returns Enabled == false on error (conservative: treat as not enabled).
+// Returns Enabled == false together with the error when either call fails;
+// callers skip the region rather than report GuardDuty as disabled.
 func collectGuardDutyStatus(ctx context.Context, client guardDutyAPIClient, region string) (models.AWSGuardDutyStatus, error) {
 	listOut, err := client.ListDetectors(ctx, &guardduty.ListDetectorsInput{})
 	if err != nil {
@@ -0,0 +1,68 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	guardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	guarddutytype "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+)
+
+// fakeGuardDutyClient returns detectorIDs from ListDetectors (or listErr) and
+// status from GetDetector.
+type fakeGuardDutyClient struct {
+	detectorIDs []string
+	status      guarddutytype.DetectorStatus
+	listErr     error
+}
+
+func (f *fakeGuardDutyClient) ListDetectors(_ context.Context, _ *guardduty.ListDetectorsInput, _ ...func(*guardduty.Options)) (*guardduty.ListDetectorsOutput, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	return &guardduty.ListDetectorsOutput{DetectorIds: f.detectorIDs}, nil
+}
+
+func (f *fakeGuardDutyClient) GetDetector(_ context.Context, _ *guardduty.GetDetectorInput, _ ...func(*guardduty.Options)) (*guardduty.GetDetectorOutput, error) {
+	return &guardduty.GetDetectorOutput{Status: f.status}, nil
+}
+
+func TestCollectGuardDutyStatus_ActiveDetector(t *testing.T) {
+	client := &fakeGuardDutyClient{detectorIDs: []string{"det-1"}, status: guarddutytype.DetectorStatusEnabled}
+	got, err := collectGuardDutyStatus(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Enabled || got.Region != "us-east-1" {
+		t.Errorf("status = %+v; want enabled in us-east-1", got)
+	}
+}
+
+func TestCollectGuardDutyStatus_DisabledDetector(t *testing.T) {
+	client := &fakeGuardDutyClient{detectorIDs: []string{"det-1"}, status: guarddutytype.DetectorStatusDisabled}
+	got, err := collectGuardDutyStatus(context.Background(), client, "eu-west-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Enabled {
+		t.Errorf("status = %+v; want disabled", got)
+	}
+}
+
+func TestCollectGuardDutyStatus_NoDetector(t *testing.T) {
+	got, err := collectGuardDutyStatus(context.Background(), &fakeGuardDutyClient{}, "eu-west-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Enabled {
+		t.Errorf("status = %+v; want disabled when no detector exists", got)
+	}
+}
+
+func TestCollectGuardDutyStatus_APIUnavailableReturnsError(t *testing.T) {
+	client := &fakeGuardDutyClient{listErr: errors.New("UnrecognizedClientException")}
+	if _, err := collectGuardDutyStatus(context.Background(), client, "me-south-1"); err == nil {
+		t.Error("expected an error so CollectAll skips the region")
+	}
+}
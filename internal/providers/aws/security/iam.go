@@ -11,8 +11,9 @@ import (
 )
 
 // collectIAMUsers returns all IAM users in the account together with their
-// relevant security attributes: whether MFA is enabled and whether the user
-// has a console login profile (i.e. can sign in to the AWS console).
+// relevant security attributes: whether MFA is enabled, whether the user
+// has a console login profile (i.e. can sign in to the AWS console), and the
+// status and creation time of each access key.
 // The ListUsers paginator handles accounts with many users.
 func collectIAMUsers(ctx context.Context, client iamAPIClient) ([]models.AWSIAMUser, error) {
 	paginator := iamsvc.NewListUsersPaginator(client, &iamsvc.ListUsersInput{})
@@ -28,6 +29,7 @@ func collectIAMUsers(ctx context.Context, client iamAPIClient) ([]models.AWSIAMU
 				UserName:        userName,
 				MFAEnabled:      userHasMFA(ctx, client, userName),
 				HasLoginProfile: userHasLoginProfile(ctx, client, userName),
+				AccessKeys:      userAccessKeys(ctx, client, userName),
 			})
 		}
 	}
@@ -56,3 +58,24 @@ func userHasLoginProfile(ctx context.Context, client iamAPIClient, userName stri
 	})
 	return err == nil
 }
+
+// userAccessKeys returns the access keys of the specified IAM user. IAM allows
+// at most two keys per user, so a single ListAccessKeys call is sufficient.
+// Errors are treated as "no keys" so a permission gap never fails the audit.
+func userAccessKeys(ctx context.Context, client iamAPIClient, userName string) []models.AWSIAMAccessKey {
+	out, err := client.ListAccessKeys(ctx, &iamsvc.ListAccessKeysInput{
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		return nil
+	}
+	keys := make([]models.AWSIAMAccessKey, 0, len(out.AccessKeyMetadata))
+	for _, k := range out.AccessKeyMetadata {
+		keys = append(keys, models.AWSIAMAccessKey{
+			AccessKeyID: aws.ToString(k.AccessKeyId),
+			Status:      string(k.Status),
+			CreatedAt:   aws.ToTime(k.CreateDate),
+		})
+	}
+	return keys
+}
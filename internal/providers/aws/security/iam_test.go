@@ -0,0 +1,81 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamsvc "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// fakeIAMClient lists the keys of accessKeys as users and serves each user's
+// access keys from it. Users named in accessKeysErr fail ListAccessKeys.
+type fakeIAMClient struct {
+	accessKeys    map[string][]iamtypes.AccessKeyMetadata
+	accessKeysErr map[string]bool
+}
+
+func (f *fakeIAMClient) ListUsers(_ context.Context, _ *iamsvc.ListUsersInput, _ ...func(*iamsvc.Options)) (*iamsvc.ListUsersOutput, error) {
+	out := &iamsvc.ListUsersOutput{}
+	for name := range f.accessKeys {
+		out.Users = append(out.Users, iamtypes.User{UserName: aws.String(name)})
+	}
+	return out, nil
+}
+
+func (f *fakeIAMClient) ListMFADevices(_ context.Context, _ *iamsvc.ListMFADevicesInput, _ ...func(*iamsvc.Options)) (*iamsvc.ListMFADevicesOutput, error) {
+	return &iamsvc.ListMFADevicesOutput{}, nil
+}
+
+func (f *fakeIAMClient) GetLoginProfile(_ context.Context, _ *iamsvc.GetLoginProfileInput, _ ...func(*iamsvc.Options)) (*iamsvc.GetLoginProfileOutput, error) {
+	return nil, errors.New("NoSuchEntity")
+}
+
+func (f *fakeIAMClient) ListAccessKeys(_ context.Context, in *iamsvc.ListAccessKeysInput, _ ...func(*iamsvc.Options)) (*iamsvc.ListAccessKeysOutput, error) {
+	name := aws.ToString(in.UserName)
+	if f.accessKeysErr[name] {
+		return nil, errors.New("AccessDenied")
+	}
+	return &iamsvc.ListAccessKeysOutput{AccessKeyMetadata: f.accessKeys[name]}, nil
+}
+
+func (f *fakeIAMClient) GetAccountSummary(_ context.Context, _ *iamsvc.GetAccountSummaryInput, _ ...func(*iamsvc.Options)) (*iamsvc.GetAccountSummaryOutput, error) {
+	return &iamsvc.GetAccountSummaryOutput{}, nil
+}
+
+func TestCollectIAMUsers_AccessKeys(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := &fakeIAMClient{
+		accessKeys: map[string][]iamtypes.AccessKeyMetadata{
+			"deployer": {{
+				AccessKeyId: aws.String("AKIA1"),
+				Status:      iamtypes.StatusTypeActive,
+				CreateDate:  aws.Time(created),
+			}},
+			"denied": nil,
+		},
+		accessKeysErr: map[string]bool{"denied": true},
+	}
+
+	users, err := collectIAMUsers(context.Background(), client)
+	if err != nil {
+		t.Fatalf("collectIAMUsers error: %v", err)
+	}
+	byName := make(map[string]int, len(users))
+	for i, u := range users {
+		byName[u.UserName] = i
+	}
+	keys := users[byName["deployer"]].AccessKeys
+	if len(keys) != 1 {
+		t.Fatalf("deployer access keys = %v; want 1 key", keys)
+	}
+	if keys[0].AccessKeyID != "AKIA1" || keys[0].Status != "Active" || !keys[0].CreatedAt.Equal(created) {
+		t.Errorf("deployer access key = %+v", keys[0])
+	}
+	if got := users[byName["denied"]].AccessKeys; len(got) != 0 {
+		t.Errorf("denied access keys = %v; want none when ListAccessKeys fails", got)
+	}
+}
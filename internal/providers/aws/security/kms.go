@@ -0,0 +1,57 @@
+package awssecurity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	kmssvc "github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// collectKMSKeys lists every KMS key in the region and describes it.
+// GetKeyRotationStatus is only called for customer-managed symmetric keys
+// with KMS-generated key material; AWS-managed keys always rotate and
+// asymmetric, HMAC, and imported keys do not support automatic rotation.
+//
+// Returns an error only when ListKeys fails; keys that cannot be described
+// are skipped and a failed rotation lookup leaves RotationStatusKnown false.
+func collectKMSKeys(ctx context.Context, client kmsAPIClient, region string) ([]models.AWSKMSKey, error) {
+	var keys []models.AWSKMSKey
+
+	paginator := kmssvc.NewListKeysPaginator(client, &kmssvc.ListKeysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range page.Keys {
+			descOut, err := client.DescribeKey(ctx, &kmssvc.DescribeKeyInput{KeyId: entry.KeyId})
+			if err != nil || descOut.KeyMetadata == nil {
+				continue
+			}
+			md := descOut.KeyMetadata
+			key := models.AWSKMSKey{
+				KeyID:      aws.ToString(md.KeyId),
+				ARN:        aws.ToString(md.Arn),
+				Region:     region,
+				KeyManager: string(md.KeyManager),
+				KeySpec:    string(md.KeySpec),
+				Origin:     string(md.Origin),
+				KeyState:   string(md.KeyState),
+			}
+			if md.KeyManager == kmstypes.KeyManagerTypeCustomer &&
+				md.KeySpec == kmstypes.KeySpecSymmetricDefault &&
+				md.Origin == kmstypes.OriginTypeAwsKms {
+				rotOut, err := client.GetKeyRotationStatus(ctx, &kmssvc.GetKeyRotationStatusInput{KeyId: md.KeyId})
+				if err == nil {
+					key.RotationEnabled = rotOut.KeyRotationEnabled
+					key.RotationStatusKnown = true
+				}
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,116 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	kmssvc "github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// fakeKMSClient serves key metadata from keys and rotation status from
+// rotation. rotationCalls records the key IDs GetKeyRotationStatus was
+// called for.
+type fakeKMSClient struct {
+	keys          []kmstypes.KeyMetadata
+	rotation      map[string]bool
+	listErr       error
+	rotationErr   error
+	rotationCalls []string
+}
+
+func (f *fakeKMSClient) ListKeys(_ context.Context, _ *kmssvc.ListKeysInput, _ ...func(*kmssvc.Options)) (*kmssvc.ListKeysOutput, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	out := &kmssvc.ListKeysOutput{}
+	for _, k := range f.keys {
+		out.Keys = append(out.Keys, kmstypes.KeyListEntry{KeyId: k.KeyId})
+	}
+	return out, nil
+}
+
+func (f *fakeKMSClient) DescribeKey(_ context.Context, in *kmssvc.DescribeKeyInput, _ ...func(*kmssvc.Options)) (*kmssvc.DescribeKeyOutput, error) {
+	for i := range f.keys {
+		if aws.ToString(f.keys[i].KeyId) == aws.ToString(in.KeyId) {
+			return &kmssvc.DescribeKeyOutput{KeyMetadata: &f.keys[i]}, nil
+		}
+	}
+	return nil, errors.New("NotFoundException")
+}
+
+func (f *fakeKMSClient) GetKeyRotationStatus(_ context.Context, in *kmssvc.GetKeyRotationStatusInput, _ ...func(*kmssvc.Options)) (*kmssvc.GetKeyRotationStatusOutput, error) {
+	id := aws.ToString(in.KeyId)
+	f.rotationCalls = append(f.rotationCalls, id)
+	if f.rotationErr != nil {
+		return nil, f.rotationErr
+	}
+	return &kmssvc.GetKeyRotationStatusOutput{KeyRotationEnabled: f.rotation[id]}, nil
+}
+
+func kmsKey(id string, manager kmstypes.KeyManagerType, spec kmstypes.KeySpec) kmstypes.KeyMetadata {
+	return kmstypes.KeyMetadata{
+		KeyId:      aws.String(id),
+		Arn:        aws.String("arn:aws:kms:us-east-1:111122223333:key/" + id),
+		KeyManager: manager,
+		KeySpec:    spec,
+		Origin:     kmstypes.OriginTypeAwsKms,
+		KeyState:   kmstypes.KeyStateEnabled,
+	}
+}
+
+func TestCollectKMSKeys_RotationOnlyQueriedForCustomerSymmetricKeys(t *testing.T) {
+	client := &fakeKMSClient{
+		keys: []kmstypes.KeyMetadata{
+			kmsKey("cmk", kmstypes.KeyManagerTypeCustomer, kmstypes.KeySpecSymmetricDefault),
+			kmsKey("aws", kmstypes.KeyManagerTypeAws, kmstypes.KeySpecSymmetricDefault),
+			kmsKey("rsa", kmstypes.KeyManagerTypeCustomer, kmstypes.KeySpecRsa2048),
+		},
+		rotation: map[string]bool{"cmk": true},
+	}
+
+	keys, err := collectKMSKeys(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 keys; got %d", len(keys))
+	}
+	if len(client.rotationCalls) != 1 || client.rotationCalls[0] != "cmk" {
+		t.Errorf("GetKeyRotationStatus calls = %v; want [cmk]", client.rotationCalls)
+	}
+	got := keys[0]
+	if !got.RotationEnabled || !got.RotationStatusKnown || got.Region != "us-east-1" || got.KeyManager != "CUSTOMER" || got.ARN == "" {
+		t.Errorf("cmk = %+v; want rotating customer key in us-east-1 with ARN", got)
+	}
+	if keys[2].KeySpec != "RSA_2048" || keys[2].RotationEnabled {
+		t.Errorf("rsa = %+v; want RSA_2048 without rotation", keys[2])
+	}
+}
+
+func TestCollectKMSKeys_RotationErrorLeavesStatusUnknown(t *testing.T) {
+	client := &fakeKMSClient{
+		keys:        []kmstypes.KeyMetadata{kmsKey("cmk", kmstypes.KeyManagerTypeCustomer, kmstypes.KeySpecSymmetricDefault)},
+		rotationErr: errors.New("AccessDeniedException"),
+	}
+
+	keys, err := collectKMSKeys(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("expected the key to be kept; got %d keys", len(keys))
+	}
+	if keys[0].RotationStatusKnown || keys[0].RotationEnabled {
+		t.Errorf("cmk = %+v; want rotation status unknown", keys[0])
+	}
+}
+
+func TestCollectKMSKeys_ListErrorReturned(t *testing.T) {
+	client := &fakeKMSClient{listErr: errors.New("AccessDeniedException")}
+	if _, err := collectKMSKeys(context.Background(), client, "us-east-1"); err == nil {
+		t.Error("expected an error so CollectAll skips the region")
+	}
+}
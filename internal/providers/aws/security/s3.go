@@ -2,17 +2,21 @@ package awssecurity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	s3svc "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
 // collectS3Buckets lists all S3 buckets in the account and checks each
-// bucket's public-access status (GetBucketPolicyStatus) and whether default
-// server-side encryption is configured (GetBucketEncryption).
+// bucket's public-access status (GetBucketPolicyStatus), whether default
+// server-side encryption is configured (GetBucketEncryption), its
+// versioning status (GetBucketVersioning), and its public access block
+// settings (GetPublicAccessBlock).
 func collectS3Buckets(ctx context.Context, client s3APIClient) ([]models.AWSS3Bucket, error) {
 	out, err := client.ListBuckets(ctx, &s3svc.ListBucketsInput{})
 	if err != nil {
@@ -26,6 +30,8 @@ func collectS3Buckets(ctx context.Context, client s3APIClient) ([]models.AWSS3Bu
 			Name:                     name,
 			Public:                   isBucketPublic(ctx, client, name),
 			DefaultEncryptionEnabled: isBucketEncryptionEnabled(ctx, client, name),
+			VersioningStatus:         bucketVersioningStatus(ctx, client, name),
+			PublicAccessBlock:        bucketPublicAccessBlock(ctx, client, name),
 		})
 	}
 	return buckets, nil
@@ -60,3 +66,45 @@ func isBucketEncryptionEnabled(ctx context.Context, client s3APIClient, name str
 	})
 	return err == nil
 }
+
+// bucketVersioningStatus returns the bucket's versioning status as reported by
+// GetBucketVersioning: "Enabled", "Suspended", or "Disabled" when versioning
+// has never been turned on (the API returns no Status). Errors return "" so
+// the bucket is not flagged on a failed lookup.
+func bucketVersioningStatus(ctx context.Context, client s3APIClient, name string) string {
+	out, err := client.GetBucketVersioning(ctx, &s3svc.GetBucketVersioningInput{
+		Bucket: aws.String(name),
+	})
+	if err != nil {
+		return ""
+	}
+	if out.Status == "" {
+		return "Disabled"
+	}
+	return string(out.Status)
+}
+
+// bucketPublicAccessBlock returns the bucket's public access block settings.
+// A NoSuchPublicAccessBlockConfiguration error means the bucket has no
+// configuration and returns a value with every flag false. Any other error
+// returns nil so the bucket is not flagged on a failed lookup.
+func bucketPublicAccessBlock(ctx context.Context, client s3APIClient, name string) *models.AWSS3PublicAccessBlock {
+	out, err := client.GetPublicAccessBlock(ctx, &s3svc.GetPublicAccessBlockInput{
+		Bucket: aws.String(name),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchPublicAccessBlockConfiguration" {
+			return &models.AWSS3PublicAccessBlock{}
+		}
+		return nil
+	}
+	pab := &models.AWSS3PublicAccessBlock{}
+	if c := out.PublicAccessBlockConfiguration; c != nil {
+		pab.BlockPublicAcls = aws.ToBool(c.BlockPublicAcls)
+		pab.IgnorePublicAcls = aws.ToBool(c.IgnorePublicAcls)
+		pab.BlockPublicPolicy = aws.ToBool(c.BlockPublicPolicy)
+		pab.RestrictPublicBuckets = aws.ToBool(c.RestrictPublicBuckets)
+	}
+	return pab
+}
@@ -0,0 +1,133 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3svc "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// fakeS3Client lists the keys of versioning as buckets and serves each
+// bucket's versioning status from it. Buckets named in versioningErr fail
+// GetBucketVersioning. GetPublicAccessBlock serves pab; buckets without an
+// entry return NoSuchPublicAccessBlockConfiguration and buckets named in
+// pabErr fail with AccessDenied.
+type fakeS3Client struct {
+	versioning    map[string]s3types.BucketVersioningStatus
+	versioningErr map[string]bool
+	pab           map[string]*s3types.PublicAccessBlockConfiguration
+	pabErr        map[string]bool
+}
+
+func (f *fakeS3Client) ListBuckets(_ context.Context, _ *s3svc.ListBucketsInput, _ ...func(*s3svc.Options)) (*s3svc.ListBucketsOutput, error) {
+	out := &s3svc.ListBucketsOutput{}
+	for name := range f.versioning {
+		out.Buckets = append(out.Buckets, s3types.Bucket{Name: aws.String(name)})
+	}
+	return out, nil
+}
+
+func (f *fakeS3Client) GetBucketPolicyStatus(_ context.Context, _ *s3svc.GetBucketPolicyStatusInput, _ ...func(*s3svc.Options)) (*s3svc.GetBucketPolicyStatusOutput, error) {
+	return nil, errors.New("NoSuchBucketPolicy")
+}
+
+func (f *fakeS3Client) GetBucketEncryption(_ context.Context, _ *s3svc.GetBucketEncryptionInput, _ ...func(*s3svc.Options)) (*s3svc.GetBucketEncryptionOutput, error) {
+	return &s3svc.GetBucketEncryptionOutput{}, nil
+}
+
+func (f *fakeS3Client) GetBucketVersioning(_ context.Context, in *s3svc.GetBucketVersioningInput, _ ...func(*s3svc.Options)) (*s3svc.GetBucketVersioningOutput, error) {
+	name := aws.ToString(in.Bucket)
+	if f.versioningErr[name] {
+		return nil, errors.New("AccessDenied")
+	}
+	return &s3svc.GetBucketVersioningOutput{Status: f.versioning[name]}, nil
+}
+
+func (f *fakeS3Client) GetPublicAccessBlock(_ context.Context, in *s3svc.GetPublicAccessBlockInput, _ ...func(*s3svc.Options)) (*s3svc.GetPublicAccessBlockOutput, error) {
+	name := aws.ToString(in.Bucket)
+	if f.pabErr[name] {
+		return nil, &smithy.GenericAPIError{Code: "AccessDenied"}
+	}
+	cfg, ok := f.pab[name]
+	if !ok {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchPublicAccessBlockConfiguration"}
+	}
+	return &s3svc.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: cfg}, nil
+}
+
+func TestCollectS3Buckets_VersioningStatus(t *testing.T) {
+	client := &fakeS3Client{
+		versioning: map[string]s3types.BucketVersioningStatus{
+			"enabled":   s3types.BucketVersioningStatusEnabled,
+			"suspended": s3types.BucketVersioningStatusSuspended,
+			"never":     "",
+			"denied":    "",
+		},
+		versioningErr: map[string]bool{"denied": true},
+	}
+
+	buckets, err := collectS3Buckets(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make(map[string]string, len(buckets))
+	for _, b := range buckets {
+		got[b.Name] = b.VersioningStatus
+	}
+	want := map[string]string{
+		"enabled":   "Enabled",
+		"suspended": "Suspended",
+		"never":     "Disabled",
+		"denied":    "",
+	}
+	for name, status := range want {
+		if got[name] != status {
+			t.Errorf("bucket %q: VersioningStatus = %q; want %q", name, got[name], status)
+		}
+	}
+}
+
+func TestCollectS3Buckets_PublicAccessBlock(t *testing.T) {
+	client := &fakeS3Client{
+		versioning: map[string]s3types.BucketVersioningStatus{
+			"full": "", "partial": "", "none": "", "denied": "",
+		},
+		pab: map[string]*s3types.PublicAccessBlockConfiguration{
+			"full": {
+				BlockPublicAcls:       aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+			"partial": {BlockPublicAcls: aws.Bool(true)},
+		},
+		pabErr: map[string]bool{"denied": true},
+	}
+
+	buckets, err := collectS3Buckets(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make(map[string]*models.AWSS3PublicAccessBlock, len(buckets))
+	for _, b := range buckets {
+		got[b.Name] = b.PublicAccessBlock
+	}
+	want := map[string]*models.AWSS3PublicAccessBlock{
+		"full":    {BlockPublicAcls: true, IgnorePublicAcls: true, BlockPublicPolicy: true, RestrictPublicBuckets: true},
+		"partial": {BlockPublicAcls: true},
+		"none":    {},
+		"denied":  nil,
+	}
+	for name, pab := range want {
+		if !reflect.DeepEqual(got[name], pab) {
+			t.Errorf("bucket %q: PublicAccessBlock = %+v; want %+v", name, got[name], pab)
+		}
+	}
+}
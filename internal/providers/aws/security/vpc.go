@@ -0,0 +1,55 @@
+package awssecurity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// flowLogStatusActive is the DescribeFlowLogs FlowLogStatus of a working flow log.
+const flowLogStatusActive = "ACTIVE"
+
+// collectVPCFlowLogs lists every VPC in the region and marks the ones with an
+// ACTIVE VPC-level flow log. Flow logs attached to subnets or network
+// interfaces are ignored because they do not cover the whole VPC.
+//
+// Returns an error when either DescribeVpcs or DescribeFlowLogs fails, so the
+// caller never reports VPCs whose flow log status is unknown.
+func collectVPCFlowLogs(ctx context.Context, client ec2SecurityAPIClient, region string) ([]models.AWSVPC, error) {
+	logged := make(map[string]bool)
+	flowLogs := ec2svc.NewDescribeFlowLogsPaginator(client, &ec2svc.DescribeFlowLogsInput{})
+	for flowLogs.HasMorePages() {
+		page, err := flowLogs.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe flow logs in %s: %w", region, err)
+		}
+		for _, fl := range page.FlowLogs {
+			if aws.ToString(fl.FlowLogStatus) == flowLogStatusActive {
+				logged[aws.ToString(fl.ResourceId)] = true
+			}
+		}
+	}
+
+	var vpcs []models.AWSVPC
+	paginator := ec2svc.NewDescribeVpcsPaginator(client, &ec2svc.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe vpcs in %s: %w", region, err)
+		}
+		for _, v := range page.Vpcs {
+			id := aws.ToString(v.VpcId)
+			vpcs = append(vpcs, models.AWSVPC{
+				VPCID:           id,
+				Region:          region,
+				IsDefault:       aws.ToBool(v.IsDefault),
+				FlowLogsEnabled: logged[id],
+			})
+		}
+	}
+	return vpcs, nil
+}
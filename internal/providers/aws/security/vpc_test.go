@@ -0,0 +1,84 @@
+package awssecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2svc "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// fakeVPCEC2Client serves VPCs and flow logs for collectVPCFlowLogs. The
+// security group and instance calls are unused and return empty output.
+type fakeVPCEC2Client struct {
+	vpcs        []ec2types.Vpc
+	flowLogs    []ec2types.FlowLog
+	flowLogsErr error
+}
+
+func (f *fakeVPCEC2Client) DescribeSecurityGroups(_ context.Context, _ *ec2svc.DescribeSecurityGroupsInput, _ ...func(*ec2svc.Options)) (*ec2svc.DescribeSecurityGroupsOutput, error) {
+	return &ec2svc.DescribeSecurityGroupsOutput{}, nil
+}
+
+func (f *fakeVPCEC2Client) DescribeInstances(_ context.Context, _ *ec2svc.DescribeInstancesInput, _ ...func(*ec2svc.Options)) (*ec2svc.DescribeInstancesOutput, error) {
+	return &ec2svc.DescribeInstancesOutput{}, nil
+}
+
+func (f *fakeVPCEC2Client) DescribeVpcs(_ context.Context, _ *ec2svc.DescribeVpcsInput, _ ...func(*ec2svc.Options)) (*ec2svc.DescribeVpcsOutput, error) {
+	return &ec2svc.DescribeVpcsOutput{Vpcs: f.vpcs}, nil
+}
+
+func (f *fakeVPCEC2Client) DescribeFlowLogs(_ context.Context, _ *ec2svc.DescribeFlowLogsInput, _ ...func(*ec2svc.Options)) (*ec2svc.DescribeFlowLogsOutput, error) {
+	if f.flowLogsErr != nil {
+		return nil, f.flowLogsErr
+	}
+	return &ec2svc.DescribeFlowLogsOutput{FlowLogs: f.flowLogs}, nil
+}
+
+func TestCollectVPCFlowLogs(t *testing.T) {
+	client := &fakeVPCEC2Client{
+		vpcs: []ec2types.Vpc{
+			{VpcId: aws.String("vpc-logged")},
+			{VpcId: aws.String("vpc-subnet-only")},
+			{VpcId: aws.String("vpc-default"), IsDefault: aws.Bool(true)},
+			{VpcId: aws.String("vpc-inactive")},
+		},
+		flowLogs: []ec2types.FlowLog{
+			{ResourceId: aws.String("vpc-logged"), FlowLogStatus: aws.String("ACTIVE")},
+			{ResourceId: aws.String("subnet-1"), FlowLogStatus: aws.String("ACTIVE")},
+			{ResourceId: aws.String("vpc-inactive"), FlowLogStatus: aws.String("INACTIVE")},
+		},
+	}
+	vpcs, err := collectVPCFlowLogs(context.Background(), client, "us-east-1")
+	if err != nil {
+		t.Fatalf("collectVPCFlowLogs: %v", err)
+	}
+	if len(vpcs) != 4 {
+		t.Fatalf("want 4 VPCs, got %d", len(vpcs))
+	}
+	want := map[string]bool{"vpc-logged": true, "vpc-subnet-only": false, "vpc-default": false, "vpc-inactive": false}
+	for _, v := range vpcs {
+		if v.Region != "us-east-1" {
+			t.Errorf("%s: Region = %q; want us-east-1", v.VPCID, v.Region)
+		}
+		if v.FlowLogsEnabled != want[v.VPCID] {
+			t.Errorf("%s: FlowLogsEnabled = %v; want %v", v.VPCID, v.FlowLogsEnabled, want[v.VPCID])
+		}
+		if v.IsDefault != (v.VPCID == "vpc-default") {
+			t.Errorf("%s: IsDefault = %v", v.VPCID, v.IsDefault)
+		}
+	}
+}
+
+func TestCollectVPCFlowLogs_FlowLogErrorDropsRegion(t *testing.T) {
+	client := &fakeVPCEC2Client{
+		vpcs:        []ec2types.Vpc{{VpcId: aws.String("vpc-1")}},
+		flowLogsErr: errors.New("UnauthorizedOperation"),
+	}
+	vpcs, err := collectVPCFlowLogs(context.Background(), client, "us-east-1")
+	if err == nil || vpcs != nil {
+		t.Errorf("got %v, %v; want nil VPCs and an error", vpcs, err)
+	}
+}
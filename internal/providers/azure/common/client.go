@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+// SubscriptionConfig is a resolved Azure subscription with the credential
+// used to authenticate against it. It is the Azure analog of the AWS
+// ProfileConfig and is passed between provider functions and into the engine.
+type SubscriptionConfig struct {
+	// SubscriptionID is the Azure subscription being audited.
+	SubscriptionID string
+
+	// Credential authenticates every ARM client created for this subscription.
+	Credential azcore.TokenCredential
+}
+
+// AzureClientProvider loads Azure credentials and resolves the subscription to
+// audit. It is the sole entry point for Azure credential management across the
+// Azure provider layer.
+//
+// Implementations must use the Azure SDK for Go only. Never call the az CLI.
+type AzureClientProvider interface {
+	// LoadSubscription returns a SubscriptionConfig for subscriptionID.
+	// Pass an empty string to use the AZURE_SUBSCRIPTION_ID environment variable.
+	LoadSubscription(ctx context.Context, subscriptionID string) (*SubscriptionConfig, error)
+}
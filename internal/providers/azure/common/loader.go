@@ -0,0 +1,63 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+// subscriptionEnvVar names the environment variable consulted when no
+// subscription ID is passed to LoadSubscription.
+const subscriptionEnvVar = "AZURE_SUBSCRIPTION_ID"
+
+// CredentialFactory creates the credential used for every ARM client.
+type CredentialFactory func() (azcore.TokenCredential, error)
+
+// DefaultAzureClientProvider is the production implementation of
+// AzureClientProvider. It authenticates with azidentity's
+// DefaultAzureCredential chain (environment, workload identity, managed
+// identity, then the Azure CLI token cache).
+//
+// Inject a custom CredentialFactory via NewDefaultAzureClientProviderWithFactory
+// to replace the real credential in unit tests.
+type DefaultAzureClientProvider struct {
+	factory CredentialFactory
+}
+
+// NewDefaultAzureClientProvider returns a provider backed by
+// DefaultAzureCredential.
+func NewDefaultAzureClientProvider() *DefaultAzureClientProvider {
+	return &DefaultAzureClientProvider{factory: newDefaultCredential}
+}
+
+// NewDefaultAzureClientProviderWithFactory returns a provider that uses f to
+// create its credential. Pass a stub factory in tests.
+func NewDefaultAzureClientProviderWithFactory(f CredentialFactory) *DefaultAzureClientProvider {
+	return &DefaultAzureClientProvider{factory: f}
+}
+
+// LoadSubscription resolves subscriptionID (falling back to
+// AZURE_SUBSCRIPTION_ID) and builds its credential. Credentials are acquired
+// lazily, so an invalid login surfaces on the first ARM call rather than here.
+func (p *DefaultAzureClientProvider) LoadSubscription(_ context.Context, subscriptionID string) (*SubscriptionConfig, error) {
+	if subscriptionID == "" {
+		subscriptionID = os.Getenv(subscriptionEnvVar)
+	}
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("no Azure subscription: pass --subscription or set %s", subscriptionEnvVar)
+	}
+
+	cred, err := p.factory()
+	if err != nil {
+		return nil, fmt.Errorf("load Azure credential: %w", err)
+	}
+	return &SubscriptionConfig{SubscriptionID: subscriptionID, Credential: cred}, nil
+}
+
+// newDefaultCredential is the production CredentialFactory.
+func newDefaultCredential() (azcore.TokenCredential, error) {
+	return azidentity.NewDefaultAzureCredential(nil)
+}
@@ -0,0 +1,77 @@
+package cost
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+)
+
+// ---------------------------------------------------------------------------
+// Narrow client interfaces
+//
+// Each interface lists only the SDK operations used by this package.
+// The real *armcompute.VirtualMachinesClient etc. satisfy these automatically.
+// Fakes build pagers with runtime.NewPager and canned pages.
+// ---------------------------------------------------------------------------
+
+// costVMClient covers the virtual machine operations required for cost
+// collection.
+type costVMClient interface {
+	NewListAllPager(
+		options *armcompute.VirtualMachinesClientListAllOptions,
+	) *runtime.Pager[armcompute.VirtualMachinesClientListAllResponse]
+}
+
+// costDiskClient covers the managed disk operations required for cost
+// collection.
+type costDiskClient interface {
+	NewListPager(
+		options *armcompute.DisksClientListOptions,
+	) *runtime.Pager[armcompute.DisksClientListResponse]
+}
+
+// costMetricsClient covers the Azure Monitor operations required for VM CPU
+// metrics.
+type costMetricsClient interface {
+	List(
+		ctx context.Context,
+		resourceURI string,
+		options *armmonitor.MetricsClientListOptions,
+	) (armmonitor.MetricsClientListResponse, error)
+}
+
+// ---------------------------------------------------------------------------
+// costClients and factory
+// ---------------------------------------------------------------------------
+
+// costClients holds all service clients needed for one collection run.
+// All fields are interfaces — swap any with a fake in tests.
+type costClients struct {
+	VMs     costVMClient
+	Disks   costDiskClient
+	Metrics costMetricsClient
+}
+
+// costClientFactory creates a costClients for a subscription.
+type costClientFactory func(sub *common.SubscriptionConfig) (*costClients, error)
+
+// newDefaultCostClients is the production costClientFactory.
+func newDefaultCostClients(sub *common.SubscriptionConfig) (*costClients, error) {
+	vms, err := armcompute.NewVirtualMachinesClient(sub.SubscriptionID, sub.Credential, nil)
+	if err != nil {
+		return nil, err
+	}
+	disks, err := armcompute.NewDisksClient(sub.SubscriptionID, sub.Credential, nil)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := armmonitor.NewMetricsClient(sub.SubscriptionID, sub.Credential, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &costClients{VMs: vms, Disks: disks, Metrics: metrics}, nil
+}
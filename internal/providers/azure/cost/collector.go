@@ -0,0 +1,25 @@
+package cost
+
+import (
+	"context"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+)
+
+// CostCollector gathers raw cost-related resource data from an Azure
+// subscription and converts it into internal models. It must not apply
+// business rules or call the LLM.
+//
+// All implementations must use the Azure SDK for Go only.
+type CostCollector interface {
+	// CollectAll lists every VM and managed disk in the subscription, across
+	// all locations, and attaches each running VM's average CPU over the last
+	// daysBack days (30 when zero). Per-VM metric failures are non-fatal and
+	// leave AvgCPUPercent at zero; listing failures are returned.
+	CollectAll(
+		ctx context.Context,
+		sub *common.SubscriptionConfig,
+		daysBack int,
+	) (*models.AzureSubscriptionData, error)
+}
@@ -0,0 +1,236 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+)
+
+// powerStatePrefix prefixes the VM instance view status that carries the
+// power state, e.g. "PowerState/running".
+const powerStatePrefix = "PowerState/"
+
+// DefaultCostCollector is the production implementation of CostCollector.
+// It uses the Azure SDK for Go to list VMs and managed disks subscription-wide.
+//
+// Inject a custom costClientFactory via NewDefaultCostCollectorWithFactory
+// to replace real SDK clients with fakes in unit tests.
+type DefaultCostCollector struct {
+	factory costClientFactory
+}
+
+// NewDefaultCostCollector returns a collector backed by the real Azure SDK.
+func NewDefaultCostCollector() *DefaultCostCollector {
+	return &DefaultCostCollector{factory: newDefaultCostClients}
+}
+
+// NewDefaultCostCollectorWithFactory returns a collector that uses f to
+// create its service clients. Pass a fake factory in tests.
+func NewDefaultCostCollectorWithFactory(f costClientFactory) *DefaultCostCollector {
+	return &DefaultCostCollector{factory: f}
+}
+
+// CollectAll implements CostCollector.
+func (d *DefaultCostCollector) CollectAll(
+	ctx context.Context,
+	sub *common.SubscriptionConfig,
+	daysBack int,
+) (*models.AzureSubscriptionData, error) {
+	if daysBack <= 0 {
+		daysBack = 30
+	}
+	clients, err := d.factory(sub)
+	if err != nil {
+		return nil, fmt.Errorf("create Azure clients: %w", err)
+	}
+
+	vms, err := listVMs(ctx, clients.VMs)
+	if err != nil {
+		return nil, fmt.Errorf("list virtual machines: %w", err)
+	}
+	disks, err := listDisks(ctx, clients.Disks)
+	if err != nil {
+		return nil, fmt.Errorf("list managed disks: %w", err)
+	}
+
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -daysBack)
+	for i := range vms {
+		if vms[i].PowerState != "running" {
+			continue
+		}
+		// Non-fatal: VMs without metric data retain AvgCPUPercent == 0,
+		// which rules treat as "unknown".
+		if avg, err := averageCPU(ctx, clients.Metrics, vms[i].ID, start, end); err == nil {
+			vms[i].AvgCPUPercent = avg
+		}
+	}
+
+	return &models.AzureSubscriptionData{
+		SubscriptionID: sub.SubscriptionID,
+		VMs:            vms,
+		Disks:          disks,
+	}, nil
+}
+
+// listVMs pages through every VM in the subscription. statusOnly=true makes
+// ARM include each VM's instance view, which carries its power state.
+func listVMs(ctx context.Context, client costVMClient) ([]models.AzureVM, error) {
+	var vms []models.AzureVM
+	pager := client.NewListAllPager(&armcompute.VirtualMachinesClientListAllOptions{StatusOnly: to.Ptr("true")})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range page.Value {
+			if vm == nil {
+				continue
+			}
+			out := models.AzureVM{
+				ID:            deref(vm.ID),
+				Name:          deref(vm.Name),
+				ResourceGroup: resourceGroupOf(deref(vm.ID)),
+				Location:      deref(vm.Location),
+				Tags:          convertTags(vm.Tags),
+			}
+			if p := vm.Properties; p != nil {
+				if p.HardwareProfile != nil && p.HardwareProfile.VMSize != nil {
+					out.VMSize = string(*p.HardwareProfile.VMSize)
+				}
+				if p.InstanceView != nil {
+					out.PowerState = powerState(p.InstanceView.Statuses)
+				}
+			}
+			vms = append(vms, out)
+		}
+	}
+	return vms, nil
+}
+
+// listDisks pages through every managed disk in the subscription.
+func listDisks(ctx context.Context, client costDiskClient) ([]models.AzureDisk, error) {
+	var disks []models.AzureDisk
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range page.Value {
+			if d == nil {
+				continue
+			}
+			out := models.AzureDisk{
+				ID:            deref(d.ID),
+				Name:          deref(d.Name),
+				ResourceGroup: resourceGroupOf(deref(d.ID)),
+				Location:      deref(d.Location),
+				ManagedBy:     deref(d.ManagedBy),
+				Tags:          convertTags(d.Tags),
+			}
+			if d.SKU != nil && d.SKU.Name != nil {
+				out.SKU = string(*d.SKU.Name)
+			}
+			if p := d.Properties; p != nil {
+				if p.DiskSizeGB != nil {
+					out.SizeGB = *p.DiskSizeGB
+				}
+				if p.DiskState != nil {
+					out.DiskState = string(*p.DiskState)
+				}
+			}
+			disks = append(disks, out)
+		}
+	}
+	return disks, nil
+}
+
+// averageCPU returns the mean of the daily "Percentage CPU" averages for the
+// VM with ARM ID resourceID between start and end.
+func averageCPU(ctx context.Context, client costMetricsClient, resourceID string, start, end time.Time) (float64, error) {
+	resp, err := client.List(ctx, resourceID, &armmonitor.MetricsClientListOptions{
+		Metricnames: to.Ptr("Percentage CPU"),
+		Aggregation: to.Ptr("Average"),
+		Interval:    to.Ptr("P1D"),
+		Timespan:    to.Ptr(start.Format(time.RFC3339) + "/" + end.Format(time.RFC3339)),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var sum float64
+	var n int
+	for _, m := range resp.Value {
+		if m == nil {
+			continue
+		}
+		for _, ts := range m.Timeseries {
+			if ts == nil {
+				continue
+			}
+			for _, v := range ts.Data {
+				if v != nil && v.Average != nil {
+					sum += *v.Average
+					n++
+				}
+			}
+		}
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return sum / float64(n), nil
+}
+
+// powerState returns the code suffix of the "PowerState/..." status, or ""
+// when the instance view carries none.
+func powerState(statuses []*armcompute.InstanceViewStatus) string {
+	for _, s := range statuses {
+		if s == nil || s.Code == nil {
+			continue
+		}
+		if state, ok := strings.CutPrefix(*s.Code, powerStatePrefix); ok {
+			return state
+		}
+	}
+	return ""
+}
+
+// resourceGroupOf extracts the resource group name from an ARM resource ID.
+// It returns "" when id cannot be parsed.
+func resourceGroupOf(id string) string {
+	rid, err := arm.ParseResourceID(id)
+	if err != nil {
+		return ""
+	}
+	return rid.ResourceGroupName
+}
+
+// convertTags flattens the SDK's map[string]*string tag representation.
+func convertTags(tags map[string]*string) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		out[k] = deref(v)
+	}
+	return out
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,144 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/providers/azure/common"
+)
+
+// singlePage returns a pager that yields resp once.
+func singlePage[T any](resp T) *runtime.Pager[T] {
+	return runtime.NewPager(runtime.PagingHandler[T]{
+		More:    func(T) bool { return false },
+		Fetcher: func(context.Context, *T) (T, error) { return resp, nil },
+	})
+}
+
+type fakeVMClient struct{ vms []*armcompute.VirtualMachine }
+
+func (f *fakeVMClient) NewListAllPager(_ *armcompute.VirtualMachinesClientListAllOptions) *runtime.Pager[armcompute.VirtualMachinesClientListAllResponse] {
+	return singlePage(armcompute.VirtualMachinesClientListAllResponse{
+		VirtualMachineListResult: armcompute.VirtualMachineListResult{Value: f.vms},
+	})
+}
+
+type fakeDiskClient struct{ disks []*armcompute.Disk }
+
+func (f *fakeDiskClient) NewListPager(_ *armcompute.DisksClientListOptions) *runtime.Pager[armcompute.DisksClientListResponse] {
+	return singlePage(armcompute.DisksClientListResponse{DiskList: armcompute.DiskList{Value: f.disks}})
+}
+
+// fakeMetricsClient returns the given daily averages for every resource, or
+// err when set, and records the resources queried.
+type fakeMetricsClient struct {
+	averages []float64
+	err      error
+	queried  []string
+}
+
+func (f *fakeMetricsClient) List(_ context.Context, resourceURI string, _ *armmonitor.MetricsClientListOptions) (armmonitor.MetricsClientListResponse, error) {
+	f.queried = append(f.queried, resourceURI)
+	if f.err != nil {
+		return armmonitor.MetricsClientListResponse{}, f.err
+	}
+	var data []*armmonitor.MetricValue
+	for _, avg := range f.averages {
+		data = append(data, &armmonitor.MetricValue{Average: to.Ptr(avg)})
+	}
+	return armmonitor.MetricsClientListResponse{Response: armmonitor.Response{
+		Value: []*armmonitor.Metric{{Timeseries: []*armmonitor.TimeSeriesElement{{Data: data}}}},
+	}}, nil
+}
+
+func vmWithState(name, state string) *armcompute.VirtualMachine {
+	return &armcompute.VirtualMachine{
+		ID:       to.Ptr("/subscriptions/sub-1/resourceGroups/rg-app/providers/Microsoft.Compute/virtualMachines/" + name),
+		Name:     to.Ptr(name),
+		Location: to.Ptr("eastus"),
+		Properties: &armcompute.VirtualMachineProperties{
+			HardwareProfile: &armcompute.HardwareProfile{VMSize: to.Ptr(armcompute.VirtualMachineSizeTypesStandardB2S)},
+			InstanceView: &armcompute.VirtualMachineInstanceView{Statuses: []*armcompute.InstanceViewStatus{
+				{Code: to.Ptr("ProvisioningState/succeeded")},
+				{Code: to.Ptr("PowerState/" + state)},
+			}},
+		},
+	}
+}
+
+func newTestCollector(clients *costClients) *DefaultCostCollector {
+	return NewDefaultCostCollectorWithFactory(func(*common.SubscriptionConfig) (*costClients, error) {
+		return clients, nil
+	})
+}
+
+func TestCollectAll_ConvertsVMsAndDisks(t *testing.T) {
+	metrics := &fakeMetricsClient{averages: []float64{2, 4}}
+	c := newTestCollector(&costClients{
+		VMs: &fakeVMClient{vms: []*armcompute.VirtualMachine{vmWithState("web-1", "running"), vmWithState("batch-1", "deallocated")}},
+		Disks: &fakeDiskClient{disks: []*armcompute.Disk{{
+			ID:       to.Ptr("/subscriptions/sub-1/resourceGroups/rg-data/providers/Microsoft.Compute/disks/orphan"),
+			Name:     to.Ptr("orphan"),
+			Location: to.Ptr("westeurope"),
+			SKU:      &armcompute.DiskSKU{Name: to.Ptr(armcompute.DiskStorageAccountTypesPremiumLRS)},
+			Tags:     map[string]*string{"team": to.Ptr("data")},
+			Properties: &armcompute.DiskProperties{
+				DiskSizeGB: to.Ptr[int32](128),
+				DiskState:  to.Ptr(armcompute.DiskStateUnattached),
+			},
+		}}},
+		Metrics: metrics,
+	})
+
+	data, err := c.CollectAll(context.Background(), &common.SubscriptionConfig{SubscriptionID: "sub-1"}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.SubscriptionID != "sub-1" || len(data.VMs) != 2 || len(data.Disks) != 1 {
+		t.Fatalf("data = %+v; want sub-1 with 2 VMs and 1 disk", data)
+	}
+
+	web := data.VMs[0]
+	if web.ResourceGroup != "rg-app" || web.PowerState != "running" || web.VMSize != "Standard_B2s" {
+		t.Errorf("web-1 = %+v; want rg-app, running, Standard_B2s", web)
+	}
+	if web.AvgCPUPercent != 3 {
+		t.Errorf("web-1 AvgCPUPercent = %v; want 3 (mean of daily averages)", web.AvgCPUPercent)
+	}
+	if batch := data.VMs[1]; batch.PowerState != "deallocated" || batch.AvgCPUPercent != 0 {
+		t.Errorf("batch-1 = %+v; want deallocated with no CPU data", batch)
+	}
+	if len(metrics.queried) != 1 {
+		t.Errorf("metrics queried for %v; want only the running VM", metrics.queried)
+	}
+
+	disk := data.Disks[0]
+	if disk.ResourceGroup != "rg-data" || disk.SKU != "Premium_LRS" || disk.SizeGB != 128 || disk.DiskState != "Unattached" {
+		t.Errorf("disk = %+v; want rg-data Premium_LRS 128GB Unattached", disk)
+	}
+	if disk.Tags["team"] != "data" {
+		t.Errorf("disk tags = %v; want team=data", disk.Tags)
+	}
+}
+
+func TestCollectAll_MetricErrorIsNonFatal(t *testing.T) {
+	c := newTestCollector(&costClients{
+		VMs:     &fakeVMClient{vms: []*armcompute.VirtualMachine{vmWithState("web-1", "running")}},
+		Disks:   &fakeDiskClient{},
+		Metrics: &fakeMetricsClient{err: errors.New("AuthorizationFailed")},
+	})
+
+	data, err := c.CollectAll(context.Background(), &common.SubscriptionConfig{SubscriptionID: "sub-1"}, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := data.VMs[0].AvgCPUPercent; got != 0 {
+		t.Errorf("AvgCPUPercent = %v; want 0 when metrics fail", got)
+	}
+}
@@ -12,16 +12,57 @@ type KubeClientProvider interface {
 	ClientsetForContext(contextName string) (k8sclient.Interface, ClusterInfo, error)
 }
 
-// DefaultKubeClientProvider loads kubeconfig from $KUBECONFIG or ~/.kube/config
-// and builds a real kubernetes clientset.
-type DefaultKubeClientProvider struct{}
+// KubeContextLister is implemented by providers that can enumerate the
+// contexts available in their kubeconfig. Used by multi-cluster audits.
+type KubeContextLister interface {
+	// ListContexts returns every context name, sorted alphabetically.
+	ListContexts() ([]string, error)
+}
+
+// DefaultKubeClientProvider loads kubeconfig from an explicit path, or from
+// $KUBECONFIG or ~/.kube/config when no path is set, and builds a real
+// kubernetes clientset.
+type DefaultKubeClientProvider struct {
+	// path is the kubeconfig file to load; empty means resolveKubeconfigPath.
+	path string
+
+	// loadClientset and listContexts default to LoadClientset and
+	// ListContexts; tests replace them to observe the resolved path.
+	loadClientset func(kubeconfigPath, contextName string) (k8sclient.Interface, ClusterInfo, error)
+	listContexts  func(kubeconfigPath string) ([]string, error)
+}
 
 // NewDefaultKubeClientProvider returns a provider backed by the system kubeconfig.
 func NewDefaultKubeClientProvider() *DefaultKubeClientProvider {
-	return &DefaultKubeClientProvider{}
+	return NewKubeClientProviderFromPath("")
+}
+
+// NewKubeClientProviderFromPath returns a provider backed by the kubeconfig
+// file at path, ignoring $KUBECONFIG. An empty path behaves like
+// NewDefaultKubeClientProvider.
+func NewKubeClientProviderFromPath(path string) *DefaultKubeClientProvider {
+	return &DefaultKubeClientProvider{
+		path:          path,
+		loadClientset: LoadClientset,
+		listContexts:  ListContexts,
+	}
+}
+
+// kubeconfigPath returns the explicit path when set, otherwise the path from
+// $KUBECONFIG or ~/.kube/config.
+func (p *DefaultKubeClientProvider) kubeconfigPath() string {
+	if p.path != "" {
+		return p.path
+	}
+	return resolveKubeconfigPath()
 }
 
 // ClientsetForContext implements KubeClientProvider.
 func (p *DefaultKubeClientProvider) ClientsetForContext(contextName string) (k8sclient.Interface, ClusterInfo, error) {
-	return LoadClientset(resolveKubeconfigPath(), contextName)
+	return p.loadClientset(p.kubeconfigPath(), contextName)
+}
+
+// ListContexts implements KubeContextLister.
+func (p *DefaultKubeClientProvider) ListContexts() ([]string, error) {
+	return p.listContexts(p.kubeconfigPath())
 }
@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"testing"
+
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// recordingProvider returns p with its loaders replaced by fakes that record
+// the kubeconfig path they were called with.
+func recordingProvider(p *DefaultKubeClientProvider, gotPath *string) *DefaultKubeClientProvider {
+	p.loadClientset = func(kubeconfigPath, contextName string) (k8sclient.Interface, ClusterInfo, error) {
+		*gotPath = kubeconfigPath
+		return fake.NewSimpleClientset(), ClusterInfo{ContextName: contextName}, nil
+	}
+	p.listContexts = func(kubeconfigPath string) ([]string, error) {
+		*gotPath = kubeconfigPath
+		return []string{"ctx"}, nil
+	}
+	return p
+}
+
+func TestNewKubeClientProviderFromPath_UsesExplicitPath(t *testing.T) {
+	t.Setenv("KUBECONFIG", "/env/kubeconfig")
+	var got string
+	p := recordingProvider(NewKubeClientProviderFromPath("/custom/kubeconfig"), &got)
+
+	if _, info, err := p.ClientsetForContext("staging"); err != nil || info.ContextName != "staging" {
+		t.Fatalf("ClientsetForContext: info=%+v err=%v", info, err)
+	}
+	if got != "/custom/kubeconfig" {
+		t.Errorf("ClientsetForContext loaded %q; want /custom/kubeconfig", got)
+	}
+
+	got = ""
+	if _, err := p.ListContexts(); err != nil {
+		t.Fatalf("ListContexts: %v", err)
+	}
+	if got != "/custom/kubeconfig" {
+		t.Errorf("ListContexts loaded %q; want /custom/kubeconfig", got)
+	}
+}
+
+func TestNewDefaultKubeClientProvider_UsesKUBECONFIG(t *testing.T) {
+	t.Setenv("KUBECONFIG", "/env/kubeconfig")
+	var got string
+	p := recordingProvider(NewDefaultKubeClientProvider(), &got)
+
+	if _, _, err := p.ClientsetForContext(""); err != nil {
+		t.Fatalf("ClientsetForContext: %v", err)
+	}
+	if got != "/env/kubeconfig" {
+		t.Errorf("loaded %q; want $KUBECONFIG /env/kubeconfig", got)
+	}
+}
@@ -3,29 +3,61 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sclient "k8s.io/client-go/kubernetes"
 )
 
-// CollectClusterData collects nodes and namespaces from the cluster using
-// the provided clientset and attaches the resolved ClusterInfo to the result.
+// DefaultCollectConcurrency is the number of workers used for per-namespace
+// lookups and pod conversion when CollectOptions.Concurrency is not set.
+const DefaultCollectConcurrency = 4
+
+// CollectOptions tunes cluster data collection.
+type CollectOptions struct {
+	// Concurrency bounds the number of concurrent per-namespace LimitRange
+	// lookups and pod conversion workers. Values <= 0 use
+	// DefaultCollectConcurrency.
+	Concurrency int
+}
+
+// CollectClusterData collects cluster data with the default CollectOptions.
+func CollectClusterData(ctx context.Context, clientset k8sclient.Interface, info ClusterInfo) (*ClusterData, error) {
+	return CollectClusterDataWithOptions(ctx, clientset, info, CollectOptions{})
+}
+
+// CollectClusterDataWithOptions collects nodes and namespaces from the cluster
+// using the provided clientset and attaches the resolved ClusterInfo to the
+// result.
 //
-// Both collections are attempted; an error from either aborts the collection.
+// Nodes, namespaces, pods, services and service accounts are required: an
+// error collecting any of them aborts the collection. Ingresses, workloads,
+// pod disruption budgets, cluster role bindings and roles are optional: their
+// errors are returned in ClusterData.CollectErrors and the field is left
+// empty, so the rules that need them stay silent. Namespaces and pods are processed by opts.Concurrency workers and returned
+// sorted by namespace and name, so the result does not depend on worker
+// scheduling. Cancelling ctx stops the remaining work and returns ctx's error.
 // The clientset parameter is an interface so tests can inject a fake clientset.
-func CollectClusterData(ctx context.Context, clientset k8sclient.Interface, info ClusterInfo) (*ClusterData, error) {
+func CollectClusterDataWithOptions(ctx context.Context, clientset k8sclient.Interface, info ClusterInfo, opts CollectOptions) (*ClusterData, error) {
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = DefaultCollectConcurrency
+	}
+
 	nodes, err := collectNodes(ctx, clientset)
 	if err != nil {
 		return nil, fmt.Errorf("collect nodes: %w", err)
 	}
 
-	namespaces, err := collectNamespaces(ctx, clientset)
+	namespaces, err := collectNamespaces(ctx, clientset, workers)
 	if err != nil {
 		return nil, fmt.Errorf("collect namespaces: %w", err)
 	}
 
-	pods, err := collectPods(ctx, clientset)
+	pods, err := collectPods(ctx, clientset, workers)
 	if err != nil {
 		return nil, fmt.Errorf("collect pods: %w", err)
 	}
@@ -35,18 +67,53 @@ func CollectClusterData(ctx context.Context, clientset k8sclient.Interface, info
 		return nil, fmt.Errorf("collect services: %w", err)
 	}
 
+	// The remaining collections feed individual rules only. Each is optional:
+	// a failure (typically RBAC forbidding the list) leaves its field empty
+	// and is recorded in CollectErrors instead of aborting the audit.
+	var collectErrs []error
+	optional := func(what string, err error) {
+		if err != nil {
+			collectErrs = append(collectErrs, fmt.Errorf("collect %s: %w", what, err))
+		}
+	}
+
+	ingresses, err := collectIngresses(ctx, clientset)
+	optional("ingresses", err)
+
+	workloads, err := collectWorkloads(ctx, clientset)
+	optional("workloads", err)
+
+	pdbs, err := collectPodDisruptionBudgets(ctx, clientset)
+	optional("pod disruption budgets", err)
+
 	serviceAccounts, err := collectServiceAccounts(ctx, clientset)
 	if err != nil {
 		return nil, fmt.Errorf("collect service accounts: %w", err)
 	}
 
+	clusterRoleBindings, err := collectClusterRoleBindings(ctx, clientset)
+	optional("cluster role bindings", err)
+
+	roles, err := collectRoles(ctx, clientset)
+	optional("roles", err)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	return &ClusterData{
-		ClusterInfo:     info,
-		Nodes:           nodes,
-		Namespaces:      namespaces,
-		Pods:            pods,
-		Services:        services,
-		ServiceAccounts: serviceAccounts,
+		ClusterInfo:         info,
+		Nodes:               nodes,
+		Namespaces:          namespaces,
+		Pods:                pods,
+		Services:            services,
+		Ingresses:           ingresses,
+		Workloads:           workloads,
+		PDBs:                pdbs,
+		ServiceAccounts:     serviceAccounts,
+		ClusterRoleBindings: clusterRoleBindings,
+		Roles:               roles,
+		CollectErrors:       collectErrs,
 	}, nil
 }
 
@@ -81,117 +148,214 @@ func collectNodes(ctx context.Context, clientset k8sclient.Interface) ([]NodeInf
 
 // collectNamespaces lists all namespaces and converts them to NamespaceInfo.
 // It also checks each namespace for the presence of at least one LimitRange,
-// which governs default resource limits for pods.
-func collectNamespaces(ctx context.Context, clientset k8sclient.Interface) ([]NamespaceInfo, error) {
+// which governs default resource limits for pods, and at least one
+// ResourceQuota, which caps the namespace's total usage. The per-namespace
+// lookups run on up to workers goroutines; the result is sorted by name.
+func collectNamespaces(ctx context.Context, clientset k8sclient.Interface, workers int) ([]NamespaceInfo, error) {
 	nsList, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	namespaces := make([]NamespaceInfo, 0, len(nsList.Items))
-	for _, ns := range nsList.Items {
-		lrList, err := clientset.CoreV1().LimitRanges(ns.Name).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("collect limitranges for namespace %q: %w", ns.Name, err)
-		}
-		labels := make(map[string]string, len(ns.Labels))
-		for k, v := range ns.Labels {
-			labels[k] = v
-		}
-		namespaces = append(namespaces, NamespaceInfo{
-			Name:          ns.Name,
-			HasLimitRange: len(lrList.Items) > 0,
-			Labels:        labels,
+	namespaces := make([]NamespaceInfo, len(nsList.Items))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+	for i := range nsList.Items {
+		ns := &nsList.Items[i]
+		g.Go(func() error {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			lrList, err := clientset.CoreV1().LimitRanges(ns.Name).List(gctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("collect limitranges for namespace %q: %w", ns.Name, err)
+			}
+			rqList, err := clientset.CoreV1().ResourceQuotas(ns.Name).List(gctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("collect resourcequotas for namespace %q: %w", ns.Name, err)
+			}
+			labels := make(map[string]string, len(ns.Labels))
+			for k, v := range ns.Labels {
+				labels[k] = v
+			}
+			namespaces[i] = NamespaceInfo{
+				Name:             ns.Name,
+				HasLimitRange:    len(lrList.Items) > 0,
+				HasResourceQuota: len(rqList.Items) > 0,
+				Labels:           labels,
+			}
+			return nil
 		})
 	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
 	return namespaces, nil
 }
 
 // collectPods lists all pods across all namespaces and converts them to PodInfo.
 // For each container it extracts the privileged flag, CPU/memory resource requests,
 // and PSS-relevant security context fields (runAsNonRoot, runAsUser, capabilities,
-// seccompProfile). Container-level security context overrides pod-level for all
-// effective PSS fields.
-func collectPods(ctx context.Context, clientset k8sclient.Interface) ([]PodInfo, error) {
+// seccompProfile, readOnlyRootFilesystem). Container-level security context
+// overrides pod-level for all effective PSS fields. Init containers are
+// collected separately into PodInfo.InitContainers.
+//
+// Pods are converted by up to workers goroutines and returned sorted by
+// namespace and name.
+func collectPods(ctx context.Context, clientset k8sclient.Interface, workers int) ([]PodInfo, error) {
 	podList, err := clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	pods := make([]PodInfo, 0, len(podList.Items))
-	for _, p := range podList.Items {
-		pod := PodInfo{
-			Name:               p.Name,
-			Namespace:          p.Namespace,
-			HostNetwork:        p.Spec.HostNetwork,
-			HostPID:            p.Spec.HostPID,
-			HostIPC:            p.Spec.HostIPC,
-			ServiceAccountName: p.Spec.ServiceAccountName,
-		}
-		for _, c := range p.Spec.Containers {
-			privileged := c.SecurityContext != nil &&
-				c.SecurityContext.Privileged != nil &&
-				*c.SecurityContext.Privileged
-
-			cpuReq, hasCPU := c.Resources.Requests[corev1.ResourceCPU]
-			hasCPURequest := hasCPU && !cpuReq.IsZero()
-
-			memReq, hasMem := c.Resources.Requests[corev1.ResourceMemory]
-			hasMemRequest := hasMem && !memReq.IsZero()
-
-			// Effective runAsNonRoot: container-level overrides pod-level.
-			var runAsNonRoot *bool
-			if p.Spec.SecurityContext != nil && p.Spec.SecurityContext.RunAsNonRoot != nil {
-				v := *p.Spec.SecurityContext.RunAsNonRoot
-				runAsNonRoot = &v
-			}
-			if c.SecurityContext != nil && c.SecurityContext.RunAsNonRoot != nil {
-				v := *c.SecurityContext.RunAsNonRoot
-				runAsNonRoot = &v
+	pods := make([]PodInfo, len(podList.Items))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+	// Each worker converts a contiguous chunk so goroutine overhead stays
+	// small for clusters with tens of thousands of pods.
+	chunk := (len(podList.Items) + workers - 1) / workers
+	for start := 0; start < len(podList.Items); start += chunk {
+		end := min(start+chunk, len(podList.Items))
+		g.Go(func() error {
+			for i := start; i < end; i++ {
+				if err := gctx.Err(); err != nil {
+					return err
+				}
+				pods[i] = toPodInfo(&podList.Items[i])
 			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
 
-			// Effective runAsUser: container-level overrides pod-level.
-			var runAsUser *int64
-			if p.Spec.SecurityContext != nil && p.Spec.SecurityContext.RunAsUser != nil {
-				v := *p.Spec.SecurityContext.RunAsUser
-				runAsUser = &v
-			}
-			if c.SecurityContext != nil && c.SecurityContext.RunAsUser != nil {
-				v := *c.SecurityContext.RunAsUser
-				runAsUser = &v
-			}
+// toPodInfo converts one pod spec into a PodInfo.
+func toPodInfo(p *corev1.Pod) PodInfo {
+	pod := PodInfo{
+		Name:                         p.Name,
+		Namespace:                    p.Namespace,
+		HostNetwork:                  p.Spec.HostNetwork,
+		HostPID:                      p.Spec.HostPID,
+		HostIPC:                      p.Spec.HostIPC,
+		ServiceAccountName:           p.Spec.ServiceAccountName,
+		AutomountServiceAccountToken: p.Spec.AutomountServiceAccountToken,
+		CreationTimestamp:            p.CreationTimestamp.Time,
+	}
+	if len(p.Labels) > 0 {
+		pod.Labels = make(map[string]string, len(p.Labels))
+		for k, v := range p.Labels {
+			pod.Labels[k] = v
+		}
+	}
+	for _, c := range p.Spec.InitContainers {
+		pod.InitContainers = append(pod.InitContainers, toContainerInfo(p.Spec.SecurityContext, c))
+	}
+	for _, c := range p.Spec.Containers {
+		pod.Containers = append(pod.Containers, toContainerInfo(p.Spec.SecurityContext, c))
+	}
+	return pod
+}
 
-			// Added capabilities from the container security context only.
-			var addedCaps []string
-			if c.SecurityContext != nil && c.SecurityContext.Capabilities != nil {
-				for _, cap := range c.SecurityContext.Capabilities.Add {
-					addedCaps = append(addedCaps, string(cap))
-				}
-			}
+// toContainerInfo converts a container spec into a ContainerInfo. podSC is the
+// pod-level security context (may be nil); container-level fields override it.
+// Used for both regular and init containers.
+func toContainerInfo(podSC *corev1.PodSecurityContext, c corev1.Container) ContainerInfo {
+	privileged := c.SecurityContext != nil &&
+		c.SecurityContext.Privileged != nil &&
+		*c.SecurityContext.Privileged
 
-			// Effective seccomp profile type: container-level overrides pod-level.
-			var seccompProfileType string
-			if p.Spec.SecurityContext != nil && p.Spec.SecurityContext.SeccompProfile != nil {
-				seccompProfileType = string(p.Spec.SecurityContext.SeccompProfile.Type)
-			}
-			if c.SecurityContext != nil && c.SecurityContext.SeccompProfile != nil {
-				seccompProfileType = string(c.SecurityContext.SeccompProfile.Type)
-			}
+	cpuReq, hasCPU := c.Resources.Requests[corev1.ResourceCPU]
+	hasCPURequest := hasCPU && !cpuReq.IsZero()
 
-			pod.Containers = append(pod.Containers, ContainerInfo{
-				Name:               c.Name,
-				Privileged:         privileged,
-				HasCPURequest:      hasCPURequest,
-				HasMemoryRequest:   hasMemRequest,
-				RunAsNonRoot:       runAsNonRoot,
-				RunAsUser:          runAsUser,
-				AddedCapabilities:  addedCaps,
-				SeccompProfileType: seccompProfileType,
+	memReq, hasMem := c.Resources.Requests[corev1.ResourceMemory]
+	hasMemRequest := hasMem && !memReq.IsZero()
+
+	// Effective runAsNonRoot: container-level overrides pod-level.
+	var runAsNonRoot *bool
+	if podSC != nil && podSC.RunAsNonRoot != nil {
+		v := *podSC.RunAsNonRoot
+		runAsNonRoot = &v
+	}
+	if c.SecurityContext != nil && c.SecurityContext.RunAsNonRoot != nil {
+		v := *c.SecurityContext.RunAsNonRoot
+		runAsNonRoot = &v
+	}
+
+	// Effective runAsUser: container-level overrides pod-level.
+	var runAsUser *int64
+	if podSC != nil && podSC.RunAsUser != nil {
+		v := *podSC.RunAsUser
+		runAsUser = &v
+	}
+	if c.SecurityContext != nil && c.SecurityContext.RunAsUser != nil {
+		v := *c.SecurityContext.RunAsUser
+		runAsUser = &v
+	}
+
+	// Added capabilities from the container security context only.
+	var addedCaps []string
+	if c.SecurityContext != nil && c.SecurityContext.Capabilities != nil {
+		for _, cap := range c.SecurityContext.Capabilities.Add {
+			addedCaps = append(addedCaps, string(cap))
+		}
+	}
+
+	// Effective seccomp profile type: container-level overrides pod-level.
+	var seccompProfileType string
+	if podSC != nil && podSC.SeccompProfile != nil {
+		seccompProfileType = string(podSC.SeccompProfile.Type)
+	}
+	if c.SecurityContext != nil && c.SecurityContext.SeccompProfile != nil {
+		seccompProfileType = string(c.SecurityContext.SeccompProfile.Type)
+	}
+
+	// readOnlyRootFilesystem exists only at container level.
+	readOnlyRootFS := c.SecurityContext != nil &&
+		c.SecurityContext.ReadOnlyRootFilesystem != nil &&
+		*c.SecurityContext.ReadOnlyRootFilesystem
+
+	// Secrets exposed as environment variables, individually or wholesale.
+	var secretEnvRefs []SecretEnvRef
+	for _, e := range c.Env {
+		if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil {
+			secretEnvRefs = append(secretEnvRefs, SecretEnvRef{
+				SecretName: e.ValueFrom.SecretKeyRef.Name,
+				EnvVar:     e.Name,
+				Key:        e.ValueFrom.SecretKeyRef.Key,
 			})
 		}
-		pods = append(pods, pod)
 	}
-	return pods, nil
+	for _, ef := range c.EnvFrom {
+		if ef.SecretRef != nil {
+			secretEnvRefs = append(secretEnvRefs, SecretEnvRef{SecretName: ef.SecretRef.Name})
+		}
+	}
+
+	return ContainerInfo{
+		Name:                   c.Name,
+		Image:                  c.Image,
+		Privileged:             privileged,
+		HasCPURequest:          hasCPURequest,
+		HasMemoryRequest:       hasMemRequest,
+		RunAsNonRoot:           runAsNonRoot,
+		RunAsUser:              runAsUser,
+		AddedCapabilities:      addedCaps,
+		SeccompProfileType:     seccompProfileType,
+		ReadOnlyRootFilesystem: readOnlyRootFS,
+		HasLivenessProbe:       c.LivenessProbe != nil,
+		HasReadinessProbe:      c.ReadinessProbe != nil,
+		SecretEnvRefs:          secretEnvRefs,
+	}
 }
 
 // collectServices lists all Services across all namespaces and converts them to ServiceInfo.
@@ -209,15 +373,128 @@ func collectServices(ctx context.Context, clientset k8sclient.Interface) ([]Serv
 			annotations[k] = v
 		}
 		services = append(services, ServiceInfo{
-			Name:        s.Name,
-			Namespace:   s.Namespace,
-			Type:        string(s.Spec.Type),
-			Annotations: annotations,
+			Name:              s.Name,
+			Namespace:         s.Namespace,
+			Type:              string(s.Spec.Type),
+			Annotations:       annotations,
+			CreationTimestamp: s.CreationTimestamp.Time,
 		})
 	}
 	return services, nil
 }
 
+// collectIngresses lists all Ingresses across all namespaces and converts them
+// to IngressInfo, keeping only the rule hosts and the TLS hosts.
+func collectIngresses(ctx context.Context, clientset k8sclient.Interface) ([]IngressInfo, error) {
+	ingList, err := clientset.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	ingresses := make([]IngressInfo, 0, len(ingList.Items))
+	for _, ing := range ingList.Items {
+		var hosts []string
+		seen := make(map[string]bool)
+		for _, rule := range ing.Spec.Rules {
+			if rule.Host == "" || seen[rule.Host] {
+				continue
+			}
+			seen[rule.Host] = true
+			hosts = append(hosts, rule.Host)
+		}
+		var tlsHosts []string
+		for _, tls := range ing.Spec.TLS {
+			tlsHosts = append(tlsHosts, tls.Hosts...)
+		}
+		ingresses = append(ingresses, IngressInfo{
+			Name:              ing.Name,
+			Namespace:         ing.Namespace,
+			Hosts:             hosts,
+			TLSHosts:          tlsHosts,
+			HasTLS:            len(ing.Spec.TLS) > 0,
+			CreationTimestamp: ing.CreationTimestamp.Time,
+		})
+	}
+	return ingresses, nil
+}
+
+// collectWorkloads lists all Deployments and StatefulSets across all
+// namespaces and converts them to WorkloadInfo. Deployments come first.
+func collectWorkloads(ctx context.Context, clientset k8sclient.Interface) ([]WorkloadInfo, error) {
+	depList, err := clientset.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	stsList, err := clientset.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := make([]WorkloadInfo, 0, len(depList.Items)+len(stsList.Items))
+	for _, d := range depList.Items {
+		workloads = append(workloads, toWorkloadInfo("Deployment", d.ObjectMeta, d.Spec.Replicas, d.Spec.Template.Labels))
+	}
+	for _, s := range stsList.Items {
+		workloads = append(workloads, toWorkloadInfo("StatefulSet", s.ObjectMeta, s.Spec.Replicas, s.Spec.Template.Labels))
+	}
+	return workloads, nil
+}
+
+// toWorkloadInfo builds a WorkloadInfo, defaulting an unset replica count to 1.
+func toWorkloadInfo(kind string, meta metav1.ObjectMeta, replicas *int32, podLabels map[string]string) WorkloadInfo {
+	n := 1
+	if replicas != nil {
+		n = int(*replicas)
+	}
+	labels := make(map[string]string, len(podLabels))
+	for k, v := range podLabels {
+		labels[k] = v
+	}
+	return WorkloadInfo{
+		Kind:              kind,
+		Name:              meta.Name,
+		Namespace:         meta.Namespace,
+		Replicas:          n,
+		PodLabels:         labels,
+		CreationTimestamp: meta.CreationTimestamp.Time,
+	}
+}
+
+// collectPodDisruptionBudgets lists all PodDisruptionBudgets across all
+// namespaces and converts them to PodDisruptionBudgetInfo. PDBs without a
+// selector are skipped because they select no pods.
+func collectPodDisruptionBudgets(ctx context.Context, clientset k8sclient.Interface) ([]PodDisruptionBudgetInfo, error) {
+	pdbList, err := clientset.PolicyV1().PodDisruptionBudgets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pdbs := make([]PodDisruptionBudgetInfo, 0, len(pdbList.Items))
+	for _, pdb := range pdbList.Items {
+		sel := pdb.Spec.Selector
+		if sel == nil {
+			continue
+		}
+		info := PodDisruptionBudgetInfo{
+			Name:        pdb.Name,
+			Namespace:   pdb.Namespace,
+			MatchLabels: make(map[string]string, len(sel.MatchLabels)),
+		}
+		for k, v := range sel.MatchLabels {
+			info.MatchLabels[k] = v
+		}
+		for _, req := range sel.MatchExpressions {
+			info.MatchExpressions = append(info.MatchExpressions, LabelSelectorRequirementInfo{
+				Key:      req.Key,
+				Operator: string(req.Operator),
+				Values:   append([]string(nil), req.Values...),
+			})
+		}
+		pdbs = append(pdbs, info)
+	}
+	return pdbs, nil
+}
+
 // collectServiceAccounts lists all ServiceAccounts across all namespaces and
 // converts them to ServiceAccountInfo. The AutomountServiceAccountToken field
 // is preserved as-is (nil = not set, Kubernetes defaults to true).
@@ -238,7 +515,91 @@ func collectServiceAccounts(ctx context.Context, clientset k8sclient.Interface)
 			Namespace:                    sa.Namespace,
 			AutomountServiceAccountToken: sa.AutomountServiceAccountToken,
 			Annotations:                  annotations,
+			CreationTimestamp:            sa.CreationTimestamp.Time,
 		})
 	}
 	return accounts, nil
 }
+
+// collectClusterRoleBindings lists all ClusterRoleBindings and converts them to
+// ClusterRoleBindingInfo, keeping only the roleRef name and the subject list.
+func collectClusterRoleBindings(ctx context.Context, clientset k8sclient.Interface) ([]ClusterRoleBindingInfo, error) {
+	crbList, err := clientset.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make([]ClusterRoleBindingInfo, 0, len(crbList.Items))
+	for _, crb := range crbList.Items {
+		bindings = append(bindings, ClusterRoleBindingInfo{
+			Name:     crb.Name,
+			RoleName: crb.RoleRef.Name,
+			Subjects: toSubjectInfos(crb.Subjects),
+		})
+	}
+	return bindings, nil
+}
+
+// collectRoles lists all ClusterRoles and the Roles of every namespace and
+// converts them to RoleInfo. ClusterRoles come first.
+func collectRoles(ctx context.Context, clientset k8sclient.Interface) ([]RoleInfo, error) {
+	crList, err := clientset.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	roleList, err := clientset.RbacV1().Roles("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]RoleInfo, 0, len(crList.Items)+len(roleList.Items))
+	for _, cr := range crList.Items {
+		roles = append(roles, RoleInfo{
+			Kind:  "ClusterRole",
+			Name:  cr.Name,
+			Rules: toPolicyRuleInfos(cr.Rules),
+		})
+	}
+	for _, r := range roleList.Items {
+		roles = append(roles, RoleInfo{
+			Kind:      "Role",
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			Rules:     toPolicyRuleInfos(r.Rules),
+		})
+	}
+	return roles, nil
+}
+
+// toPolicyRuleInfos converts RBAC policy rules to PolicyRuleInfo values,
+// skipping rules that only grant nonResourceURLs.
+func toPolicyRuleInfos(rules []rbacv1.PolicyRule) []PolicyRuleInfo {
+	var out []PolicyRuleInfo
+	for _, r := range rules {
+		if len(r.Resources) == 0 {
+			continue
+		}
+		out = append(out, PolicyRuleInfo{
+			APIGroups: append([]string(nil), r.APIGroups...),
+			Resources: append([]string(nil), r.Resources...),
+			Verbs:     append([]string(nil), r.Verbs...),
+		})
+	}
+	return out
+}
+
+// toSubjectInfos converts RBAC subjects to SubjectInfo values.
+func toSubjectInfos(subjects []rbacv1.Subject) []SubjectInfo {
+	if len(subjects) == 0 {
+		return nil
+	}
+	out := make([]SubjectInfo, 0, len(subjects))
+	for _, s := range subjects {
+		out = append(out, SubjectInfo{
+			Kind:      s.Kind,
+			Name:      s.Name,
+			Namespace: s.Namespace,
+		})
+	}
+	return out
+}
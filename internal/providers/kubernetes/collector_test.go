@@ -2,12 +2,22 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 // boolPtr is a helper that returns a pointer to the given bool value.
@@ -421,3 +431,484 @@ func TestCollectClusterData_ServiceClusterIP(t *testing.T) {
 		t.Errorf("Service Type = %q; want ClusterIP", data.Services[0].Type)
 	}
 }
+
+// TestCollectClusterData_ContainerImage verifies that the container image
+// reference is copied verbatim into ContainerInfo.Image.
+func TestCollectClusterData_ContainerImage(t *testing.T) {
+	c := makeContainer("app", false, "100m", "128Mi")
+	c.Image = "registry:5000/app:1.2"
+	fakeClient := fake.NewSimpleClientset(makePod("default", "img-pod", []corev1.Container{c}))
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	if len(data.Pods) != 1 {
+		t.Fatalf("Pods count = %d; want 1", len(data.Pods))
+	}
+	if got := data.Pods[0].Containers[0].Image; got != "registry:5000/app:1.2" {
+		t.Errorf("Image = %q; want registry:5000/app:1.2", got)
+	}
+}
+
+func TestCollectClusterData_PodAutomountToken(t *testing.T) {
+	automount := true
+	pod := makePod("default", "api-pod", []corev1.Container{makeContainer("app", false, "100m", "128Mi")})
+	pod.Spec.AutomountServiceAccountToken = &automount
+	fakeClient := fake.NewSimpleClientset(pod, makePod("default", "unset-pod", nil))
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	got := make(map[string]*bool)
+	for _, p := range data.Pods {
+		got[p.Name] = p.AutomountServiceAccountToken
+	}
+	if v := got["api-pod"]; v == nil || !*v {
+		t.Errorf("api-pod AutomountServiceAccountToken = %v; want true", v)
+	}
+	if v := got["unset-pod"]; v != nil {
+		t.Errorf("unset-pod AutomountServiceAccountToken = %v; want nil", *v)
+	}
+}
+
+// TestCollectClusterData_ReadOnlyRootFSAndInitContainers verifies that
+// readOnlyRootFilesystem is collected and init containers are kept separate
+// from regular containers.
+func TestCollectClusterData_ReadOnlyRootFSAndInitContainers(t *testing.T) {
+	app := makeContainer("app", false, "100m", "128Mi")
+	app.SecurityContext.ReadOnlyRootFilesystem = boolPtr(true)
+	pod := makePod("default", "rofs-pod", []corev1.Container{app})
+	pod.Spec.InitContainers = []corev1.Container{makeContainer("init", false, "", "")}
+	fakeClient := fake.NewSimpleClientset(pod)
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	p := data.Pods[0]
+	if len(p.Containers) != 1 || !p.Containers[0].ReadOnlyRootFilesystem {
+		t.Errorf("Containers = %+v; want one read-only container", p.Containers)
+	}
+	if len(p.InitContainers) != 1 || p.InitContainers[0].Name != "init" {
+		t.Fatalf("InitContainers = %+v; want one container named init", p.InitContainers)
+	}
+	if p.InitContainers[0].ReadOnlyRootFilesystem {
+		t.Error("init container ReadOnlyRootFilesystem = true; want false")
+	}
+}
+
+// TestCollectClusterData_SeccompProfileType verifies the effective seccomp
+// profile consumed by K8S_POD_NO_SECCOMP: a pod-level profile is inherited,
+// a container-level profile overrides it, and no profile leaves the type empty.
+func TestCollectClusterData_SeccompProfileType(t *testing.T) {
+	inherits := makeContainer("inherits", false, "", "")
+	overrides := makeContainer("overrides", false, "", "")
+	overrides.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}
+	withPodProfile := makePod("default", "pod-profile", []corev1.Container{inherits, overrides})
+	withPodProfile.Spec.SecurityContext = &corev1.PodSecurityContext{
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	unset := makePod("default", "no-profile", []corev1.Container{makeContainer("app", false, "", "")})
+	fakeClient := fake.NewSimpleClientset(withPodProfile, unset)
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	got := make(map[string]string)
+	for _, p := range data.Pods {
+		for _, c := range p.Containers {
+			got[p.Name+"/"+c.Name] = c.SeccompProfileType
+		}
+	}
+	want := map[string]string{
+		"pod-profile/inherits":  "RuntimeDefault",
+		"pod-profile/overrides": "Unconfined",
+		"no-profile/app":        "",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s SeccompProfileType = %q; want %q", k, got[k], v)
+		}
+	}
+}
+
+// TestCollectClusterData_CreationTimestamps verifies that creation timestamps
+// are copied for pods, services, and service accounts.
+func TestCollectClusterData_CreationTimestamps(t *testing.T) {
+	ts := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	pod := makePod("default", "p", []corev1.Container{makeContainer("app", false, "", "")})
+	pod.CreationTimestamp = ts
+	svc := makeService("default", "s", corev1.ServiceTypeClusterIP, nil)
+	svc.CreationTimestamp = ts
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: "default", CreationTimestamp: ts}}
+
+	data, err := CollectClusterData(context.Background(), fake.NewSimpleClientset(pod, svc, sa), ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	if !data.Pods[0].CreationTimestamp.Equal(ts.Time) {
+		t.Errorf("pod CreationTimestamp = %v; want %v", data.Pods[0].CreationTimestamp, ts.Time)
+	}
+	if !data.Services[0].CreationTimestamp.Equal(ts.Time) {
+		t.Errorf("service CreationTimestamp = %v; want %v", data.Services[0].CreationTimestamp, ts.Time)
+	}
+	if !data.ServiceAccounts[0].CreationTimestamp.Equal(ts.Time) {
+		t.Errorf("service account CreationTimestamp = %v; want %v", data.ServiceAccounts[0].CreationTimestamp, ts.Time)
+	}
+}
+
+// TestCollectClusterData_ClusterRoleBindings verifies that ClusterRoleBinding
+// role references and subjects are copied into ClusterRoleBindingInfo.
+func TestCollectClusterData_ClusterRoleBindings(t *testing.T) {
+	crb := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci-admin"},
+		RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "cluster-admin"},
+		Subjects: []rbacv1.Subject{
+			{Kind: "ServiceAccount", Name: "deployer", Namespace: "ci"},
+			{Kind: "Group", Name: "ops", APIGroup: "rbac.authorization.k8s.io"},
+		},
+	}
+
+	data, err := CollectClusterData(context.Background(), fake.NewSimpleClientset(crb), ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	if len(data.ClusterRoleBindings) != 1 {
+		t.Fatalf("ClusterRoleBindings count = %d; want 1", len(data.ClusterRoleBindings))
+	}
+	got := data.ClusterRoleBindings[0]
+	if got.Name != "ci-admin" || got.RoleName != "cluster-admin" {
+		t.Errorf("binding = %q -> %q; want ci-admin -> cluster-admin", got.Name, got.RoleName)
+	}
+	if len(got.Subjects) != 2 {
+		t.Fatalf("Subjects count = %d; want 2", len(got.Subjects))
+	}
+	want := SubjectInfo{Kind: "ServiceAccount", Name: "deployer", Namespace: "ci"}
+	if got.Subjects[0] != want {
+		t.Errorf("Subjects[0] = %+v; want %+v", got.Subjects[0], want)
+	}
+	if got.Subjects[1].Kind != "Group" || got.Subjects[1].Name != "ops" {
+		t.Errorf("Subjects[1] = %+v; want Group ops", got.Subjects[1])
+	}
+}
+
+// TestCollectClusterData_OptionalCollectionFailureIsNotFatal verifies that a
+// forbidden RBAC list leaves the RBAC fields empty, records the failure in
+// CollectErrors and still returns the rest of the inventory.
+func TestCollectClusterData_OptionalCollectionFailureIsNotFatal(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(makeNode("node-1", "4", "8Gi", "3800m", "7Gi"))
+	fakeClient.PrependReactor("list", "clusterrolebindings", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("clusterrolebindings is forbidden")
+	})
+	fakeClient.PrependReactor("list", "roles", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("roles is forbidden")
+	})
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v; want optional failures to be non-fatal", err)
+	}
+	if len(data.Nodes) != 1 {
+		t.Errorf("Nodes count = %d; want 1", len(data.Nodes))
+	}
+	if len(data.ClusterRoleBindings) != 0 || len(data.Roles) != 0 {
+		t.Errorf("RBAC fields = %d bindings, %d roles; want both empty", len(data.ClusterRoleBindings), len(data.Roles))
+	}
+	if len(data.CollectErrors) != 2 {
+		t.Fatalf("CollectErrors = %v; want 2 errors", data.CollectErrors)
+	}
+	if got := data.CollectErrors[0].Error(); got != "collect cluster role bindings: clusterrolebindings is forbidden" {
+		t.Errorf("CollectErrors[0] = %q", got)
+	}
+}
+
+// TestCollectClusterData_RequiredCollectionFailureIsFatal verifies that a
+// failed pod list still aborts the collection.
+func TestCollectClusterData_RequiredCollectionFailureIsFatal(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	fakeClient.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("pods is forbidden")
+	})
+
+	if _, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{}); err == nil {
+		t.Fatal("expected an error when pods cannot be listed")
+	}
+}
+
+// TestCollectClusterData_Roles verifies that ClusterRoles and namespaced Roles
+// are copied into RoleInfo and that nonResourceURL-only rules are dropped.
+func TestCollectClusterData_Roles(t *testing.T) {
+	cr := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "ops-superuser"},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+		},
+	}
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-reader", Namespace: "prod"},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+		},
+	}
+
+	data, err := CollectClusterData(context.Background(), fake.NewSimpleClientset(cr, role), ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	want := []RoleInfo{
+		{
+			Kind: "ClusterRole", Name: "ops-superuser",
+			Rules: []PolicyRuleInfo{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+		},
+		{
+			Kind: "Role", Name: "pod-reader", Namespace: "prod",
+			Rules: []PolicyRuleInfo{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}},
+		},
+	}
+	if !reflect.DeepEqual(data.Roles, want) {
+		t.Errorf("Roles = %+v; want %+v", data.Roles, want)
+	}
+}
+
+// TestCollectClusterData_IngressHostsAndTLS verifies that rule hosts are
+// de-duplicated, catch-all rules are skipped, and TLS hosts are flattened.
+func TestCollectClusterData_IngressHostsAndTLS(t *testing.T) {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{Host: "shop.example.com"},
+				{Host: "api.example.com"},
+				{Host: "shop.example.com"}, // duplicate path rule for the same host
+				{},                         // catch-all rule without a host
+			},
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"},
+			},
+		},
+	}
+	plain := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "plain", Namespace: "dev"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "dev.example.com"}},
+		},
+	}
+	fakeClient := fake.NewSimpleClientset(ing, plain)
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	if len(data.Ingresses) != 2 {
+		t.Fatalf("Ingresses count = %d; want 2", len(data.Ingresses))
+	}
+	byName := make(map[string]IngressInfo)
+	for _, i := range data.Ingresses {
+		byName[i.Name] = i
+	}
+
+	web := byName["web"]
+	if web.Namespace != "prod" {
+		t.Errorf("web Namespace = %q; want prod", web.Namespace)
+	}
+	if len(web.Hosts) != 2 || web.Hosts[0] != "shop.example.com" || web.Hosts[1] != "api.example.com" {
+		t.Errorf("web Hosts = %v; want [shop.example.com api.example.com]", web.Hosts)
+	}
+	if !web.HasTLS || len(web.TLSHosts) != 1 || web.TLSHosts[0] != "shop.example.com" {
+		t.Errorf("web TLS = (%v, %v); want (true, [shop.example.com])", web.HasTLS, web.TLSHosts)
+	}
+
+	if p := byName["plain"]; p.HasTLS || len(p.TLSHosts) != 0 {
+		t.Errorf("plain TLS = (%v, %v); want (false, [])", p.HasTLS, p.TLSHosts)
+	}
+}
+
+// TestCollectClusterData_WorkloadsAndPDBs verifies that Deployments and
+// StatefulSets are collected with their replica counts (defaulting to 1) and
+// pod template labels, and that PDBs without a selector are skipped.
+func TestCollectClusterData_WorkloadsAndPDBs(t *testing.T) {
+	three := int32(3)
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "prod"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &three,
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "api"}}},
+		},
+	}
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"},
+	}
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-pdb", Namespace: "prod"},
+		Spec: policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "api"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"backend"}},
+			},
+		}},
+	}
+	noSelector := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "prod"},
+	}
+	fakeClient := fake.NewSimpleClientset(dep, sts, pdb, noSelector)
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	if len(data.Workloads) != 2 {
+		t.Fatalf("Workloads count = %d; want 2", len(data.Workloads))
+	}
+	api, db := data.Workloads[0], data.Workloads[1]
+	if api.Kind != "Deployment" || api.Replicas != 3 || api.PodLabels["app"] != "api" {
+		t.Errorf("api = %+v; want Deployment with 3 replicas and app=api", api)
+	}
+	if db.Kind != "StatefulSet" || db.Replicas != 1 {
+		t.Errorf("db = %+v; want StatefulSet with default 1 replica", db)
+	}
+
+	if len(data.PDBs) != 1 {
+		t.Fatalf("PDBs count = %d; want 1 (selector-less PDB skipped)", len(data.PDBs))
+	}
+	got := data.PDBs[0]
+	if got.Name != "api-pdb" || got.MatchLabels["app"] != "api" {
+		t.Errorf("PDB = %+v; want api-pdb selecting app=api", got)
+	}
+	if len(got.MatchExpressions) != 1 || got.MatchExpressions[0].Operator != "In" || got.MatchExpressions[0].Values[0] != "backend" {
+		t.Errorf("MatchExpressions = %+v; want tier In [backend]", got.MatchExpressions)
+	}
+}
+
+// manyPodsClient returns a fake clientset holding n pods spread across ten
+// namespaces, inserted in an order that is not sorted by namespace or name.
+func manyPodsClient(n int) *fake.Clientset {
+	objs := make([]runtime.Object, 0, n+10)
+	for i := 0; i < 10; i++ {
+		objs = append(objs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", 9-i)}})
+	}
+	for i := n - 1; i >= 0; i-- {
+		c := makeContainer("app", i%7 == 0, "100m", "")
+		objs = append(objs, makePod(fmt.Sprintf("ns-%d", i%10), fmt.Sprintf("pod-%05d", i), []corev1.Container{c}))
+	}
+	return fake.NewSimpleClientset(objs...)
+}
+
+// TestCollectClusterDataWithOptions_DeterministicAcrossConcurrency verifies
+// that namespaces and pods come back sorted and identical regardless of the
+// number of workers.
+func TestCollectClusterDataWithOptions_DeterministicAcrossConcurrency(t *testing.T) {
+	client := manyPodsClient(1000)
+
+	base, err := CollectClusterDataWithOptions(context.Background(), client, ClusterInfo{}, CollectOptions{Concurrency: 1})
+	if err != nil {
+		t.Fatalf("CollectClusterDataWithOptions error: %v", err)
+	}
+	if len(base.Pods) != 1000 || len(base.Namespaces) != 10 {
+		t.Fatalf("got %d pods, %d namespaces; want 1000, 10", len(base.Pods), len(base.Namespaces))
+	}
+	for i := 1; i < len(base.Pods); i++ {
+		prev, cur := base.Pods[i-1], base.Pods[i]
+		if prev.Namespace > cur.Namespace || (prev.Namespace == cur.Namespace && prev.Name >= cur.Name) {
+			t.Fatalf("pods not sorted at %d: %s/%s before %s/%s", i, prev.Namespace, prev.Name, cur.Namespace, cur.Name)
+		}
+	}
+	if base.Namespaces[0].Name != "ns-0" || base.Namespaces[9].Name != "ns-9" {
+		t.Errorf("namespaces not sorted: first %q, last %q", base.Namespaces[0].Name, base.Namespaces[9].Name)
+	}
+
+	for _, workers := range []int{0, 3, 16, 2000} {
+		got, err := CollectClusterDataWithOptions(context.Background(), client, ClusterInfo{}, CollectOptions{Concurrency: workers})
+		if err != nil {
+			t.Fatalf("concurrency %d: %v", workers, err)
+		}
+		if !reflect.DeepEqual(got.Pods, base.Pods) || !reflect.DeepEqual(got.Namespaces, base.Namespaces) {
+			t.Errorf("concurrency %d: result differs from concurrency 1", workers)
+		}
+	}
+}
+
+// TestCollectClusterDataWithOptions_CancelledContext verifies that a cancelled
+// context aborts collection with the context error.
+func TestCollectClusterDataWithOptions_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := CollectClusterDataWithOptions(ctx, manyPodsClient(100), ClusterInfo{}, CollectOptions{Concurrency: 4})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v; want context.Canceled", err)
+	}
+}
+
+func BenchmarkCollectClusterDataWithOptions(b *testing.B) {
+	client := manyPodsClient(10000)
+	for _, workers := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("concurrency=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := CollectClusterDataWithOptions(context.Background(), client, ClusterInfo{}, CollectOptions{Concurrency: workers}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// TestCollectClusterData_HealthProbes verifies that liveness and readiness
+// probe presence is collected per container.
+func TestCollectClusterData_HealthProbes(t *testing.T) {
+	probed := makeContainer("probed", false, "", "")
+	probed.LivenessProbe = &corev1.Probe{}
+	probed.ReadinessProbe = &corev1.Probe{}
+	bare := makeContainer("bare", false, "", "")
+	pod := makePod("default", "probe-pod", []corev1.Container{probed, bare})
+	fakeClient := fake.NewSimpleClientset(pod)
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	cs := data.Pods[0].Containers
+	if len(cs) != 2 {
+		t.Fatalf("Containers = %+v; want 2", cs)
+	}
+	if !cs[0].HasLivenessProbe || !cs[0].HasReadinessProbe {
+		t.Errorf("probed container = %+v; want both probes", cs[0])
+	}
+	if cs[1].HasLivenessProbe || cs[1].HasReadinessProbe {
+		t.Errorf("bare container = %+v; want no probes", cs[1])
+	}
+}
+
+func TestCollectClusterData_SecretEnvRefs(t *testing.T) {
+	c := makeContainer("app", false, "", "")
+	c.Env = []corev1.EnvVar{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "DB_PASSWORD", ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "db-creds"}, Key: "password"},
+		}},
+		{Name: "REGION", ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "region"},
+		}},
+	}
+	c.EnvFrom = []corev1.EnvFromSource{
+		{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}}},
+		{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "api-keys"}}},
+	}
+	fakeClient := fake.NewSimpleClientset(makePod("default", "env-pod", []corev1.Container{c}))
+
+	data, err := CollectClusterData(context.Background(), fakeClient, ClusterInfo{})
+	if err != nil {
+		t.Fatalf("CollectClusterData error: %v", err)
+	}
+	refs := data.Pods[0].Containers[0].SecretEnvRefs
+	want := []SecretEnvRef{
+		{SecretName: "db-creds", EnvVar: "DB_PASSWORD", Key: "password"},
+		{SecretName: "api-keys"},
+	}
+	if len(refs) != len(want) || refs[0] != want[0] || refs[1] != want[1] {
+		t.Errorf("SecretEnvRefs = %+v; want %+v", refs, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	k8sclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
@@ -71,3 +72,22 @@ func LoadClientset(kubeconfigPath, contextName string) (k8sclient.Interface, Clu
 		Server:      server,
 	}, nil
 }
+
+// ListContexts returns the names of every context defined in the kubeconfig
+// file at path, sorted alphabetically.
+func ListContexts(kubeconfigPath string) ([]string, error) {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{
+		ExplicitPath: kubeconfigPath,
+	}
+	cfg := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{})
+	rawCfg, err := cfg.RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig %q: %w", kubeconfigPath, err)
+	}
+	names := make([]string, 0, len(rawCfg.Contexts))
+	for name := range rawCfg.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
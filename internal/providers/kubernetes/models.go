@@ -1,5 +1,7 @@
 package kubernetes
 
+import "time"
+
 // ClusterInfo identifies a Kubernetes cluster and the kubeconfig context used
 // to connect to it.
 type ClusterInfo struct {
@@ -48,6 +50,10 @@ type NamespaceInfo struct {
 	// this namespace, indicating default resource limits are configured.
 	HasLimitRange bool
 
+	// HasResourceQuota is true when at least one ResourceQuota object exists
+	// in this namespace, capping the namespace's aggregate resource usage.
+	HasResourceQuota bool
+
 	// Labels is a copy of the namespace's label map, used for Pod Security
 	// Admission enforcement checks.
 	Labels map[string]string
@@ -68,6 +74,9 @@ type ServiceAccountInfo struct {
 	// Annotations is a copy of the ServiceAccount's annotation map.
 	// Used to check for the IRSA annotation (eks.amazonaws.com/role-arn).
 	Annotations map[string]string
+
+	// CreationTimestamp is metadata.creationTimestamp.
+	CreationTimestamp time.Time
 }
 
 // ContainerInfo holds per-container security and resource request data.
@@ -75,6 +84,9 @@ type ContainerInfo struct {
 	// Name is the container name within the pod spec.
 	Name string
 
+	// Image is the container image reference from the pod spec.
+	Image string
+
 	// Privileged is true when securityContext.privileged == true.
 	Privileged bool
 
@@ -100,6 +112,28 @@ type ContainerInfo struct {
 	// overrides pod-level). Values: "RuntimeDefault", "Localhost", "Unconfined",
 	// or "" when not set.
 	SeccompProfileType string
+
+	// ReadOnlyRootFilesystem is true when
+	// securityContext.readOnlyRootFilesystem == true.
+	ReadOnlyRootFilesystem bool
+
+	// HasLivenessProbe is true when the container declares a livenessProbe.
+	HasLivenessProbe bool
+
+	// HasReadinessProbe is true when the container declares a readinessProbe.
+	HasReadinessProbe bool
+
+	// SecretEnvRefs lists the Secrets referenced by env[].valueFrom.secretKeyRef
+	// and envFrom[].secretRef, in spec order.
+	SecretEnvRefs []SecretEnvRef
+}
+
+// SecretEnvRef is one Secret reference in a container's environment.
+// EnvVar and Key are empty for envFrom[].secretRef.
+type SecretEnvRef struct {
+	SecretName string
+	EnvVar     string
+	Key        string
 }
 
 // PodInfo holds basic pod metadata and its container list.
@@ -123,8 +157,21 @@ type PodInfo struct {
 	// (spec.serviceAccountName).
 	ServiceAccountName string
 
+	// AutomountServiceAccountToken reflects spec.automountServiceAccountToken.
+	// Nil means the field was not set on the pod.
+	AutomountServiceAccountToken *bool
+
 	// Containers holds per-container security and resource data.
 	Containers []ContainerInfo
+
+	// InitContainers holds the same data for spec.initContainers.
+	InitContainers []ContainerInfo
+
+	// Labels is a copy of the pod's label map.
+	Labels map[string]string
+
+	// CreationTimestamp is metadata.creationTimestamp.
+	CreationTimestamp time.Time
 }
 
 // ServiceInfo holds basic Service metadata used for network exposure checks.
@@ -140,15 +187,154 @@ type ServiceInfo struct {
 
 	// Annotations is a copy of the Service's annotation map.
 	Annotations map[string]string
+
+	// CreationTimestamp is metadata.creationTimestamp.
+	CreationTimestamp time.Time
+}
+
+// IngressInfo holds the host and TLS configuration of an Ingress used for
+// data-in-transit checks.
+type IngressInfo struct {
+	// Name is the Ingress name.
+	Name string
+
+	// Namespace is the Kubernetes namespace that owns this Ingress.
+	Namespace string
+
+	// Hosts lists the distinct spec.rules[].host values in declaration order.
+	// Rules without a host (catch-all) are not included.
+	Hosts []string
+
+	// TLSHosts lists every host named in spec.tls[].hosts.
+	TLSHosts []string
+
+	// HasTLS is true when spec.tls contains at least one entry.
+	HasTLS bool
+
+	// CreationTimestamp is metadata.creationTimestamp.
+	CreationTimestamp time.Time
+}
+
+// WorkloadInfo holds the replica count and pod template labels of a
+// Deployment or StatefulSet, used for availability checks.
+type WorkloadInfo struct {
+	// Kind is "Deployment" or "StatefulSet".
+	Kind string
+
+	// Name is the workload name.
+	Name string
+
+	// Namespace is the Kubernetes namespace that owns this workload.
+	Namespace string
+
+	// Replicas is spec.replicas; 1 when unset (the Kubernetes default).
+	Replicas int
+
+	// PodLabels is a copy of spec.template.metadata.labels.
+	PodLabels map[string]string
+
+	// CreationTimestamp is metadata.creationTimestamp.
+	CreationTimestamp time.Time
+}
+
+// LabelSelectorRequirementInfo is one matchExpressions entry of a label
+// selector.
+type LabelSelectorRequirementInfo struct {
+	// Key is the label key the requirement applies to.
+	Key string
+
+	// Operator is "In", "NotIn", "Exists" or "DoesNotExist".
+	Operator string
+
+	// Values lists the operand values for In and NotIn.
+	Values []string
+}
+
+// PodDisruptionBudgetInfo holds the pod selector of a PodDisruptionBudget.
+// PDBs without a selector select no pods and are not collected.
+type PodDisruptionBudgetInfo struct {
+	// Name is the PodDisruptionBudget name.
+	Name string
+
+	// Namespace is the Kubernetes namespace that owns this PodDisruptionBudget.
+	Namespace string
+
+	// MatchLabels is a copy of spec.selector.matchLabels.
+	MatchLabels map[string]string
+
+	// MatchExpressions is spec.selector.matchExpressions.
+	MatchExpressions []LabelSelectorRequirementInfo
+}
+
+// SubjectInfo identifies a single subject of an RBAC binding.
+type SubjectInfo struct {
+	// Kind is the subject kind: "ServiceAccount", "User", or "Group".
+	Kind string
+
+	// Name is the subject name.
+	Name string
+
+	// Namespace is the subject namespace. Only set for ServiceAccount subjects.
+	Namespace string
+}
+
+// ClusterRoleBindingInfo holds the role reference and subjects of a
+// ClusterRoleBinding, used for RBAC privilege checks.
+type ClusterRoleBindingInfo struct {
+	// Name is the ClusterRoleBinding name.
+	Name string
+
+	// RoleName is roleRef.name (e.g. "cluster-admin").
+	RoleName string
+
+	// Subjects lists every subject bound by this ClusterRoleBinding.
+	Subjects []SubjectInfo
+}
+
+// PolicyRuleInfo holds the API groups, resources and verbs of one RBAC
+// PolicyRule. Non-resource URL rules are not collected.
+type PolicyRuleInfo struct {
+	// APIGroups lists the rule's apiGroups ("" is the core group, "*" is all).
+	APIGroups []string
+
+	// Resources lists the rule's resources ("*" is all).
+	Resources []string
+
+	// Verbs lists the rule's verbs ("*" is all).
+	Verbs []string
+}
+
+// RoleInfo holds the rules of a Role or ClusterRole, used for RBAC
+// permission checks.
+type RoleInfo struct {
+	// Kind is "Role" or "ClusterRole".
+	Kind string
+
+	// Name is the Role or ClusterRole name.
+	Name string
+
+	// Namespace is the Role namespace. Empty for ClusterRoles.
+	Namespace string
+
+	// Rules lists the role's resource rules in declaration order.
+	Rules []PolicyRuleInfo
 }
 
 // ClusterData is the inventory collected from a single Kubernetes cluster.
 // It is the k8s equivalent of models.AWSRegionData and is the input to k8s rules.
 type ClusterData struct {
-	ClusterInfo     ClusterInfo
-	Nodes           []NodeInfo
-	Namespaces      []NamespaceInfo
-	Pods            []PodInfo
-	Services        []ServiceInfo
-	ServiceAccounts []ServiceAccountInfo
+	ClusterInfo         ClusterInfo
+	Nodes               []NodeInfo
+	Namespaces          []NamespaceInfo
+	Pods                []PodInfo
+	Services            []ServiceInfo
+	Ingresses           []IngressInfo
+	Workloads           []WorkloadInfo
+	PDBs                []PodDisruptionBudgetInfo
+	ServiceAccounts     []ServiceAccountInfo
+	ClusterRoleBindings []ClusterRoleBindingInfo
+	Roles               []RoleInfo
+	// CollectErrors lists failures of the optional collections (see
+	// CollectClusterDataWithOptions); the matching fields are empty.
+	CollectErrors []error
 }
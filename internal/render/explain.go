@@ -34,6 +34,7 @@ func FindPathByScore(paths []models.AttackPath, score int) *models.AttackPath {
 //	ATTACK PATH (Score: 96)
 //	Description: Externally reachable workload can assume over-permissive cloud IAM role.
 //	Layers: Network Exposure → Workload Compromise → Cloud IAM Escalation
+//	ATT&CK: T1190, T1611, T1552.005, T1078.004
 //
 //	Findings (4):
 //
@@ -47,6 +48,9 @@ func RenderAttackPathExplanation(w io.Writer, path models.AttackPath, findings [
 	fmt.Fprintf(w, "ATTACK PATH (Score: %d)\n", path.Score)
 	fmt.Fprintf(w, "Description: %s\n", path.Description)
 	fmt.Fprintf(w, "Layers: %s\n", strings.Join(path.Layers, " → "))
+	if len(path.Techniques) > 0 {
+		fmt.Fprintf(w, "ATT&CK: %s\n", strings.Join(path.Techniques, ", "))
+	}
 	fmt.Fprintln(w)
 
 	// Build findingByID for fast lookup.
@@ -115,3 +119,128 @@ func WriteExplainJSON(w io.Writer, path *models.AttackPath, score int) error {
 		"attack_path": path,
 	})
 }
+
+// FindChainsByScore returns every RiskChain in chains whose Score equals score,
+// preserving input order. Several chains may share a score when their reasons
+// differ. Returns nil when no chain matches.
+func FindChainsByScore(chains []models.RiskChain, score int) []models.RiskChain {
+	var matched []models.RiskChain
+	for _, c := range chains {
+		if c.Score == score {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// RenderRiskChainExplanation writes the reason and participating findings of a
+// single risk chain to w. Only findings whose IDs appear in chain.FindingIDs
+// are rendered, one line per finding, sorted by rule ID then resource ID.
+//
+// Example output:
+//
+//	RISK CHAIN (Score: 80)
+//	Reason: Public service exposes privileged workload
+//
+//	Findings (2):
+//	  - K8S_POD_RUN_AS_ROOT  web-pod (prod)
+//	  - K8S_SERVICE_PUBLIC_LOADBALANCER  web-svc (prod)
+func RenderRiskChainExplanation(w io.Writer, chain models.RiskChain, findings []models.Finding) {
+	fmt.Fprintf(w, "RISK CHAIN (Score: %d)\n", chain.Score)
+	fmt.Fprintf(w, "Reason: %s\n", chain.Reason)
+	fmt.Fprintln(w)
+
+	findingByID := make(map[string]*models.Finding, len(findings))
+	for i := range findings {
+		f := &findings[i]
+		findingByID[f.ID] = f
+	}
+
+	var members []*models.Finding
+	for _, fid := range chain.FindingIDs {
+		if f, ok := findingByID[fid]; ok {
+			members = append(members, f)
+		}
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].RuleID != members[j].RuleID {
+			return members[i].RuleID < members[j].RuleID
+		}
+		return members[i].ResourceID < members[j].ResourceID
+	})
+
+	fmt.Fprintf(w, "Findings (%d):\n", len(members))
+	for _, f := range members {
+		ns := ""
+		if n, ok := f.Metadata["namespace"].(string); ok && n != "" {
+			ns = " (" + n + ")"
+		}
+		fmt.Fprintf(w, "  - %s  %s%s\n", f.RuleID, f.ResourceID, ns)
+	}
+}
+
+// WriteExplainChainJSON writes the risk chain explanation as indented JSON to w.
+//
+// When chains is non-empty, the output is:
+//
+//	{"risk_chains": [ ...chain objects... ]}
+//
+// When chains is empty (score not found in the report), the output is:
+//
+//	{"error": "No risk chain found with score N"}
+func WriteExplainChainJSON(w io.Writer, chains []models.RiskChain, score int) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if len(chains) == 0 {
+		return enc.Encode(map[string]string{
+			"error": fmt.Sprintf("No risk chain found with score %d", score),
+		})
+	}
+	return enc.Encode(map[string]any{
+		"risk_chains": chains,
+	})
+}
+
+// RenderAllExplanations writes the explanation of every attack path followed
+// by every risk chain to w, using RenderAttackPathExplanation and
+// RenderRiskChainExplanation, with a blank line between explanations. It
+// writes nothing when paths and chains are both empty.
+func RenderAllExplanations(w io.Writer, paths []models.AttackPath, chains []models.RiskChain, findings []models.Finding) {
+	first := true
+	separate := func() {
+		if !first {
+			fmt.Fprintln(w)
+		}
+		first = false
+	}
+	for _, p := range paths {
+		separate()
+		RenderAttackPathExplanation(w, p, findings)
+	}
+	for _, c := range chains {
+		separate()
+		RenderRiskChainExplanation(w, c, findings)
+	}
+}
+
+// WriteExplainAllJSON writes every attack path and risk chain as indented JSON
+// to w:
+//
+//	{"attack_paths": [ ... ], "risk_chains": [ ... ]}
+//
+// Both keys are always present; an empty report yields two empty arrays.
+func WriteExplainAllJSON(w io.Writer, paths []models.AttackPath, chains []models.RiskChain) error {
+	if paths == nil {
+		paths = []models.AttackPath{}
+	}
+	if chains == nil {
+		chains = []models.RiskChain{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]any{
+		"attack_paths": paths,
+		"risk_chains":  chains,
+	})
+}
@@ -97,6 +97,25 @@ func TestExplain_HappyPath(t *testing.T) {
 	}
 }
 
+// TestExplain_Techniques verifies that the ATT&CK line lists the path's
+// technique IDs and is omitted when the path has none.
+func TestExplain_Techniques(t *testing.T) {
+	path := makePath(98, "d", []string{"Network Exposure"}, nil)
+	path.Techniques = []string{"T1190", "T1611", "T1078"}
+
+	var buf bytes.Buffer
+	RenderAttackPathExplanation(&buf, path, nil)
+	if !strings.Contains(buf.String(), "ATT&CK: T1190, T1611, T1078\n") {
+		t.Errorf("missing ATT&CK line in output:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	RenderAttackPathExplanation(&buf, makePath(98, "d", nil, nil), nil)
+	if strings.Contains(buf.String(), "ATT&CK") {
+		t.Errorf("ATT&CK line rendered for a path without techniques:\n%s", buf.String())
+	}
+}
+
 // ── TestExplain_NoSuchScore ───────────────────────────────────────────────────
 
 // TestExplain_NoSuchScore verifies that FindPathByScore returns nil when no
@@ -229,3 +248,165 @@ func TestExplain_JSONMode(t *testing.T) {
 		}
 	})
 }
+
+// ── TestExplainChain ──────────────────────────────────────────────────────────
+
+// TestExplainChain_MatchingScore verifies that FindChainsByScore returns every
+// chain with the requested score and that RenderRiskChainExplanation prints the
+// reason plus only the findings in the chain.
+func TestExplainChain_MatchingScore(t *testing.T) {
+	chains := []models.RiskChain{
+		{Score: 80, Reason: "reason-80", FindingIDs: []string{"f1", "f2"}},
+		{Score: 60, Reason: "reason-60", FindingIDs: []string{"f3"}},
+	}
+	findings := []models.Finding{
+		makeFinding("f1", "K8S_PRIVILEGED_CONTAINER", "web-pod", map[string]any{"namespace": "prod"}),
+		makeFinding("f2", "K8S_POD_RUN_AS_ROOT", "web-pod", map[string]any{"namespace": "prod"}),
+		makeFinding("f3", "K8S_SERVICE_PUBLIC_LOADBALANCER", "other-svc", map[string]any{"namespace": "dev"}),
+	}
+
+	matched := FindChainsByScore(chains, 80)
+	if len(matched) != 1 {
+		t.Fatalf("FindChainsByScore(80) returned %d chains; want 1", len(matched))
+	}
+
+	var buf bytes.Buffer
+	RenderRiskChainExplanation(&buf, matched[0], findings)
+	out := buf.String()
+
+	for _, want := range []string{
+		"RISK CHAIN (Score: 80)",
+		"Reason: reason-80",
+		"Findings (2):",
+		"K8S_POD_RUN_AS_ROOT  web-pod (prod)",
+		"K8S_PRIVILEGED_CONTAINER  web-pod (prod)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\n--- output ---\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "other-svc") {
+		t.Errorf("output contains finding from another chain\n--- output ---\n%s", out)
+	}
+	// Rule IDs are sorted ascending.
+	if strings.Index(out, "K8S_POD_RUN_AS_ROOT") > strings.Index(out, "K8S_PRIVILEGED_CONTAINER") {
+		t.Errorf("findings not sorted by rule ID\n--- output ---\n%s", out)
+	}
+}
+
+// TestExplainChain_NoSuchScore verifies that a missing score yields no chains
+// and that the JSON writer emits an error object.
+func TestExplainChain_NoSuchScore(t *testing.T) {
+	chains := []models.RiskChain{{Score: 80, Reason: "r", FindingIDs: []string{"f1"}}}
+	if got := FindChainsByScore(chains, 999); got != nil {
+		t.Errorf("FindChainsByScore(999) = %+v; want nil", got)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteExplainChainJSON(&buf, nil, 999); err != nil {
+		t.Fatalf("WriteExplainChainJSON error: %v", err)
+	}
+	var out map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if out["error"] != "No risk chain found with score 999" {
+		t.Errorf("error = %q; want %q", out["error"], "No risk chain found with score 999")
+	}
+}
+
+// ── TestExplainAll ────────────────────────────────────────────────────────────
+
+// TestExplainAll_RendersEveryPathAndChain verifies that RenderAllExplanations
+// writes one explanation per attack path and risk chain, paths first.
+func TestExplainAll_RendersEveryPathAndChain(t *testing.T) {
+	paths := []models.AttackPath{
+		makePath(98, "path-98", []string{"Network Exposure", "Workload Compromise"}, []string{"f1"}),
+		makePath(90, "path-90", []string{"Workload Compromise"}, []string{"f2"}),
+	}
+	chains := []models.RiskChain{
+		{Score: 80, Reason: "reason-80", FindingIDs: []string{"f1", "f2"}},
+		{Score: 60, Reason: "reason-60", FindingIDs: []string{"f3"}},
+	}
+	findings := []models.Finding{
+		makeFinding("f1", "K8S_SERVICE_PUBLIC_LOADBALANCER", "web-svc", map[string]any{"namespace": "prod"}),
+		makeFinding("f2", "K8S_PRIVILEGED_CONTAINER", "web-pod", map[string]any{"namespace": "prod"}),
+		makeFinding("f3", "K8S_POD_RUN_AS_ROOT", "db-pod", nil),
+	}
+
+	var buf bytes.Buffer
+	RenderAllExplanations(&buf, paths, chains, findings)
+	out := buf.String()
+
+	headers := []string{
+		"ATTACK PATH (Score: 98)",
+		"ATTACK PATH (Score: 90)",
+		"RISK CHAIN (Score: 80)",
+		"RISK CHAIN (Score: 60)",
+	}
+	last := -1
+	for _, h := range headers {
+		i := strings.Index(out, h)
+		if i < 0 {
+			t.Errorf("output missing %q\n--- output ---\n%s", h, out)
+			continue
+		}
+		if i < last {
+			t.Errorf("%q out of order\n--- output ---\n%s", h, out)
+		}
+		last = i
+	}
+	for _, want := range []string{"Description: path-98", "Reason: reason-60", "K8S_POD_RUN_AS_ROOT  db-pod"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\n--- output ---\n%s", want, out)
+		}
+	}
+}
+
+// TestExplainAll_NoPathsOrChains verifies that nothing is written when the
+// report has no attack paths or risk chains, and that the JSON writer emits
+// empty arrays.
+func TestExplainAll_NoPathsOrChains(t *testing.T) {
+	var buf bytes.Buffer
+	RenderAllExplanations(&buf, nil, nil, []models.Finding{makeFinding("f1", "R", "res", nil)})
+	if buf.Len() != 0 {
+		t.Errorf("expected no output; got %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteExplainAllJSON(&buf, nil, nil); err != nil {
+		t.Fatalf("WriteExplainAllJSON error: %v", err)
+	}
+	var out map[string][]json.RawMessage
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	for _, key := range []string{"attack_paths", "risk_chains"} {
+		v, ok := out[key]
+		if !ok || v == nil || len(v) != 0 {
+			t.Errorf("%s = %v (present=%v); want empty array", key, v, ok)
+		}
+	}
+}
+
+// TestExplainAll_JSONIncludesEverything verifies that WriteExplainAllJSON
+// carries every path and chain.
+func TestExplainAll_JSONIncludesEverything(t *testing.T) {
+	paths := []models.AttackPath{makePath(98, "p", nil, nil)}
+	chains := []models.RiskChain{{Score: 80}, {Score: 60}}
+
+	var buf bytes.Buffer
+	if err := WriteExplainAllJSON(&buf, paths, chains); err != nil {
+		t.Fatalf("WriteExplainAllJSON error: %v", err)
+	}
+	var out struct {
+		AttackPaths []models.AttackPath `json:"attack_paths"`
+		RiskChains  []models.RiskChain  `json:"risk_chains"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(out.AttackPaths) != 1 || len(out.RiskChains) != 2 {
+		t.Errorf("got %d paths, %d chains; want 1 and 2", len(out.AttackPaths), len(out.RiskChains))
+	}
+}
@@ -17,9 +17,13 @@ func New() []rules.Rule {
 		rules.AWSEBSGP2LegacyRule{},
 		rules.AWSEC2LowCPURule{},
 		rules.AWSNATLowTrafficRule{},
+		rules.AWSNATGatewayIdleRule{},
 		rules.AWSSavingsPlanUnderutilizedRule{},
 		rules.AWSRDSLowCPURule{},
+		rules.AWSRDSOverprovisionedRule{},
 		rules.AWSALBIdleRule{},
+		rules.AWSLBIdleRule{},
 		rules.AWSEC2NoSavingsPlanRule{},
+		rules.AWSEIPUnattachedRule{},
 	}
 }
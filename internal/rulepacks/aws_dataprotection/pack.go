@@ -1,16 +1,22 @@
 // Package aws_dataprotection provides the AWS data-protection rule pack.
 // It groups encryption-at-rest checks for EBS volumes, RDS instances,
-// and S3 buckets into a single registration call.
+// and S3 buckets, plus RDS automated backups, S3 versioning, CloudWatch Logs
+// retention, and KMS key rotation, into a single registration call.
 package aws_dataprotection
 
 import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
 
 // New returns the complete set of AWS data-protection rules ordered by severity:
-// CRITICAL first (RDS), then HIGH (EBS, S3).
+// CRITICAL first (RDS), then HIGH (RDS backups, EBS, S3), then MEDIUM (S3 versioning, log
+// retention, KMS key rotation).
 func New() []rules.Rule {
 	return []rules.Rule{
 		rules.AWSRDSUnencryptedRule{},              // CRITICAL
+		rules.AWSRDSNoBackupRetentionRule{},        // HIGH
 		rules.AWSEBSUnencryptedRule{},              // HIGH
 		rules.AWSS3DefaultEncryptionMissingRule{},  // HIGH
+		rules.AWSS3VersioningDisabledRule{},        // MEDIUM
+		rules.AWSLogGroupNoRetentionRule{},         // MEDIUM
+		rules.AWSKMSKeyRotationDisabledRule{},      // MEDIUM
 	}
 }
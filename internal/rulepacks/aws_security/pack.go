@@ -16,9 +16,15 @@ func New() []rules.Rule {
 		rules.AWSRootAccountMFADisabledRule{},      // CRITICAL: root account MFA not enabled
 		rules.AWSCloudTrailNotMultiRegionRule{},    // HIGH:     no multi-region CloudTrail trail
 		rules.AWSS3PublicBucketRule{},              // HIGH:     S3 bucket lacks public access block
+		rules.AWSS3NoPublicAccessBlockRule{},       // HIGH:     S3 bucket public access block incomplete
 		rules.AWSSecurityGroupOpenSSHRule{},        // HIGH:     security group exposes SSH to internet
+		rules.AWSEC2IMDSv1AllowedRule{},            // HIGH:     EC2 instance metadata accepts IMDSv1
 		rules.AWSGuardDutyDisabledRule{},           // HIGH:     GuardDuty not enabled in region
 		rules.AWSConfigDisabledRule{},              // HIGH:     AWS Config not enabled in region
+		rules.AWSECRImageCriticalCVERule{},         // HIGH:     latest ECR image has CRITICAL CVEs
+		rules.AWSACMCertExpiringRule{},             // HIGH:     ACM certificate expiring (CRITICAL once expired)
+		rules.AWSIAMAccessKeyStaleRule{},           // HIGH:     active IAM access key older than max age
 		rules.AWSIAMUserWithoutMFARule{},           // MEDIUM:   IAM user has no MFA device
+		rules.AWSVPCNoFlowLogsRule{},               // MEDIUM:   VPC has no active flow log
 	}
 }
@@ -0,0 +1,20 @@
+// Package azure_cost provides the rule pack for Azure cost audit.
+// New returns every Azure cost rule in evaluation order; callers register them
+// into a RuleRegistry via a loop rather than listing each rule explicitly.
+//
+// Adding a new Azure cost rule:
+//  1. Implement the rule in internal/rules/ following the Rule interface,
+//     reading its inputs from RuleContext.AzureData.
+//  2. Append it to the slice returned by New().
+//  3. No other files need to change.
+package azure_cost
+
+import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+
+// New returns all Azure cost rules in the order they should be evaluated.
+func New() []rules.Rule {
+	return []rules.Rule{
+		rules.AzureVMIdleRule{},
+		rules.AzureDiskUnattachedRule{},
+	}
+}
@@ -9,7 +9,7 @@ import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
 func New() []rules.Rule {
 	return []rules.Rule{
 		rules.K8SPrivilegedContainerRule{},        // CRITICAL
-		rules.K8SClusterSingleNodeRule{},           // HIGH
+		rules.K8SClusterInsufficientNodesRule{}, // HIGH
 		rules.K8SNodeOverallocatedRule{},           // HIGH
 		rules.K8SServicePublicLoadBalancerRule{},   // HIGH
 		rules.K8SNamespaceWithoutLimitsRule{},      // MEDIUM
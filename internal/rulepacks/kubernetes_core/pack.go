@@ -3,33 +3,99 @@
 // underlying cloud provider.
 package kubernetes_core
 
-import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+import (
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
+)
+
+const (
+	// nodeAllocatableMinPctParam is the K8S_NODE_OVERALLOCATED policy param that
+	// overrides the default 20% allocatable-CPU threshold.
+	nodeAllocatableMinPctParam = "node_allocatable_min_pct"
+
+	// minNodesParam is the K8S_CLUSTER_INSUFFICIENT_NODES policy param that
+	// overrides the default minimum of 2 nodes.
+	minNodesParam = "min_nodes"
+)
+
+// Options holds rule settings chosen on the command line rather than in
+// dp.yaml.
+type Options struct {
+	// StrictRoot reports implicit root in K8S_POD_RUN_AS_ROOT at HIGH.
+	StrictRoot bool
+}
 
 // New returns the complete set of cloud-agnostic Kubernetes governance rules
-// ordered by severity: CRITICAL first, then HIGH, then MEDIUM.
+// ordered by severity: CRITICAL first, then HIGH, then MEDIUM, then LOW.
 // Includes PSS Phase 3A rules and Phase 3B admission/SA governance rules.
-func New() []rules.Rule {
+//
+// cfg supplies rule settings that are fixed at construction time
+// (K8S_NODE_OVERALLOCATED params.node_allocatable_min_pct,
+// K8S_CLUSTER_INSUFFICIENT_NODES params.min_nodes, the internal_lb_annotations
+// used by K8S_SERVICE_PUBLIC_LOADBALANCER, and the system_namespaces skipped
+// by K8S_NAMESPACE_NO_PSA and K8S_NAMESPACE_NO_RESOURCEQUOTA). It may be nil, in which case every rule uses its
+// built-in default.
+func New(cfg *policy.PolicyConfig) []rules.Rule {
+	return NewWithOptions(cfg, Options{})
+}
+
+// NewWithOptions is New with command-line rule settings applied.
+func NewWithOptions(cfg *policy.PolicyConfig, opts Options) []rules.Rule {
+	overallocated := rules.K8SNodeOverallocatedRule{}
+	overallocated.MinAllocatablePct = policy.GetThreshold(
+		overallocated.ID(), nodeAllocatableMinPctParam, 0, cfg,
+	)
+
+	insufficientNodes := rules.K8SClusterInsufficientNodesRule{}
+	insufficientNodes.MinNodes = int(policy.GetThreshold(
+		insufficientNodes.ID(), minNodesParam, 0, cfg,
+	))
+
+	publicLB := rules.K8SServicePublicLoadBalancerRule{}
+	noPSA := rules.K8SNamespaceNoPSARule{}
+	noQuota := rules.K8SNamespaceNoResourceQuotaRule{}
+	if cfg != nil {
+		publicLB.InternalAnnotations = cfg.InternalLBAnnotations
+		noPSA.SystemNamespaces = cfg.SystemNamespaces
+		noQuota.SystemNamespaces = cfg.SystemNamespaces
+	}
+	runAsRoot := rules.K8SPSSRunAsRootRule{StrictRoot: opts.StrictRoot}
+
 	return []rules.Rule{
 		// CRITICAL
-		rules.K8SPrivilegedContainerRule{},       // K8S_PRIVILEGED_CONTAINER
-		rules.K8SPSSPrivilegedContainerRule{},    // K8S_POD_PRIVILEGED_CONTAINER (PSS)
+		rules.K8SPrivilegedContainerRule{},        // K8S_PRIVILEGED_CONTAINER
+		rules.K8SPSSPrivilegedContainerRule{},     // K8S_POD_PRIVILEGED_CONTAINER (PSS)
+		rules.K8SServiceAccountClusterAdminRule{}, // K8S_SERVICEACCOUNT_CLUSTER_ADMIN
 
 		// HIGH
-		rules.K8SClusterSingleNodeRule{},                     // K8S_CLUSTER_SINGLE_NODE
-		rules.K8SNodeOverallocatedRule{},                     // K8S_NODE_OVERALLOCATED
-		rules.K8SServicePublicLoadBalancerRule{},             // K8S_SERVICE_PUBLIC_LOADBALANCER
+		insufficientNodes,                                    // K8S_CLUSTER_INSUFFICIENT_NODES
+		overallocated,                                        // K8S_NODE_OVERALLOCATED
+		publicLB,                                             // K8S_SERVICE_PUBLIC_LOADBALANCER
 		rules.K8SPSSHostNetworkRule{},                        // K8S_POD_HOST_NETWORK (PSS)
 		rules.K8SPSSHostPIDOrIPCRule{},                       // K8S_POD_HOST_PID_OR_IPC (PSS)
-		rules.K8SPSSRunAsRootRule{},                          // K8S_POD_RUN_AS_ROOT (PSS)
+		runAsRoot,                                            // K8S_POD_RUN_AS_ROOT (PSS)
 		rules.K8SPSSCapSysAdminRule{},                        // K8S_POD_CAP_SYS_ADMIN (PSS)
+		rules.K8SPodDangerousCapabilityRule{},                // K8S_POD_DANGEROUS_CAPABILITY
 		rules.K8SPodSecurityAdmissionNotEnforcedRule{},       // K8S_POD_SECURITY_ADMISSION_NOT_ENFORCED
+		rules.K8SRBACWildcardPermissionRule{},                // K8S_RBAC_WILDCARD_PERMISSION
 
 		// MEDIUM
 		rules.K8SNamespaceWithoutLimitsRule{},                // K8S_NAMESPACE_WITHOUT_LIMITS
 		rules.K8SPodNoResourceRequestsRule{},                 // K8S_POD_NO_RESOURCE_REQUESTS
 		rules.K8SPSSNoSeccompRule{},                          // K8S_POD_NO_SECCOMP (PSS)
 		rules.K8SNamespacePSSNotSetRule{},                    // K8S_NAMESPACE_PSS_NOT_SET
+		noPSA,                                                // K8S_NAMESPACE_NO_PSA
 		rules.K8SServiceAccountTokenAutomountRule{},          // K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT
+		rules.K8SPodAutomountTokenRule{},                     // K8S_POD_AUTOMOUNT_TOKEN
 		rules.K8SDefaultServiceAccountUsedRule{},             // K8S_DEFAULT_SERVICEACCOUNT_USED
+		rules.K8SPodImageLatestTagRule{},                     // K8S_POD_IMAGE_LATEST_TAG
+		rules.K8SPodReadOnlyRootFSDisabledRule{},             // K8S_POD_READONLY_ROOT_FS_DISABLED
+		rules.K8SIngressNoTLSRule{},                          // K8S_INGRESS_NO_TLS
+
+		// LOW
+		rules.K8SDeploymentNoPDBRule{},                       // K8S_DEPLOYMENT_NO_PDB
+		noQuota,                                              // K8S_NAMESPACE_NO_RESOURCEQUOTA
+		rules.K8SPodNoHealthProbesRule{},                     // K8S_POD_NO_HEALTH_PROBES
+		rules.K8SPodSecretEnvRule{},                          // K8S_POD_SECRET_ENV
 	}
 }
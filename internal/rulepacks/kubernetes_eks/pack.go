@@ -11,17 +11,29 @@ import "github.com/pankaj-dahiya-devops/Devops-proxy/internal/rules"
 //   - EKS_NODE_ROLE_OVERPERMISSIVE     — node group IAM role has AdministratorAccess or Action:"*"
 //
 // HIGH:
+//   - K8S_SECRET_UNENCRYPTED_ETCD      — Secrets not covered by envelope encryption
 //   - EKS_PUBLIC_ENDPOINT_ENABLED      — API server endpoint publicly accessible
 //   - EKS_CONTROL_PLANE_LOGGING_DISABLED — api/audit/authenticator logs not all enabled
 //   - EKS_OIDC_PROVIDER_NOT_ASSOCIATED — no IAM OIDC provider associated; IRSA unavailable
 //   - EKS_SERVICEACCOUNT_NO_IRSA       — ServiceAccount missing eks.amazonaws.com/role-arn
+//   - EKS_OIDC_ISSUER_MISMATCH         — associated OIDC provider does not match cluster issuer
+//   - EKS_VERSION_END_OF_SUPPORT       — Kubernetes version past AWS standard support (MEDIUM within 90 days)
+//   - EKS_NODE_SG_OPEN_INGRESS         — node security group open to 0.0.0.0/0 on SSH, RDP, etcd, or kubelet ports
+//
+// MEDIUM:
+//   - EKS_ADDON_OUTDATED               — managed add-on more than one minor version behind latest
 func New() []rules.Rule {
 	return []rules.Rule{
 		rules.EKSEncryptionDisabledRule{},             // CRITICAL (5A)
 		rules.EKSNodeRoleOverpermissiveRule{},         // CRITICAL (5B)
+		rules.K8SSecretUnencryptedEtcdRule{},          // HIGH
 		rules.EKSPublicEndpointRule{},                 // HIGH (5A)
 		rules.EKSControlPlaneLoggingDisabledRule{},    // HIGH (5A)
 		rules.EKSOIDCProviderNotAssociatedRule{},      // HIGH (5B)
 		rules.EKSServiceAccountNoIRSARule{},           // HIGH (5B)
+		rules.EKSOIDCIssuerMismatchRule{},             // HIGH
+		rules.EKSVersionEndOfSupportRule{},            // HIGH
+		rules.EKSNodeSGOpenIngressRule{},              // HIGH
+		rules.EKSAddonOutdatedRule{},                  // MEDIUM
 	}
 }
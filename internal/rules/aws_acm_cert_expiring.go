@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+const (
+	acmCertExpiringRuleID = "AWS_ACM_CERT_EXPIRING"
+
+	// acmCertExpiringWindowDays is how many days before NotAfter a
+	// certificate is reported. 30 days leaves time to fix a renewal that
+	// ACM cannot complete on its own (imported certificates, failed DNS
+	// validation).
+	acmCertExpiringWindowDays = 30.0
+)
+
+// AWSACMCertExpiringRule flags ACM certificates that expire within the
+// configured window (rules.AWS_ACM_CERT_EXPIRING.params.window_days, default
+// 30). Certificates that have already expired are reported as CRITICAL.
+// Certificates without an expiry time (not yet issued) are skipped.
+type AWSACMCertExpiringRule struct{}
+
+func (r AWSACMCertExpiringRule) ID() string   { return acmCertExpiringRuleID }
+func (r AWSACMCertExpiringRule) Name() string { return "ACM Certificate Expiring" }
+
+// Evaluate returns one HIGH finding per certificate expiring within the
+// window and one CRITICAL finding per certificate already past NotAfter.
+func (r AWSACMCertExpiringRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	windowDays := policy.GetThreshold(acmCertExpiringRuleID, "window_days", acmCertExpiringWindowDays, ctx.Policy)
+	now := time.Now().UTC()
+
+	var findings []models.Finding
+	for _, cert := range ctx.RegionData.Security.ACMCertificates {
+		if cert.NotAfter.IsZero() {
+			continue
+		}
+		remaining := cert.NotAfter.Sub(now).Hours() / 24
+		if remaining > windowDays {
+			continue
+		}
+		daysToExpiry := int(remaining)
+
+		severity := models.SeverityHigh
+		explanation := fmt.Sprintf("ACM certificate for %s in region %s expires in %d days (%s).",
+			cert.DomainName, cert.Region, daysToExpiry, cert.NotAfter.Format("2006-01-02"))
+		if remaining < 0 {
+			severity = models.SeverityCritical
+			explanation = fmt.Sprintf("ACM certificate for %s in region %s expired %d days ago (%s).",
+				cert.DomainName, cert.Region, -daysToExpiry, cert.NotAfter.Format("2006-01-02"))
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), cert.CertificateARN),
+			RuleID:         r.ID(),
+			ResourceID:     cert.CertificateARN,
+			ResourceType:   models.ResourceAWSACMCert,
+			Region:         cert.Region,
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       severity,
+			Explanation:    explanation,
+			Recommendation: "Check why ACM has not renewed the certificate: re-import imported certificates with a new one, and fix DNS or email validation for ACM-issued certificates. Delete the certificate if nothing uses it.",
+			DetectedAt:     now,
+			Metadata: map[string]any{
+				"domain_name":    cert.DomainName,
+				"days_to_expiry": daysToExpiry,
+				"not_after":      cert.NotAfter.Format(time.RFC3339),
+				"status":         cert.Status,
+				"in_use":         cert.InUse,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// expiresIn returns a NotAfter days days from now, padded by an hour away
+// from the boundary so the whole-day count computed by the rule is exactly
+// days.
+func expiresIn(days int) time.Time {
+	pad := time.Hour
+	if days < 0 {
+		pad = -pad
+	}
+	return time.Now().UTC().Add(time.Duration(days)*24*time.Hour + pad)
+}
+
+func acmCertCtx(certs ...models.AWSACMCertificate) RuleContext {
+	return RuleContext{
+		AccountID: "111122223333",
+		Profile:   "prod",
+		RegionData: &models.AWSRegionData{
+			Region:   "global",
+			Security: models.AWSSecurityData{ACMCertificates: certs},
+		},
+	}
+}
+
+func acmCert(domain string, notAfter time.Time) models.AWSACMCertificate {
+	return models.AWSACMCertificate{
+		CertificateARN: "arn:aws:acm:eu-west-1:111122223333:certificate/" + domain,
+		DomainName:     domain,
+		Region:         "eu-west-1",
+		Status:         "ISSUED",
+		NotAfter:       notAfter,
+	}
+}
+
+func TestAWSACMCertExpiringRule_ID(t *testing.T) {
+	if id := (AWSACMCertExpiringRule{}).ID(); id != "AWS_ACM_CERT_EXPIRING" {
+		t.Errorf("ID = %q; want AWS_ACM_CERT_EXPIRING", id)
+	}
+}
+
+func TestAWSACMCertExpiringRule_NilRegionData(t *testing.T) {
+	if findings := (AWSACMCertExpiringRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSACMCertExpiringRule_ExpiringSoon_FiresHigh(t *testing.T) {
+	ctx := acmCertCtx(acmCert("api.example.com", expiresIn(10)))
+	findings := AWSACMCertExpiringRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("severity = %s; want HIGH", f.Severity)
+	}
+	if f.ResourceType != models.ResourceAWSACMCert || f.Region != "eu-west-1" {
+		t.Errorf("resource = %s in %s; want ACM_CERTIFICATE in eu-west-1", f.ResourceType, f.Region)
+	}
+	if f.Metadata["domain_name"] != "api.example.com" || f.Metadata["days_to_expiry"] != 10 {
+		t.Errorf("metadata = %v; want domain_name api.example.com, days_to_expiry 10", f.Metadata)
+	}
+}
+
+func TestAWSACMCertExpiringRule_LongValid_NoFinding(t *testing.T) {
+	ctx := acmCertCtx(acmCert("api.example.com", expiresIn(200)))
+	if findings := (AWSACMCertExpiringRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for a certificate valid for 200 days, got %d", len(findings))
+	}
+}
+
+func TestAWSACMCertExpiringRule_AlreadyExpired_FiresCritical(t *testing.T) {
+	ctx := acmCertCtx(acmCert("old.example.com", expiresIn(-5)))
+	findings := AWSACMCertExpiringRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityCritical {
+		t.Errorf("severity = %s; want CRITICAL", f.Severity)
+	}
+	if f.Metadata["days_to_expiry"] != -5 {
+		t.Errorf("days_to_expiry = %v; want -5", f.Metadata["days_to_expiry"])
+	}
+}
+
+func TestAWSACMCertExpiringRule_NotIssued_Skipped(t *testing.T) {
+	ctx := acmCertCtx(models.AWSACMCertificate{CertificateARN: "arn:pending", Status: "PENDING_VALIDATION"})
+	if findings := (AWSACMCertExpiringRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for a certificate without NotAfter, got %d", len(findings))
+	}
+}
+
+func TestAWSACMCertExpiringRule_WindowOverride(t *testing.T) {
+	// 45 days out is beyond the default 30-day window; widening window_days
+	// to 60 reports it.
+	ctx := acmCertCtx(acmCert("api.example.com", expiresIn(45)))
+	if findings := (AWSACMCertExpiringRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Fatalf("want 0 findings with the default window, got %d", len(findings))
+	}
+	ctx.Policy = &policy.PolicyConfig{
+		Rules: map[string]policy.RuleConfig{
+			"AWS_ACM_CERT_EXPIRING": {Params: map[string]float64{"window_days": 60}},
+		},
+	}
+	if findings := (AWSACMCertExpiringRule{}).Evaluate(ctx); len(findings) != 1 {
+		t.Errorf("want 1 finding with window_days=60, got %d", len(findings))
+	}
+}
@@ -49,6 +49,7 @@ func (r AWSALBIdleRule) Evaluate(ctx RuleContext) []models.Finding {
 			// ALB fixed cost: ~$0.008/hr × 730 hr/mo = ~$5.84/mo base + LCU charges.
 			// Conservative estimate of $18/mo covers base + minimal LCU usage.
 			Severity:                models.SeverityHigh,
+			Confidence:              models.ConfidenceMedium,
 			EstimatedMonthlySavings: 18.0,
 			Explanation:             "Application Load Balancer has received no traffic over the evaluation period.",
 			Recommendation:          "Verify the load balancer is not needed and delete it to stop incurring hourly charges.",
@@ -8,24 +8,52 @@ import (
 )
 
 // AWSCloudTrailNotMultiRegionRule flags accounts that have no multi-region
-// CloudTrail trail. A multi-region trail is required to capture API activity
-// across all regions; single-region trails leave blind spots that attackers
-// can exploit by operating in unmonitored regions.
+// CloudTrail trail logging management events. A multi-region trail is
+// required to capture API activity across all regions; single-region trails
+// leave blind spots that attackers can exploit by operating in unmonitored
+// regions, and a stopped trail or one excluding management events records
+// nothing useful. Formerly CLOUDTRAIL_NOT_MULTI_REGION.
 type AWSCloudTrailNotMultiRegionRule struct{}
 
-func (r AWSCloudTrailNotMultiRegionRule) ID() string { return "CLOUDTRAIL_NOT_MULTI_REGION" }
+func (r AWSCloudTrailNotMultiRegionRule) ID() string { return "AWS_CLOUDTRAIL_NOT_MULTIREGION" }
 func (r AWSCloudTrailNotMultiRegionRule) Name() string {
 	return "No Multi-Region CloudTrail Trail"
 }
+func (r AWSCloudTrailNotMultiRegionRule) Frameworks() []string {
+	return []string{frameworkCIS14, frameworkPCIDSS}
+}
 
-// Evaluate returns one HIGH finding when no multi-region trail exists.
+// Evaluate returns one HIGH finding when no multi-region trail is logging
+// management events. Metadata lists the account's trail names and a coverage
+// summary: "none", "single-region", or "multi-region-inactive".
 func (r AWSCloudTrailNotMultiRegionRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.RegionData == nil {
 		return nil
 	}
-	if ctx.RegionData.Security.CloudTrail.HasMultiRegionTrail {
+	ct := ctx.RegionData.Security.CloudTrail
+	if ct.HasMultiRegionTrail {
 		return nil
 	}
+
+	names := make([]string, 0, len(ct.Trails))
+	coverage := "none"
+	for _, t := range ct.Trails {
+		names = append(names, t.Name)
+		if t.IsMultiRegion {
+			coverage = "multi-region-inactive"
+		} else if coverage == "none" {
+			coverage = "single-region"
+		}
+	}
+
+	explanation := "No CloudTrail trail is configured. API activity is not logged in any region."
+	switch coverage {
+	case "single-region":
+		explanation = fmt.Sprintf("Only single-region CloudTrail trails are configured (%d). API activity in other regions goes unlogged.", len(names))
+	case "multi-region-inactive":
+		explanation = "No multi-region CloudTrail trail is logging management events. A multi-region trail exists but is stopped or excludes management events."
+	}
+
 	return []models.Finding{
 		{
 			ID:             fmt.Sprintf("%s-%s", r.ID(), ctx.AccountID),
@@ -36,9 +64,13 @@ func (r AWSCloudTrailNotMultiRegionRule) Evaluate(ctx RuleContext) []models.Find
 			AccountID:      ctx.AccountID,
 			Profile:        ctx.Profile,
 			Severity:       models.SeverityHigh,
-			Explanation:    "No multi-region CloudTrail trail is configured. API activity in some regions may go unlogged.",
-			Recommendation: "Create a multi-region CloudTrail trail that captures events from all AWS regions and stores logs in a secure S3 bucket.",
+			Explanation:    explanation,
+			Recommendation: "Create a multi-region CloudTrail trail that captures management events from all AWS regions, start logging on it, and store logs in a secure S3 bucket.",
 			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"trail_names": names,
+				"coverage":    coverage,
+			},
 		},
 	}
 }
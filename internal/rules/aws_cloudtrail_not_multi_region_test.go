@@ -6,10 +6,20 @@ import (
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
+func cloudTrailCtx(status models.AWSCloudTrailStatus) RuleContext {
+	return RuleContext{
+		AccountID: "123456789012",
+		Profile:   "prod",
+		RegionData: &models.AWSRegionData{
+			Security: models.AWSSecurityData{CloudTrail: status},
+		},
+	}
+}
+
 func TestAWSCloudTrailNotMultiRegionRule_ID(t *testing.T) {
 	r := AWSCloudTrailNotMultiRegionRule{}
-	if r.ID() != "CLOUDTRAIL_NOT_MULTI_REGION" {
-		t.Errorf("expected CLOUDTRAIL_NOT_MULTI_REGION, got %s", r.ID())
+	if r.ID() != "AWS_CLOUDTRAIL_NOT_MULTIREGION" {
+		t.Errorf("expected AWS_CLOUDTRAIL_NOT_MULTIREGION, got %s", r.ID())
 	}
 }
 
@@ -22,36 +32,31 @@ func TestAWSCloudTrailNotMultiRegionRule_NilRegionData(t *testing.T) {
 
 func TestAWSCloudTrailNotMultiRegionRule_HasMultiRegion_NotFlagged(t *testing.T) {
 	r := AWSCloudTrailNotMultiRegionRule{}
-	ctx := RuleContext{
-		RegionData: &models.AWSRegionData{
-			Security: models.AWSSecurityData{
-				CloudTrail: models.AWSCloudTrailStatus{HasMultiRegionTrail: true},
-			},
+	ctx := cloudTrailCtx(models.AWSCloudTrailStatus{
+		HasMultiRegionTrail: true,
+		Trails: []models.AWSCloudTrailTrail{
+			{Name: "org-trail", HomeRegion: "us-east-1", IsMultiRegion: true, IsLogging: true, ManagementEvents: true},
 		},
-	}
+	})
 	if findings := r.Evaluate(ctx); len(findings) != 0 {
 		t.Errorf("expected 0 findings when multi-region trail exists, got %d", len(findings))
 	}
 }
 
-func TestAWSCloudTrailNotMultiRegionRule_NoMultiRegion_Flagged(t *testing.T) {
+func TestAWSCloudTrailNotMultiRegionRule_SingleRegionOnly_Flagged(t *testing.T) {
 	r := AWSCloudTrailNotMultiRegionRule{}
-	ctx := RuleContext{
-		AccountID: "123456789012",
-		Profile:   "prod",
-		RegionData: &models.AWSRegionData{
-			Security: models.AWSSecurityData{
-				CloudTrail: models.AWSCloudTrailStatus{HasMultiRegionTrail: false},
-			},
+	ctx := cloudTrailCtx(models.AWSCloudTrailStatus{
+		Trails: []models.AWSCloudTrailTrail{
+			{Name: "app-trail", HomeRegion: "eu-west-1", IsLogging: true, ManagementEvents: true},
 		},
-	}
+	})
 	findings := r.Evaluate(ctx)
 	if len(findings) != 1 {
-		t.Fatalf("expected 1 finding when no multi-region trail, got %d", len(findings))
+		t.Fatalf("expected 1 finding when only single-region trails exist, got %d", len(findings))
 	}
 	f := findings[0]
-	if f.RuleID != "CLOUDTRAIL_NOT_MULTI_REGION" {
-		t.Errorf("expected CLOUDTRAIL_NOT_MULTI_REGION, got %s", f.RuleID)
+	if f.RuleID != "AWS_CLOUDTRAIL_NOT_MULTIREGION" {
+		t.Errorf("expected AWS_CLOUDTRAIL_NOT_MULTIREGION, got %s", f.RuleID)
 	}
 	if f.Severity != models.SeverityHigh {
 		t.Errorf("expected HIGH severity, got %s", f.Severity)
@@ -59,18 +64,38 @@ func TestAWSCloudTrailNotMultiRegionRule_NoMultiRegion_Flagged(t *testing.T) {
 	if f.Region != "global" {
 		t.Errorf("expected global region, got %s", f.Region)
 	}
+	if f.Metadata["coverage"] != "single-region" {
+		t.Errorf("coverage = %v; want single-region", f.Metadata["coverage"])
+	}
+	if names, _ := f.Metadata["trail_names"].([]string); len(names) != 1 || names[0] != "app-trail" {
+		t.Errorf("trail_names = %v; want [app-trail]", f.Metadata["trail_names"])
+	}
+}
+
+func TestAWSCloudTrailNotMultiRegionRule_MultiRegionNotLogging_Flagged(t *testing.T) {
+	r := AWSCloudTrailNotMultiRegionRule{}
+	ctx := cloudTrailCtx(models.AWSCloudTrailStatus{
+		Trails: []models.AWSCloudTrailTrail{
+			{Name: "org-trail", HomeRegion: "us-east-1", IsMultiRegion: true, IsLogging: false, ManagementEvents: true},
+		},
+	})
+	findings := r.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for a stopped multi-region trail, got %d", len(findings))
+	}
+	if findings[0].Metadata["coverage"] != "multi-region-inactive" {
+		t.Errorf("coverage = %v; want multi-region-inactive", findings[0].Metadata["coverage"])
+	}
 }
 
 func TestAWSCloudTrailNotMultiRegionRule_NoTrailsAtAll_Flagged(t *testing.T) {
 	// Zero value for AWSCloudTrailStatus means HasMultiRegionTrail == false.
 	r := AWSCloudTrailNotMultiRegionRule{}
-	ctx := RuleContext{
-		AccountID: "123456789012",
-		RegionData: &models.AWSRegionData{
-			Security: models.AWSSecurityData{},
-		},
+	findings := r.Evaluate(cloudTrailCtx(models.AWSCloudTrailStatus{}))
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding when no trails configured, got %d", len(findings))
 	}
-	if findings := r.Evaluate(ctx); len(findings) != 1 {
-		t.Errorf("expected 1 finding when no trails configured, got %d", len(findings))
+	if findings[0].Metadata["coverage"] != "none" {
+		t.Errorf("coverage = %v; want none", findings[0].Metadata["coverage"])
 	}
 }
@@ -15,6 +15,9 @@ type AWSConfigDisabledRule struct{}
 
 func (r AWSConfigDisabledRule) ID() string   { return "AWS_CONFIG_DISABLED" }
 func (r AWSConfigDisabledRule) Name() string { return "AWS Config Not Enabled In Region" }
+func (r AWSConfigDisabledRule) Frameworks() []string {
+	return []string{frameworkCIS14}
+}
 
 // Evaluate returns one HIGH finding per region where AWS Config is not recording.
 func (r AWSConfigDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
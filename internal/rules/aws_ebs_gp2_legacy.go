@@ -8,21 +8,32 @@ import (
 )
 
 const (
-	ebsGP2LegacyRuleID = "EBS_GP2_LEGACY"
-	// ebsGP2SavingsPerGBMonth is a placeholder representing the ~20% cost
-	// reduction when migrating from gp2 ($0.10/GB-mo) to gp3 ($0.08/GB-mo).
-	ebsGP2SavingsPerGBMonth = 0.02
+	ebsGP2LegacyRuleID = "AWS_EBS_GP2_LEGACY"
+
+	// us-east-1 gp2 and gp3 storage prices, and the gp3 charge per
+	// provisioned IOPS above the free 3,000 IOPS baseline.
+	gp2PricePerGBMonth   = 0.10
+	gp3PricePerGBMonth   = 0.08
+	gp3PricePerIOPSMonth = 0.005
+
+	// gp2 delivers 3 baseline IOPS per GB, capped at 16,000; gp3 includes
+	// 3,000 IOPS at no extra charge.
+	gp2IOPSPerGB    = 3
+	gp2MaxIOPS      = 16000
+	gp3BaselineIOPS = 3000
 )
 
-// AWSEBSGP2LegacyRule flags EBS volumes still using the legacy gp2 volume type.
-// gp2 volumes cost more per GB than gp3 and offer no performance advantage
-// for most workloads; migrating is low-risk and requires no downtime.
+// AWSEBSGP2LegacyRule flags EBS volumes still using the legacy gp2 volume type
+// when gp3 delivers the same baseline IOPS for less. gp2 volumes cost more per
+// GB than gp3 and offer no performance advantage for most workloads; migrating
+// is low-risk and requires no downtime. Formerly EBS_GP2_LEGACY.
 type AWSEBSGP2LegacyRule struct{}
 
 func (r AWSEBSGP2LegacyRule) ID() string   { return ebsGP2LegacyRuleID }
 func (r AWSEBSGP2LegacyRule) Name() string { return "Legacy gp2 EBS Volume" }
 
-// Evaluate returns one Finding per gp2 volume found in ctx.RegionData.
+// Evaluate returns one LOW Finding per gp2 volume in ctx.RegionData whose
+// gp2→gp3 price delta (gp3GP2Savings) is positive.
 func (r AWSEBSGP2LegacyRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.RegionData == nil {
 		return nil
@@ -34,7 +45,10 @@ func (r AWSEBSGP2LegacyRule) Evaluate(ctx RuleContext) []models.Finding {
 			continue
 		}
 
-		savings := float64(vol.SizeGB) * ebsGP2SavingsPerGBMonth
+		savings := gp3GP2Savings(vol.SizeGB)
+		if savings <= 0 {
+			continue
+		}
 
 		findings = append(findings, models.Finding{
 			ID:                      fmt.Sprintf("%s-%s", ebsGP2LegacyRuleID, vol.VolumeID),
@@ -52,8 +66,27 @@ func (r AWSEBSGP2LegacyRule) Evaluate(ctx RuleContext) []models.Finding {
 			Metadata: map[string]any{
 				"volume_type": vol.VolumeType,
 				"size_gb":     vol.SizeGB,
+				"gp2_iops":    gp2BaselineIOPS(vol.SizeGB),
 			},
 		})
 	}
 	return findings
 }
+
+// gp2BaselineIOPS returns the baseline IOPS of a gp2 volume of sizeGB.
+func gp2BaselineIOPS(sizeGB int32) int {
+	return min(int(sizeGB)*gp2IOPSPerGB, gp2MaxIOPS)
+}
+
+// gp3GP2Savings returns the monthly price delta between a gp2 volume of sizeGB
+// and a gp3 volume of the same size provisioned with at least the gp2
+// baseline IOPS. Throughput is not priced: gp3's included 125 MiB/s covers
+// gp2's baseline for most sizes.
+func gp3GP2Savings(sizeGB int32) float64 {
+	gp2Cost := float64(sizeGB) * gp2PricePerGBMonth
+	gp3Cost := float64(sizeGB) * gp3PricePerGBMonth
+	if extra := gp2BaselineIOPS(sizeGB) - gp3BaselineIOPS; extra > 0 {
+		gp3Cost += float64(extra) * gp3PricePerIOPSMonth
+	}
+	return gp2Cost - gp3Cost
+}
@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"math"
 	"testing"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -8,8 +9,8 @@ import (
 
 func TestAWSEBSGP2LegacyRule_IDAndName(t *testing.T) {
 	r := AWSEBSGP2LegacyRule{}
-	if r.ID() != "EBS_GP2_LEGACY" {
-		t.Errorf("ID = %q; want EBS_GP2_LEGACY", r.ID())
+	if r.ID() != "AWS_EBS_GP2_LEGACY" {
+		t.Errorf("ID = %q; want AWS_EBS_GP2_LEGACY", r.ID())
 	}
 	if r.Name() == "" {
 		t.Error("Name must not be empty")
@@ -66,11 +67,11 @@ func TestAWSEBSGP2LegacyRule_Evaluate(t *testing.T) {
 		}
 		f := findings[0]
 
-		if want := "EBS_GP2_LEGACY-vol-gp2"; f.ID != want {
+		if want := "AWS_EBS_GP2_LEGACY-vol-gp2"; f.ID != want {
 			t.Errorf("ID = %q; want %q", f.ID, want)
 		}
-		if f.RuleID != "EBS_GP2_LEGACY" {
-			t.Errorf("RuleID = %q; want EBS_GP2_LEGACY", f.RuleID)
+		if f.RuleID != "AWS_EBS_GP2_LEGACY" {
+			t.Errorf("RuleID = %q; want AWS_EBS_GP2_LEGACY", f.RuleID)
 		}
 		if f.ResourceID != "vol-gp2" {
 			t.Errorf("ResourceID = %q; want vol-gp2", f.ResourceID)
@@ -122,14 +123,16 @@ func TestAWSEBSGP2LegacyRule_Evaluate(t *testing.T) {
 		}
 	})
 
-	t.Run("savings are proportional to volume size", func(t *testing.T) {
+	t.Run("savings are the gp2 to gp3 price delta", func(t *testing.T) {
 		cases := []struct {
 			sizeGB  int32
 			wantUSD float64
 		}{
 			{50, 1.00},
 			{200, 4.00},
-			{1000, 20.00},
+			{1000, 20.00}, // 3,000 IOPS: covered by the gp3 baseline
+			{2000, 25.00}, // 6,000 IOPS: 40.00 less 3,000 extra IOPS × $0.005
+			{6000, 55.00}, // 16,000 IOPS cap: 120.00 less 13,000 extra IOPS × $0.005
 		}
 		for _, tc := range cases {
 			ctx := makeCtx(models.AWSEBSVolume{
@@ -140,7 +143,7 @@ func TestAWSEBSGP2LegacyRule_Evaluate(t *testing.T) {
 			if len(findings) != 1 {
 				t.Fatalf("sizeGB=%d: want 1 finding, got %d", tc.sizeGB, len(findings))
 			}
-			if findings[0].EstimatedMonthlySavings != tc.wantUSD {
+			if math.Abs(findings[0].EstimatedMonthlySavings-tc.wantUSD) > 1e-9 {
 				t.Errorf("sizeGB=%d: savings = %.2f; want %.2f",
 					tc.sizeGB, findings[0].EstimatedMonthlySavings, tc.wantUSD)
 			}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSEC2IMDSv1AllowedRule flags EC2 instances whose instance metadata service
+// still accepts IMDSv1 requests (HttpTokens != "required"). IMDSv1 is
+// vulnerable to SSRF attacks that can exfiltrate the instance role's
+// temporary credentials.
+//
+// Instances with HttpEndpoint == "disabled" are skipped: the metadata service
+// is unreachable, so neither IMDS version is exposed.
+type AWSEC2IMDSv1AllowedRule struct{}
+
+func (r AWSEC2IMDSv1AllowedRule) ID() string   { return "AWS_EC2_IMDSV1_ALLOWED" }
+func (r AWSEC2IMDSv1AllowedRule) Name() string { return "EC2 Instance Allows IMDSv1" }
+
+// Evaluate returns one HIGH finding per EC2 instance that does not require
+// IMDSv2 session tokens while the metadata endpoint is enabled.
+func (r AWSEC2IMDSv1AllowedRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, inst := range ctx.RegionData.Security.EC2Instances {
+		if inst.HttpEndpoint == "disabled" {
+			continue
+		}
+		if inst.HttpTokens == "required" {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), inst.InstanceID),
+			RuleID:         r.ID(),
+			ResourceID:     inst.InstanceID,
+			ResourceType:   models.ResourceAWSEC2,
+			Region:         inst.Region,
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityHigh,
+			Explanation:    fmt.Sprintf("EC2 instance %s allows IMDSv1 (HttpTokens=%q).", inst.InstanceID, inst.HttpTokens),
+			Recommendation: "Set the instance metadata option HttpTokens to \"required\" to enforce IMDSv2 (aws ec2 modify-instance-metadata-options --http-tokens required).",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"instance_id": inst.InstanceID,
+				"http_tokens": inst.HttpTokens,
+			},
+		})
+	}
+	return findings
+}
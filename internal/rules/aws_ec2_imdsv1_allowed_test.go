@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func imdsCtx(instances ...models.AWSEC2InstanceMetadata) RuleContext {
+	return RuleContext{
+		AccountID: "123456789012",
+		Profile:   "test",
+		RegionData: &models.AWSRegionData{
+			Security: models.AWSSecurityData{EC2Instances: instances},
+		},
+	}
+}
+
+func TestAWSEC2IMDSv1AllowedRule_ID(t *testing.T) {
+	r := AWSEC2IMDSv1AllowedRule{}
+	if r.ID() != "AWS_EC2_IMDSV1_ALLOWED" {
+		t.Errorf("expected AWS_EC2_IMDSV1_ALLOWED, got %s", r.ID())
+	}
+}
+
+func TestAWSEC2IMDSv1AllowedRule_NilRegionData(t *testing.T) {
+	if findings := (AWSEC2IMDSv1AllowedRule{}).Evaluate(RuleContext{}); len(findings) != 0 {
+		t.Errorf("expected 0 findings for nil RegionData, got %d", len(findings))
+	}
+}
+
+func TestAWSEC2IMDSv1AllowedRule_Optional_Fires(t *testing.T) {
+	ctx := imdsCtx(models.AWSEC2InstanceMetadata{
+		InstanceID: "i-v1", Region: "us-east-1", HttpTokens: "optional", HttpEndpoint: "enabled",
+	})
+	findings := AWSEC2IMDSv1AllowedRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("Severity = %q; want HIGH", f.Severity)
+	}
+	if f.ResourceType != models.ResourceAWSEC2 {
+		t.Errorf("ResourceType = %q; want EC2_INSTANCE", f.ResourceType)
+	}
+	if f.Region != "us-east-1" {
+		t.Errorf("Region = %q; want us-east-1", f.Region)
+	}
+	if f.Metadata["instance_id"] != "i-v1" {
+		t.Errorf("metadata instance_id = %v; want i-v1", f.Metadata["instance_id"])
+	}
+	if f.Metadata["http_tokens"] != "optional" {
+		t.Errorf("metadata http_tokens = %v; want optional", f.Metadata["http_tokens"])
+	}
+}
+
+func TestAWSEC2IMDSv1AllowedRule_Required_NoFinding(t *testing.T) {
+	ctx := imdsCtx(models.AWSEC2InstanceMetadata{
+		InstanceID: "i-v2", Region: "us-east-1", HttpTokens: "required", HttpEndpoint: "enabled",
+	})
+	if findings := (AWSEC2IMDSv1AllowedRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("expected 0 findings for IMDSv2-required instance, got %d", len(findings))
+	}
+}
+
+func TestAWSEC2IMDSv1AllowedRule_EndpointDisabled_NoFinding(t *testing.T) {
+	ctx := imdsCtx(models.AWSEC2InstanceMetadata{
+		InstanceID: "i-off", Region: "us-east-1", HttpTokens: "optional", HttpEndpoint: "disabled",
+	})
+	if findings := (AWSEC2IMDSv1AllowedRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("expected 0 findings when metadata endpoint is disabled, got %d", len(findings))
+	}
+}
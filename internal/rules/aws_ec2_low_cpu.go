@@ -70,6 +70,7 @@ func (r AWSEC2LowCPURule) Evaluate(ctx RuleContext) []models.Finding {
 			AccountID:               ctx.AccountID,
 			Profile:                 ctx.Profile,
 			Severity:                models.SeverityMedium,
+			Confidence:              models.ConfidenceMedium,
 			EstimatedMonthlySavings: inst.MonthlyCostUSD * ec2LowCPUSavingsFraction,
 			Explanation:             "Instance type may be overprovisioned.",
 			Recommendation:          "Review instance sizing and consider downsizing or Savings Plan.",
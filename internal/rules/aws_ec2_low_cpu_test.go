@@ -146,6 +146,9 @@ func TestAWSEC2LowCPURule_Evaluate(t *testing.T) {
 		if f.Severity != models.SeverityMedium {
 			t.Errorf("Severity = %q; want MEDIUM", f.Severity)
 		}
+		if f.Confidence != models.ConfidenceMedium {
+			t.Errorf("Confidence = %q; want medium", f.Confidence)
+		}
 		if f.Region != region {
 			t.Errorf("Region = %q; want %q", f.Region, region)
 		}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSECRImageCriticalCVERule flags ECR repositories whose most recently pushed
+// image has CRITICAL vulnerabilities in its scan results. Images pushed before
+// the audit lookback window are removed by the engine before evaluation.
+//
+// Only completed scans are evaluated: "COMPLETE" for basic scanning and
+// "ACTIVE" for enhanced (Inspector) scanning. Images whose scan is pending,
+// in progress, failed, or unsupported are skipped because their severity
+// counts are absent or stale.
+type AWSECRImageCriticalCVERule struct{}
+
+func (r AWSECRImageCriticalCVERule) ID() string   { return "AWS_ECR_IMAGE_CRITICAL_CVE" }
+func (r AWSECRImageCriticalCVERule) Name() string { return "ECR Image Has Critical CVEs" }
+
+// Evaluate returns one HIGH finding per repository whose latest scanned image
+// reports at least one CRITICAL finding.
+func (r AWSECRImageCriticalCVERule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, img := range ctx.RegionData.Security.ECRImages {
+		if img.ScanStatus != "COMPLETE" && img.ScanStatus != "ACTIVE" {
+			continue
+		}
+		critical := img.SeverityCounts["CRITICAL"]
+		if critical == 0 {
+			continue
+		}
+		var total int32
+		for _, n := range img.SeverityCounts {
+			total += n
+		}
+		tag := img.Tag
+		if tag == "" {
+			tag = img.Digest
+		}
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s-%s-%s", r.ID(), img.Region, img.Repository),
+			RuleID:       r.ID(),
+			ResourceID:   img.Repository,
+			ResourceType: models.ResourceAWSECRRepository,
+			Region:       img.Region,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityHigh,
+			Explanation: fmt.Sprintf(
+				"Latest image %s:%s has %d CRITICAL vulnerabilities (%d total).",
+				img.Repository, tag, critical, total,
+			),
+			Recommendation: "Rebuild the image on a patched base image and update vulnerable packages, then push and redeploy it.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"repository":         img.Repository,
+				"image_tag":          img.Tag,
+				"image_digest":       img.Digest,
+				"critical_cve_count": critical,
+				"cve_count":          total,
+			},
+		})
+	}
+	return findings
+}
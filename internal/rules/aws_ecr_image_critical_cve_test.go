@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ecrCtx returns a RuleContext whose security data holds images.
+func ecrCtx(images ...models.AWSECRImageScan) RuleContext {
+	return RuleContext{
+		AccountID: "111122223333",
+		Profile:   "test",
+		RegionData: &models.AWSRegionData{
+			Region:   "global",
+			Security: models.AWSSecurityData{ECRImages: images},
+		},
+	}
+}
+
+// ecrImage returns a scan summary for repo:latest with the given status and counts.
+func ecrImage(repo, status string, counts map[string]int32) models.AWSECRImageScan {
+	return models.AWSECRImageScan{
+		Repository:     repo,
+		Region:         "us-east-1",
+		Tag:            "latest",
+		Digest:         "sha256:abc",
+		ScanStatus:     status,
+		SeverityCounts: counts,
+	}
+}
+
+func TestAWSECRImageCriticalCVERule_NilRegionData(t *testing.T) {
+	if findings := (AWSECRImageCriticalCVERule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSECRImageCriticalCVERule_Critical_Fires(t *testing.T) {
+	img := ecrImage("api", "COMPLETE", map[string]int32{"CRITICAL": 2, "HIGH": 3, "LOW": 1})
+	findings := AWSECRImageCriticalCVERule{}.Evaluate(ecrCtx(img))
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "AWS_ECR_IMAGE_CRITICAL_CVE" || f.Severity != models.SeverityHigh {
+		t.Errorf("finding = %s/%s; want AWS_ECR_IMAGE_CRITICAL_CVE/HIGH", f.RuleID, f.Severity)
+	}
+	if f.ResourceID != "api" || f.ResourceType != models.ResourceAWSECRRepository || f.Region != "us-east-1" {
+		t.Errorf("resource = %s/%s/%s; want api/ECR_REPOSITORY/us-east-1", f.ResourceID, f.ResourceType, f.Region)
+	}
+	if f.Metadata["repository"] != "api" || f.Metadata["image_tag"] != "latest" {
+		t.Errorf("metadata = %v; want repository api and image_tag latest", f.Metadata)
+	}
+	if f.Metadata["critical_cve_count"] != int32(2) || f.Metadata["cve_count"] != int32(6) {
+		t.Errorf("metadata counts = %v/%v; want 2/6", f.Metadata["critical_cve_count"], f.Metadata["cve_count"])
+	}
+}
+
+func TestAWSECRImageCriticalCVERule_EnhancedScanActive_Fires(t *testing.T) {
+	img := ecrImage("worker", "ACTIVE", map[string]int32{"CRITICAL": 1})
+	if findings := (AWSECRImageCriticalCVERule{}).Evaluate(ecrCtx(img)); len(findings) != 1 {
+		t.Errorf("want 1 finding for an ACTIVE enhanced scan, got %d", len(findings))
+	}
+}
+
+func TestAWSECRImageCriticalCVERule_NoCritical_NoFinding(t *testing.T) {
+	images := []models.AWSECRImageScan{
+		ecrImage("clean", "COMPLETE", nil),
+		ecrImage("high-only", "COMPLETE", map[string]int32{"HIGH": 4}),
+	}
+	if findings := (AWSECRImageCriticalCVERule{}).Evaluate(ecrCtx(images...)); len(findings) != 0 {
+		t.Errorf("want 0 findings without CRITICAL counts, got %d", len(findings))
+	}
+}
+
+func TestAWSECRImageCriticalCVERule_ScanNotCompleted_Skipped(t *testing.T) {
+	for _, status := range []string{"IN_PROGRESS", "PENDING", "FAILED", "UNSUPPORTED_IMAGE", ""} {
+		img := ecrImage("api", status, map[string]int32{"CRITICAL": 5})
+		if findings := (AWSECRImageCriticalCVERule{}).Evaluate(ecrCtx(img)); len(findings) != 0 {
+			t.Errorf("status %q: want 0 findings, got %d", status, len(findings))
+		}
+	}
+}
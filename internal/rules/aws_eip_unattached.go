@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+const (
+	eipUnattachedRuleID = "AWS_EIP_UNATTACHED"
+
+	// eipIdleHourlyPriceUSD is the us-east-1 hourly charge for an Elastic IP
+	// that is allocated but not associated with a running resource.
+	eipIdleHourlyPriceUSD = 0.005
+)
+
+// AWSEIPUnattachedRule flags Elastic IPs that are allocated but not associated
+// with an instance or network interface. AWS bills idle addresses by the hour,
+// so an unassociated Elastic IP is pure cost.
+type AWSEIPUnattachedRule struct{}
+
+func (r AWSEIPUnattachedRule) ID() string   { return eipUnattachedRuleID }
+func (r AWSEIPUnattachedRule) Name() string { return "Unattached Elastic IP" }
+
+// Evaluate returns one LOW finding per unassociated Elastic IP.
+// EstimatedMonthlySavings is the idle-address hourly charge for a month.
+func (r AWSEIPUnattachedRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+
+	var findings []models.Finding
+	for _, eip := range ctx.RegionData.ElasticIPs {
+		if eip.AssociationID != "" || eip.InstanceID != "" || eip.NetworkInterfaceID != "" {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", eipUnattachedRuleID, eip.AllocationID),
+			RuleID:                  eipUnattachedRuleID,
+			ResourceID:              eip.AllocationID,
+			ResourceType:            models.ResourceAWSElasticIP,
+			Region:                  eip.Region,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                models.SeverityLow,
+			EstimatedMonthlySavings: eipIdleHourlyPriceUSD * hoursPerMonth,
+			Explanation: fmt.Sprintf(
+				"Elastic IP %s is allocated but not associated with any instance or network interface.",
+				eip.PublicIP,
+			),
+			Recommendation: "Release the Elastic IP if it is no longer needed, or associate it with the resource it is reserved for.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"public_ip":     eip.PublicIP,
+				"allocation_id": eip.AllocationID,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func eipCtx(eips ...models.AWSElasticIP) RuleContext {
+	return RuleContext{
+		AccountID:  "111122223333",
+		Profile:    "test",
+		RegionData: &models.AWSRegionData{Region: "us-east-1", ElasticIPs: eips},
+	}
+}
+
+func TestAWSEIPUnattachedRule_IDAndName(t *testing.T) {
+	r := AWSEIPUnattachedRule{}
+	if r.ID() != "AWS_EIP_UNATTACHED" {
+		t.Errorf("ID = %q; want AWS_EIP_UNATTACHED", r.ID())
+	}
+	if r.Name() == "" {
+		t.Error("Name must not be empty")
+	}
+}
+
+func TestAWSEIPUnattachedRule_NilRegionData(t *testing.T) {
+	if got := (AWSEIPUnattachedRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil RegionData, got len=%d", len(got))
+	}
+}
+
+func TestAWSEIPUnattachedRule_Fires_Unattached(t *testing.T) {
+	findings := (AWSEIPUnattachedRule{}).Evaluate(eipCtx(models.AWSElasticIP{
+		AllocationID: "eipalloc-1",
+		PublicIP:     "203.0.113.10",
+		Region:       "us-east-1",
+	}))
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for unattached EIP; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityLow {
+		t.Errorf("Severity = %s; want LOW", f.Severity)
+	}
+	if f.ResourceID != "eipalloc-1" || f.ResourceType != models.ResourceAWSElasticIP {
+		t.Errorf("resource = %s/%s; want ELASTIC_IP/eipalloc-1", f.ResourceType, f.ResourceID)
+	}
+	if want := 0.005 * 730; math.Abs(f.EstimatedMonthlySavings-want) > 0.001 {
+		t.Errorf("EstimatedMonthlySavings = %.3f; want %.3f", f.EstimatedMonthlySavings, want)
+	}
+	if f.Metadata["public_ip"] != "203.0.113.10" {
+		t.Errorf("metadata public_ip = %v; want 203.0.113.10", f.Metadata["public_ip"])
+	}
+}
+
+func TestAWSEIPUnattachedRule_Silent_Attached(t *testing.T) {
+	findings := (AWSEIPUnattachedRule{}).Evaluate(eipCtx(
+		models.AWSElasticIP{
+			AllocationID:  "eipalloc-1",
+			PublicIP:      "203.0.113.10",
+			Region:        "us-east-1",
+			AssociationID: "eipassoc-1",
+			InstanceID:    "i-1",
+		},
+		models.AWSElasticIP{
+			AllocationID:       "eipalloc-2",
+			PublicIP:           "203.0.113.11",
+			Region:             "us-east-1",
+			AssociationID:      "eipassoc-2",
+			NetworkInterfaceID: "eni-1",
+		},
+	))
+	if len(findings) != 0 {
+		t.Errorf("expected no findings for attached EIPs; got %d", len(findings))
+	}
+}
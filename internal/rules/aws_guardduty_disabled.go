@@ -13,10 +13,12 @@ import (
 // credentials.
 type AWSGuardDutyDisabledRule struct{}
 
-func (r AWSGuardDutyDisabledRule) ID() string   { return "GUARDDUTY_DISABLED" }
+func (r AWSGuardDutyDisabledRule) ID() string   { return "AWS_GUARDDUTY_DISABLED" }
 func (r AWSGuardDutyDisabledRule) Name() string { return "GuardDuty Not Enabled In Region" }
 
 // Evaluate returns one HIGH finding per region where GuardDuty is not enabled.
+// Regions where the GuardDuty API could not be queried carry no status and
+// are never flagged.
 func (r AWSGuardDutyDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.RegionData == nil {
 		return nil
@@ -39,6 +41,9 @@ func (r AWSGuardDutyDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
 			Explanation:    fmt.Sprintf("AWS GuardDuty is not enabled in region %s.", gd.Region),
 			Recommendation: "Enable GuardDuty in all active regions to ensure continuous threat detection.",
 			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"region": gd.Region,
+			},
 		})
 	}
 	return findings
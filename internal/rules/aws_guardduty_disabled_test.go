@@ -8,8 +8,8 @@ import (
 
 func TestAWSGuardDutyDisabledRule_ID(t *testing.T) {
 	r := AWSGuardDutyDisabledRule{}
-	if r.ID() != "GUARDDUTY_DISABLED" {
-		t.Errorf("expected GUARDDUTY_DISABLED, got %s", r.ID())
+	if r.ID() != "AWS_GUARDDUTY_DISABLED" {
+		t.Errorf("expected AWS_GUARDDUTY_DISABLED, got %s", r.ID())
 	}
 }
 
@@ -68,8 +68,8 @@ func TestAWSGuardDutyDisabledRule_DisabledRegion_Flagged(t *testing.T) {
 		t.Fatalf("expected 1 finding for disabled region, got %d", len(findings))
 	}
 	f := findings[0]
-	if f.RuleID != "GUARDDUTY_DISABLED" {
-		t.Errorf("expected GUARDDUTY_DISABLED, got %s", f.RuleID)
+	if f.RuleID != "AWS_GUARDDUTY_DISABLED" {
+		t.Errorf("expected AWS_GUARDDUTY_DISABLED, got %s", f.RuleID)
 	}
 	if f.Region != "ap-southeast-1" {
 		t.Errorf("expected ap-southeast-1, got %s", f.Region)
@@ -77,6 +77,9 @@ func TestAWSGuardDutyDisabledRule_DisabledRegion_Flagged(t *testing.T) {
 	if f.Severity != models.SeverityHigh {
 		t.Errorf("expected HIGH severity, got %s", f.Severity)
 	}
+	if f.Metadata["region"] != "ap-southeast-1" {
+		t.Errorf("expected metadata region ap-southeast-1, got %v", f.Metadata["region"])
+	}
 }
 
 func TestAWSGuardDutyDisabledRule_MultipleDisabled_MultipleFlagged(t *testing.T) {
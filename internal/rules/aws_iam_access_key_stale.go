@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+const (
+	iamAccessKeyStaleRuleID = "AWS_IAM_ACCESS_KEY_STALE"
+
+	// iamAccessKeyStaleMaxAgeDays is the age in days after which an active
+	// access key is considered stale. 90 days matches the CIS AWS Foundations
+	// Benchmark rotation recommendation.
+	iamAccessKeyStaleMaxAgeDays = 90.0
+)
+
+// AWSIAMAccessKeyStaleRule flags IAM users holding an active access key older
+// than the configured maximum age (rules.AWS_IAM_ACCESS_KEY_STALE.params.max_age_days,
+// default 90). Long-lived keys widen the window in which a leaked credential
+// remains usable. Inactive keys and keys without a creation time are skipped.
+type AWSIAMAccessKeyStaleRule struct{}
+
+func (r AWSIAMAccessKeyStaleRule) ID() string   { return iamAccessKeyStaleRuleID }
+func (r AWSIAMAccessKeyStaleRule) Name() string { return "Stale IAM Access Key" }
+func (r AWSIAMAccessKeyStaleRule) Frameworks() []string {
+	return []string{frameworkCIS14}
+}
+
+// Evaluate returns one HIGH finding per IAM user with at least one active
+// access key older than the maximum age. The finding describes the oldest such
+// key; stale_key_count reports how many of the user's keys are stale.
+func (r AWSIAMAccessKeyStaleRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	maxAgeDays := policy.GetThreshold(iamAccessKeyStaleRuleID, "max_age_days", iamAccessKeyStaleMaxAgeDays, ctx.Policy)
+	now := time.Now().UTC()
+
+	var findings []models.Finding
+	for _, u := range ctx.RegionData.Security.IAMUsers {
+		var oldest *models.AWSIAMAccessKey
+		staleCount := 0
+		for i := range u.AccessKeys {
+			k := &u.AccessKeys[i]
+			if k.Status != "Active" || k.CreatedAt.IsZero() {
+				continue
+			}
+			if now.Sub(k.CreatedAt).Hours()/24 <= maxAgeDays {
+				continue
+			}
+			staleCount++
+			if oldest == nil || k.CreatedAt.Before(oldest.CreatedAt) {
+				oldest = k
+			}
+		}
+		if oldest == nil {
+			continue
+		}
+		ageDays := int(now.Sub(oldest.CreatedAt).Hours() / 24)
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), u.UserName),
+			RuleID:         r.ID(),
+			ResourceID:     u.UserName,
+			ResourceType:   models.ResourceAWSIAMUser,
+			Region:         "global",
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityHigh,
+			Explanation:    fmt.Sprintf("IAM user %q has an active access key %s that is %d days old (maximum %.0f).", u.UserName, oldest.AccessKeyID, ageDays, maxAgeDays),
+			Recommendation: "Rotate the access key: create a new key, update its consumers, then deactivate and delete the old key. Prefer IAM roles or short-lived credentials where possible.",
+			DetectedAt:     now,
+			Metadata: map[string]any{
+				"user_name":       u.UserName,
+				"access_key_id":   oldest.AccessKeyID,
+				"key_age_days":    ageDays,
+				"stale_key_count": staleCount,
+			},
+		})
+	}
+	return findings
+}
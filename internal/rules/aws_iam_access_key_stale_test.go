@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+// daysAgo returns a time days days in the past, padded by an hour so the
+// whole-day age computed by the rule is exactly days.
+func daysAgo(days int) time.Time {
+	return time.Now().UTC().Add(-(time.Duration(days)*24 + 1) * time.Hour)
+}
+
+func accessKeyCtx(users ...models.AWSIAMUser) RuleContext {
+	return RuleContext{
+		AccountID: "123",
+		Profile:   "test",
+		RegionData: &models.AWSRegionData{
+			Security: models.AWSSecurityData{IAMUsers: users},
+		},
+	}
+}
+
+func TestAWSIAMAccessKeyStaleRule_ID(t *testing.T) {
+	if (AWSIAMAccessKeyStaleRule{}).ID() != "AWS_IAM_ACCESS_KEY_STALE" {
+		t.Error("unexpected rule ID")
+	}
+}
+
+func TestAWSIAMAccessKeyStaleRule_NilRegionData(t *testing.T) {
+	if findings := (AWSIAMAccessKeyStaleRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSIAMAccessKeyStaleRule_StaleActiveKeyFires(t *testing.T) {
+	ctx := accessKeyCtx(models.AWSIAMUser{
+		UserName: "deployer",
+		AccessKeys: []models.AWSIAMAccessKey{
+			{AccessKeyID: "AKIAOLD", Status: "Active", CreatedAt: daysAgo(200)},
+		},
+	})
+	findings := AWSIAMAccessKeyStaleRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.ResourceID != "deployer" || f.ResourceType != models.ResourceAWSIAMUser {
+		t.Errorf("resource: got %q/%q; want deployer/IAM_USER", f.ResourceID, f.ResourceType)
+	}
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("severity: got %q; want HIGH", f.Severity)
+	}
+	if f.Region != "global" {
+		t.Errorf("region: got %q; want global", f.Region)
+	}
+	if f.Metadata["user_name"] != "deployer" || f.Metadata["access_key_id"] != "AKIAOLD" {
+		t.Errorf("metadata: got %v", f.Metadata)
+	}
+	if age, _ := f.Metadata["key_age_days"].(int); age != 200 {
+		t.Errorf("key_age_days: got %v; want 200", f.Metadata["key_age_days"])
+	}
+}
+
+func TestAWSIAMAccessKeyStaleRule_RecentKeyDoesNotFire(t *testing.T) {
+	ctx := accessKeyCtx(models.AWSIAMUser{
+		UserName: "deployer",
+		AccessKeys: []models.AWSIAMAccessKey{
+			{AccessKeyID: "AKIANEW", Status: "Active", CreatedAt: daysAgo(30)},
+		},
+	})
+	if findings := (AWSIAMAccessKeyStaleRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for a 30-day-old key, got %d", len(findings))
+	}
+}
+
+func TestAWSIAMAccessKeyStaleRule_InactiveOldKeyDoesNotFire(t *testing.T) {
+	ctx := accessKeyCtx(models.AWSIAMUser{
+		UserName: "deployer",
+		AccessKeys: []models.AWSIAMAccessKey{
+			{AccessKeyID: "AKIAOLD", Status: "Inactive", CreatedAt: daysAgo(400)},
+		},
+	})
+	if findings := (AWSIAMAccessKeyStaleRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for an inactive key, got %d", len(findings))
+	}
+}
+
+// TestAWSIAMAccessKeyStaleRule_ReportsOldestKey verifies that a user with two
+// stale keys produces a single finding describing the oldest one.
+func TestAWSIAMAccessKeyStaleRule_ReportsOldestKey(t *testing.T) {
+	ctx := accessKeyCtx(models.AWSIAMUser{
+		UserName: "deployer",
+		AccessKeys: []models.AWSIAMAccessKey{
+			{AccessKeyID: "AKIAOLD", Status: "Active", CreatedAt: daysAgo(120)},
+			{AccessKeyID: "AKIAOLDER", Status: "Active", CreatedAt: daysAgo(300)},
+		},
+	})
+	findings := AWSIAMAccessKeyStaleRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	if findings[0].Metadata["access_key_id"] != "AKIAOLDER" {
+		t.Errorf("access_key_id: got %v; want AKIAOLDER", findings[0].Metadata["access_key_id"])
+	}
+	if n, _ := findings[0].Metadata["stale_key_count"].(int); n != 2 {
+		t.Errorf("stale_key_count: got %v; want 2", findings[0].Metadata["stale_key_count"])
+	}
+}
+
+func TestAWSIAMAccessKeyStaleRule_MaxAgeOverride(t *testing.T) {
+	// A 45-day-old key is within the default 90 days; lowering max_age_days
+	// to 30 makes it stale.
+	ctx := accessKeyCtx(models.AWSIAMUser{
+		UserName: "deployer",
+		AccessKeys: []models.AWSIAMAccessKey{
+			{AccessKeyID: "AKIAMID", Status: "Active", CreatedAt: daysAgo(45)},
+		},
+	})
+	if findings := (AWSIAMAccessKeyStaleRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Fatalf("want 0 findings with default max age, got %d", len(findings))
+	}
+	ctx.Policy = &policy.PolicyConfig{
+		Rules: map[string]policy.RuleConfig{
+			"AWS_IAM_ACCESS_KEY_STALE": {Params: map[string]float64{"max_age_days": 30}},
+		},
+	}
+	if findings := (AWSIAMAccessKeyStaleRule{}).Evaluate(ctx); len(findings) != 1 {
+		t.Errorf("want 1 finding with max_age_days=30, got %d", len(findings))
+	}
+}
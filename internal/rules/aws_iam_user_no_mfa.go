@@ -14,6 +14,9 @@ type AWSIAMUserWithoutMFARule struct{}
 
 func (r AWSIAMUserWithoutMFARule) ID() string   { return "IAM_USER_NO_MFA" }
 func (r AWSIAMUserWithoutMFARule) Name() string { return "IAM Console User Without MFA" }
+func (r AWSIAMUserWithoutMFARule) Frameworks() []string {
+	return []string{frameworkCIS14, frameworkPCIDSS}
+}
 
 // Evaluate returns one MEDIUM finding per IAM user that has a console login
 // profile but no MFA device. Users without a login profile are skipped.
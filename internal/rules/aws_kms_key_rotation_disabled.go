@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSKMSKeyRotationDisabledRule flags customer-managed KMS keys that do not
+// have automatic key rotation enabled. Without rotation the same key material
+// protects data indefinitely, widening the impact of a key compromise.
+//
+// Only enabled, customer-managed symmetric keys with KMS-generated material
+// are evaluated: AWS-managed keys rotate automatically, and asymmetric, HMAC,
+// and imported-material keys do not support automatic rotation. Keys whose
+// rotation status could not be read (RotationStatusKnown false) are skipped
+// rather than reported as not rotating.
+type AWSKMSKeyRotationDisabledRule struct{}
+
+func (r AWSKMSKeyRotationDisabledRule) ID() string   { return "AWS_KMS_KEY_ROTATION_DISABLED" }
+func (r AWSKMSKeyRotationDisabledRule) Name() string { return "KMS Key Rotation Disabled" }
+
+// Evaluate returns one MEDIUM finding per eligible KMS key with
+// RotationEnabled == false.
+func (r AWSKMSKeyRotationDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, k := range ctx.RegionData.Security.KMSKeys {
+		if k.KeyManager != "CUSTOMER" || k.KeySpec != "SYMMETRIC_DEFAULT" || k.Origin != "AWS_KMS" {
+			continue
+		}
+		if k.KeyState != "Enabled" || !k.RotationStatusKnown || k.RotationEnabled {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), k.KeyID),
+			RuleID:         r.ID(),
+			ResourceID:     k.KeyID,
+			ResourceType:   models.ResourceAWSKMSKey,
+			Region:         k.Region,
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityMedium,
+			Explanation:    fmt.Sprintf("Customer-managed KMS key %s does not have automatic key rotation enabled.", k.KeyID),
+			Recommendation: "Enable automatic key rotation (aws kms enable-key-rotation) so KMS generates new key material every year.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"key_arn": k.ARN,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// kmsCtx returns a RuleContext whose security data holds keys.
+func kmsCtx(keys ...models.AWSKMSKey) RuleContext {
+	return RuleContext{
+		AccountID: "111122223333",
+		Profile:   "test",
+		RegionData: &models.AWSRegionData{
+			Region:   "global",
+			Security: models.AWSSecurityData{KMSKeys: keys},
+		},
+	}
+}
+
+// cmk returns an enabled customer-managed symmetric key with the given rotation state.
+func cmk(id string, rotation bool) models.AWSKMSKey {
+	return models.AWSKMSKey{
+		KeyID:               id,
+		ARN:                 "arn:aws:kms:eu-west-1:111122223333:key/" + id,
+		Region:              "eu-west-1",
+		KeyManager:          "CUSTOMER",
+		KeySpec:             "SYMMETRIC_DEFAULT",
+		Origin:              "AWS_KMS",
+		KeyState:            "Enabled",
+		RotationEnabled:     rotation,
+		RotationStatusKnown: true,
+	}
+}
+
+func TestAWSKMSKeyRotationDisabledRule_NilRegionData(t *testing.T) {
+	if findings := (AWSKMSKeyRotationDisabledRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSKMSKeyRotationDisabledRule_RotationOff_Fires(t *testing.T) {
+	findings := AWSKMSKeyRotationDisabledRule{}.Evaluate(kmsCtx(cmk("key-1", false)))
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "AWS_KMS_KEY_ROTATION_DISABLED" || f.Severity != models.SeverityMedium {
+		t.Errorf("finding = %s/%s; want AWS_KMS_KEY_ROTATION_DISABLED/MEDIUM", f.RuleID, f.Severity)
+	}
+	if f.ResourceID != "key-1" || f.ResourceType != models.ResourceAWSKMSKey || f.Region != "eu-west-1" {
+		t.Errorf("resource = %s %s %s; want key-1 KMS_KEY eu-west-1", f.ResourceID, f.ResourceType, f.Region)
+	}
+	if arn := f.Metadata["key_arn"]; arn != "arn:aws:kms:eu-west-1:111122223333:key/key-1" {
+		t.Errorf("key_arn = %v", arn)
+	}
+}
+
+func TestAWSKMSKeyRotationDisabledRule_RotationOn_NoFinding(t *testing.T) {
+	if findings := (AWSKMSKeyRotationDisabledRule{}).Evaluate(kmsCtx(cmk("key-1", true))); len(findings) != 0 {
+		t.Errorf("want 0 findings for a rotating key, got %d", len(findings))
+	}
+}
+
+func TestAWSKMSKeyRotationDisabledRule_UnknownRotationStatus_NoFinding(t *testing.T) {
+	unknown := cmk("key-1", false)
+	unknown.RotationStatusKnown = false
+	if findings := (AWSKMSKeyRotationDisabledRule{}).Evaluate(kmsCtx(unknown)); len(findings) != 0 {
+		t.Errorf("want 0 findings when rotation status is unknown, got %d", len(findings))
+	}
+}
+
+func TestAWSKMSKeyRotationDisabledRule_SkipsUnsupportedKeys(t *testing.T) {
+	asymmetric := cmk("rsa", false)
+	asymmetric.KeySpec = "RSA_2048"
+	awsManaged := cmk("aws-managed", false)
+	awsManaged.KeyManager = "AWS"
+	imported := cmk("imported", false)
+	imported.Origin = "EXTERNAL"
+	pending := cmk("pending", false)
+	pending.KeyState = "PendingDeletion"
+
+	findings := AWSKMSKeyRotationDisabledRule{}.Evaluate(kmsCtx(asymmetric, awsManaged, imported, pending))
+	if len(findings) != 0 {
+		t.Errorf("want 0 findings for keys that cannot rotate, got %d: %v", len(findings), findings)
+	}
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+const (
+	lbIdleRuleID = "AWS_LB_IDLE"
+
+	// lbIdleProcessedBytesThreshold is the total ProcessedBytes over the
+	// lookback window below which traffic is considered near-zero (1 MiB).
+	lbIdleProcessedBytesThreshold = 1048576.0
+
+	// lbHourlyPriceUSD is the us-east-1 fixed hourly charge for an NLB,
+	// excluding NLCU usage.
+	lbHourlyPriceUSD = 0.0225
+
+	// hoursPerMonth is the AWS billing convention for one month of runtime.
+	hoursPerMonth = 730.0
+)
+
+// AWSLBIdleRule flags active Network Load Balancers whose ProcessedBytes over
+// the lookback window is near zero. ALBs are left to ALB_IDLE so one load
+// balancer never gets two idle findings; Gateway Load Balancers are not
+// evaluated because the collector fetches no metrics for them.
+//
+// Load balancers created after MetricsStart are skipped because they have not
+// existed for the full lookback window. Load balancers with a zero
+// MetricsStart (metrics never fetched) are skipped as well.
+type AWSLBIdleRule struct{}
+
+func (r AWSLBIdleRule) ID() string   { return lbIdleRuleID }
+func (r AWSLBIdleRule) Name() string { return "Idle Elastic Load Balancer" }
+
+// Evaluate returns one finding per idle NLB. Severity is MEDIUM when
+// no traffic at all was recorded and LOW when traffic was non-zero but below
+// the threshold. EstimatedMonthlySavings is the fixed hourly LB charge.
+func (r AWSLBIdleRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+
+	bytesThreshold := policy.GetThreshold(lbIdleRuleID, "processed_bytes_threshold", lbIdleProcessedBytesThreshold, ctx.Policy)
+
+	var findings []models.Finding
+	for _, lb := range ctx.RegionData.LoadBalancers {
+		if lb.Type != "network" {
+			continue
+		}
+		if lb.State != "active" || lb.MetricsStart.IsZero() {
+			continue
+		}
+		// Younger than the lookback window: low totals are expected.
+		if !lb.CreatedTime.IsZero() && lb.CreatedTime.After(lb.MetricsStart) {
+			continue
+		}
+		if float64(lb.ProcessedBytes) >= bytesThreshold {
+			continue
+		}
+
+		severity := models.SeverityLow
+		if lb.ProcessedBytes == 0 {
+			severity = models.SeverityMedium
+		}
+
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", lbIdleRuleID, lb.LoadBalancerName),
+			RuleID:                  lbIdleRuleID,
+			ResourceID:              lb.LoadBalancerName,
+			ResourceType:            models.ResourceAWSLoadBalancer,
+			Region:                  lb.Region,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                severity,
+			Confidence:              models.ConfidenceMedium,
+			EstimatedMonthlySavings: lbHourlyPriceUSD * hoursPerMonth,
+			Explanation: fmt.Sprintf(
+				"%s load balancer %q processed %d bytes over the lookback window.",
+				lb.Type, lb.LoadBalancerName, lb.ProcessedBytes,
+			),
+			Recommendation: "Verify the load balancer is not needed and delete it to stop incurring hourly charges.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"load_balancer_arn": lb.LoadBalancerARN,
+				"type":              lb.Type,
+				"processed_bytes":   lb.ProcessedBytes,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestAWSLBIdleRule_IDAndName(t *testing.T) {
+	r := AWSLBIdleRule{}
+	if r.ID() != "AWS_LB_IDLE" {
+		t.Errorf("ID = %q; want AWS_LB_IDLE", r.ID())
+	}
+	if r.Name() == "" {
+		t.Error("Name must not be empty")
+	}
+}
+
+func TestAWSLBIdleRule_NilRegionData(t *testing.T) {
+	if got := (AWSLBIdleRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil RegionData, got len=%d", len(got))
+	}
+}
+
+func TestAWSLBIdleRule_Evaluate(t *testing.T) {
+	windowStart := time.Now().UTC().AddDate(0, 0, -30)
+	created := windowStart.AddDate(0, -6, 0)
+
+	makeCtx := func(lbs ...models.AWSLoadBalancer) RuleContext {
+		return RuleContext{
+			AccountID:  "111122223333",
+			Profile:    "test",
+			RegionData: &models.AWSRegionData{Region: "us-east-1", LoadBalancers: lbs},
+		}
+	}
+	lb := func(name, typ string, requests, bytes int64) models.AWSLoadBalancer {
+		return models.AWSLoadBalancer{
+			LoadBalancerARN:  "arn:aws:elasticloadbalancing:us-east-1:111122223333:loadbalancer/app/" + name + "/abc",
+			LoadBalancerName: name,
+			Region:           "us-east-1",
+			Type:             typ,
+			State:            "active",
+			RequestCount:     requests,
+			ProcessedBytes:   bytes,
+			CreatedTime:      created,
+			MetricsStart:     windowStart,
+		}
+	}
+
+	t.Run("idle NLB with zero traffic → MEDIUM", func(t *testing.T) {
+		findings := (AWSLBIdleRule{}).Evaluate(makeCtx(lb("idle-nlb", "network", 0, 0)))
+		if len(findings) != 1 {
+			t.Fatalf("want 1 finding, got %d", len(findings))
+		}
+		f := findings[0]
+		if f.Severity != models.SeverityMedium {
+			t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+		}
+		if want := 0.0225 * 730; f.EstimatedMonthlySavings != want {
+			t.Errorf("EstimatedMonthlySavings = %v; want %v", f.EstimatedMonthlySavings, want)
+		}
+		if f.ResourceType != models.ResourceAWSLoadBalancer {
+			t.Errorf("ResourceType = %q; want LOAD_BALANCER", f.ResourceType)
+		}
+	})
+
+	t.Run("near-idle NLB → LOW", func(t *testing.T) {
+		findings := (AWSLBIdleRule{}).Evaluate(makeCtx(lb("quiet-nlb", "network", 0, 2048)))
+		if len(findings) != 1 {
+			t.Fatalf("want 1 finding, got %d", len(findings))
+		}
+		if findings[0].Severity != models.SeverityLow {
+			t.Errorf("Severity = %q; want LOW", findings[0].Severity)
+		}
+	})
+
+	t.Run("idle ALB → left to ALB_IDLE", func(t *testing.T) {
+		if got := (AWSLBIdleRule{}).Evaluate(makeCtx(lb("idle-alb", "application", 0, 0))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("active NLB → no finding", func(t *testing.T) {
+		if got := (AWSLBIdleRule{}).Evaluate(makeCtx(lb("busy-nlb", "network", 0, 50<<20))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("LB younger than lookback window → skipped", func(t *testing.T) {
+		young := lb("new-nlb", "network", 0, 0)
+		young.CreatedTime = windowStart.AddDate(0, 0, 10)
+		if got := (AWSLBIdleRule{}).Evaluate(makeCtx(young)); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("metrics not fetched → skipped", func(t *testing.T) {
+		nlb := lb("tcp", "network", 0, 0)
+		nlb.MetricsStart = time.Time{}
+		if got := (AWSLBIdleRule{}).Evaluate(makeCtx(nlb)); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("GWLB → skipped", func(t *testing.T) {
+		if got := (AWSLBIdleRule{}).Evaluate(makeCtx(lb("gw", "gateway", 0, 0))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+const (
+	logGroupNoRetentionRuleID = "AWS_LOG_GROUP_NO_RETENTION"
+	// logStorageUSDPerGBMonth is the CloudWatch Logs archived storage price
+	// (~$0.03/GB-mo in us-east-1). Savings assume the stored data would age
+	// out under a retention policy.
+	logStorageUSDPerGBMonth = 0.03
+)
+
+// AWSLogGroupNoRetentionRule flags CloudWatch Logs log groups with no
+// retention policy. Such groups never expire events, so storage cost grows
+// without bound and logs may be kept well past compliance requirements.
+type AWSLogGroupNoRetentionRule struct{}
+
+func (r AWSLogGroupNoRetentionRule) ID() string   { return logGroupNoRetentionRuleID }
+func (r AWSLogGroupNoRetentionRule) Name() string { return "CloudWatch Log Group Without Retention" }
+
+// Evaluate returns one MEDIUM finding per log group where RetentionInDays
+// is unset (0).
+func (r AWSLogGroupNoRetentionRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+
+	var findings []models.Finding
+	for _, lg := range ctx.RegionData.LogGroups {
+		if lg.RetentionInDays > 0 {
+			continue
+		}
+
+		storedGB := float64(lg.StoredBytes) / (1024 * 1024 * 1024)
+
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", logGroupNoRetentionRuleID, lg.LogGroupName),
+			RuleID:                  logGroupNoRetentionRuleID,
+			ResourceID:              lg.LogGroupName,
+			ResourceType:            models.ResourceAWSLogGroup,
+			Region:                  lg.Region,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                models.SeverityMedium,
+			EstimatedMonthlySavings: storedGB * logStorageUSDPerGBMonth,
+			Explanation: fmt.Sprintf(
+				"Log group %s has no retention policy; its %.2f GB of stored events never expire.",
+				lg.LogGroupName, storedGB,
+			),
+			Recommendation: "Set a retention period on the log group (e.g. 30–365 days, per your compliance requirements) and export long-term archives to S3 if needed.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"log_group_name": lg.LogGroupName,
+				"stored_bytes":   lg.StoredBytes,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestAWSLogGroupNoRetentionRule_ID(t *testing.T) {
+	if (AWSLogGroupNoRetentionRule{}).ID() != "AWS_LOG_GROUP_NO_RETENTION" {
+		t.Error("unexpected rule ID")
+	}
+}
+
+func TestAWSLogGroupNoRetentionRule_NilRegionData(t *testing.T) {
+	if findings := (AWSLogGroupNoRetentionRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSLogGroupNoRetentionRule_UnsetRetention_Fires(t *testing.T) {
+	ctx := RuleContext{
+		AccountID: "111122223333",
+		RegionData: &models.AWSRegionData{
+			Region: "us-east-1",
+			LogGroups: []models.AWSLogGroup{
+				{LogGroupName: "/aws/lambda/api", Region: "us-east-1", StoredBytes: 10 << 30},
+			},
+		},
+	}
+	findings := AWSLogGroupNoRetentionRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("severity: got %q; want MEDIUM", f.Severity)
+	}
+	if f.ResourceID != "/aws/lambda/api" || f.ResourceType != models.ResourceAWSLogGroup {
+		t.Errorf("resource: got %q (%s); want /aws/lambda/api (LOG_GROUP)", f.ResourceID, f.ResourceType)
+	}
+	if f.Metadata["log_group_name"] != "/aws/lambda/api" {
+		t.Errorf("metadata log_group_name: got %v", f.Metadata["log_group_name"])
+	}
+	if f.Metadata["stored_bytes"] != int64(10<<30) {
+		t.Errorf("metadata stored_bytes: got %v; want %d", f.Metadata["stored_bytes"], int64(10<<30))
+	}
+	if want := 10 * logStorageUSDPerGBMonth; math.Abs(f.EstimatedMonthlySavings-want) > 1e-9 {
+		t.Errorf("savings: got %.4f; want %.4f", f.EstimatedMonthlySavings, want)
+	}
+}
+
+func TestAWSLogGroupNoRetentionRule_RetentionSet_NoFinding(t *testing.T) {
+	ctx := RuleContext{
+		RegionData: &models.AWSRegionData{
+			Region: "us-east-1",
+			LogGroups: []models.AWSLogGroup{
+				{LogGroupName: "/app/audit", Region: "us-east-1", RetentionInDays: 90, StoredBytes: 1 << 30},
+			},
+		},
+	}
+	if findings := (AWSLogGroupNoRetentionRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings with retention set, got %d", len(findings))
+	}
+}
+
+func TestAWSLogGroupNoRetentionRule_NoLogGroups(t *testing.T) {
+	ctx := RuleContext{RegionData: &models.AWSRegionData{Region: "us-west-2"}}
+	if findings := (AWSLogGroupNoRetentionRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings with no log groups, got %d", len(findings))
+	}
+}
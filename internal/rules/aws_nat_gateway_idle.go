@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+const (
+	natGatewayIdleRuleID = "AWS_NAT_GATEWAY_IDLE"
+
+	// natGatewayIdleBytesThreshold is the total BytesOutToDestination over the
+	// lookback window below which traffic is considered near-zero (1 MiB).
+	natGatewayIdleBytesThreshold = 1048576.0
+
+	// natHourlyPriceUSD and natDataProcessingPerGBUSD are the us-east-1 NAT
+	// Gateway hourly and per-GB data processing charges.
+	natHourlyPriceUSD         = 0.045
+	natDataProcessingPerGBUSD = 0.045
+
+	// natGatewayIdlePriority ranks this rule above NAT_LOW_TRAFFIC, whose
+	// finding it refines with a stricter cut-off and a fuller cost estimate.
+	natGatewayIdlePriority = 10
+
+	bytesPerGB = 1024 * 1024 * 1024
+)
+
+// AWSNATGatewayIdleRule flags available NAT Gateways that sent near-zero bytes
+// (BytesOutToDestination) over the lookback window. Unlike NAT_LOW_TRAFFIC,
+// which uses a 1 GB cut-off, it only fires on gateways that are effectively
+// unused, and it skips gateways that have not existed for the full window.
+// When both fire on a gateway they share a savings component, so the merged
+// finding is led by this rule and carries only its estimate.
+//
+// Gateways created after MetricsStart are skipped because low totals are
+// expected. Gateways with a zero MetricsStart (metrics never fetched) are
+// skipped as well.
+type AWSNATGatewayIdleRule struct{}
+
+func (r AWSNATGatewayIdleRule) ID() string   { return natGatewayIdleRuleID }
+func (r AWSNATGatewayIdleRule) Name() string { return "Idle NAT Gateway" }
+
+// Priority makes this rule the primary finding when merged with NAT_LOW_TRAFFIC.
+func (r AWSNATGatewayIdleRule) Priority() int { return natGatewayIdlePriority }
+
+// SavingsComponent shares the gateway removal saving with NAT_LOW_TRAFFIC.
+func (r AWSNATGatewayIdleRule) SavingsComponent() string { return savingsNATGateway }
+
+// Evaluate returns one MEDIUM finding per idle NAT Gateway.
+// EstimatedMonthlySavings is the hourly NAT charge for a month plus the data
+// processing charge for the window's traffic projected to 30 days.
+func (r AWSNATGatewayIdleRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+
+	threshold := policy.GetThreshold(natGatewayIdleRuleID, "bytes_threshold", natGatewayIdleBytesThreshold, ctx.Policy)
+
+	var findings []models.Finding
+	for _, ng := range ctx.RegionData.NATGateways {
+		if ng.State != "available" || ng.MetricsStart.IsZero() {
+			continue
+		}
+		// Younger than the lookback window: low totals are expected.
+		if !ng.CreatedTime.IsZero() && ng.CreatedTime.After(ng.MetricsStart) {
+			continue
+		}
+		bytesOut := ng.BytesProcessedGB * bytesPerGB
+		if bytesOut >= threshold {
+			continue
+		}
+
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", natGatewayIdleRuleID, ng.NATGatewayID),
+			RuleID:                  natGatewayIdleRuleID,
+			ResourceID:              ng.NATGatewayID,
+			ResourceType:            models.ResourceAWSNATGateway,
+			Region:                  ng.Region,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                models.SeverityMedium,
+			Confidence:              models.ConfidenceMedium,
+			EstimatedMonthlySavings: natIdleMonthlyCost(ng),
+			Explanation: fmt.Sprintf(
+				"NAT Gateway %s sent %.0f bytes over the lookback window.",
+				ng.NATGatewayID, bytesOut,
+			),
+			Recommendation: "Verify no private subnet routes depend on the NAT Gateway and delete it to stop incurring hourly charges.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"vpc_id":    ng.VPCID,
+				"bytes_out": bytesOut,
+			},
+		})
+	}
+	return findings
+}
+
+// natIdleMonthlyCost returns the monthly cost of keeping ng: the fixed hourly
+// charge plus data processing for its observed traffic projected to 30 days.
+func natIdleMonthlyCost(ng models.AWSNATGateway) float64 {
+	cost := natHourlyPriceUSD * hoursPerMonth
+	windowDays := time.Since(ng.MetricsStart).Hours() / 24
+	if windowDays >= 1 {
+		cost += ng.BytesProcessedGB * (30 / windowDays) * natDataProcessingPerGBUSD
+	}
+	return cost
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestAWSNATGatewayIdleRule_IDAndName(t *testing.T) {
+	r := AWSNATGatewayIdleRule{}
+	if r.ID() != "AWS_NAT_GATEWAY_IDLE" {
+		t.Errorf("ID = %q; want AWS_NAT_GATEWAY_IDLE", r.ID())
+	}
+	if r.Name() == "" {
+		t.Error("Name must not be empty")
+	}
+}
+
+func TestAWSNATGatewayIdleRule_NilRegionData(t *testing.T) {
+	if got := (AWSNATGatewayIdleRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil RegionData, got len=%d", len(got))
+	}
+}
+
+func TestAWSNATGatewayIdleRule_Evaluate(t *testing.T) {
+	windowStart := time.Now().UTC().AddDate(0, 0, -30)
+	created := windowStart.AddDate(0, -6, 0)
+
+	makeCtx := func(gws ...models.AWSNATGateway) RuleContext {
+		return RuleContext{
+			AccountID:  "111122223333",
+			Profile:    "test",
+			RegionData: &models.AWSRegionData{Region: "us-east-1", NATGateways: gws},
+		}
+	}
+	nat := func(id string, gb float64) models.AWSNATGateway {
+		return models.AWSNATGateway{
+			NATGatewayID:     id,
+			Region:           "us-east-1",
+			State:            "available",
+			VPCID:            "vpc-1",
+			BytesProcessedGB: gb,
+			CreatedTime:      created,
+			MetricsStart:     windowStart,
+		}
+	}
+
+	t.Run("idle gateway → MEDIUM with hourly savings", func(t *testing.T) {
+		findings := (AWSNATGatewayIdleRule{}).Evaluate(makeCtx(nat("nat-idle", 0)))
+		if len(findings) != 1 {
+			t.Fatalf("want 1 finding, got %d", len(findings))
+		}
+		f := findings[0]
+		if f.Severity != models.SeverityMedium {
+			t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+		}
+		if want := 0.045 * 730; f.EstimatedMonthlySavings != want {
+			t.Errorf("EstimatedMonthlySavings = %v; want %v", f.EstimatedMonthlySavings, want)
+		}
+		if f.ResourceType != models.ResourceAWSNATGateway || f.ResourceID != "nat-idle" {
+			t.Errorf("resource = %s/%s; want NAT_GATEWAY/nat-idle", f.ResourceType, f.ResourceID)
+		}
+	})
+
+	t.Run("near-zero traffic adds data processing to savings", func(t *testing.T) {
+		gb := 512.0 / (1024 * 1024) // 512 KiB
+		findings := (AWSNATGatewayIdleRule{}).Evaluate(makeCtx(nat("nat-quiet", gb)))
+		if len(findings) != 1 {
+			t.Fatalf("want 1 finding, got %d", len(findings))
+		}
+		want := 0.045*730 + gb*0.045
+		if got := findings[0].EstimatedMonthlySavings; math.Abs(got-want) > 1e-6 {
+			t.Errorf("EstimatedMonthlySavings = %v; want ≈ %v", got, want)
+		}
+	})
+
+	t.Run("active gateway → no finding", func(t *testing.T) {
+		if got := (AWSNATGatewayIdleRule{}).Evaluate(makeCtx(nat("nat-busy", 0.5))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("gateway younger than lookback window → skipped", func(t *testing.T) {
+		young := nat("nat-new", 0)
+		young.CreatedTime = windowStart.AddDate(0, 0, 10)
+		if got := (AWSNATGatewayIdleRule{}).Evaluate(makeCtx(young)); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("metrics not fetched → skipped", func(t *testing.T) {
+		unknown := nat("nat-unknown", 0)
+		unknown.MetricsStart = time.Time{}
+		if got := (AWSNATGatewayIdleRule{}).Evaluate(makeCtx(unknown)); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+}
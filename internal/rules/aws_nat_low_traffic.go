@@ -29,6 +29,9 @@ type AWSNATLowTrafficRule struct{}
 func (r AWSNATLowTrafficRule) ID() string   { return natLowTrafficRuleID }
 func (r AWSNATLowTrafficRule) Name() string { return "NAT Gateway Low Traffic" }
 
+// SavingsComponent shares the gateway removal saving with AWS_NAT_GATEWAY_IDLE.
+func (r AWSNATLowTrafficRule) SavingsComponent() string { return savingsNATGateway }
+
 // Evaluate returns one Finding per available NAT Gateway whose
 // BytesProcessedGB is strictly less than natLowTrafficThresholdGB.
 func (r AWSNATLowTrafficRule) Evaluate(ctx RuleContext) []models.Finding {
@@ -55,6 +58,7 @@ func (r AWSNATLowTrafficRule) Evaluate(ctx RuleContext) []models.Finding {
 			AccountID:               ctx.AccountID,
 			Profile:                 ctx.Profile,
 			Severity:                models.SeverityHigh,
+			Confidence:              models.ConfidenceMedium,
 			EstimatedMonthlySavings: natLowTrafficSavingsUSD,
 			Explanation:             "NAT Gateway has negligible traffic.",
 			Recommendation:          "Delete NAT or consolidate egress via shared NAT.",
@@ -38,6 +38,9 @@ type AWSRDSLowCPURule struct{}
 func (r AWSRDSLowCPURule) ID() string   { return rdsLowCPURuleID }
 func (r AWSRDSLowCPURule) Name() string { return "Low CPU RDS Instance" }
 
+// SavingsComponent shares the downsizing saving with AWS_RDS_OVERPROVISIONED.
+func (r AWSRDSLowCPURule) SavingsComponent() string { return savingsRDSInstanceSize }
+
 // Evaluate returns one Finding per available RDS instance whose AvgCPUPercent
 // is greater than 0, below rdsLowCPUThresholdPercent, and MonthlyCostUSD > 0.
 // Severity is HIGH for CPU < 5% and MEDIUM for 5–10%.
@@ -78,6 +81,7 @@ func (r AWSRDSLowCPURule) Evaluate(ctx RuleContext) []models.Finding {
 			AccountID:               ctx.AccountID,
 			Profile:                 ctx.Profile,
 			Severity:                severity,
+			Confidence:              models.ConfidenceMedium,
 			EstimatedMonthlySavings: inst.MonthlyCostUSD * rdsLowCPUSavingsFraction,
 			Explanation:             "RDS instance class may be overprovisioned.",
 			Recommendation:          "Review instance sizing and consider downsizing to a smaller DB instance class.",
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSRDSNoBackupRetentionRule flags RDS instances with automated backups
+// disabled (BackupRetentionPeriod == 0). Without automated backups there is
+// no point-in-time recovery, so a bad migration or accidental delete cannot
+// be rolled back.
+//
+// Read replicas are skipped: they are rebuilt from their source instance,
+// and engines such as MySQL create them with retention 0 by default.
+type AWSRDSNoBackupRetentionRule struct{}
+
+func (r AWSRDSNoBackupRetentionRule) ID() string   { return "AWS_RDS_NO_BACKUP_RETENTION" }
+func (r AWSRDSNoBackupRetentionRule) Name() string { return "RDS Instance Without Automated Backups" }
+
+// Evaluate returns one HIGH finding per non-replica RDS instance with
+// BackupRetentionPeriod == 0.
+func (r AWSRDSNoBackupRetentionRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, inst := range ctx.RegionData.RDSInstances {
+		if inst.BackupRetentionPeriod > 0 || inst.ReadReplicaSourceID != "" {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), inst.DBInstanceID),
+			RuleID:         r.ID(),
+			ResourceID:     inst.DBInstanceID,
+			ResourceType:   models.ResourceAWSRDS,
+			Region:         ctx.RegionData.Region,
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityHigh,
+			Explanation:    fmt.Sprintf("RDS instance %s has automated backups disabled (backup retention period is 0 days).", inst.DBInstanceID),
+			Recommendation: "Set a backup retention period of at least 7 days (aws rds modify-db-instance --backup-retention-period 7) to enable automated backups and point-in-time recovery.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"db_instance_id":          inst.DBInstanceID,
+				"backup_retention_period": inst.BackupRetentionPeriod,
+				"engine":                  inst.Engine,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func rdsBackupCtx(instances ...models.AWSRDSInstance) RuleContext {
+	return RuleContext{
+		AccountID:  "111122223333",
+		Profile:    "prod",
+		RegionData: &models.AWSRegionData{Region: "us-east-1", RDSInstances: instances},
+	}
+}
+
+func TestAWSRDSNoBackupRetentionRule_ID(t *testing.T) {
+	if id := (AWSRDSNoBackupRetentionRule{}).ID(); id != "AWS_RDS_NO_BACKUP_RETENTION" {
+		t.Errorf("ID = %q; want AWS_RDS_NO_BACKUP_RETENTION", id)
+	}
+}
+
+func TestAWSRDSNoBackupRetentionRule_ZeroRetention_Fires(t *testing.T) {
+	ctx := rdsBackupCtx(models.AWSRDSInstance{DBInstanceID: "db-prod", Engine: "postgres", BackupRetentionPeriod: 0})
+	findings := AWSRDSNoBackupRetentionRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "AWS_RDS_NO_BACKUP_RETENTION" || f.Severity != models.SeverityHigh {
+		t.Errorf("RuleID/Severity = %s/%s; want AWS_RDS_NO_BACKUP_RETENTION/HIGH", f.RuleID, f.Severity)
+	}
+	if f.ResourceID != "db-prod" || f.ResourceType != models.ResourceAWSRDS || f.Region != "us-east-1" {
+		t.Errorf("resource = %s/%s in %s; want db-prod/RDS_INSTANCE in us-east-1", f.ResourceID, f.ResourceType, f.Region)
+	}
+	if f.Metadata["db_instance_id"] != "db-prod" || f.Metadata["backup_retention_period"] != 0 {
+		t.Errorf("metadata = %v; want db_instance_id db-prod, backup_retention_period 0", f.Metadata)
+	}
+}
+
+func TestAWSRDSNoBackupRetentionRule_SevenDayRetention_NoFinding(t *testing.T) {
+	ctx := rdsBackupCtx(models.AWSRDSInstance{DBInstanceID: "db-prod", BackupRetentionPeriod: 7})
+	if findings := (AWSRDSNoBackupRetentionRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for 7-day retention, got %d", len(findings))
+	}
+}
+
+func TestAWSRDSNoBackupRetentionRule_ReadReplica_Skipped(t *testing.T) {
+	ctx := rdsBackupCtx(models.AWSRDSInstance{DBInstanceID: "db-replica", BackupRetentionPeriod: 0, ReadReplicaSourceID: "db-prod"})
+	if findings := (AWSRDSNoBackupRetentionRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for a read replica, got %d", len(findings))
+	}
+}
+
+func TestAWSRDSNoBackupRetentionRule_NilRegionData(t *testing.T) {
+	if findings := (AWSRDSNoBackupRetentionRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
@@ -0,0 +1,175 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+const (
+	rdsOverprovisionedRuleID = "AWS_RDS_OVERPROVISIONED"
+
+	// rdsOverprovisionedCPUThresholdPercent is the average CPU below which an
+	// RDS instance is a right-sizing candidate.
+	rdsOverprovisionedCPUThresholdPercent = 10.0
+
+	// rdsOverprovisionedConnectionThreshold is the average DatabaseConnections
+	// count below which an instance is considered lightly used.
+	rdsOverprovisionedConnectionThreshold = 5.0
+
+	// rdsOverprovisionedPriority ranks this rule above RDS_LOW_CPU, so its
+	// concrete target class and cost delta lead a merged finding.
+	rdsOverprovisionedPriority = 10
+)
+
+// rdsSizeUnits maps an instance size suffix to its relative capacity,
+// following the AWS normalization factors (small = 1).
+var rdsSizeUnits = map[string]float64{
+	"micro":    0.5,
+	"small":    1,
+	"medium":   2,
+	"large":    4,
+	"xlarge":   8,
+	"2xlarge":  16,
+	"4xlarge":  32,
+	"8xlarge":  64,
+	"10xlarge": 80,
+	"12xlarge": 96,
+	"16xlarge": 128,
+	"24xlarge": 192,
+	"32xlarge": 256,
+}
+
+// rdsFamilySizes lists the sizes RDS offers in each instance family, from
+// smallest to largest. Families absent from this table are not right-sized,
+// so a suggested class always exists.
+var rdsFamilySizes = map[string][]string{
+	"db.t2":   {"micro", "small", "medium", "large", "xlarge", "2xlarge"},
+	"db.t3":   {"micro", "small", "medium", "large", "xlarge", "2xlarge"},
+	"db.t4g":  {"micro", "small", "medium", "large", "xlarge", "2xlarge"},
+	"db.m4":   {"large", "xlarge", "2xlarge", "4xlarge", "10xlarge", "16xlarge"},
+	"db.m5":   {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"db.m5d":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"db.m6g":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+	"db.m6gd": {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+	"db.m6i":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge", "32xlarge"},
+	"db.m7g":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+	"db.r4":   {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "16xlarge"},
+	"db.r5":   {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"db.r5b":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"db.r5d":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"db.r6g":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+	"db.r6gd": {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+	"db.r6i":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge", "32xlarge"},
+	"db.r7g":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+	"db.x2g":  {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge"},
+}
+
+// AWSRDSOverprovisionedRule flags available RDS instances whose average CPU
+// and average connection count over the lookback window are both low,
+// recommending a move to the next-smaller instance class in the same family.
+//
+// Instances with AvgCPUPercent == 0 are skipped: 0 means CloudWatch data was
+// unavailable. Instances already at the smallest size of their family, or
+// whose family is unknown, are skipped because there is no smaller class to
+// suggest.
+//
+// RDS_LOW_CPU often flags the same instance. Both estimate the downsizing
+// saving, so they share a savings component and the merged finding carries
+// only this rule's estimate.
+type AWSRDSOverprovisionedRule struct{}
+
+func (r AWSRDSOverprovisionedRule) ID() string   { return rdsOverprovisionedRuleID }
+func (r AWSRDSOverprovisionedRule) Name() string { return "Overprovisioned RDS Instance" }
+
+// Priority makes this rule the primary finding when merged with RDS_LOW_CPU.
+func (r AWSRDSOverprovisionedRule) Priority() int { return rdsOverprovisionedPriority }
+
+// SavingsComponent shares the downsizing saving with RDS_LOW_CPU.
+func (r AWSRDSOverprovisionedRule) SavingsComponent() string { return savingsRDSInstanceSize }
+
+// Evaluate returns one MEDIUM finding per available RDS instance whose
+// AvgCPUPercent is below cpu_threshold and AvgConnections is below
+// connection_threshold. EstimatedMonthlySavings is the cost delta to the
+// next-smaller class, or 0 when MonthlyCostUSD is unknown.
+func (r AWSRDSOverprovisionedRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+
+	cpuThreshold := policy.GetThreshold(rdsOverprovisionedRuleID, "cpu_threshold", rdsOverprovisionedCPUThresholdPercent, ctx.Policy)
+	connThreshold := policy.GetThreshold(rdsOverprovisionedRuleID, "connection_threshold", rdsOverprovisionedConnectionThreshold, ctx.Policy)
+
+	var findings []models.Finding
+	for _, inst := range ctx.RegionData.RDSInstances {
+		if inst.Status != "available" {
+			continue
+		}
+		// 0 means CloudWatch had no data; skip to avoid false positives.
+		if inst.AvgCPUPercent == 0 {
+			continue
+		}
+		if inst.AvgCPUPercent >= cpuThreshold || inst.AvgConnections >= connThreshold {
+			continue
+		}
+		smaller, ratio, ok := nextSmallerRDSClass(inst.DBInstanceClass)
+		if !ok {
+			continue
+		}
+
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", rdsOverprovisionedRuleID, inst.DBInstanceID),
+			RuleID:                  rdsOverprovisionedRuleID,
+			ResourceID:              inst.DBInstanceID,
+			ResourceType:            models.ResourceAWSRDS,
+			Region:                  inst.Region,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                models.SeverityMedium,
+			Confidence:              models.ConfidenceLow,
+			EstimatedMonthlySavings: inst.MonthlyCostUSD * (1 - ratio),
+			Explanation: fmt.Sprintf(
+				"RDS instance %q (%s) averaged %.1f%% CPU and %.1f connections over the lookback window.",
+				inst.DBInstanceID, inst.DBInstanceClass, inst.AvgCPUPercent, inst.AvgConnections,
+			),
+			Recommendation: fmt.Sprintf("Downsize the instance to %s.", smaller),
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"avg_cpu_percent":   inst.AvgCPUPercent,
+				"avg_connections":   inst.AvgConnections,
+				"monthly_cost_usd":  inst.MonthlyCostUSD,
+				"instance_class":    inst.DBInstanceClass,
+				"recommended_class": smaller,
+			},
+		})
+	}
+	return findings
+}
+
+// nextSmallerRDSClass returns the next-smaller class in the same family
+// (e.g. "db.m5.xlarge" → "db.m5.large") and the capacity ratio of the smaller
+// class to the current one. ok is false when the family is not in
+// rdsFamilySizes, the size is not offered in it, or it is already the
+// family's smallest size.
+func nextSmallerRDSClass(class string) (smaller string, ratio float64, ok bool) {
+	i := strings.LastIndex(class, ".")
+	if i < 0 {
+		return "", 0, false
+	}
+	family, size := class[:i], class[i+1:]
+	sizes := rdsFamilySizes[family]
+	for j, s := range sizes {
+		if s != size {
+			continue
+		}
+		if j == 0 {
+			return "", 0, false
+		}
+		prev := sizes[j-1]
+		return family + "." + prev, rdsSizeUnits[prev] / rdsSizeUnits[size], true
+	}
+	return "", 0, false
+}
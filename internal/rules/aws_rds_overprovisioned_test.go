@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+func TestAWSRDSOverprovisionedRule_IDAndName(t *testing.T) {
+	r := AWSRDSOverprovisionedRule{}
+	if r.ID() != "AWS_RDS_OVERPROVISIONED" {
+		t.Errorf("ID = %q; want AWS_RDS_OVERPROVISIONED", r.ID())
+	}
+	if r.Name() == "" {
+		t.Error("Name must not be empty")
+	}
+}
+
+func TestAWSRDSOverprovisionedRule_NilRegionData(t *testing.T) {
+	if got := (AWSRDSOverprovisionedRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil RegionData, got len=%d", len(got))
+	}
+}
+
+func TestAWSRDSOverprovisionedRule_Evaluate(t *testing.T) {
+	const region = "us-east-1"
+
+	makeCtx := func(instances ...models.AWSRDSInstance) RuleContext {
+		return RuleContext{
+			AccountID: "111122223333",
+			Profile:   "test",
+			RegionData: &models.AWSRegionData{
+				Region:       region,
+				RDSInstances: instances,
+			},
+		}
+	}
+	inst := func(id, class string, cpu, conns, cost float64) models.AWSRDSInstance {
+		return models.AWSRDSInstance{
+			DBInstanceID:    id,
+			Region:          region,
+			DBInstanceClass: class,
+			Status:          "available",
+			AvgCPUPercent:   cpu,
+			AvgConnections:  conns,
+			MonthlyCostUSD:  cost,
+		}
+	}
+
+	t.Run("idle xlarge → MEDIUM, savings to large", func(t *testing.T) {
+		findings := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(inst("idle-db", "db.m5.xlarge", 3, 1, 400)))
+		if len(findings) != 1 {
+			t.Fatalf("want 1 finding, got %d", len(findings))
+		}
+		f := findings[0]
+		if f.Severity != models.SeverityMedium {
+			t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+		}
+		if want := 200.0; math.Abs(f.EstimatedMonthlySavings-want) > 1e-9 { // 400 - 400*(4/8)
+			t.Errorf("EstimatedMonthlySavings = %v; want %v", f.EstimatedMonthlySavings, want)
+		}
+		if got := f.Metadata["recommended_class"]; got != "db.m5.large" {
+			t.Errorf("recommended_class = %v; want db.m5.large", got)
+		}
+		if f.ResourceType != models.ResourceAWSRDS {
+			t.Errorf("ResourceType = %q; want RDS_INSTANCE", f.ResourceType)
+		}
+	})
+
+	t.Run("busy CPU → no finding", func(t *testing.T) {
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(inst("busy-db", "db.m5.xlarge", 55, 1, 400))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("low CPU but many connections → no finding", func(t *testing.T) {
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(inst("chatty-db", "db.m5.xlarge", 3, 80, 400))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("no CPU metrics → skipped", func(t *testing.T) {
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(inst("nodata-db", "db.m5.xlarge", 0, 0, 400))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("smallest size → skipped", func(t *testing.T) {
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(inst("tiny-db", "db.t3.micro", 3, 1, 15))); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("unknown cost → finding with zero savings", func(t *testing.T) {
+		findings := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(inst("nocost-db", "db.r6g.2xlarge", 3, 1, 0)))
+		if len(findings) != 1 {
+			t.Fatalf("want 1 finding, got %d", len(findings))
+		}
+		if findings[0].EstimatedMonthlySavings != 0 {
+			t.Errorf("EstimatedMonthlySavings = %v; want 0", findings[0].EstimatedMonthlySavings)
+		}
+	})
+
+	t.Run("stopped instance → skipped", func(t *testing.T) {
+		i := inst("stopped-db", "db.m5.xlarge", 3, 1, 400)
+		i.Status = "stopped"
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(makeCtx(i)); len(got) != 0 {
+			t.Errorf("want 0 findings, got %d", len(got))
+		}
+	})
+
+	t.Run("policy cpu_threshold override", func(t *testing.T) {
+		ctx := makeCtx(inst("mid-db", "db.m5.xlarge", 15, 1, 400))
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(ctx); len(got) != 0 {
+			t.Fatalf("default threshold: want 0 findings, got %d", len(got))
+		}
+		ctx.Policy = &policy.PolicyConfig{
+			Rules: map[string]policy.RuleConfig{
+				"AWS_RDS_OVERPROVISIONED": {Params: map[string]float64{"cpu_threshold": 20}},
+			},
+		}
+		if got := (AWSRDSOverprovisionedRule{}).Evaluate(ctx); len(got) != 1 {
+			t.Errorf("cpu_threshold 20: want 1 finding, got %d", len(got))
+		}
+	})
+}
+
+func TestNextSmallerRDSClass(t *testing.T) {
+	tests := []struct {
+		class     string
+		want      string
+		wantRatio float64
+		wantOK    bool
+	}{
+		{"db.m5.xlarge", "db.m5.large", 0.5, true},
+		{"db.r6g.12xlarge", "db.r6g.8xlarge", 64.0 / 96.0, true},
+		{"db.t3.small", "db.t3.micro", 0.5, true},
+		{"db.t3.micro", "", 0, false},
+		{"db.m5.large", "", 0, false}, // db.m5.medium does not exist
+		{"db.m4.16xlarge", "db.m4.10xlarge", 80.0 / 128.0, true},
+		{"db.z9.xlarge", "", 0, false},
+		{"db.m5.metal", "", 0, false},
+		{"garbage", "", 0, false},
+	}
+	for _, tt := range tests {
+		got, ratio, ok := nextSmallerRDSClass(tt.class)
+		if got != tt.want || ok != tt.wantOK || math.Abs(ratio-tt.wantRatio) > 1e-9 {
+			t.Errorf("nextSmallerRDSClass(%q) = (%q, %v, %v); want (%q, %v, %v)",
+				tt.class, got, ratio, ok, tt.want, tt.wantRatio, tt.wantOK)
+		}
+	}
+}
@@ -14,6 +14,9 @@ type AWSRootAccessKeyExistsRule struct{}
 
 func (r AWSRootAccessKeyExistsRule) ID() string   { return "ROOT_ACCESS_KEY" }
 func (r AWSRootAccessKeyExistsRule) Name() string { return "Root Account Has Active Access Keys" }
+func (r AWSRootAccessKeyExistsRule) Frameworks() []string {
+	return []string{frameworkCIS14}
+}
 
 // Evaluate returns one CRITICAL finding when the root account has access keys.
 func (r AWSRootAccessKeyExistsRule) Evaluate(ctx RuleContext) []models.Finding {
@@ -17,6 +17,9 @@ type AWSRootAccountMFADisabledRule struct{}
 
 func (r AWSRootAccountMFADisabledRule) ID() string   { return "ROOT_ACCOUNT_MFA_DISABLED" }
 func (r AWSRootAccountMFADisabledRule) Name() string { return "Root Account MFA Not Enabled" }
+func (r AWSRootAccountMFADisabledRule) Frameworks() []string {
+	return []string{frameworkCIS14, frameworkPCIDSS}
+}
 
 // Evaluate returns one CRITICAL finding when the root account has no MFA.
 func (r AWSRootAccountMFADisabledRule) Evaluate(ctx RuleContext) []models.Finding {
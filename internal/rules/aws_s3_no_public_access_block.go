@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSS3NoPublicAccessBlockRule flags S3 buckets whose bucket-level public
+// access block does not enable all four settings. Without a full block, a
+// future ACL or bucket policy change can expose the bucket publicly.
+//
+// Buckets with no configuration at all fire with every setting missing.
+// Buckets whose settings could not be read (nil PublicAccessBlock) are skipped.
+type AWSS3NoPublicAccessBlockRule struct{}
+
+func (r AWSS3NoPublicAccessBlockRule) ID() string   { return "AWS_S3_NO_PUBLIC_ACCESS_BLOCK" }
+func (r AWSS3NoPublicAccessBlockRule) Name() string { return "S3 Public Access Block Incomplete" }
+func (r AWSS3NoPublicAccessBlockRule) Frameworks() []string {
+	return []string{frameworkCIS14}
+}
+
+// Evaluate returns one HIGH finding per S3 bucket with at least one public
+// access block setting disabled. missing_flags lists the disabled settings.
+func (r AWSS3NoPublicAccessBlockRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, b := range ctx.RegionData.Security.Buckets {
+		if b.PublicAccessBlock == nil {
+			continue
+		}
+		missing := missingPublicAccessBlockFlags(*b.PublicAccessBlock)
+		if len(missing) == 0 {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), b.Name),
+			RuleID:         r.ID(),
+			ResourceID:     b.Name,
+			ResourceType:   models.ResourceAWSS3Bucket,
+			Region:         "global",
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityHigh,
+			Explanation:    fmt.Sprintf("S3 bucket %q does not fully block public access (missing: %s).", b.Name, strings.Join(missing, ", ")),
+			Recommendation: "Enable BlockPublicAcls, IgnorePublicAcls, BlockPublicPolicy, and RestrictPublicBuckets in the bucket's public access block.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"bucket_name":   b.Name,
+				"missing_flags": missing,
+			},
+		})
+	}
+	return findings
+}
+
+// missingPublicAccessBlockFlags returns the names of the disabled settings in
+// pab, in the order the S3 API documents them.
+func missingPublicAccessBlockFlags(pab models.AWSS3PublicAccessBlock) []string {
+	var missing []string
+	if !pab.BlockPublicAcls {
+		missing = append(missing, "BlockPublicAcls")
+	}
+	if !pab.IgnorePublicAcls {
+		missing = append(missing, "IgnorePublicAcls")
+	}
+	if !pab.BlockPublicPolicy {
+		missing = append(missing, "BlockPublicPolicy")
+	}
+	if !pab.RestrictPublicBuckets {
+		missing = append(missing, "RestrictPublicBuckets")
+	}
+	return missing
+}
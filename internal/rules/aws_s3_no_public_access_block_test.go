@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func s3PABCtx(buckets ...models.AWSS3Bucket) RuleContext {
+	return RuleContext{
+		AccountID: "111122223333",
+		Profile:   "test",
+		RegionData: &models.AWSRegionData{
+			Security: models.AWSSecurityData{Buckets: buckets},
+		},
+	}
+}
+
+func TestAWSS3NoPublicAccessBlockRule_ID(t *testing.T) {
+	r := AWSS3NoPublicAccessBlockRule{}
+	if r.ID() != "AWS_S3_NO_PUBLIC_ACCESS_BLOCK" {
+		t.Error("unexpected rule ID")
+	}
+}
+
+func TestAWSS3NoPublicAccessBlockRule_FullyBlocked(t *testing.T) {
+	ctx := s3PABCtx(models.AWSS3Bucket{
+		Name: "locked",
+		PublicAccessBlock: &models.AWSS3PublicAccessBlock{
+			BlockPublicAcls:       true,
+			IgnorePublicAcls:      true,
+			BlockPublicPolicy:     true,
+			RestrictPublicBuckets: true,
+		},
+	})
+	if findings := (AWSS3NoPublicAccessBlockRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("expected no findings for fully blocked bucket, got %d", len(findings))
+	}
+}
+
+func TestAWSS3NoPublicAccessBlockRule_PartiallyBlocked(t *testing.T) {
+	ctx := s3PABCtx(models.AWSS3Bucket{
+		Name: "partial",
+		PublicAccessBlock: &models.AWSS3PublicAccessBlock{
+			BlockPublicAcls:   true,
+			BlockPublicPolicy: true,
+		},
+	})
+	findings := AWSS3NoPublicAccessBlockRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("severity = %s; want HIGH", f.Severity)
+	}
+	if f.ResourceID != "partial" || f.Region != "global" {
+		t.Errorf("resource = %s/%s; want partial/global", f.ResourceID, f.Region)
+	}
+	want := []string{"IgnorePublicAcls", "RestrictPublicBuckets"}
+	if got := f.Metadata["missing_flags"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("missing_flags = %v; want %v", got, want)
+	}
+}
+
+func TestAWSS3NoPublicAccessBlockRule_NoConfiguration(t *testing.T) {
+	ctx := s3PABCtx(models.AWSS3Bucket{
+		Name:              "open",
+		PublicAccessBlock: &models.AWSS3PublicAccessBlock{},
+	})
+	findings := AWSS3NoPublicAccessBlockRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(findings))
+	}
+	missing, _ := findings[0].Metadata["missing_flags"].([]string)
+	if len(missing) != 4 {
+		t.Errorf("missing_flags = %v; want all four settings", missing)
+	}
+}
+
+func TestAWSS3NoPublicAccessBlockRule_UnknownSkipped(t *testing.T) {
+	ctx := s3PABCtx(models.AWSS3Bucket{Name: "denied"})
+	if findings := (AWSS3NoPublicAccessBlockRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("expected no findings when settings are unknown, got %d", len(findings))
+	}
+}
+
+func TestAWSS3NoPublicAccessBlockRule_NilRegionData(t *testing.T) {
+	if findings := (AWSS3NoPublicAccessBlockRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("expected nil findings, got %v", findings)
+	}
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSS3VersioningDisabledRule flags S3 buckets that do not have versioning
+// enabled. Without versioning, overwritten or deleted objects cannot be
+// recovered, leaving the bucket exposed to accidental loss and ransomware.
+//
+// Buckets whose versioning was suspended fire as well as buckets where it was
+// never enabled. Buckets with an unknown status (empty VersioningStatus) are
+// skipped.
+type AWSS3VersioningDisabledRule struct{}
+
+func (r AWSS3VersioningDisabledRule) ID() string   { return "AWS_S3_VERSIONING_DISABLED" }
+func (r AWSS3VersioningDisabledRule) Name() string { return "S3 Bucket Versioning Disabled" }
+
+// Evaluate returns one MEDIUM finding per S3 bucket whose VersioningStatus is
+// "Suspended" or "Disabled".
+func (r AWSS3VersioningDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, b := range ctx.RegionData.Security.Buckets {
+		if b.VersioningStatus == "" || b.VersioningStatus == "Enabled" {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), b.Name),
+			RuleID:         r.ID(),
+			ResourceID:     b.Name,
+			ResourceType:   models.ResourceAWSS3Bucket,
+			Region:         "global",
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityMedium,
+			Explanation:    fmt.Sprintf("S3 bucket %q does not have versioning enabled (status: %s).", b.Name, b.VersioningStatus),
+			Recommendation: "Enable S3 bucket versioning so overwritten or deleted objects can be recovered; consider MFA delete for critical buckets.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"bucket_name":       b.Name,
+				"versioning_status": b.VersioningStatus,
+			},
+		})
+	}
+	return findings
+}
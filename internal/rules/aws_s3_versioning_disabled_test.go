@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func s3VersioningCtx(buckets ...models.AWSS3Bucket) RuleContext {
+	return RuleContext{
+		AccountID: "111122223333",
+		Profile:   "test",
+		RegionData: &models.AWSRegionData{
+			Security: models.AWSSecurityData{Buckets: buckets},
+		},
+	}
+}
+
+func TestAWSS3VersioningDisabledRule_ID(t *testing.T) {
+	r := AWSS3VersioningDisabledRule{}
+	if r.ID() != "AWS_S3_VERSIONING_DISABLED" {
+		t.Error("unexpected rule ID")
+	}
+}
+
+func TestAWSS3VersioningDisabledRule_NilRegionData(t *testing.T) {
+	findings := AWSS3VersioningDisabledRule{}.Evaluate(RuleContext{})
+	if findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSS3VersioningDisabledRule_Enabled_NoFinding(t *testing.T) {
+	ctx := s3VersioningCtx(models.AWSS3Bucket{Name: "versioned", VersioningStatus: "Enabled"})
+	if findings := (AWSS3VersioningDisabledRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for versioned bucket, got %d", len(findings))
+	}
+}
+
+// TestAWSS3VersioningDisabledRule_SuspendedAndNeverEnabled verifies that both
+// a suspended bucket and a bucket that never had versioning are flagged MEDIUM
+// with the bucket name in metadata.
+func TestAWSS3VersioningDisabledRule_SuspendedAndNeverEnabled(t *testing.T) {
+	for _, status := range []string{"Suspended", "Disabled"} {
+		t.Run(status, func(t *testing.T) {
+			ctx := s3VersioningCtx(models.AWSS3Bucket{Name: "logs-bucket", VersioningStatus: status})
+			findings := AWSS3VersioningDisabledRule{}.Evaluate(ctx)
+			if len(findings) != 1 {
+				t.Fatalf("want 1 finding, got %d", len(findings))
+			}
+			f := findings[0]
+			if f.ResourceID != "logs-bucket" {
+				t.Errorf("resource_id: got %q; want logs-bucket", f.ResourceID)
+			}
+			if f.Severity != models.SeverityMedium {
+				t.Errorf("severity: got %q; want MEDIUM", f.Severity)
+			}
+			if f.ResourceType != models.ResourceAWSS3Bucket || f.Region != "global" {
+				t.Errorf("got resource_type %q region %q; want S3_BUCKET global", f.ResourceType, f.Region)
+			}
+			if f.Metadata["bucket_name"] != "logs-bucket" {
+				t.Errorf("metadata.bucket_name: got %v; want logs-bucket", f.Metadata["bucket_name"])
+			}
+			if f.Metadata["versioning_status"] != status {
+				t.Errorf("metadata.versioning_status: got %v; want %s", f.Metadata["versioning_status"], status)
+			}
+		})
+	}
+}
+
+// TestAWSS3VersioningDisabledRule_UnknownStatus_NoFinding verifies that a
+// bucket whose versioning status could not be read is not flagged.
+func TestAWSS3VersioningDisabledRule_UnknownStatus_NoFinding(t *testing.T) {
+	ctx := s3VersioningCtx(models.AWSS3Bucket{Name: "unknown"})
+	if findings := (AWSS3VersioningDisabledRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for unknown status, got %d", len(findings))
+	}
+}
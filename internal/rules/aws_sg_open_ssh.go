@@ -20,6 +20,9 @@ type AWSSecurityGroupOpenSSHRule struct{}
 
 func (r AWSSecurityGroupOpenSSHRule) ID() string   { return "SG_OPEN_SSH" }
 func (r AWSSecurityGroupOpenSSHRule) Name() string { return "Security Group With Open Remote Admin Access" }
+func (r AWSSecurityGroupOpenSSHRule) Frameworks() []string {
+	return []string{frameworkCIS14, frameworkPCIDSS}
+}
 
 // Evaluate returns one HIGH finding per security group that exposes SSH (22)
 // or RDP (3389) to the internet. Duplicate matches within the same group are
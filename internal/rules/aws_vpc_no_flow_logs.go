@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// AWSVPCNoFlowLogsRule flags VPCs without an active VPC-level flow log.
+// Without flow logs there is no record of accepted or rejected traffic to
+// investigate an incident with (CIS AWS Foundations 3.9).
+//
+// Default VPCs are reported too: they are created in every region and are
+// often used by accident. Their findings carry metadata.is_default so they
+// can be filtered or deleted rather than logged.
+type AWSVPCNoFlowLogsRule struct{}
+
+func (r AWSVPCNoFlowLogsRule) ID() string   { return "AWS_VPC_NO_FLOW_LOGS" }
+func (r AWSVPCNoFlowLogsRule) Name() string { return "VPC Flow Logs Not Enabled" }
+func (r AWSVPCNoFlowLogsRule) Frameworks() []string {
+	return []string{frameworkCIS14}
+}
+
+// Evaluate returns one MEDIUM finding per VPC without flow logs.
+func (r AWSVPCNoFlowLogsRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.RegionData == nil {
+		return nil
+	}
+
+	var findings []models.Finding
+	for _, vpc := range ctx.RegionData.Security.VPCs {
+		if vpc.FlowLogsEnabled {
+			continue
+		}
+		explanation := fmt.Sprintf("VPC %s in region %s has no active flow log.", vpc.VPCID, vpc.Region)
+		recommendation := "Create a VPC flow log (aws ec2 create-flow-logs --resource-type VPC) delivering to CloudWatch Logs or S3."
+		if vpc.IsDefault {
+			explanation = fmt.Sprintf("Default VPC %s in region %s has no active flow log.", vpc.VPCID, vpc.Region)
+			recommendation = "Delete the default VPC if nothing uses it; otherwise create a VPC flow log delivering to CloudWatch Logs or S3."
+		}
+		findings = append(findings, models.Finding{
+			ID:             fmt.Sprintf("%s-%s", r.ID(), vpc.VPCID),
+			RuleID:         r.ID(),
+			ResourceID:     vpc.VPCID,
+			ResourceType:   models.ResourceAWSVPC,
+			Region:         vpc.Region,
+			AccountID:      ctx.AccountID,
+			Profile:        ctx.Profile,
+			Severity:       models.SeverityMedium,
+			Explanation:    explanation,
+			Recommendation: recommendation,
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"vpc_id":     vpc.VPCID,
+				"region":     vpc.Region,
+				"is_default": vpc.IsDefault,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func vpcCtx(vpcs ...models.AWSVPC) RuleContext {
+	return RuleContext{
+		AccountID: "111122223333",
+		Profile:   "prod",
+		RegionData: &models.AWSRegionData{
+			Region:   "global",
+			Security: models.AWSSecurityData{VPCs: vpcs},
+		},
+	}
+}
+
+func TestAWSVPCNoFlowLogsRule_ID(t *testing.T) {
+	if id := (AWSVPCNoFlowLogsRule{}).ID(); id != "AWS_VPC_NO_FLOW_LOGS" {
+		t.Errorf("ID = %q; want AWS_VPC_NO_FLOW_LOGS", id)
+	}
+}
+
+func TestAWSVPCNoFlowLogsRule_NilRegionData(t *testing.T) {
+	if findings := (AWSVPCNoFlowLogsRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("want nil with nil RegionData, got %v", findings)
+	}
+}
+
+func TestAWSVPCNoFlowLogsRule_Enabled_NoFinding(t *testing.T) {
+	ctx := vpcCtx(models.AWSVPC{VPCID: "vpc-1", Region: "us-east-1", FlowLogsEnabled: true})
+	if findings := (AWSVPCNoFlowLogsRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("want 0 findings for a VPC with flow logs, got %d", len(findings))
+	}
+}
+
+func TestAWSVPCNoFlowLogsRule_Disabled_Fires(t *testing.T) {
+	ctx := vpcCtx(models.AWSVPC{VPCID: "vpc-1", Region: "eu-west-1"})
+	findings := (AWSVPCNoFlowLogsRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "AWS_VPC_NO_FLOW_LOGS" || f.Severity != models.SeverityMedium {
+		t.Errorf("RuleID/Severity = %s/%s; want AWS_VPC_NO_FLOW_LOGS/MEDIUM", f.RuleID, f.Severity)
+	}
+	if f.ResourceID != "vpc-1" || f.ResourceType != models.ResourceAWSVPC || f.Region != "eu-west-1" {
+		t.Errorf("resource = %s/%s in %s; want vpc-1/VPC in eu-west-1", f.ResourceID, f.ResourceType, f.Region)
+	}
+	if f.Metadata["vpc_id"] != "vpc-1" || f.Metadata["region"] != "eu-west-1" || f.Metadata["is_default"] != false {
+		t.Errorf("metadata = %v; want vpc_id vpc-1, region eu-west-1, is_default false", f.Metadata)
+	}
+}
+
+func TestAWSVPCNoFlowLogsRule_DefaultVPC_FiresWithDefaultFlag(t *testing.T) {
+	ctx := vpcCtx(
+		models.AWSVPC{VPCID: "vpc-default", Region: "us-east-1", IsDefault: true},
+		models.AWSVPC{VPCID: "vpc-default-logged", Region: "us-west-2", IsDefault: true, FlowLogsEnabled: true},
+	)
+	findings := (AWSVPCNoFlowLogsRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding for the unlogged default VPC, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.ResourceID != "vpc-default" || f.Metadata["is_default"] != true {
+		t.Errorf("finding = %s with metadata %v; want vpc-default with is_default true", f.ResourceID, f.Metadata)
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+const (
+	azureDiskUnattachedRuleID = "AZURE_DISK_UNATTACHED"
+
+	// azureDiskDefaultPricePerGBMonth is used for SKUs missing from
+	// azureDiskPricePerGBMonth.
+	azureDiskDefaultPricePerGBMonth = 0.08
+)
+
+// azureDiskPricePerGBMonth holds conservative per-GB monthly placeholders by
+// managed disk SKU. Azure bills disks by size tier rather than per GB, so
+// these approximate the East US tier price divided by tier size; adjust when
+// a pricing service is available.
+var azureDiskPricePerGBMonth = map[string]float64{
+	"Standard_LRS":    0.045,
+	"StandardSSD_LRS": 0.075,
+	"StandardSSD_ZRS": 0.094,
+	"Premium_LRS":     0.135,
+	"Premium_ZRS":     0.169,
+}
+
+// AzureDiskUnattachedRule flags managed disks in the "Unattached" state. An
+// unattached disk incurs storage charges with no workload benefit, the Azure
+// equivalent of EBS_UNATTACHED.
+type AzureDiskUnattachedRule struct{}
+
+func (r AzureDiskUnattachedRule) ID() string   { return azureDiskUnattachedRuleID }
+func (r AzureDiskUnattachedRule) Name() string { return "Unattached Azure Managed Disk" }
+
+// Evaluate returns one MEDIUM finding per unattached disk in ctx.AzureData.
+// Reserved disks (attached to a stopped-deallocated VM) are not flagged.
+func (r AzureDiskUnattachedRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.AzureData == nil {
+		return nil
+	}
+
+	var findings []models.Finding
+	for _, disk := range ctx.AzureData.Disks {
+		if disk.DiskState != "Unattached" || disk.ManagedBy != "" {
+			continue
+		}
+
+		price, ok := azureDiskPricePerGBMonth[disk.SKU]
+		if !ok {
+			price = azureDiskDefaultPricePerGBMonth
+		}
+
+		resourceID := azureResourceName(disk.ResourceGroup, disk.Name)
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", azureDiskUnattachedRuleID, resourceID),
+			RuleID:                  azureDiskUnattachedRuleID,
+			ResourceID:              resourceID,
+			ResourceType:            models.ResourceAzureDisk,
+			Region:                  disk.Location,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                models.SeverityMedium,
+			EstimatedMonthlySavings: float64(disk.SizeGB) * price,
+			Explanation:             "Managed disk is unattached.",
+			Recommendation:          "Snapshot the disk if its data is needed, then delete it.",
+			DetectedAt:              time.Now().UTC(),
+			Metadata: map[string]any{
+				"resource_group": disk.ResourceGroup,
+				"sku":            disk.SKU,
+				"size_gb":        disk.SizeGB,
+				"azure_id":       disk.ID,
+			},
+		})
+	}
+	return findings
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func TestAzureDiskUnattachedRule_IDAndName(t *testing.T) {
+	r := AzureDiskUnattachedRule{}
+	if r.ID() != "AZURE_DISK_UNATTACHED" {
+		t.Errorf("ID = %q; want AZURE_DISK_UNATTACHED", r.ID())
+	}
+	if r.Name() == "" {
+		t.Error("Name must not be empty")
+	}
+}
+
+func TestAzureDiskUnattachedRule_NilAzureData(t *testing.T) {
+	if got := (AzureDiskUnattachedRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil AzureData, got len=%d", len(got))
+	}
+}
+
+func TestAzureDiskUnattachedRule_Evaluate(t *testing.T) {
+	makeCtx := func(disks ...models.AzureDisk) RuleContext {
+		return RuleContext{
+			AccountID: "sub-1",
+			AzureData: &models.AzureSubscriptionData{SubscriptionID: "sub-1", Disks: disks},
+		}
+	}
+	disk := func(name, sku, state, managedBy string) models.AzureDisk {
+		return models.AzureDisk{
+			Name: name, ResourceGroup: "rg-data", Location: "westeurope",
+			SKU: sku, SizeGB: 100, DiskState: state, ManagedBy: managedBy,
+		}
+	}
+
+	t.Run("unattached disk is flagged with SKU pricing", func(t *testing.T) {
+		got := (AzureDiskUnattachedRule{}).Evaluate(makeCtx(disk("data-1", "Premium_LRS", "Unattached", "")))
+		if len(got) != 1 {
+			t.Fatalf("expected 1 finding, got %d", len(got))
+		}
+		f := got[0]
+		if f.ResourceID != "rg-data/data-1" || f.ResourceType != models.ResourceAzureDisk || f.Region != "westeurope" {
+			t.Errorf("finding = %s %s %s; want rg-data/data-1 AZURE_MANAGED_DISK westeurope", f.ResourceID, f.ResourceType, f.Region)
+		}
+		if f.Severity != models.SeverityMedium {
+			t.Errorf("Severity = %s; want MEDIUM", f.Severity)
+		}
+		if want := 100 * 0.135; math.Abs(f.EstimatedMonthlySavings-want) > 1e-9 {
+			t.Errorf("savings = %.4f; want %.4f", f.EstimatedMonthlySavings, want)
+		}
+		if f.Metadata["sku"] != "Premium_LRS" {
+			t.Errorf("Metadata[sku] = %v; want Premium_LRS", f.Metadata["sku"])
+		}
+	})
+
+	t.Run("unknown SKU uses default price", func(t *testing.T) {
+		got := (AzureDiskUnattachedRule{}).Evaluate(makeCtx(disk("data-1", "UltraSSD_LRS", "Unattached", "")))
+		if len(got) != 1 {
+			t.Fatalf("expected 1 finding, got %d", len(got))
+		}
+		if want := 100 * 0.08; math.Abs(got[0].EstimatedMonthlySavings-want) > 1e-9 {
+			t.Errorf("savings = %.4f; want %.4f", got[0].EstimatedMonthlySavings, want)
+		}
+	})
+
+	t.Run("not flagged", func(t *testing.T) {
+		cases := map[string]models.AzureDisk{
+			"attached disk":          disk("os-1", "Premium_LRS", "Attached", "/subscriptions/sub-1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm-1"),
+			"reserved disk":          disk("os-1", "Premium_LRS", "Reserved", "/subscriptions/sub-1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm-1"),
+			"active SAS export":      disk("data-1", "Premium_LRS", "ActiveSAS", ""),
+			"unattached but managed": disk("data-1", "Premium_LRS", "Unattached", "/subscriptions/sub-1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm-1"),
+		}
+		for name, d := range cases {
+			t.Run(name, func(t *testing.T) {
+				if got := (AzureDiskUnattachedRule{}).Evaluate(makeCtx(d)); len(got) != 0 {
+					t.Errorf("expected 0 findings, got %d", len(got))
+				}
+			})
+		}
+	})
+}
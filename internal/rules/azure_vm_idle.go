@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+const (
+	azureVMIdleRuleID = "AZURE_VM_IDLE"
+
+	// azureVMIdleCPUThresholdPercent is the average "Percentage CPU" below
+	// which a running VM is considered idle. It is deliberately lower than
+	// EC2_LOW_CPU's downsizing threshold because the recommendation is to
+	// deallocate the VM, not resize it.
+	azureVMIdleCPUThresholdPercent = 5.0
+)
+
+// AzureVMIdleRule flags running Azure VMs whose average CPU over the lookback
+// window is below the threshold. A deallocated VM stops incurring compute
+// charges, so the full MonthlyCostUSD is reported as savings.
+//
+// VMs with AvgCPUPercent == 0 are skipped: 0 means Azure Monitor data was
+// unavailable, not that CPU is truly zero. Unlike EC2_LOW_CPU, VMs without
+// cost data are still flagged with zero savings because the Azure collector
+// does not price VMs yet.
+type AzureVMIdleRule struct{}
+
+func (r AzureVMIdleRule) ID() string   { return azureVMIdleRuleID }
+func (r AzureVMIdleRule) Name() string { return "Idle Azure VM" }
+
+// Evaluate returns one MEDIUM finding per idle running VM in ctx.AzureData.
+func (r AzureVMIdleRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.AzureData == nil {
+		return nil
+	}
+
+	threshold := policy.GetThreshold(azureVMIdleRuleID, "cpu_threshold", azureVMIdleCPUThresholdPercent, ctx.Policy)
+
+	var findings []models.Finding
+	for _, vm := range ctx.AzureData.VMs {
+		if vm.PowerState != "running" {
+			continue
+		}
+		// 0 means Azure Monitor had no data; skip to avoid false positives.
+		if vm.AvgCPUPercent == 0 || vm.AvgCPUPercent >= threshold {
+			continue
+		}
+
+		resourceID := azureResourceName(vm.ResourceGroup, vm.Name)
+		findings = append(findings, models.Finding{
+			ID:                      fmt.Sprintf("%s-%s", azureVMIdleRuleID, resourceID),
+			RuleID:                  azureVMIdleRuleID,
+			ResourceID:              resourceID,
+			ResourceType:            models.ResourceAzureVM,
+			Region:                  vm.Location,
+			AccountID:               ctx.AccountID,
+			Profile:                 ctx.Profile,
+			Severity:                models.SeverityMedium,
+			Confidence:              models.ConfidenceMedium,
+			EstimatedMonthlySavings: vm.MonthlyCostUSD,
+			Explanation: fmt.Sprintf(
+				"VM %s averaged %.1f%% CPU over the lookback window.",
+				vm.Name, vm.AvgCPUPercent,
+			),
+			Recommendation: "Deallocate or delete the VM if it is no longer needed; a stopped-but-allocated VM still incurs compute charges.",
+			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"resource_group":   vm.ResourceGroup,
+				"vm_size":          vm.VMSize,
+				"avg_cpu_percent":  vm.AvgCPUPercent,
+				"monthly_cost_usd": vm.MonthlyCostUSD,
+				"azure_id":         vm.ID,
+			},
+		})
+	}
+	return findings
+}
+
+// azureResourceName returns the "resourceGroup/name" identifier used as
+// ResourceID by Azure findings. Resource names are only unique within a
+// resource group, and full ARM IDs are too long for table output.
+func azureResourceName(resourceGroup, name string) string {
+	if resourceGroup == "" {
+		return name
+	}
+	return resourceGroup + "/" + name
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/policy"
+)
+
+func TestAzureVMIdleRule_IDAndName(t *testing.T) {
+	r := AzureVMIdleRule{}
+	if r.ID() != "AZURE_VM_IDLE" {
+		t.Errorf("ID = %q; want AZURE_VM_IDLE", r.ID())
+	}
+	if r.Name() == "" {
+		t.Error("Name must not be empty")
+	}
+}
+
+func TestAzureVMIdleRule_NilAzureData(t *testing.T) {
+	if got := (AzureVMIdleRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil AzureData, got len=%d", len(got))
+	}
+}
+
+func TestAzureVMIdleRule_Evaluate(t *testing.T) {
+	const subscription = "00000000-0000-0000-0000-000000000001"
+
+	makeCtx := func(vms ...models.AzureVM) RuleContext {
+		return RuleContext{
+			AccountID: subscription,
+			AzureData: &models.AzureSubscriptionData{SubscriptionID: subscription, VMs: vms},
+		}
+	}
+	vm := func(name, state string, cpu, cost float64) models.AzureVM {
+		return models.AzureVM{
+			ID:   "/subscriptions/" + subscription + "/resourceGroups/rg-app/providers/Microsoft.Compute/virtualMachines/" + name,
+			Name: name, ResourceGroup: "rg-app", Location: "eastus", VMSize: "Standard_D2s_v5",
+			PowerState: state, AvgCPUPercent: cpu, MonthlyCostUSD: cost,
+		}
+	}
+
+	t.Run("idle running VM is flagged", func(t *testing.T) {
+		got := (AzureVMIdleRule{}).Evaluate(makeCtx(vm("web-1", "running", 1.5, 70)))
+		if len(got) != 1 {
+			t.Fatalf("expected 1 finding, got %d", len(got))
+		}
+		f := got[0]
+		if f.ResourceID != "rg-app/web-1" || f.ResourceType != models.ResourceAzureVM || f.Region != "eastus" {
+			t.Errorf("finding = %s %s %s; want rg-app/web-1 AZURE_VM eastus", f.ResourceID, f.ResourceType, f.Region)
+		}
+		if f.AccountID != subscription {
+			t.Errorf("AccountID = %q; want %q", f.AccountID, subscription)
+		}
+		if f.Severity != models.SeverityMedium {
+			t.Errorf("Severity = %s; want MEDIUM", f.Severity)
+		}
+		if f.EstimatedMonthlySavings != 70 {
+			t.Errorf("savings = %.2f; want 70.00 (full monthly cost)", f.EstimatedMonthlySavings)
+		}
+		if f.Metadata["vm_size"] != "Standard_D2s_v5" {
+			t.Errorf("Metadata[vm_size] = %v; want Standard_D2s_v5", f.Metadata["vm_size"])
+		}
+	})
+
+	t.Run("VM without cost data is flagged with zero savings", func(t *testing.T) {
+		got := (AzureVMIdleRule{}).Evaluate(makeCtx(vm("web-1", "running", 1.5, 0)))
+		if len(got) != 1 || got[0].EstimatedMonthlySavings != 0 {
+			t.Fatalf("got %+v; want one finding with zero savings", got)
+		}
+	})
+
+	t.Run("not flagged", func(t *testing.T) {
+		cases := map[string]models.AzureVM{
+			"busy VM":        vm("web-1", "running", 40, 70),
+			"at threshold":   vm("web-1", "running", 5, 70),
+			"no metric data": vm("web-1", "running", 0, 70),
+			"deallocated VM": vm("web-1", "deallocated", 1, 70),
+			"stopped VM":     vm("web-1", "stopped", 1, 70),
+			"unknown power":  vm("web-1", "", 1, 70),
+		}
+		for name, v := range cases {
+			t.Run(name, func(t *testing.T) {
+				if got := (AzureVMIdleRule{}).Evaluate(makeCtx(v)); len(got) != 0 {
+					t.Errorf("expected 0 findings, got %d", len(got))
+				}
+			})
+		}
+	})
+
+	t.Run("policy cpu_threshold overrides default", func(t *testing.T) {
+		ctx := makeCtx(vm("web-1", "running", 8, 70))
+		ctx.Policy = &policy.PolicyConfig{Rules: map[string]policy.RuleConfig{
+			"AZURE_VM_IDLE": {Params: map[string]float64{"cpu_threshold": 10}},
+		}}
+		if got := (AzureVMIdleRule{}).Evaluate(ctx); len(got) != 1 {
+			t.Errorf("expected 1 finding with cpu_threshold=10, got %d", len(got))
+		}
+	})
+}
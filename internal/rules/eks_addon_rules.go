@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// addonMaxMinorLag is the number of minor versions an EKS add-on may trail the
+// latest available version before EKS_ADDON_OUTDATED fires.
+const addonMaxMinorLag = 1
+
+// ── EKS_ADDON_OUTDATED ───────────────────────────────────────────────────────
+
+// EKSAddonOutdatedRule fires when an EKS managed add-on (vpc-cni, coredns,
+// kube-proxy, ...) lags the latest version available for the cluster's
+// Kubernetes version by more than one minor version. Outdated add-ons miss
+// security fixes and commonly carry known CVEs.
+type EKSAddonOutdatedRule struct{}
+
+func (r EKSAddonOutdatedRule) ID() string   { return "EKS_ADDON_OUTDATED" }
+func (r EKSAddonOutdatedRule) Name() string { return "EKS Managed Add-on Outdated" }
+
+// Evaluate returns one MEDIUM finding per add-on in EKSData.Addons whose
+// installed version is a major version behind LatestVersion, or more than
+// addonMaxMinorLag minor versions behind it. Add-ons with an unknown or
+// unparseable version are skipped.
+func (r EKSAddonOutdatedRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil || ctx.ClusterData.EKSData == nil {
+		return nil
+	}
+	eks := ctx.ClusterData.EKSData
+
+	var findings []models.Finding
+	for _, addon := range eks.Addons {
+		curMajor, curMinor, ok := parseAddonMinorVersion(addon.Version)
+		if !ok {
+			continue
+		}
+		latestMajor, latestMinor, ok := parseAddonMinorVersion(addon.LatestVersion)
+		if !ok {
+			continue
+		}
+		outdated := latestMajor > curMajor ||
+			(latestMajor == curMajor && latestMinor-curMinor > addonMaxMinorLag)
+		if !outdated {
+			continue
+		}
+
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s", r.ID(), eks.ClusterName, addon.Name),
+			RuleID:       r.ID(),
+			ResourceID:   eks.ClusterName + "/" + addon.Name,
+			ResourceType: models.ResourceK8sCluster,
+			Region:       eks.Region,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityMedium,
+			Explanation: fmt.Sprintf(
+				"EKS add-on %q on cluster %q runs version %s, more than %d minor version behind the latest available %s.",
+				addon.Name, eks.ClusterName, addon.Version, addonMaxMinorLag, addon.LatestVersion,
+			),
+			Recommendation: fmt.Sprintf(
+				"Update the %s add-on to %s (aws eks update-addon --cluster-name %s --addon-name %s --addon-version %s) "+
+					"to pick up upstream security fixes.",
+				addon.Name, addon.LatestVersion, eks.ClusterName, addon.Name, addon.LatestVersion,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"cluster_name":   eks.ClusterName,
+				"region":         eks.Region,
+				"addon_name":     addon.Name,
+				"version":        addon.Version,
+				"latest_version": addon.LatestVersion,
+			},
+		})
+	}
+	return findings
+}
+
+// parseAddonMinorVersion extracts the major and minor components from an EKS
+// add-on version such as "v1.15.1-eksbuild.1". ok is false when either
+// component is missing or not numeric.
+func parseAddonMinorVersion(v string) (major, minor int, ok bool) {
+	core, _, _ := strings.Cut(strings.TrimPrefix(v, "v"), "-")
+	fields := strings.Split(core, ".")
+	if len(fields) < 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
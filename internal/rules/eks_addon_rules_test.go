@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// eksAddonCluster builds a minimal EKS cluster carrying only the given add-ons.
+func eksAddonCluster(addons ...models.KubernetesEKSAddonData) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{
+		ContextName:     "addon-cluster",
+		ClusterProvider: "eks",
+		EKSData: &models.KubernetesEKSData{
+			ClusterName: "addon-cluster",
+			Region:      "us-east-1",
+			Addons:      addons,
+		},
+	}
+}
+
+// ── EKS_ADDON_OUTDATED ───────────────────────────────────────────────────────
+
+func TestEKSAddonOutdatedRule_Silent_WhenUpToDate(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksAddonCluster(
+		models.KubernetesEKSAddonData{Name: "vpc-cni", Version: "v1.18.3-eksbuild.1", LatestVersion: "v1.18.3-eksbuild.3"},
+	)}
+	if got := (EKSAddonOutdatedRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected no findings for an up-to-date add-on; got %d", len(got))
+	}
+}
+
+func TestEKSAddonOutdatedRule_Silent_WhenOneMinorBehind(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksAddonCluster(
+		models.KubernetesEKSAddonData{Name: "coredns", Version: "v1.10.1-eksbuild.7", LatestVersion: "v1.11.1-eksbuild.9"},
+	)}
+	if got := (EKSAddonOutdatedRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected no findings for an add-on one minor version behind; got %d", len(got))
+	}
+}
+
+func TestEKSAddonOutdatedRule_Fires_WhenMultipleMinorsBehind(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksAddonCluster(
+		models.KubernetesEKSAddonData{Name: "vpc-cni", Version: "v1.15.1-eksbuild.1", LatestVersion: "v1.18.3-eksbuild.1"},
+		models.KubernetesEKSAddonData{Name: "kube-proxy", Version: "v1.29.0-eksbuild.1", LatestVersion: "v1.29.3-eksbuild.2"},
+	)}
+	findings := (EKSAddonOutdatedRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding (vpc-cni only); got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "EKS_ADDON_OUTDATED" {
+		t.Errorf("RuleID = %q; want EKS_ADDON_OUTDATED", f.RuleID)
+	}
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+	}
+	if f.ID != "EKS_ADDON_OUTDATED:addon-cluster:vpc-cni" {
+		t.Errorf("ID = %q; want EKS_ADDON_OUTDATED:addon-cluster:vpc-cni", f.ID)
+	}
+	if f.ResourceID != "addon-cluster/vpc-cni" {
+		t.Errorf("ResourceID = %q; want addon-cluster/vpc-cni", f.ResourceID)
+	}
+	if f.Metadata["addon_name"] != "vpc-cni" || f.Metadata["latest_version"] != "v1.18.3-eksbuild.1" {
+		t.Errorf("Metadata = %v; want addon_name vpc-cni and latest_version v1.18.3-eksbuild.1", f.Metadata)
+	}
+}
+
+func TestEKSAddonOutdatedRule_Fires_WhenMajorBehind(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksAddonCluster(
+		models.KubernetesEKSAddonData{Name: "aws-ebs-csi-driver", Version: "v1.35.0-eksbuild.1", LatestVersion: "v2.0.0-eksbuild.1"},
+	)}
+	if got := (EKSAddonOutdatedRule{}).Evaluate(ctx); len(got) != 1 {
+		t.Errorf("expected 1 finding for an add-on a major version behind; got %d", len(got))
+	}
+}
+
+func TestEKSAddonOutdatedRule_Silent_WhenLatestUnknown(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksAddonCluster(
+		models.KubernetesEKSAddonData{Name: "coredns", Version: "v1.8.7-eksbuild.1"},
+	)}
+	if got := (EKSAddonOutdatedRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected no findings when LatestVersion is empty; got %d", len(got))
+	}
+}
+
+func TestEKSAddonOutdatedRule_Silent_WhenEKSDataNil(t *testing.T) {
+	ctx := RuleContext{ClusterData: &models.KubernetesClusterData{ClusterProvider: "eks"}}
+	if got := (EKSAddonOutdatedRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected no findings when EKSData is nil; got %d", len(got))
+	}
+}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -59,6 +60,74 @@ func (r EKSOIDCProviderNotAssociatedRule) Evaluate(ctx RuleContext) []models.Fin
 	}
 }
 
+// ── EKS_OIDC_ISSUER_MISMATCH ──────────────────────────────────────────────────
+
+// EKSOIDCIssuerMismatchRule fires when an IAM OIDC provider ARN is associated
+// with the cluster but its provider URL does not match the cluster's OIDC
+// issuer. IRSA token exchange then fails because STS cannot validate the
+// projected service account tokens against the registered provider.
+//
+// When OIDCProviderARN is empty the rule stays silent and defers to
+// EKS_OIDC_PROVIDER_NOT_ASSOCIATED so the two rules never double-fire.
+type EKSOIDCIssuerMismatchRule struct{}
+
+func (r EKSOIDCIssuerMismatchRule) ID() string   { return "EKS_OIDC_ISSUER_MISMATCH" }
+func (r EKSOIDCIssuerMismatchRule) Name() string { return "EKS OIDC Issuer Does Not Match IAM Provider" }
+
+// Evaluate returns a HIGH finding when the provider URL embedded in
+// EKSData.OIDCProviderARN differs from EKSData.OIDCIssuer.
+func (r EKSOIDCIssuerMismatchRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil || ctx.ClusterData.EKSData == nil {
+		return nil
+	}
+	eks := ctx.ClusterData.EKSData
+	if eks.OIDCProviderARN == "" || eks.OIDCIssuer == "" {
+		return nil
+	}
+	issuerURL := strings.TrimPrefix(eks.OIDCIssuer, "https://")
+	providerURL := oidcProviderURLFromARN(eks.OIDCProviderARN)
+	if providerURL == issuerURL {
+		return nil
+	}
+	return []models.Finding{
+		{
+			ID:           fmt.Sprintf("%s:%s", r.ID(), eks.ClusterName),
+			RuleID:       r.ID(),
+			ResourceID:   eks.ClusterName,
+			ResourceType: models.ResourceK8sCluster,
+			Region:       eks.Region,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityHigh,
+			Explanation: fmt.Sprintf(
+				"EKS cluster %q has OIDC issuer %q but the associated IAM OIDC provider is %q; "+
+					"IRSA role assumption will fail.",
+				eks.ClusterName, issuerURL, providerURL,
+			),
+			Recommendation: "Re-associate an IAM OIDC identity provider whose URL matches the " +
+				"cluster's OIDC issuer and update IRSA role trust policies to reference it.",
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"cluster_name":      eks.ClusterName,
+				"region":            eks.Region,
+				"oidc_issuer":       eks.OIDCIssuer,
+				"oidc_provider_arn": eks.OIDCProviderARN,
+			},
+		},
+	}
+}
+
+// oidcProviderURLFromARN extracts the provider URL from an IAM OIDC provider
+// ARN of the form arn:aws:iam::{accountID}:oidc-provider/{providerURL}.
+// Returns the input unchanged when the ARN does not contain the marker.
+func oidcProviderURLFromARN(arn string) string {
+	const marker = ":oidc-provider/"
+	if i := strings.Index(arn, marker); i >= 0 {
+		return arn[i+len(marker):]
+	}
+	return arn
+}
+
 // ── EKS_SERVICEACCOUNT_NO_IRSA ────────────────────────────────────────────────
 
 // EKSServiceAccountNoIRSARule fires for each Kubernetes ServiceAccount that
@@ -94,6 +94,114 @@ func TestEKSOIDCProviderNotAssociatedRule_Silent_WhenClusterDataNil(t *testing.T
 	}
 }
 
+// ── EKS_OIDC_ISSUER_MISMATCH ──────────────────────────────────────────────────
+
+// eksOIDCMismatchClusterData builds a KubernetesClusterData whose EKSData
+// carries the supplied OIDC issuer URL and IAM OIDC provider ARN.
+func eksOIDCMismatchClusterData(issuer, providerARN string) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{
+		ContextName:     "oidc-cluster",
+		ClusterProvider: "eks",
+		EKSData: &models.KubernetesEKSData{
+			ClusterName:     "oidc-cluster",
+			Region:          "us-east-1",
+			OIDCIssuer:      issuer,
+			OIDCProviderARN: providerARN,
+		},
+	}
+}
+
+// TestEKSOIDCIssuerMismatchRule_Silent_WhenMatching verifies that the rule is
+// silent when the provider ARN embeds the cluster's issuer URL.
+func TestEKSOIDCIssuerMismatchRule_Silent_WhenMatching(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksOIDCMismatchClusterData(
+		"https://oidc.eks.us-east-1.amazonaws.com/id/ABC",
+		"arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ABC",
+	)}
+	if got := (EKSOIDCIssuerMismatchRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings when issuer matches provider; got %d", len(got))
+	}
+}
+
+// TestEKSOIDCIssuerMismatchRule_Fires_WhenMismatched verifies that a HIGH
+// finding is produced when the provider ARN points at a different issuer.
+func TestEKSOIDCIssuerMismatchRule_Fires_WhenMismatched(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksOIDCMismatchClusterData(
+		"https://oidc.eks.us-east-1.amazonaws.com/id/ABC",
+		"arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OLD",
+	)}
+	findings := (EKSOIDCIssuerMismatchRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for mismatched issuer; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "EKS_OIDC_ISSUER_MISMATCH" {
+		t.Errorf("RuleID = %q; want EKS_OIDC_ISSUER_MISMATCH", f.RuleID)
+	}
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("Severity = %q; want HIGH", f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sCluster {
+		t.Errorf("ResourceType = %q; want K8S_CLUSTER", f.ResourceType)
+	}
+	if f.ID != "EKS_OIDC_ISSUER_MISMATCH:oidc-cluster" {
+		t.Errorf("ID = %q; want EKS_OIDC_ISSUER_MISMATCH:oidc-cluster", f.ID)
+	}
+}
+
+// TestEKSOIDCIssuerMismatchRule_Silent_WhenProviderEmpty verifies that the rule
+// defers to EKS_OIDC_PROVIDER_NOT_ASSOCIATED when no provider ARN is present.
+func TestEKSOIDCIssuerMismatchRule_Silent_WhenProviderEmpty(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksOIDCMismatchClusterData(
+		"https://oidc.eks.us-east-1.amazonaws.com/id/ABC", "",
+	)}
+	if got := (EKSOIDCIssuerMismatchRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings when provider ARN empty; got %d", len(got))
+	}
+}
+
+// TestEKSOIDCIssuerMismatchRule_NoDoubleFire_WithNotAssociated verifies that at
+// most one of the two OIDC rules fires for any provider configuration.
+func TestEKSOIDCIssuerMismatchRule_NoDoubleFire_WithNotAssociated(t *testing.T) {
+	issuer := "https://oidc.eks.us-east-1.amazonaws.com/id/ABC"
+	cases := []struct {
+		name        string
+		providerARN string
+		wantRule    string
+	}{
+		{"empty", "", "EKS_OIDC_PROVIDER_NOT_ASSOCIATED"},
+		{"mismatch", "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OLD", "EKS_OIDC_ISSUER_MISMATCH"},
+		{"match", "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ABC", ""},
+	}
+	for _, tc := range cases {
+		ctx := RuleContext{ClusterData: eksOIDCMismatchClusterData(issuer, tc.providerARN)}
+		var fired []string
+		for _, r := range []Rule{EKSOIDCProviderNotAssociatedRule{}, EKSOIDCIssuerMismatchRule{}} {
+			for _, f := range r.Evaluate(ctx) {
+				fired = append(fired, f.RuleID)
+			}
+		}
+		if tc.wantRule == "" {
+			if len(fired) != 0 {
+				t.Errorf("%s: expected no OIDC findings; got %v", tc.name, fired)
+			}
+			continue
+		}
+		if len(fired) != 1 || fired[0] != tc.wantRule {
+			t.Errorf("%s: expected only %s; got %v", tc.name, tc.wantRule, fired)
+		}
+	}
+}
+
+// TestEKSOIDCIssuerMismatchRule_Silent_WhenEKSDataNil verifies that nil EKSData
+// does not panic and produces no findings.
+func TestEKSOIDCIssuerMismatchRule_Silent_WhenEKSDataNil(t *testing.T) {
+	ctx := RuleContext{ClusterData: &models.KubernetesClusterData{ContextName: "generic"}}
+	if got := (EKSOIDCIssuerMismatchRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings when EKSData is nil; got %d", len(got))
+	}
+}
+
 // ── EKS_SERVICEACCOUNT_NO_IRSA ────────────────────────────────────────────────
 
 // TestEKSServiceAccountNoIRSARule_Fires_WhenNoAnnotation verifies that the rule
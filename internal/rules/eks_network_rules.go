@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// eksNodeSensitivePorts are the node ports EKS_NODE_SG_OPEN_INGRESS refuses to
+// see open to the internet, in ascending order: remote admin access, etcd,
+// and the kubelet APIs.
+var eksNodeSensitivePorts = []struct {
+	port    int
+	service string
+}{
+	{22, "SSH"},
+	{2379, "etcd"},
+	{2380, "etcd peer"},
+	{3389, "RDP"},
+	{10250, "kubelet API"},
+	{10255, "kubelet read-only API"},
+}
+
+// ── EKS_NODE_SG_OPEN_INGRESS ─────────────────────────────────────────────────
+
+// EKSNodeSGOpenIngressRule fires when a node security group allows inbound
+// TCP traffic from anywhere (0.0.0.0/0 or ::/0) to a port range that covers a
+// sensitive node port. Wide ranges such as the ephemeral 1024-65535 fire too,
+// since they expose the kubelet API.
+type EKSNodeSGOpenIngressRule struct{}
+
+func (r EKSNodeSGOpenIngressRule) ID() string { return "EKS_NODE_SG_OPEN_INGRESS" }
+func (r EKSNodeSGOpenIngressRule) Name() string {
+	return "EKS Node Security Group Open to the Internet"
+}
+
+// Evaluate returns one HIGH finding per node security group and port range
+// open to the internet that covers at least one of eksNodeSensitivePorts.
+// IPv4 and IPv6 rules for the same range produce a single finding.
+func (r EKSNodeSGOpenIngressRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil || ctx.ClusterData.EKSData == nil {
+		return nil
+	}
+	eks := ctx.ClusterData.EKSData
+
+	type openRange struct {
+		groupID, protocol string
+		fromPort, toPort  int
+	}
+	cidrs := make(map[openRange][]string)
+	var order []openRange
+	for _, in := range eks.NodeSecurityGroupIngress {
+		if in.CIDR != "0.0.0.0/0" && in.CIDR != "::/0" {
+			continue
+		}
+		if in.Protocol != "tcp" && in.Protocol != "6" && in.Protocol != "-1" {
+			continue
+		}
+		key := openRange{in.GroupID, in.Protocol, in.FromPort, in.ToPort}
+		if _, ok := cidrs[key]; !ok {
+			order = append(order, key)
+		}
+		cidrs[key] = append(cidrs[key], in.CIDR)
+	}
+
+	var findings []models.Finding
+	for _, key := range order {
+		var ports []int
+		var services []string
+		for _, p := range eksNodeSensitivePorts {
+			if p.port >= key.fromPort && p.port <= key.toPort {
+				ports = append(ports, p.port)
+				services = append(services, fmt.Sprintf("%s (%d)", p.service, p.port))
+			}
+		}
+		if len(ports) == 0 {
+			continue
+		}
+		portRange := eksPortRange(key.protocol, key.fromPort, key.toPort)
+		openCIDRs := cidrs[key]
+		sort.Strings(openCIDRs)
+
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s:%s", r.ID(), eks.ClusterName, key.groupID, portRange),
+			RuleID:       r.ID(),
+			ResourceID:   key.groupID,
+			ResourceType: models.ResourceAWSSecurityGroup,
+			Region:       eks.Region,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityHigh,
+			Explanation: fmt.Sprintf(
+				"Node security group %s of EKS cluster %q allows ports %s from %s, exposing %s to the internet.",
+				key.groupID, eks.ClusterName, portRange, strings.Join(openCIDRs, " and "), strings.Join(services, ", "),
+			),
+			Recommendation: fmt.Sprintf(
+				"Remove the 0.0.0.0/0 and ::/0 ingress for ports %s from %s and allow only the cluster security group "+
+					"and trusted CIDRs; use SSM Session Manager instead of SSH for node access.",
+				portRange, key.groupID,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"cluster_name":      eks.ClusterName,
+				"region":            eks.Region,
+				"security_group_id": key.groupID,
+				"protocol":          key.protocol,
+				"port_range":        portRange,
+				"from_port":         key.fromPort,
+				"to_port":           key.toPort,
+				"open_cidrs":        openCIDRs,
+				"exposed_ports":     ports,
+			},
+		})
+	}
+	return findings
+}
+
+// eksPortRange formats an ingress port range: "all" for every protocol,
+// "22" for a single port, and "1024-65535" otherwise.
+func eksPortRange(protocol string, from, to int) string {
+	switch {
+	case protocol == "-1":
+		return "all"
+	case from == to:
+		return strconv.Itoa(from)
+	}
+	return fmt.Sprintf("%d-%d", from, to)
+}
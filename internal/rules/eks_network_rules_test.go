@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// eksNodeSGCluster builds a minimal EKS cluster carrying only the given node
+// security group ingress rules.
+func eksNodeSGCluster(ingress ...models.KubernetesEKSIngressRule) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{
+		ContextName:     "sg-cluster",
+		ClusterProvider: "eks",
+		EKSData: &models.KubernetesEKSData{
+			ClusterName:              "sg-cluster",
+			Region:                   "us-east-1",
+			NodeSecurityGroupIngress: ingress,
+		},
+	}
+}
+
+// ── EKS_NODE_SG_OPEN_INGRESS ─────────────────────────────────────────────────
+
+func TestEKSNodeSGOpenIngressRule_Fires_WhenSSHOpenToWorld(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksNodeSGCluster(
+		models.KubernetesEKSIngressRule{GroupID: "sg-remote", Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: "0.0.0.0/0"},
+		models.KubernetesEKSIngressRule{GroupID: "sg-remote", Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: "::/0"},
+	)}
+	findings := (EKSNodeSGOpenIngressRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding (IPv4 and IPv6 merged); got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "EKS_NODE_SG_OPEN_INGRESS" || f.Severity != models.SeverityHigh {
+		t.Errorf("RuleID/Severity = %s/%s; want EKS_NODE_SG_OPEN_INGRESS/HIGH", f.RuleID, f.Severity)
+	}
+	if f.ID != "EKS_NODE_SG_OPEN_INGRESS:sg-cluster:sg-remote:22" {
+		t.Errorf("ID = %q; want EKS_NODE_SG_OPEN_INGRESS:sg-cluster:sg-remote:22", f.ID)
+	}
+	if f.ResourceID != "sg-remote" || f.ResourceType != models.ResourceAWSSecurityGroup {
+		t.Errorf("resource = %s/%s; want sg-remote/SECURITY_GROUP", f.ResourceID, f.ResourceType)
+	}
+	if f.Metadata["security_group_id"] != "sg-remote" || f.Metadata["port_range"] != "22" {
+		t.Errorf("metadata = %v; want security_group_id sg-remote, port_range 22", f.Metadata)
+	}
+	if cidrs, _ := f.Metadata["open_cidrs"].([]string); !slices.Equal(cidrs, []string{"0.0.0.0/0", "::/0"}) {
+		t.Errorf("open_cidrs = %v; want [0.0.0.0/0 ::/0]", f.Metadata["open_cidrs"])
+	}
+}
+
+func TestEKSNodeSGOpenIngressRule_Silent_WhenIngressScoped(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksNodeSGCluster(
+		models.KubernetesEKSIngressRule{GroupID: "sg-remote", Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: "10.0.0.0/8"},
+		models.KubernetesEKSIngressRule{GroupID: "sg-cluster", Protocol: "-1", FromPort: 0, ToPort: 65535, CIDR: "192.168.0.0/16"},
+	)}
+	if got := (EKSNodeSGOpenIngressRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected no findings for ingress scoped to private CIDRs; got %d", len(got))
+	}
+}
+
+func TestEKSNodeSGOpenIngressRule_Silent_WhenOpenPortNotSensitive(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksNodeSGCluster(
+		models.KubernetesEKSIngressRule{GroupID: "sg-cluster", Protocol: "tcp", FromPort: 443, ToPort: 443, CIDR: "0.0.0.0/0"},
+	)}
+	if got := (EKSNodeSGOpenIngressRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected no findings for open HTTPS; got %d", len(got))
+	}
+}
+
+func TestEKSNodeSGOpenIngressRule_Fires_WhenEphemeralRangeOpen(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksNodeSGCluster(
+		models.KubernetesEKSIngressRule{GroupID: "sg-cluster", Protocol: "tcp", FromPort: 1024, ToPort: 65535, CIDR: "0.0.0.0/0"},
+	)}
+	findings := (EKSNodeSGOpenIngressRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for open ephemeral range; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Metadata["port_range"] != "1024-65535" || f.Metadata["from_port"] != 1024 || f.Metadata["to_port"] != 65535 {
+		t.Errorf("metadata = %v; want port_range 1024-65535", f.Metadata)
+	}
+	if ports, _ := f.Metadata["exposed_ports"].([]int); !slices.Equal(ports, []int{2379, 2380, 3389, 10250, 10255}) {
+		t.Errorf("exposed_ports = %v; want [2379 2380 3389 10250 10255]", f.Metadata["exposed_ports"])
+	}
+}
+
+func TestEKSNodeSGOpenIngressRule_Fires_WhenAllTrafficOpen(t *testing.T) {
+	ctx := RuleContext{ClusterData: eksNodeSGCluster(
+		models.KubernetesEKSIngressRule{GroupID: "sg-cluster", Protocol: "-1", FromPort: 0, ToPort: 65535, CIDR: "::/0"},
+	)}
+	findings := (EKSNodeSGOpenIngressRule{}).Evaluate(ctx)
+	if len(findings) != 1 || findings[0].Metadata["port_range"] != "all" {
+		t.Fatalf("expected 1 finding with port_range all; got %+v", findings)
+	}
+}
+
+func TestEKSNodeSGOpenIngressRule_NilEKSData(t *testing.T) {
+	ctx := RuleContext{ClusterData: &models.KubernetesClusterData{ContextName: "gke"}}
+	if got := (EKSNodeSGOpenIngressRule{}).Evaluate(ctx); got != nil {
+		t.Errorf("expected nil without EKS data; got %v", got)
+	}
+}
@@ -119,3 +119,74 @@ func (r EKSEncryptionDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
 		},
 	}
 }
+
+// ── K8S_SECRET_UNENCRYPTED_ETCD ──────────────────────────────────────────────
+
+// K8SSecretUnencryptedEtcdRule fires when Kubernetes Secrets on an EKS cluster
+// are stored in etcd without envelope encryption. It is the secrets-scoped
+// counterpart of EKS_ENCRYPTION_DISABLED: it also fires when an encryption
+// configuration exists but does not list the "secrets" resource. Both rules
+// target the cluster name and region, so the engine merges them into one
+// finding rather than counting the cluster twice.
+type K8SSecretUnencryptedEtcdRule struct{}
+
+func (r K8SSecretUnencryptedEtcdRule) ID() string { return "K8S_SECRET_UNENCRYPTED_ETCD" }
+func (r K8SSecretUnencryptedEtcdRule) Name() string {
+	return "Kubernetes Secrets Not Encrypted in etcd"
+}
+
+// Evaluate returns a HIGH finding when EKSData shows no envelope encryption
+// covering secrets. When EncryptionEnabled is true and EncryptedResources is
+// empty, secrets are assumed covered because EKS only encrypts secrets.
+func (r K8SSecretUnencryptedEtcdRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil || ctx.ClusterData.EKSData == nil {
+		return nil
+	}
+	eks := ctx.ClusterData.EKSData
+	if secretsEncrypted(eks) {
+		return nil
+	}
+	return []models.Finding{
+		{
+			ID:           fmt.Sprintf("%s:%s", r.ID(), eks.ClusterName),
+			RuleID:       r.ID(),
+			ResourceID:   eks.ClusterName,
+			ResourceType: models.ResourceK8sCluster,
+			Region:       eks.Region,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityHigh,
+			Explanation: fmt.Sprintf(
+				"Kubernetes Secrets on EKS cluster %q are stored in etcd without envelope encryption. "+
+					"Anyone with access to the etcd datastore or its backups can read every Secret.",
+				eks.ClusterName,
+			),
+			Recommendation: "Associate an AWS KMS key with the cluster and enable envelope encryption " +
+				"for the \"secrets\" resource (aws eks associate-encryption-config). " +
+				"Existing Secrets are re-encrypted once the configuration is applied.",
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"cluster_name":        eks.ClusterName,
+				"region":              eks.Region,
+				"encrypted_resources": eks.EncryptedResources,
+			},
+		},
+	}
+}
+
+// secretsEncrypted reports whether the cluster's envelope encryption covers
+// Kubernetes Secrets.
+func secretsEncrypted(eks *models.KubernetesEKSData) bool {
+	if !eks.EncryptionEnabled {
+		return false
+	}
+	if len(eks.EncryptedResources) == 0 {
+		return true
+	}
+	for _, res := range eks.EncryptedResources {
+		if res == "secrets" {
+			return true
+		}
+	}
+	return false
+}
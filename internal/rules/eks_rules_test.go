@@ -178,6 +178,59 @@ func TestEKSEncryptionDisabledRule_Silent_WhenClusterDataNil(t *testing.T) {
 	}
 }
 
+// ── K8S_SECRET_UNENCRYPTED_ETCD ──────────────────────────────────────────────
+
+// TestK8SSecretUnencryptedEtcdRule_Fires_WhenNotEnabled verifies a HIGH finding
+// on the same resource as EKS_ENCRYPTION_DISABLED when encryption is off.
+func TestK8SSecretUnencryptedEtcdRule_Fires_WhenNotEnabled(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: eksClusterDataPhase5("no-enc-cluster", "us-east-1", false,
+			[]string{"api", "audit", "authenticator"}, false),
+	}
+	findings := (K8SSecretUnencryptedEtcdRule{}).Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding when encryption disabled; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_SECRET_UNENCRYPTED_ETCD" {
+		t.Errorf("RuleID = %q; want K8S_SECRET_UNENCRYPTED_ETCD", f.RuleID)
+	}
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("Severity = %q; want HIGH", f.Severity)
+	}
+	enc := (EKSEncryptionDisabledRule{}).Evaluate(ctx)[0]
+	if f.ResourceID != enc.ResourceID || f.Region != enc.Region {
+		t.Errorf("resource = %s/%s; want %s/%s so the engine merges it with EKS_ENCRYPTION_DISABLED",
+			f.ResourceID, f.Region, enc.ResourceID, enc.Region)
+	}
+}
+
+// TestK8SSecretUnencryptedEtcdRule_Fires_WhenSecretsNotCovered verifies the
+// rule fires when an encryption config exists without the secrets resource.
+func TestK8SSecretUnencryptedEtcdRule_Fires_WhenSecretsNotCovered(t *testing.T) {
+	data := eksClusterDataPhase5("other-res", "us-east-1", false, nil, true)
+	data.EKSData.EncryptedResources = []string{"configmaps"}
+	if got := (K8SSecretUnencryptedEtcdRule{}).Evaluate(RuleContext{ClusterData: data}); len(got) != 1 {
+		t.Errorf("expected 1 finding when secrets are not covered; got %d", len(got))
+	}
+}
+
+// TestK8SSecretUnencryptedEtcdRule_Silent_WhenSecretsEncrypted verifies the
+// rule is silent when secrets are covered, including when the resource list
+// was not collected.
+func TestK8SSecretUnencryptedEtcdRule_Silent_WhenSecretsEncrypted(t *testing.T) {
+	for _, resources := range [][]string{nil, {"secrets"}} {
+		data := eksClusterDataPhase5("enc-ok", "eu-central-1", false, nil, true)
+		data.EKSData.EncryptedResources = resources
+		if got := (K8SSecretUnencryptedEtcdRule{}).Evaluate(RuleContext{ClusterData: data}); len(got) != 0 {
+			t.Errorf("resources %v: expected 0 findings; got %d", resources, len(got))
+		}
+	}
+	if got := (K8SSecretUnencryptedEtcdRule{}).Evaluate(RuleContext{}); len(got) != 0 {
+		t.Errorf("expected 0 findings when ClusterData is nil; got %d", len(got))
+	}
+}
+
 // ── Cross-rule: Phase 5A all-fire / none-fire ─────────────────────────────────
 
 // TestPhase5AEKSRules_AllThreeFire verifies that all three Phase 5A rules fire
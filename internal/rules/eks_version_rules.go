@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// eksEOLWarningDays is how many days before the end of standard support
+// EKS_VERSION_END_OF_SUPPORT starts warning at MEDIUM severity.
+const eksEOLWarningDays = 90
+
+// eksStandardSupportEnd maps EKS Kubernetes minor versions to the date AWS
+// ends standard support for them (14 months after the EKS release). After
+// this date clusters move to paid extended support and are eventually
+// upgraded automatically. Versions released after the table was last updated
+// are reported at INFO until an entry is added.
+var eksStandardSupportEnd = map[string]time.Time{
+	"1.21": time.Date(2023, 2, 15, 0, 0, 0, 0, time.UTC),
+	"1.22": time.Date(2023, 6, 4, 0, 0, 0, 0, time.UTC),
+	"1.23": time.Date(2023, 10, 11, 0, 0, 0, 0, time.UTC),
+	"1.24": time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	"1.25": time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	"1.26": time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC),
+	"1.27": time.Date(2024, 7, 24, 0, 0, 0, 0, time.UTC),
+	"1.28": time.Date(2024, 11, 26, 0, 0, 0, 0, time.UTC),
+	"1.29": time.Date(2025, 3, 23, 0, 0, 0, 0, time.UTC),
+	"1.30": time.Date(2025, 7, 23, 0, 0, 0, 0, time.UTC),
+	"1.31": time.Date(2025, 11, 26, 0, 0, 0, 0, time.UTC),
+	"1.32": time.Date(2026, 3, 23, 0, 0, 0, 0, time.UTC),
+	"1.33": time.Date(2026, 7, 29, 0, 0, 0, 0, time.UTC),
+	"1.34": time.Date(2026, 12, 2, 0, 0, 0, 0, time.UTC),
+}
+
+// ── EKS_VERSION_END_OF_SUPPORT ───────────────────────────────────────────────
+
+// EKSVersionEndOfSupportRule fires when the cluster's Kubernetes version has
+// reached AWS's end-of-standard-support date. Such clusters no longer receive
+// patches under standard support and are billed for extended support.
+type EKSVersionEndOfSupportRule struct{}
+
+func (r EKSVersionEndOfSupportRule) ID() string   { return "EKS_VERSION_END_OF_SUPPORT" }
+func (r EKSVersionEndOfSupportRule) Name() string { return "EKS Kubernetes Version End of Support" }
+
+// Evaluate returns a HIGH finding when today is on or after the standard
+// support end date of EKSData.Version, and a MEDIUM finding when that date is
+// less than eksEOLWarningDays away. A version missing from
+// eksStandardSupportEnd yields an INFO finding so the gap is visible rather
+// than silently passing; an empty version is skipped.
+func (r EKSVersionEndOfSupportRule) Evaluate(ctx RuleContext) []models.Finding {
+	return r.evaluateAt(ctx, time.Now().UTC())
+}
+
+func (r EKSVersionEndOfSupportRule) evaluateAt(ctx RuleContext, now time.Time) []models.Finding {
+	if ctx.ClusterData == nil || ctx.ClusterData.EKSData == nil {
+		return nil
+	}
+	eks := ctx.ClusterData.EKSData
+	if eks.Version == "" {
+		return nil
+	}
+	eol, ok := eksStandardSupportEnd[eks.Version]
+	if !ok {
+		return []models.Finding{r.unknownVersionFinding(ctx, now)}
+	}
+
+	daysRemaining := int(eol.Sub(now).Hours() / 24)
+	if eol.After(now) && daysRemaining >= eksEOLWarningDays {
+		return nil
+	}
+
+	eolDate := eol.Format(time.DateOnly)
+	severity := models.SeverityHigh
+	explanation := fmt.Sprintf(
+		"EKS cluster %q runs Kubernetes %s, whose standard support ended on %s.",
+		eks.ClusterName, eks.Version, eolDate,
+	)
+	if eol.After(now) {
+		severity = models.SeverityMedium
+		explanation = fmt.Sprintf(
+			"EKS cluster %q runs Kubernetes %s, whose standard support ends on %s (%d days from now).",
+			eks.ClusterName, eks.Version, eolDate, daysRemaining,
+		)
+	} else {
+		daysRemaining = 0
+	}
+
+	return []models.Finding{{
+		ID:           fmt.Sprintf("%s:%s:%s", r.ID(), eks.ClusterName, eks.Version),
+		RuleID:       r.ID(),
+		ResourceID:   eks.ClusterName,
+		ResourceType: models.ResourceK8sCluster,
+		Region:       eks.Region,
+		AccountID:    ctx.AccountID,
+		Profile:      ctx.Profile,
+		Severity:     severity,
+		Explanation:  explanation,
+		Recommendation: fmt.Sprintf(
+			"Upgrade cluster %s to a supported Kubernetes version (aws eks update-cluster-version --name %s --kubernetes-version <next>) "+
+				"to stay on standard support and avoid extended-support charges.",
+			eks.ClusterName, eks.ClusterName,
+		),
+		DetectedAt: now,
+		Metadata: map[string]any{
+			"cluster_name":            eks.ClusterName,
+			"region":                  eks.Region,
+			"version":                 eks.Version,
+			"end_of_standard_support": eolDate,
+			"days_remaining":          daysRemaining,
+		},
+	}}
+}
+
+// unknownVersionFinding reports that the end of standard support for the
+// cluster's version is not in eksStandardSupportEnd, so the rule cannot tell
+// whether the cluster is still on standard support.
+func (r EKSVersionEndOfSupportRule) unknownVersionFinding(ctx RuleContext, now time.Time) models.Finding {
+	eks := ctx.ClusterData.EKSData
+	return models.Finding{
+		ID:           fmt.Sprintf("%s:%s:%s", r.ID(), eks.ClusterName, eks.Version),
+		RuleID:       r.ID(),
+		ResourceID:   eks.ClusterName,
+		ResourceType: models.ResourceK8sCluster,
+		Region:       eks.Region,
+		AccountID:    ctx.AccountID,
+		Profile:      ctx.Profile,
+		Severity:     models.SeverityInfo,
+		Confidence:   models.ConfidenceLow,
+		Explanation: fmt.Sprintf(
+			"EKS cluster %q runs Kubernetes %s, whose end of standard support is not in dp's version table.",
+			eks.ClusterName, eks.Version,
+		),
+		Recommendation: "Check the Amazon EKS Kubernetes release calendar for the standard support end date of this version, " +
+			"and upgrade dp to pick up a newer version table.",
+		DetectedAt: now,
+		Metadata: map[string]any{
+			"cluster_name": eks.ClusterName,
+			"region":       eks.Region,
+			"version":      eks.Version,
+		},
+	}
+}
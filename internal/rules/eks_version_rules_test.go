@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// eksVersionCluster builds a minimal EKS cluster running the given Kubernetes version.
+func eksVersionCluster(version string) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{
+		ContextName:     "version-cluster",
+		ClusterProvider: "eks",
+		EKSData: &models.KubernetesEKSData{
+			ClusterName: "version-cluster",
+			Region:      "us-east-1",
+			Version:     version,
+		},
+	}
+}
+
+// ── EKS_VERSION_END_OF_SUPPORT ───────────────────────────────────────────────
+
+func TestEKSVersionEndOfSupportRule_Silent_WhenSupported(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	ctx := RuleContext{ClusterData: eksVersionCluster("1.33")}
+	if got := (EKSVersionEndOfSupportRule{}).evaluateAt(ctx, now); len(got) != 0 {
+		t.Errorf("expected no findings for a supported version; got %d", len(got))
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_Warns_WhenNearEOL(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	ctx := RuleContext{ClusterData: eksVersionCluster("1.32")}
+	got := (EKSVersionEndOfSupportRule{}).evaluateAt(ctx, now)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 finding for a version near end of support; got %d", len(got))
+	}
+	f := got[0]
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %s; want MEDIUM", f.Severity)
+	}
+	if f.Metadata["end_of_standard_support"] != "2026-03-23" || f.Metadata["days_remaining"] != 72 {
+		t.Errorf("metadata = %v; want end_of_standard_support 2026-03-23, days_remaining 72", f.Metadata)
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_Fires_WhenPastEOL(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	ctx := RuleContext{ClusterData: eksVersionCluster("1.29")}
+	got := (EKSVersionEndOfSupportRule{}).evaluateAt(ctx, now)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 finding for a version past end of support; got %d", len(got))
+	}
+	f := got[0]
+	if f.RuleID != "EKS_VERSION_END_OF_SUPPORT" || f.Severity != models.SeverityHigh {
+		t.Errorf("RuleID/Severity = %s/%s; want EKS_VERSION_END_OF_SUPPORT/HIGH", f.RuleID, f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sCluster || f.ResourceID != "version-cluster" || f.Region != "us-east-1" {
+		t.Errorf("resource = %s/%s in %s; want K8S_CLUSTER/version-cluster in us-east-1", f.ResourceType, f.ResourceID, f.Region)
+	}
+	if f.Metadata["version"] != "1.29" || f.Metadata["end_of_standard_support"] != "2025-03-23" {
+		t.Errorf("metadata = %v; want version 1.29, end_of_standard_support 2025-03-23", f.Metadata)
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_Fires_OnEOLDate(t *testing.T) {
+	now := time.Date(2025, 3, 23, 0, 0, 0, 0, time.UTC)
+	got := (EKSVersionEndOfSupportRule{}).evaluateAt(RuleContext{ClusterData: eksVersionCluster("1.29")}, now)
+	if len(got) != 1 || got[0].Severity != models.SeverityHigh {
+		t.Fatalf("expected 1 HIGH finding on the end-of-support date; got %+v", got)
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_Warns_134NearEOL(t *testing.T) {
+	now := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	got := (EKSVersionEndOfSupportRule{}).evaluateAt(RuleContext{ClusterData: eksVersionCluster("1.34")}, now)
+	if len(got) != 1 || got[0].Severity != models.SeverityMedium || got[0].Metadata["end_of_standard_support"] != "2026-12-02" {
+		t.Fatalf("expected 1 MEDIUM finding with end_of_standard_support 2026-12-02; got %+v", got)
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_Info_UnknownVersion(t *testing.T) {
+	got := (EKSVersionEndOfSupportRule{}).Evaluate(RuleContext{ClusterData: eksVersionCluster("1.99")})
+	if len(got) != 1 {
+		t.Fatalf("expected 1 finding for a version missing from the table; got %d", len(got))
+	}
+	f := got[0]
+	if f.Severity != models.SeverityInfo || f.Metadata["version"] != "1.99" {
+		t.Errorf("Severity = %s, metadata = %v; want INFO with version 1.99", f.Severity, f.Metadata)
+	}
+	if _, ok := f.Metadata["end_of_standard_support"]; ok {
+		t.Error("finding for an unknown version must not carry end_of_standard_support")
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_Silent_EmptyVersion(t *testing.T) {
+	if got := (EKSVersionEndOfSupportRule{}).Evaluate(RuleContext{ClusterData: eksVersionCluster("")}); len(got) != 0 {
+		t.Errorf("expected no findings for an empty version; got %d", len(got))
+	}
+}
+
+func TestEKSVersionEndOfSupportRule_NilEKSData(t *testing.T) {
+	ctx := RuleContext{ClusterData: &models.KubernetesClusterData{ContextName: "kind"}}
+	if got := (EKSVersionEndOfSupportRule{}).Evaluate(ctx); got != nil {
+		t.Errorf("expected nil findings; got %v", got)
+	}
+}
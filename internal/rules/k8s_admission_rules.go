@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -105,6 +106,70 @@ func (r K8SNamespacePSSNotSetRule) Evaluate(ctx RuleContext) []models.Finding {
 	return findings
 }
 
+// ── K8S_NAMESPACE_NO_PSA ──────────────────────────────────────────────────────
+
+// defaultSystemNamespaces are the namespaces K8SNamespaceNoPSARule skips when
+// SystemNamespaces is empty.
+var defaultSystemNamespaces = []string{"kube-system", "kube-public", "kube-node-lease"}
+
+// K8SNamespaceNoPSARule fires for each non-system namespace whose PSAEnforce
+// level is empty, i.e. the namespace has no pod-security.kubernetes.io/enforce
+// label and Pod Security Admission does not check its pods at all. Any enforce
+// level, including an explicit "privileged", counts as a deliberate choice and
+// does not fire.
+//
+// System namespaces are skipped because their control-plane workloads usually
+// need privileges PSA would reject. SystemNamespaces, when non-empty, replaces
+// the default set (kube-system, kube-public, kube-node-lease); it is populated
+// from dp.yaml system_namespaces by the rule pack.
+type K8SNamespaceNoPSARule struct {
+	SystemNamespaces []string
+}
+
+func (r K8SNamespaceNoPSARule) ID() string   { return "K8S_NAMESPACE_NO_PSA" }
+func (r K8SNamespaceNoPSARule) Name() string { return "Namespace Without Pod Security Admission" }
+
+func (r K8SNamespaceNoPSARule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	system := r.SystemNamespaces
+	if len(system) == 0 {
+		system = defaultSystemNamespaces
+	}
+	var findings []models.Finding
+	for _, ns := range ctx.ClusterData.Namespaces {
+		if ns.PSAEnforce != "" || slices.Contains(system, ns.Name) {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s", r.ID(), ctx.ClusterData.ContextName, ns.Name),
+			RuleID:       r.ID(),
+			ResourceID:   ns.Name,
+			ResourceType: models.ResourceK8sNamespace,
+			Region:       ctx.ClusterData.ContextName,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityMedium,
+			Explanation: fmt.Sprintf(
+				"Namespace %q has no Pod Security Admission enforce label, so pods "+
+					"are admitted without any Pod Security Standards check.",
+				ns.Name,
+			),
+			Recommendation: fmt.Sprintf(
+				"Label namespace %q with %s=restricted (or baseline while workloads "+
+					"are being hardened). Use warn and audit labels first to preview violations.",
+				ns.Name, psaEnforceLabel,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"namespace": ns.Name,
+			},
+		})
+	}
+	return findings
+}
+
 // ── K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT ────────────────────────────────────────
 
 // K8SServiceAccountTokenAutomountRule fires for each ServiceAccount whose
@@ -160,6 +225,57 @@ func (r K8SServiceAccountTokenAutomountRule) Evaluate(ctx RuleContext) []models.
 	return findings
 }
 
+// ── K8S_POD_AUTOMOUNT_TOKEN ──────────────────────────────────────────────────
+
+// K8SPodAutomountTokenRule fires for each pod that explicitly sets
+// spec.automountServiceAccountToken: true. The pod-level field overrides the
+// ServiceAccount's setting, so a pod can re-enable token mounting even when
+// its ServiceAccount disables it. Pods that leave the field unset are not
+// flagged; their ServiceAccount is covered by K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT.
+type K8SPodAutomountTokenRule struct{}
+
+func (r K8SPodAutomountTokenRule) ID() string { return "K8S_POD_AUTOMOUNT_TOKEN" }
+func (r K8SPodAutomountTokenRule) Name() string {
+	return "Pod Explicitly Auto-Mounts API Token"
+}
+
+func (r K8SPodAutomountTokenRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, pod := range ctx.ClusterData.Pods {
+		if pod.AutomountServiceAccountToken == nil || !*pod.AutomountServiceAccountToken {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name),
+			RuleID:       r.ID(),
+			ResourceID:   pod.Name,
+			ResourceType: models.ResourceK8sPod,
+			Region:       ctx.ClusterData.ContextName,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityMedium,
+			Explanation: fmt.Sprintf(
+				"Pod %q (namespace %q) sets automountServiceAccountToken: true, mounting "+
+					"the ServiceAccount API token regardless of the ServiceAccount's setting.",
+				pod.Name, pod.Namespace,
+			),
+			Recommendation: fmt.Sprintf(
+				"Remove automountServiceAccountToken: true from pod %q in namespace %q, or set it "+
+					"to false, unless the workload calls the Kubernetes API.",
+				pod.Name, pod.Namespace,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"namespace": pod.Namespace,
+			},
+		})
+	}
+	return findings
+}
+
 // ── K8S_DEFAULT_SERVICEACCOUNT_USED ──────────────────────────────────────────
 
 // K8SDefaultServiceAccountUsedRule fires for each pod whose
@@ -211,3 +327,73 @@ func (r K8SDefaultServiceAccountUsedRule) Evaluate(ctx RuleContext) []models.Fin
 	}
 	return findings
 }
+
+// clusterAdminRole is the built-in ClusterRole granting unrestricted access
+// to every resource in the cluster.
+const clusterAdminRole = "cluster-admin"
+
+// ── K8S_SERVICEACCOUNT_CLUSTER_ADMIN ──────────────────────────────────────────
+
+// K8SServiceAccountClusterAdminRule fires for each ServiceAccount subject bound
+// to the cluster-admin ClusterRole via a ClusterRoleBinding. Any pod running
+// under such a ServiceAccount holds full control of the cluster; a single
+// compromised container is therefore a full cluster compromise.
+// User and Group subjects are ignored by this rule. ResourceID is
+// "<namespace>/<name>@<binding>", so a ServiceAccount bound by two
+// ClusterRoleBindings, or same-named ServiceAccounts in different namespaces,
+// stay distinct findings after the engine merges findings per resource.
+type K8SServiceAccountClusterAdminRule struct{}
+
+func (r K8SServiceAccountClusterAdminRule) ID() string {
+	return "K8S_SERVICEACCOUNT_CLUSTER_ADMIN"
+}
+func (r K8SServiceAccountClusterAdminRule) Name() string {
+	return "ServiceAccount Bound to cluster-admin"
+}
+
+func (r K8SServiceAccountClusterAdminRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, crb := range ctx.ClusterData.ClusterRoleBindings {
+		if crb.RoleName != clusterAdminRole {
+			continue
+		}
+		for _, subj := range crb.Subjects {
+			if subj.Kind != "ServiceAccount" {
+				continue
+			}
+			findings = append(findings, models.Finding{
+				ID:           fmt.Sprintf("%s:%s:%s:%s/%s", r.ID(), ctx.ClusterData.ContextName, crb.Name, subj.Namespace, subj.Name),
+				RuleID:       r.ID(),
+				ResourceID:   fmt.Sprintf("%s/%s@%s", subj.Namespace, subj.Name, crb.Name),
+				ResourceType: models.ResourceK8sServiceAccount,
+				Region:       ctx.ClusterData.ContextName,
+				AccountID:    ctx.AccountID,
+				Profile:      ctx.Profile,
+				Severity:     models.SeverityCritical,
+				Explanation: fmt.Sprintf(
+					"ServiceAccount %q in namespace %q is bound to the cluster-admin "+
+						"ClusterRole via ClusterRoleBinding %q. Any pod running under this "+
+						"ServiceAccount has unrestricted control of the cluster.",
+					subj.Name, subj.Namespace, crb.Name,
+				),
+				Recommendation: fmt.Sprintf(
+					"Remove ServiceAccount %q from ClusterRoleBinding %q and grant it a "+
+						"narrowly scoped Role or ClusterRole containing only the verbs and "+
+						"resources the workload requires.",
+					subj.Name, crb.Name,
+				),
+				DetectedAt: time.Now().UTC(),
+				Metadata: map[string]any{
+					"namespace":       subj.Namespace,
+					"service_account": subj.Name,
+					"binding_name":    crb.Name,
+					"resource_name":   subj.Name,
+				},
+			})
+		}
+	}
+	return findings
+}
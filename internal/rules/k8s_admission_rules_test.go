@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -209,6 +210,97 @@ func TestNamespacePSSNotSet_ResourceID_IsNamespaceName(t *testing.T) {
 	}
 }
 
+// ── K8S_NAMESPACE_NO_PSA ──────────────────────────────────────────────────────
+
+// nsWithPSALevel returns a KubernetesNamespaceData enforcing the given PSA level.
+func nsWithPSALevel(name, level string) models.KubernetesNamespaceData {
+	return models.KubernetesNamespaceData{
+		Name:       name,
+		Labels:     map[string]string{psaEnforceLabel: level},
+		PSAEnforce: level,
+	}
+}
+
+func noPSANamespaces(findings []models.Finding) []string {
+	var names []string
+	for _, f := range findings {
+		names = append(names, f.ResourceID)
+	}
+	return names
+}
+
+func TestNamespaceNoPSA_EnforcedAndPrivilegedSilent_MissingFires(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: admissionCluster(
+			[]models.KubernetesNamespaceData{
+				nsWithPSALevel("payments", "restricted"),
+				nsWithPSALevel("legacy", "privileged"),
+				nsWithoutLabel("staging"),
+			},
+			nil, nil,
+		),
+	}
+	findings := K8SNamespaceNoPSARule{}.Evaluate(ctx)
+	if len(findings) != 1 || findings[0].ResourceID != "staging" {
+		t.Fatalf("expected 1 finding for staging; got %v", noPSANamespaces(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_NAMESPACE_NO_PSA" {
+		t.Errorf("RuleID = %q; want K8S_NAMESPACE_NO_PSA", f.RuleID)
+	}
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sNamespace {
+		t.Errorf("ResourceType = %q; want K8S_NAMESPACE", f.ResourceType)
+	}
+	if f.Metadata["namespace"] != "staging" {
+		t.Errorf("Metadata[namespace] = %v; want staging", f.Metadata["namespace"])
+	}
+}
+
+func TestNamespaceNoPSA_SkipsDefaultSystemNamespaces(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: admissionCluster(
+			[]models.KubernetesNamespaceData{
+				nsWithoutLabel("kube-system"),
+				nsWithoutLabel("kube-public"),
+				nsWithoutLabel("kube-node-lease"),
+				nsWithoutLabel("default"),
+			},
+			nil, nil,
+		),
+	}
+	findings := K8SNamespaceNoPSARule{}.Evaluate(ctx)
+	if len(findings) != 1 || findings[0].ResourceID != "default" {
+		t.Errorf("expected only default to fire; got %v", noPSANamespaces(findings))
+	}
+}
+
+// TestNamespaceNoPSA_SystemNamespacesReplaceDefaults verifies that a
+// configured system namespace list replaces, rather than extends, the defaults.
+func TestNamespaceNoPSA_SystemNamespacesReplaceDefaults(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: admissionCluster(
+			[]models.KubernetesNamespaceData{
+				nsWithoutLabel("kube-system"),
+				nsWithoutLabel("istio-system"),
+			},
+			nil, nil,
+		),
+	}
+	findings := K8SNamespaceNoPSARule{SystemNamespaces: []string{"istio-system"}}.Evaluate(ctx)
+	if len(findings) != 1 || findings[0].ResourceID != "kube-system" {
+		t.Errorf("expected only kube-system to fire; got %v", noPSANamespaces(findings))
+	}
+}
+
+func TestNamespaceNoPSA_Silent_WhenClusterDataNil(t *testing.T) {
+	if got := (K8SNamespaceNoPSARule{}).Evaluate(RuleContext{}); len(got) != 0 {
+		t.Errorf("expected 0 findings for nil ClusterData; got %d", len(got))
+	}
+}
+
 // ── K8S_SERVICEACCOUNT_TOKEN_AUTOMOUNT ────────────────────────────────────────
 
 func TestSATokenAutomount_Fires_WhenAutomountNil(t *testing.T) {
@@ -273,6 +365,66 @@ func TestSATokenAutomount_MultipleSAs_OnlyUnsafeFire(t *testing.T) {
 	}
 }
 
+// ── K8S_POD_AUTOMOUNT_TOKEN ──────────────────────────────────────────────────
+
+// podWithAutomount returns a pod whose spec.automountServiceAccountToken is automount.
+func podWithAutomount(name, ns string, automount *bool) models.KubernetesPodData {
+	return models.KubernetesPodData{Name: name, Namespace: ns, AutomountServiceAccountToken: automount}
+}
+
+func TestPodAutomountToken_Fires_WhenExplicitlyTrue(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: admissionCluster(nil, nil, []models.KubernetesPodData{
+			podWithAutomount("api-client", "jobs", boolPtr(true)),
+		}),
+	}
+	findings := K8SPodAutomountTokenRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_POD_AUTOMOUNT_TOKEN" {
+		t.Errorf("RuleID = %q; want K8S_POD_AUTOMOUNT_TOKEN", f.RuleID)
+	}
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sPod || f.ResourceID != "api-client" {
+		t.Errorf("resource = %s/%s; want K8S_POD/api-client", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["namespace"] != "jobs" {
+		t.Errorf("namespace = %v; want jobs", f.Metadata["namespace"])
+	}
+}
+
+func TestPodAutomountToken_Silent_WhenExplicitlyFalse(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: admissionCluster(nil, nil, []models.KubernetesPodData{
+			podWithAutomount("worker", "jobs", boolPtr(false)),
+		}),
+	}
+	if got := (K8SPodAutomountTokenRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings when automount=false; got %d", len(got))
+	}
+}
+
+func TestPodAutomountToken_Silent_WhenUnset(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: admissionCluster(nil, nil, []models.KubernetesPodData{
+			podWithAutomount("worker", "jobs", nil),
+		}),
+	}
+	if got := (K8SPodAutomountTokenRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings when automount is unset; got %d", len(got))
+	}
+}
+
+func TestPodAutomountToken_Silent_WhenClusterDataNil(t *testing.T) {
+	if got := (K8SPodAutomountTokenRule{}).Evaluate(RuleContext{}); len(got) != 0 {
+		t.Errorf("expected 0 findings for nil ClusterData; got %d", len(got))
+	}
+}
+
 // ── K8S_DEFAULT_SERVICEACCOUNT_USED ──────────────────────────────────────────
 
 func TestDefaultSAUsed_Fires_WhenPodUsesDefaultSA(t *testing.T) {
@@ -344,3 +496,121 @@ func TestDefaultSAUsed_MultiplePods_OnlyDefaultFires(t *testing.T) {
 		t.Errorf("expected findings for pod-b and pod-d; got %v", names)
 	}
 }
+
+// ── K8S_SERVICEACCOUNT_CLUSTER_ADMIN ─────────────────────────────────────────
+
+// crbCluster builds a KubernetesClusterData holding only ClusterRoleBindings.
+func crbCluster(bindings ...models.KubernetesClusterRoleBindingData) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{
+		ContextName:         "test-cluster",
+		ClusterRoleBindings: bindings,
+	}
+}
+
+// saSubject returns a ServiceAccount RBAC subject.
+func saSubject(name, ns string) models.KubernetesRBACSubjectData {
+	return models.KubernetesRBACSubjectData{Kind: "ServiceAccount", Name: name, Namespace: ns}
+}
+
+func TestSAClusterAdmin_Fires_WhenSABoundToClusterAdmin(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: crbCluster(models.KubernetesClusterRoleBindingData{
+			Name:     "ci-admin",
+			RoleName: "cluster-admin",
+			Subjects: []models.KubernetesRBACSubjectData{saSubject("deployer", "ci")},
+		}),
+	}
+	findings := K8SServiceAccountClusterAdminRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_SERVICEACCOUNT_CLUSTER_ADMIN" {
+		t.Errorf("RuleID = %q; want K8S_SERVICEACCOUNT_CLUSTER_ADMIN", f.RuleID)
+	}
+	if f.Severity != models.SeverityCritical {
+		t.Errorf("Severity = %q; want CRITICAL", f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sServiceAccount {
+		t.Errorf("ResourceType = %q; want %q", f.ResourceType, models.ResourceK8sServiceAccount)
+	}
+	if f.ResourceID != "ci/deployer@ci-admin" {
+		t.Errorf("ResourceID = %q; want ci/deployer@ci-admin", f.ResourceID)
+	}
+	if f.Metadata["resource_name"] != "deployer" {
+		t.Errorf("metadata resource_name = %v; want deployer", f.Metadata["resource_name"])
+	}
+	if f.Metadata["service_account"] != "deployer" {
+		t.Errorf("metadata service_account = %v; want deployer", f.Metadata["service_account"])
+	}
+	if f.Metadata["namespace"] != "ci" {
+		t.Errorf("metadata namespace = %v; want ci", f.Metadata["namespace"])
+	}
+	if f.Metadata["binding_name"] != "ci-admin" {
+		t.Errorf("metadata binding_name = %v; want ci-admin", f.Metadata["binding_name"])
+	}
+}
+
+func TestSAClusterAdmin_DistinctIDsPerBinding(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: crbCluster(
+			models.KubernetesClusterRoleBindingData{
+				Name:     "ci-admin",
+				RoleName: "cluster-admin",
+				Subjects: []models.KubernetesRBACSubjectData{saSubject("deployer", "ci")},
+			},
+			models.KubernetesClusterRoleBindingData{
+				Name:     "legacy-admin",
+				RoleName: "cluster-admin",
+				Subjects: []models.KubernetesRBACSubjectData{saSubject("deployer", "ci")},
+			},
+		),
+	}
+	findings := K8SServiceAccountClusterAdminRule{}.Evaluate(ctx)
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings; got %d", len(findings))
+	}
+	if findings[0].ID == findings[1].ID {
+		t.Errorf("findings for different bindings share ID %q", findings[0].ID)
+	}
+	if findings[0].ResourceID == findings[1].ResourceID {
+		t.Errorf("findings for different bindings share ResourceID %q", findings[0].ResourceID)
+	}
+	if !strings.Contains(findings[1].ID, "legacy-admin") {
+		t.Errorf("ID = %q; want it to include the binding name legacy-admin", findings[1].ID)
+	}
+}
+
+func TestSAClusterAdmin_Silent_WhenSABoundToView(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: crbCluster(models.KubernetesClusterRoleBindingData{
+			Name:     "monitoring-view",
+			RoleName: "view",
+			Subjects: []models.KubernetesRBACSubjectData{saSubject("prometheus", "monitoring")},
+		}),
+	}
+	if got := (K8SServiceAccountClusterAdminRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings for view-bound SA; got %d", len(got))
+	}
+}
+
+func TestSAClusterAdmin_Silent_WhenSubjectIsGroup(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: crbCluster(models.KubernetesClusterRoleBindingData{
+			Name:     "cluster-admin",
+			RoleName: "cluster-admin",
+			Subjects: []models.KubernetesRBACSubjectData{
+				{Kind: "Group", Name: "system:masters"},
+			},
+		}),
+	}
+	if got := (K8SServiceAccountClusterAdminRule{}).Evaluate(ctx); len(got) != 0 {
+		t.Errorf("expected 0 findings for Group subject; got %d", len(got))
+	}
+}
+
+func TestSAClusterAdmin_Silent_WhenClusterDataNil(t *testing.T) {
+	if got := (K8SServiceAccountClusterAdminRule{}).Evaluate(RuleContext{}); len(got) != 0 {
+		t.Errorf("expected 0 findings for nil ClusterData; got %d", len(got))
+	}
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_READONLY_ROOT_FS_DISABLED ────────────────────────────────────────
+
+// K8SPodReadOnlyRootFSDisabledRule fires for each container (including init
+// containers) that does not set securityContext.readOnlyRootFilesystem: true.
+// A writable root filesystem lets an attacker drop tooling or persist changes
+// inside a compromised container.
+type K8SPodReadOnlyRootFSDisabledRule struct{}
+
+func (r K8SPodReadOnlyRootFSDisabledRule) ID() string {
+	return "K8S_POD_READONLY_ROOT_FS_DISABLED"
+}
+func (r K8SPodReadOnlyRootFSDisabledRule) Name() string {
+	return "Kubernetes Container Root Filesystem Is Writable"
+}
+
+// Evaluate returns one MEDIUM finding per container or init container whose
+// root filesystem is writable.
+func (r K8SPodReadOnlyRootFSDisabledRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, pod := range ctx.ClusterData.Pods {
+		for _, c := range pod.InitContainers {
+			if !c.ReadOnlyRootFilesystem {
+				findings = append(findings, r.finding(ctx, pod, c, true))
+			}
+		}
+		for _, c := range pod.Containers {
+			if !c.ReadOnlyRootFilesystem {
+				findings = append(findings, r.finding(ctx, pod, c, false))
+			}
+		}
+	}
+	return findings
+}
+
+func (r K8SPodReadOnlyRootFSDisabledRule) finding(
+	ctx RuleContext,
+	pod models.KubernetesPodData,
+	c models.KubernetesContainerData,
+	initContainer bool,
+) models.Finding {
+	kind := "Container"
+	if initContainer {
+		kind = "Init container"
+	}
+	return models.Finding{
+		ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name, c.Name),
+		RuleID:       r.ID(),
+		ResourceID:   pod.Name,
+		ResourceType: models.ResourceK8sPod,
+		Region:       ctx.ClusterData.ContextName,
+		AccountID:    ctx.AccountID,
+		Profile:      ctx.Profile,
+		Severity:     models.SeverityMedium,
+		Explanation: fmt.Sprintf(
+			"%s %q in pod %q (namespace %q) does not set readOnlyRootFilesystem: true; "+
+				"its root filesystem is writable.",
+			kind, c.Name, pod.Name, pod.Namespace,
+		),
+		Recommendation: "Set securityContext.readOnlyRootFilesystem: true on the container and mount " +
+			"an emptyDir volume for any path the workload must write to (e.g. /tmp).",
+		DetectedAt: time.Now().UTC(),
+		Metadata: map[string]any{
+			"namespace":      pod.Namespace,
+			"pod_name":       pod.Name,
+			"container_name": c.Name,
+			"init_container": initContainer,
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_READONLY_ROOT_FS_DISABLED ────────────────────────────────────────
+
+func TestReadOnlyRootFS_Fires_WhenWritable(t *testing.T) {
+	cluster := pssCluster(simplePod("web", "prod", models.KubernetesContainerData{Name: "app"}))
+	findings := (K8SPodReadOnlyRootFSDisabledRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for writable root fs; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_POD_READONLY_ROOT_FS_DISABLED" {
+		t.Errorf("RuleID = %q; want K8S_POD_READONLY_ROOT_FS_DISABLED", f.RuleID)
+	}
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+	}
+	if f.Metadata["namespace"] != "prod" {
+		t.Errorf("metadata namespace = %v; want prod", f.Metadata["namespace"])
+	}
+	if f.Metadata["pod_name"] != "web" {
+		t.Errorf("metadata pod_name = %v; want web", f.Metadata["pod_name"])
+	}
+	if f.Metadata["container_name"] != "app" {
+		t.Errorf("metadata container_name = %v; want app", f.Metadata["container_name"])
+	}
+	if f.Metadata["init_container"] != false {
+		t.Errorf("metadata init_container = %v; want false", f.Metadata["init_container"])
+	}
+}
+
+func TestReadOnlyRootFS_Silent_WhenReadOnly(t *testing.T) {
+	cluster := pssCluster(simplePod("web", "prod",
+		models.KubernetesContainerData{Name: "app", ReadOnlyRootFilesystem: true}))
+	if got := (K8SPodReadOnlyRootFSDisabledRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(got) != 0 {
+		t.Errorf("expected 0 findings for read-only root fs; got %d", len(got))
+	}
+}
+
+func TestReadOnlyRootFS_MixedInitAndAppContainers(t *testing.T) {
+	pod := models.KubernetesPodData{
+		Name:      "web",
+		Namespace: "prod",
+		InitContainers: []models.KubernetesContainerData{
+			{Name: "migrate"}, // writable → fires
+			{Name: "fetch-config", ReadOnlyRootFilesystem: true}, // compliant
+		},
+		Containers: []models.KubernetesContainerData{
+			{Name: "app", ReadOnlyRootFilesystem: true}, // compliant
+			{Name: "sidecar"}, // writable → fires
+		},
+	}
+	findings := (K8SPodReadOnlyRootFSDisabledRule{}).Evaluate(RuleContext{ClusterData: pssCluster(pod)})
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings (migrate, sidecar); got %d", len(findings))
+	}
+	got := map[string]any{}
+	for _, f := range findings {
+		got[f.Metadata["container_name"].(string)] = f.Metadata["init_container"]
+	}
+	if got["migrate"] != true {
+		t.Errorf("migrate: init_container = %v; want true", got["migrate"])
+	}
+	if got["sidecar"] != false {
+		t.Errorf("sidecar: init_container = %v; want false", got["sidecar"])
+	}
+}
+
+func TestReadOnlyRootFS_NilClusterData(t *testing.T) {
+	if got := (K8SPodReadOnlyRootFSDisabledRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil ClusterData; got %v", got)
+	}
+}
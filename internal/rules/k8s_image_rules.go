@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_IMAGE_LATEST_TAG ─────────────────────────────────────────────────
+
+// K8SPodImageLatestTagRule fires for each container whose image reference has
+// no tag or uses the mutable ":latest" tag. Such images can change underneath
+// a running workload, breaking reproducibility and opening a supply-chain gap.
+// Digest-pinned references (image@sha256:...) are always compliant.
+type K8SPodImageLatestTagRule struct{}
+
+func (r K8SPodImageLatestTagRule) ID() string   { return "K8S_POD_IMAGE_LATEST_TAG" }
+func (r K8SPodImageLatestTagRule) Name() string { return "Kubernetes Container Uses Latest or Untagged Image" }
+
+// Evaluate returns one MEDIUM finding per container with an untagged or
+// ":latest" image. Containers with no recorded image are skipped.
+func (r K8SPodImageLatestTagRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, pod := range ctx.ClusterData.Pods {
+		for _, c := range pod.Containers {
+			if c.Image == "" || strings.Contains(c.Image, "@") {
+				continue // no image data, or digest-pinned
+			}
+			tag := imageTag(c.Image)
+			if tag != "" && tag != "latest" {
+				continue
+			}
+			findings = append(findings, models.Finding{
+				ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name, c.Name),
+				RuleID:       r.ID(),
+				ResourceID:   pod.Name,
+				ResourceType: models.ResourceK8sPod,
+				Region:       ctx.ClusterData.ContextName,
+				AccountID:    ctx.AccountID,
+				Profile:      ctx.Profile,
+				Severity:     models.SeverityMedium,
+				Explanation: fmt.Sprintf(
+					"Container %q in pod %q (namespace %q) uses image %q with no tag or the mutable \"latest\" tag.",
+					c.Name, pod.Name, pod.Namespace, c.Image,
+				),
+				Recommendation: "Pin container images to an immutable version tag or, preferably, " +
+					"a digest (image@sha256:...) so deployments are reproducible.",
+				DetectedAt: time.Now().UTC(),
+				Metadata: map[string]any{
+					"namespace":      pod.Namespace,
+					"container_name": c.Name,
+					"image":          c.Image,
+				},
+			})
+		}
+	}
+	return findings
+}
+
+// imageTag returns the tag portion of an image reference, or "" when the
+// reference carries no tag. Only the final path segment is inspected so a
+// registry port ("registry:5000/app") is not mistaken for a tag.
+func imageTag(image string) string {
+	name := image
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return ""
+}
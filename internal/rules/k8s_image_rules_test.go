@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// imagePod returns a cluster with a single pod whose one container uses image.
+func imagePod(image string) *models.KubernetesClusterData {
+	return pssCluster(simplePod("web", "prod", models.KubernetesContainerData{Name: "app", Image: image}))
+}
+
+// ── K8S_POD_IMAGE_LATEST_TAG ─────────────────────────────────────────────────
+
+func TestImageLatestTag_Fires_WhenLatestTag(t *testing.T) {
+	findings := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{ClusterData: imagePod("nginx:latest")})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for nginx:latest; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_POD_IMAGE_LATEST_TAG" {
+		t.Errorf("RuleID = %q; want K8S_POD_IMAGE_LATEST_TAG", f.RuleID)
+	}
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+	}
+	if f.Metadata["image"] != "nginx:latest" {
+		t.Errorf("metadata image = %v; want nginx:latest", f.Metadata["image"])
+	}
+	if f.Metadata["container_name"] != "app" {
+		t.Errorf("metadata container_name = %v; want app", f.Metadata["container_name"])
+	}
+	if f.Metadata["namespace"] != "prod" {
+		t.Errorf("metadata namespace = %v; want prod", f.Metadata["namespace"])
+	}
+}
+
+func TestImageLatestTag_Fires_WhenUntagged(t *testing.T) {
+	if got := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{ClusterData: imagePod("nginx")}); len(got) != 1 {
+		t.Errorf("expected 1 finding for untagged image; got %d", len(got))
+	}
+}
+
+func TestImageLatestTag_Silent_WhenVersionTagged(t *testing.T) {
+	if got := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{ClusterData: imagePod("nginx:1.25.3")}); len(got) != 0 {
+		t.Errorf("expected 0 findings for version-tagged image; got %d", len(got))
+	}
+}
+
+func TestImageLatestTag_Silent_WhenDigestPinned(t *testing.T) {
+	cases := []string{
+		"nginx@sha256:0d17b565c37bcbd895e9d92315a05c1c3c9a29f762b011a10c54a66cd53c9b31",
+		"nginx:latest@sha256:0d17b565c37bcbd895e9d92315a05c1c3c9a29f762b011a10c54a66cd53c9b31",
+	}
+	for _, img := range cases {
+		if got := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{ClusterData: imagePod(img)}); len(got) != 0 {
+			t.Errorf("%s: expected 0 findings for digest-pinned image; got %d", img, len(got))
+		}
+	}
+}
+
+func TestImageLatestTag_RegistryPort(t *testing.T) {
+	cases := []struct {
+		image string
+		fires bool
+	}{
+		{"registry:5000/app:1.2", false},
+		{"registry:5000/app", true},
+		{"registry:5000/team/app:latest", true},
+	}
+	for _, tc := range cases {
+		got := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{ClusterData: imagePod(tc.image)})
+		if tc.fires && len(got) != 1 {
+			t.Errorf("%s: expected 1 finding; got %d", tc.image, len(got))
+		}
+		if !tc.fires && len(got) != 0 {
+			t.Errorf("%s: expected 0 findings; got %d", tc.image, len(got))
+		}
+	}
+}
+
+func TestImageLatestTag_Silent_WhenImageEmpty(t *testing.T) {
+	if got := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{ClusterData: imagePod("")}); len(got) != 0 {
+		t.Errorf("expected 0 findings when image is not recorded; got %d", len(got))
+	}
+}
+
+func TestImageLatestTag_Silent_WhenClusterDataNil(t *testing.T) {
+	if got := (K8SPodImageLatestTagRule{}).Evaluate(RuleContext{}); len(got) != 0 {
+		t.Errorf("expected 0 findings for nil ClusterData; got %d", len(got))
+	}
+}
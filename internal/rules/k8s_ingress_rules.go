@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ingressCatchAllHost is reported as the host of an Ingress whose rules do
+// not name any host and which therefore serves every host routed to it.
+const ingressCatchAllHost = "*"
+
+// ── K8S_INGRESS_NO_TLS ───────────────────────────────────────────────────────
+
+// K8SIngressNoTLSRule fires for each Ingress that serves a host in plaintext.
+// An Ingress without a TLS section is reported with every host it serves (or
+// host "*" when its rules name no host); an Ingress whose TLS section covers
+// only some hosts is reported with the uncovered ones. Each Ingress yields at
+// most one finding, listing its plaintext hosts in Metadata["hosts"].
+type K8SIngressNoTLSRule struct{}
+
+func (r K8SIngressNoTLSRule) ID() string   { return "K8S_INGRESS_NO_TLS" }
+func (r K8SIngressNoTLSRule) Name() string { return "Ingress Serves Traffic Without TLS" }
+
+// Evaluate returns one MEDIUM finding per Ingress with a plaintext host.
+func (r K8SIngressNoTLSRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, ing := range ctx.ClusterData.Ingresses {
+		hosts := uncoveredIngressHosts(ing)
+		if len(hosts) == 0 {
+			continue
+		}
+		hostList := strings.Join(hosts, ", ")
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s/%s", r.ID(), ctx.ClusterData.ContextName, ing.Namespace, ing.Name),
+			RuleID:       r.ID(),
+			ResourceID:   ing.Name,
+			ResourceType: models.ResourceK8sIngress,
+			Region:       ctx.ClusterData.ContextName,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityMedium,
+			Explanation: fmt.Sprintf(
+				"Ingress %q (namespace %q) serves %s without TLS; that traffic "+
+					"travels in plaintext.",
+				ing.Name, ing.Namespace, pluralHosts(hosts),
+			),
+			Recommendation: fmt.Sprintf(
+				"Add spec.tls entries covering %s to Ingress %q, backed by a certificate "+
+					"Secret (e.g. issued by cert-manager), and redirect HTTP to HTTPS.",
+				hostList, ing.Name,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"namespace": ing.Namespace,
+				"hosts":     hosts,
+			},
+		})
+	}
+	return findings
+}
+
+// pluralHosts renders hosts for an explanation, e.g. `host "a"` or
+// `hosts "a", "b"`.
+func pluralHosts(hosts []string) string {
+	quoted := make([]string, len(hosts))
+	for i, h := range hosts {
+		quoted[i] = fmt.Sprintf("%q", h)
+	}
+	if len(hosts) == 1 {
+		return "host " + quoted[0]
+	}
+	return "hosts " + strings.Join(quoted, ", ")
+}
+
+// uncoveredIngressHosts returns the hosts of ing not covered by its TLS
+// section. TLS hosts may use a leading "*." wildcard, which covers exactly
+// one DNS label. An Ingress with no named hosts is uncovered only when it
+// has no TLS section at all.
+func uncoveredIngressHosts(ing models.KubernetesIngressData) []string {
+	if len(ing.Hosts) == 0 {
+		if ing.HasTLS {
+			return nil
+		}
+		return []string{ingressCatchAllHost}
+	}
+	var uncovered []string
+	for _, host := range ing.Hosts {
+		if !tlsCoversHost(ing.TLSHosts, host) {
+			uncovered = append(uncovered, host)
+		}
+	}
+	return uncovered
+}
+
+// tlsCoversHost reports whether any entry in tlsHosts matches host.
+func tlsCoversHost(tlsHosts []string, host string) bool {
+	for _, t := range tlsHosts {
+		if strings.EqualFold(t, host) {
+			return true
+		}
+		if suffix, ok := strings.CutPrefix(t, "*."); ok {
+			if _, rest, found := strings.Cut(host, "."); found && strings.EqualFold(rest, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
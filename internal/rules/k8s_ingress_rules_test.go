@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func ingressCluster(ingresses ...models.KubernetesIngressData) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{ContextName: "test-ctx", Ingresses: ingresses}
+}
+
+// ── K8S_INGRESS_NO_TLS ───────────────────────────────────────────────────────
+
+func TestIngressNoTLS_Fires_WhenNoTLSSection(t *testing.T) {
+	cluster := ingressCluster(models.KubernetesIngressData{
+		Name: "web", Namespace: "prod", Hosts: []string{"shop.example.com"},
+	})
+	findings := (K8SIngressNoTLSRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for Ingress without TLS; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_INGRESS_NO_TLS" {
+		t.Errorf("RuleID = %q; want K8S_INGRESS_NO_TLS", f.RuleID)
+	}
+	if f.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sIngress || f.ResourceID != "web" {
+		t.Errorf("resource = %s/%s; want K8S_INGRESS/web", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["namespace"] != "prod" {
+		t.Errorf("metadata namespace = %v; want prod", f.Metadata["namespace"])
+	}
+	if hosts, _ := f.Metadata["hosts"].([]string); !slices.Equal(hosts, []string{"shop.example.com"}) {
+		t.Errorf("metadata hosts = %v; want [shop.example.com]", f.Metadata["hosts"])
+	}
+}
+
+func TestIngressNoTLS_Fires_CatchAllWithoutTLS(t *testing.T) {
+	cluster := ingressCluster(models.KubernetesIngressData{Name: "default-backend", Namespace: "prod"})
+	findings := (K8SIngressNoTLSRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for catch-all Ingress without TLS; got %d", len(findings))
+	}
+	if hosts, _ := findings[0].Metadata["hosts"].([]string); !slices.Equal(hosts, []string{"*"}) {
+		t.Errorf("metadata hosts = %v; want [*]", findings[0].Metadata["hosts"])
+	}
+}
+
+func TestIngressNoTLS_Silent_FullCoverage(t *testing.T) {
+	cluster := ingressCluster(
+		models.KubernetesIngressData{
+			Name: "web", Namespace: "prod",
+			Hosts:    []string{"shop.example.com", "api.example.com"},
+			TLSHosts: []string{"shop.example.com", "api.example.com"},
+			HasTLS:   true,
+		},
+		models.KubernetesIngressData{
+			Name: "wildcard", Namespace: "prod",
+			Hosts:    []string{"a.example.org", "b.example.org"},
+			TLSHosts: []string{"*.example.org"},
+			HasTLS:   true,
+		},
+	)
+	if got := (K8SIngressNoTLSRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(got) != 0 {
+		t.Errorf("expected 0 findings for fully TLS-covered Ingresses; got %d", len(got))
+	}
+}
+
+func TestIngressNoTLS_PartialCoverage_FiresForUncoveredHosts(t *testing.T) {
+	cluster := ingressCluster(models.KubernetesIngressData{
+		Name: "web", Namespace: "prod",
+		Hosts:    []string{"shop.example.com", "admin.example.com", "deep.sub.example.com"},
+		TLSHosts: []string{"shop.example.com", "*.example.com"},
+		HasTLS:   true,
+	})
+	findings := (K8SIngressNoTLSRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	// admin.example.com is covered by the wildcard; deep.sub.example.com is not
+	// (a wildcard covers exactly one DNS label).
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for the uncovered host; got %d", len(findings))
+	}
+	if hosts, _ := findings[0].Metadata["hosts"].([]string); !slices.Equal(hosts, []string{"deep.sub.example.com"}) {
+		t.Errorf("metadata hosts = %v; want [deep.sub.example.com]", findings[0].Metadata["hosts"])
+	}
+}
+
+func TestIngressNoTLS_OneFindingPerIngress(t *testing.T) {
+	cluster := ingressCluster(
+		models.KubernetesIngressData{
+			Name: "web", Namespace: "prod",
+			Hosts: []string{"shop.example.com", "api.example.com"},
+		},
+		models.KubernetesIngressData{
+			Name: "web", Namespace: "staging",
+			Hosts: []string{"shop.staging.example.com"},
+		},
+	)
+	findings := (K8SIngressNoTLSRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 2 {
+		t.Fatalf("expected 1 finding per Ingress; got %d", len(findings))
+	}
+	if hosts, _ := findings[0].Metadata["hosts"].([]string); !slices.Equal(hosts, []string{"shop.example.com", "api.example.com"}) {
+		t.Errorf("metadata hosts = %v; want both plaintext hosts", findings[0].Metadata["hosts"])
+	}
+	if findings[0].ID == findings[1].ID {
+		t.Errorf("Ingresses in different namespaces share finding ID %q", findings[0].ID)
+	}
+}
+
+func TestIngressNoTLS_NilClusterData(t *testing.T) {
+	if got := (K8SIngressNoTLSRule{}).Evaluate(RuleContext{}); got != nil {
+		t.Errorf("expected nil for nil ClusterData; got %v", got)
+	}
+}
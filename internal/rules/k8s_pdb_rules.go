@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_DEPLOYMENT_NO_PDB ────────────────────────────────────────────────────
+
+// K8SDeploymentNoPDBRule fires for each Deployment or StatefulSet running more
+// than one replica whose pods are not selected by any PodDisruptionBudget in
+// its namespace. Without a PDB, a node drain or cluster upgrade may evict all
+// replicas at once and take the workload down. Single-replica workloads are
+// skipped: a PDB cannot keep them available during a drain anyway.
+//
+// ResourceID is Kind/namespace/name so the finding does not share a merge
+// group with a Service or Pod of the same name; Metadata["resource_name"]
+// carries the bare workload name for --show-passed and --since.
+type K8SDeploymentNoPDBRule struct{}
+
+func (r K8SDeploymentNoPDBRule) ID() string   { return "K8S_DEPLOYMENT_NO_PDB" }
+func (r K8SDeploymentNoPDBRule) Name() string { return "Workload Without PodDisruptionBudget" }
+
+// Evaluate returns one LOW finding per uncovered multi-replica workload.
+func (r K8SDeploymentNoPDBRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, wl := range ctx.ClusterData.Workloads {
+		if wl.Replicas <= 1 || workloadHasPDB(wl, ctx.ClusterData.PodDisruptionBudgets) {
+			continue
+		}
+		resourceType := models.ResourceK8sDeployment
+		if wl.Kind == "StatefulSet" {
+			resourceType = models.ResourceK8sStatefulSet
+		}
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, wl.Kind, wl.Namespace, wl.Name),
+			RuleID:       r.ID(),
+			ResourceID:   fmt.Sprintf("%s/%s/%s", wl.Kind, wl.Namespace, wl.Name),
+			ResourceType: resourceType,
+			Region:       ctx.ClusterData.ContextName,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityLow,
+			Explanation: fmt.Sprintf(
+				"%s %q (namespace %q) runs %d replicas but no PodDisruptionBudget selects its pods; "+
+					"a node drain can evict every replica at once.",
+				wl.Kind, wl.Name, wl.Namespace, wl.Replicas,
+			),
+			Recommendation: fmt.Sprintf(
+				"Add a PodDisruptionBudget in namespace %q selecting the pods of %s %q "+
+					"(e.g. minAvailable: 1 or maxUnavailable: 1).",
+				wl.Namespace, wl.Kind, wl.Name,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"namespace":     wl.Namespace,
+				"workload_name": wl.Name,
+				"workload_kind": wl.Kind,
+				"replicas":      wl.Replicas,
+				"resource_name": wl.Name,
+			},
+		})
+	}
+	return findings
+}
+
+// workloadHasPDB reports whether any PDB in wl's namespace selects wl's pods.
+func workloadHasPDB(wl models.KubernetesWorkloadData, pdbs []models.KubernetesPDBData) bool {
+	for _, pdb := range pdbs {
+		if pdb.Namespace == wl.Namespace && pdbSelectsLabels(pdb, wl.PodLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// pdbSelectsLabels evaluates pdb's label selector against labels. Every
+// matchLabels entry and every matchExpressions requirement must hold; an
+// empty selector matches everything. Unknown operators never match.
+func pdbSelectsLabels(pdb models.KubernetesPDBData, labels map[string]string) bool {
+	for k, v := range pdb.MatchLabels {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	for _, req := range pdb.MatchExpressions {
+		val, ok := labels[req.Key]
+		switch req.Operator {
+		case "In":
+			if !ok || !slices.Contains(req.Values, val) {
+				return false
+			}
+		case "NotIn":
+			if ok && slices.Contains(req.Values, val) {
+				return false
+			}
+		case "Exists":
+			if !ok {
+				return false
+			}
+		case "DoesNotExist":
+			if ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func pdbCluster(workloads []models.KubernetesWorkloadData, pdbs ...models.KubernetesPDBData) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{ContextName: "test-ctx", Workloads: workloads, PodDisruptionBudgets: pdbs}
+}
+
+func apiWorkload(kind string, replicas int) models.KubernetesWorkloadData {
+	return models.KubernetesWorkloadData{
+		Kind: kind, Name: "api", Namespace: "payments", Replicas: replicas,
+		PodLabels: map[string]string{"app": "api", "tier": "backend"},
+	}
+}
+
+// ── K8S_DEPLOYMENT_NO_PDB ────────────────────────────────────────────────────
+
+func TestDeploymentNoPDB_Fires_UncoveredWorkload(t *testing.T) {
+	cluster := pdbCluster([]models.KubernetesWorkloadData{apiWorkload("Deployment", 3)})
+	findings := (K8SDeploymentNoPDBRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for uncovered Deployment; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_DEPLOYMENT_NO_PDB" || f.Severity != models.SeverityLow {
+		t.Errorf("RuleID/Severity = %s/%s; want K8S_DEPLOYMENT_NO_PDB/LOW", f.RuleID, f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sDeployment || f.ResourceID != "Deployment/payments/api" {
+		t.Errorf("resource = %s/%s; want K8S_DEPLOYMENT/Deployment/payments/api", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["resource_name"] != "api" {
+		t.Errorf("resource_name = %v; want api", f.Metadata["resource_name"])
+	}
+	if f.Metadata["namespace"] != "payments" || f.Metadata["workload_name"] != "api" || f.Metadata["replicas"] != 3 {
+		t.Errorf("metadata = %v; want namespace payments, workload_name api, replicas 3", f.Metadata)
+	}
+}
+
+func TestDeploymentNoPDB_Fires_StatefulSet(t *testing.T) {
+	cluster := pdbCluster([]models.KubernetesWorkloadData{apiWorkload("StatefulSet", 2)})
+	findings := (K8SDeploymentNoPDBRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 || findings[0].ResourceType != models.ResourceK8sStatefulSet {
+		t.Fatalf("expected 1 K8S_STATEFULSET finding; got %+v", findings)
+	}
+}
+
+func TestDeploymentNoPDB_Silent_CoveredWorkload(t *testing.T) {
+	for name, pdb := range map[string]models.KubernetesPDBData{
+		"matchLabels":      {Name: "api-pdb", Namespace: "payments", MatchLabels: map[string]string{"app": "api"}},
+		"matchExpressions": {Name: "backend-pdb", Namespace: "payments", MatchExpressions: []models.KubernetesLabelSelectorRequirement{{Key: "tier", Operator: "In", Values: []string{"backend", "worker"}}}},
+		"empty selector":   {Name: "all-pdb", Namespace: "payments"},
+	} {
+		cluster := pdbCluster([]models.KubernetesWorkloadData{apiWorkload("Deployment", 3)}, pdb)
+		if findings := (K8SDeploymentNoPDBRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+			t.Errorf("%s: expected no findings for covered workload; got %d", name, len(findings))
+		}
+	}
+}
+
+func TestDeploymentNoPDB_Fires_WhenPDBDoesNotSelectPods(t *testing.T) {
+	for name, pdb := range map[string]models.KubernetesPDBData{
+		"other namespace": {Name: "api-pdb", Namespace: "default", MatchLabels: map[string]string{"app": "api"}},
+		"other labels":    {Name: "web-pdb", Namespace: "payments", MatchLabels: map[string]string{"app": "web"}},
+		"NotIn":           {Name: "not-backend", Namespace: "payments", MatchExpressions: []models.KubernetesLabelSelectorRequirement{{Key: "tier", Operator: "NotIn", Values: []string{"backend"}}}},
+	} {
+		cluster := pdbCluster([]models.KubernetesWorkloadData{apiWorkload("Deployment", 3)}, pdb)
+		if findings := (K8SDeploymentNoPDBRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 1 {
+			t.Errorf("%s: expected 1 finding; got %d", name, len(findings))
+		}
+	}
+}
+
+func TestDeploymentNoPDB_Silent_SingleReplica(t *testing.T) {
+	cluster := pdbCluster([]models.KubernetesWorkloadData{apiWorkload("Deployment", 1), apiWorkload("StatefulSet", 0)})
+	if findings := (K8SDeploymentNoPDBRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+		t.Errorf("expected no findings for single-replica workloads; got %d", len(findings))
+	}
+}
+
+func TestDeploymentNoPDB_NilClusterData(t *testing.T) {
+	if findings := (K8SDeploymentNoPDBRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("expected nil findings; got %v", findings)
+	}
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_NO_HEALTH_PROBES ─────────────────────────────────────────────────
+
+// K8SPodNoHealthProbesRule fires for each container that declares neither a
+// livenessProbe nor a readinessProbe. Without either probe the kubelet cannot
+// restart a hung container and Services keep routing traffic to it.
+//
+// A container with only one of the two probes is not reported; the rule
+// targets workloads with no health signal at all. Init containers are skipped
+// because Kubernetes does not run probes on them.
+type K8SPodNoHealthProbesRule struct{}
+
+func (r K8SPodNoHealthProbesRule) ID() string   { return "K8S_POD_NO_HEALTH_PROBES" }
+func (r K8SPodNoHealthProbesRule) Name() string { return "Kubernetes Container Without Health Probes" }
+
+// Evaluate returns one LOW finding per container missing both probes.
+func (r K8SPodNoHealthProbesRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, pod := range ctx.ClusterData.Pods {
+		for _, c := range pod.Containers {
+			if c.HasLivenessProbe || c.HasReadinessProbe {
+				continue
+			}
+			findings = append(findings, models.Finding{
+				ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name, c.Name),
+				RuleID:       r.ID(),
+				ResourceID:   pod.Name,
+				ResourceType: models.ResourceK8sPod,
+				Region:       ctx.ClusterData.ContextName,
+				AccountID:    ctx.AccountID,
+				Profile:      ctx.Profile,
+				Severity:     models.SeverityLow,
+				Explanation: fmt.Sprintf(
+					"Container %q in pod %q (namespace %q) declares neither a livenessProbe nor a readinessProbe.",
+					c.Name, pod.Name, pod.Namespace,
+				),
+				Recommendation: "Add a readinessProbe so traffic is only sent to ready containers, and a " +
+					"livenessProbe so the kubelet restarts the container when it stops responding.",
+				DetectedAt: time.Now().UTC(),
+				Metadata: map[string]any{
+					"namespace":      pod.Namespace,
+					"pod_name":       pod.Name,
+					"container_name": c.Name,
+				},
+			})
+		}
+	}
+	return findings
+}
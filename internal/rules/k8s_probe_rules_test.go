@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_NO_HEALTH_PROBES ─────────────────────────────────────────────────
+
+func TestPodNoHealthProbes_Fires_NeitherProbe(t *testing.T) {
+	cluster := pssCluster(simplePod("web", "prod", models.KubernetesContainerData{Name: "app"}))
+	findings := (K8SPodNoHealthProbesRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_POD_NO_HEALTH_PROBES" || f.Severity != models.SeverityLow {
+		t.Errorf("RuleID/Severity = %s/%s; want K8S_POD_NO_HEALTH_PROBES/LOW", f.RuleID, f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sPod || f.ResourceID != "web" {
+		t.Errorf("resource = %s/%s; want K8S_POD/web", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["namespace"] != "prod" || f.Metadata["pod_name"] != "web" || f.Metadata["container_name"] != "app" {
+		t.Errorf("metadata = %v; want namespace prod, pod_name web, container_name app", f.Metadata)
+	}
+}
+
+func TestPodNoHealthProbes_Silent_BothProbes(t *testing.T) {
+	cluster := pssCluster(simplePod("web", "prod",
+		models.KubernetesContainerData{Name: "app", HasLivenessProbe: true, HasReadinessProbe: true}))
+	if findings := (K8SPodNoHealthProbesRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+		t.Errorf("expected no findings with both probes; got %d", len(findings))
+	}
+}
+
+func TestPodNoHealthProbes_Silent_OneProbe(t *testing.T) {
+	for name, c := range map[string]models.KubernetesContainerData{
+		"liveness only":  {Name: "app", HasLivenessProbe: true},
+		"readiness only": {Name: "app", HasReadinessProbe: true},
+	} {
+		cluster := pssCluster(simplePod("web", "prod", c))
+		if findings := (K8SPodNoHealthProbesRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+			t.Errorf("%s: expected no findings; got %d", name, len(findings))
+		}
+	}
+}
+
+func TestPodNoHealthProbes_SkipsInitContainers(t *testing.T) {
+	pod := models.KubernetesPodData{
+		Name:           "web",
+		Namespace:      "prod",
+		InitContainers: []models.KubernetesContainerData{{Name: "migrate"}},
+		Containers:     []models.KubernetesContainerData{{Name: "app", HasReadinessProbe: true}},
+	}
+	if findings := (K8SPodNoHealthProbesRule{}).Evaluate(RuleContext{ClusterData: pssCluster(pod)}); len(findings) != 0 {
+		t.Errorf("expected init containers to be ignored; got %d findings", len(findings))
+	}
+}
+
+func TestPodNoHealthProbes_NilClusterData(t *testing.T) {
+	if findings := (K8SPodNoHealthProbesRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("expected nil with nil ClusterData; got %v", findings)
+	}
+}
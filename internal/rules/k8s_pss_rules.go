@@ -18,6 +18,9 @@ type K8SPSSPrivilegedContainerRule struct{}
 func (r K8SPSSPrivilegedContainerRule) ID() string   { return "K8S_POD_PRIVILEGED_CONTAINER" }
 func (r K8SPSSPrivilegedContainerRule) Name() string { return "PSS: Privileged Container Detected" }
 
+// Priority keeps this rule primary when merged with other pod findings.
+func (r K8SPSSPrivilegedContainerRule) Priority() int { return privilegedContainerPriority }
+
 func (r K8SPSSPrivilegedContainerRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.ClusterData == nil {
 		return nil
@@ -152,10 +155,20 @@ func (r K8SPSSHostPIDOrIPCRule) Evaluate(ctx RuleContext) []models.Finding {
 // ── K8S_POD_RUN_AS_ROOT ──────────────────────────────────────────────────────
 
 // K8SPSSRunAsRootRule fires for each container where the effective security
-// context does not prevent root execution: runAsNonRoot is absent or false,
-// or runAsUser is explicitly 0 (root UID).
+// context does not prevent root execution. It distinguishes two cases,
+// recorded in Metadata["root_source"]:
+//
+//   - "explicit": runAsUser is 0 (root UID). Always HIGH.
+//   - "implicit": runAsNonRoot is absent or false and no root UID is set, so
+//     the container runs as whatever user the image declares, which is often
+//     root. MEDIUM, or HIGH when StrictRoot is set.
+//
 // The effective values are resolved at collection time (container overrides pod).
-type K8SPSSRunAsRootRule struct{}
+type K8SPSSRunAsRootRule struct {
+	// StrictRoot reports implicit root at HIGH, the same as explicit root.
+	// Set from the CLI --strict-root flag.
+	StrictRoot bool
+}
 
 func (r K8SPSSRunAsRootRule) ID() string   { return "K8S_POD_RUN_AS_ROOT" }
 func (r K8SPSSRunAsRootRule) Name() string { return "PSS: Container May Run as Root" }
@@ -174,11 +187,15 @@ func (r K8SPSSRunAsRootRule) Evaluate(ctx RuleContext) []models.Finding {
 				continue
 			}
 
-			var reason string
-			if runAsRootUID {
-				reason = "runAsUser is 0 (root UID)"
-			} else {
+			source := "explicit"
+			severity := models.SeverityHigh
+			reason := "runAsUser is 0 (root UID)"
+			if !runAsRootUID {
+				source = "implicit"
 				reason = "runAsNonRoot is not set or is false"
+				if !r.StrictRoot {
+					severity = models.SeverityMedium
+				}
 			}
 			findings = append(findings, models.Finding{
 				ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name, c.Name),
@@ -188,7 +205,7 @@ func (r K8SPSSRunAsRootRule) Evaluate(ctx RuleContext) []models.Finding {
 				Region:       ctx.ClusterData.ContextName,
 				AccountID:    ctx.AccountID,
 				Profile:      ctx.Profile,
-				Severity:     models.SeverityHigh,
+				Severity:     severity,
 				Explanation: fmt.Sprintf(
 					"Container %q in pod %q (namespace %q) may run as root: %s.",
 					c.Name, pod.Name, pod.Namespace, reason,
@@ -199,6 +216,7 @@ func (r K8SPSSRunAsRootRule) Evaluate(ctx RuleContext) []models.Finding {
 				Metadata: map[string]any{
 					"namespace":      pod.Namespace,
 					"container_name": c.Name,
+					"root_source":    source,
 				},
 			})
 		}
@@ -262,6 +280,98 @@ func containsSysAdmin(caps []string) bool {
 	return false
 }
 
+// ── K8S_POD_DANGEROUS_CAPABILITY ─────────────────────────────────────────────
+
+// DefaultDangerousCapabilities is the capability set K8SPodDangerousCapabilityRule
+// checks when its Capabilities field is empty. SYS_ADMIN is deliberately absent:
+// it is covered by K8S_POD_CAP_SYS_ADMIN.
+var DefaultDangerousCapabilities = []string{
+	"ALL",
+	"BPF",
+	"DAC_READ_SEARCH",
+	"NET_ADMIN",
+	"NET_RAW",
+	"SYS_BOOT",
+	"SYS_MODULE",
+	"SYS_PTRACE",
+	"SYS_RAWIO",
+	"SYS_TIME",
+}
+
+// K8SPodDangerousCapabilityRule fires once per (container, capability) pair for
+// every capability added via securityContext.capabilities.add that appears in
+// the dangerous set. Capability names are compared case-insensitively with any
+// "CAP_" prefix removed. SYS_ADMIN never fires here, even when listed, so it
+// is reported only by K8S_POD_CAP_SYS_ADMIN.
+type K8SPodDangerousCapabilityRule struct {
+	// Capabilities overrides DefaultDangerousCapabilities when non-empty.
+	Capabilities []string
+}
+
+func (r K8SPodDangerousCapabilityRule) ID() string { return "K8S_POD_DANGEROUS_CAPABILITY" }
+func (r K8SPodDangerousCapabilityRule) Name() string {
+	return "Container Adds Dangerous Linux Capability"
+}
+
+func (r K8SPodDangerousCapabilityRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	dangerous := r.Capabilities
+	if len(dangerous) == 0 {
+		dangerous = DefaultDangerousCapabilities
+	}
+	set := make(map[string]struct{}, len(dangerous))
+	for _, c := range dangerous {
+		set[normalizeCapability(c)] = struct{}{}
+	}
+	delete(set, "SYS_ADMIN") // owned by K8S_POD_CAP_SYS_ADMIN
+
+	var findings []models.Finding
+	for _, pod := range ctx.ClusterData.Pods {
+		for _, c := range pod.Containers {
+			for _, added := range c.AddedCapabilities {
+				capName := normalizeCapability(added)
+				if _, ok := set[capName]; !ok {
+					continue
+				}
+				findings = append(findings, models.Finding{
+					ID:           fmt.Sprintf("%s:%s:%s/%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name, c.Name, capName),
+					RuleID:       r.ID(),
+					ResourceID:   pod.Name,
+					ResourceType: models.ResourceK8sPod,
+					Region:       ctx.ClusterData.ContextName,
+					AccountID:    ctx.AccountID,
+					Profile:      ctx.Profile,
+					Severity:     models.SeverityHigh,
+					Explanation: fmt.Sprintf(
+						"Container %q in pod %q (namespace %q) adds the %s Linux capability.",
+						c.Name, pod.Name, pod.Namespace, capName,
+					),
+					Recommendation: fmt.Sprintf(
+						"Remove %s from capabilities.add, or drop ALL capabilities and add back only "+
+							"those the workload demonstrably needs.",
+						capName,
+					),
+					DetectedAt: time.Now().UTC(),
+					Metadata: map[string]any{
+						"namespace":      pod.Namespace,
+						"container_name": c.Name,
+						"capability":     capName,
+					},
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// normalizeCapability upper-cases a capability name and strips the optional
+// "CAP_" prefix so "cap_net_admin" and "NET_ADMIN" compare equal.
+func normalizeCapability(c string) string {
+	return strings.TrimPrefix(strings.ToUpper(c), "CAP_")
+}
+
 // ── K8S_POD_NO_SECCOMP ───────────────────────────────────────────────────────
 
 // K8SPSSNoSeccompRule fires for each container whose effective seccomp profile
@@ -262,7 +262,7 @@ func TestPSSHostPIDOrIPC_BothTrue_OneFinding(t *testing.T) {
 // ── K8S_POD_RUN_AS_ROOT ──────────────────────────────────────────────────────
 
 func TestPSSRunAsRoot_Fires_WhenRunAsNonRootNil(t *testing.T) {
-	// RunAsNonRoot nil → not enforced → fires
+	// RunAsNonRoot nil → not enforced → fires as implicit root at MEDIUM
 	ctx := RuleContext{
 		ClusterData: pssCluster(simplePod("root-pod", "default", models.KubernetesContainerData{
 			Name:         "app",
@@ -276,8 +276,26 @@ func TestPSSRunAsRoot_Fires_WhenRunAsNonRootNil(t *testing.T) {
 	if findings[0].RuleID != "K8S_POD_RUN_AS_ROOT" {
 		t.Errorf("RuleID = %q; want K8S_POD_RUN_AS_ROOT", findings[0].RuleID)
 	}
-	if findings[0].Severity != models.SeverityHigh {
-		t.Errorf("Severity = %q; want HIGH", findings[0].Severity)
+	if findings[0].Severity != models.SeverityMedium {
+		t.Errorf("Severity = %q; want MEDIUM", findings[0].Severity)
+	}
+	if findings[0].Metadata["root_source"] != "implicit" {
+		t.Errorf("root_source = %v; want implicit", findings[0].Metadata["root_source"])
+	}
+}
+
+func TestPSSRunAsRoot_StrictRoot_ImplicitIsHigh(t *testing.T) {
+	ctx := RuleContext{
+		ClusterData: pssCluster(simplePod("root-pod", "default", models.KubernetesContainerData{
+			Name: "app",
+		})),
+	}
+	findings := K8SPSSRunAsRootRule{StrictRoot: true}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding; got %d", len(findings))
+	}
+	if findings[0].Severity != models.SeverityHigh || findings[0].Metadata["root_source"] != "implicit" {
+		t.Errorf("Severity/root_source = %s/%v; want HIGH/implicit", findings[0].Severity, findings[0].Metadata["root_source"])
 	}
 }
 
@@ -294,6 +312,12 @@ func TestPSSRunAsRoot_Fires_WhenRunAsUserZero(t *testing.T) {
 	if len(findings) != 1 {
 		t.Fatalf("expected 1 finding; got %d", len(findings))
 	}
+	if findings[0].Severity != models.SeverityHigh {
+		t.Errorf("Severity = %q; want HIGH", findings[0].Severity)
+	}
+	if findings[0].Metadata["root_source"] != "explicit" {
+		t.Errorf("root_source = %v; want explicit", findings[0].Metadata["root_source"])
+	}
 }
 
 func TestPSSRunAsRoot_Silent_WhenNonRootEnforced(t *testing.T) {
@@ -405,6 +429,78 @@ func TestPSSCapSysAdmin_MultipleContainers_OnlySysAdminFires(t *testing.T) {
 	}
 }
 
+// ── K8S_POD_DANGEROUS_CAPABILITY ─────────────────────────────────────────────
+
+// capPod returns a cluster with one container adding the given capabilities.
+func capPod(caps ...string) *models.KubernetesClusterData {
+	return pssCluster(simplePod("cap-pod", "default", models.KubernetesContainerData{
+		Name:              "app",
+		AddedCapabilities: caps,
+	}))
+}
+
+func TestDangerousCapability_FiresForEachDefaultCap(t *testing.T) {
+	for _, capName := range DefaultDangerousCapabilities {
+		t.Run(capName, func(t *testing.T) {
+			findings := (K8SPodDangerousCapabilityRule{}).Evaluate(RuleContext{ClusterData: capPod(capName)})
+			if len(findings) != 1 {
+				t.Fatalf("expected 1 finding for %s; got %d", capName, len(findings))
+			}
+			f := findings[0]
+			if f.RuleID != "K8S_POD_DANGEROUS_CAPABILITY" {
+				t.Errorf("RuleID = %q; want K8S_POD_DANGEROUS_CAPABILITY", f.RuleID)
+			}
+			if f.Severity != models.SeverityHigh {
+				t.Errorf("Severity = %q; want HIGH", f.Severity)
+			}
+			if f.Metadata["capability"] != capName {
+				t.Errorf("metadata capability = %v; want %s", f.Metadata["capability"], capName)
+			}
+		})
+	}
+}
+
+func TestDangerousCapability_Silent_WhenBenignCap(t *testing.T) {
+	if got := (K8SPodDangerousCapabilityRule{}).Evaluate(RuleContext{ClusterData: capPod("NET_BIND_SERVICE", "CHOWN")}); len(got) != 0 {
+		t.Errorf("expected 0 findings for benign caps; got %d", len(got))
+	}
+}
+
+func TestDangerousCapability_Silent_ForSysAdmin(t *testing.T) {
+	rule := K8SPodDangerousCapabilityRule{Capabilities: []string{"SYS_ADMIN", "NET_ADMIN"}}
+	findings := rule.Evaluate(RuleContext{ClusterData: capPod("SYS_ADMIN")})
+	if len(findings) != 0 {
+		t.Errorf("SYS_ADMIN must be left to K8S_POD_CAP_SYS_ADMIN; got %d findings", len(findings))
+	}
+}
+
+func TestDangerousCapability_NormalisesCapPrefixAndCase(t *testing.T) {
+	findings := (K8SPodDangerousCapabilityRule{}).Evaluate(RuleContext{ClusterData: capPod("cap_sys_ptrace")})
+	if len(findings) != 1 || findings[0].Metadata["capability"] != "SYS_PTRACE" {
+		t.Errorf("expected 1 finding with capability SYS_PTRACE; got %+v", findings)
+	}
+}
+
+func TestDangerousCapability_CustomSetReplacesDefault(t *testing.T) {
+	rule := K8SPodDangerousCapabilityRule{Capabilities: []string{"CHOWN"}}
+	if got := rule.Evaluate(RuleContext{ClusterData: capPod("NET_ADMIN")}); len(got) != 0 {
+		t.Errorf("NET_ADMIN not in custom set; expected 0 findings, got %d", len(got))
+	}
+	if got := rule.Evaluate(RuleContext{ClusterData: capPod("CHOWN")}); len(got) != 1 {
+		t.Errorf("CHOWN in custom set; expected 1 finding, got %d", len(got))
+	}
+}
+
+func TestDangerousCapability_OneFindingPerCap(t *testing.T) {
+	findings := (K8SPodDangerousCapabilityRule{}).Evaluate(RuleContext{ClusterData: capPod("NET_ADMIN", "SYS_TIME", "CHOWN")})
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings (NET_ADMIN, SYS_TIME); got %d", len(findings))
+	}
+	if findings[0].ID == findings[1].ID {
+		t.Errorf("finding IDs must be unique per capability; both %q", findings[0].ID)
+	}
+}
+
 // ── K8S_POD_NO_SECCOMP ───────────────────────────────────────────────────────
 
 func TestPSSNoSeccomp_Fires_WhenProfileTypeEmpty(t *testing.T) {
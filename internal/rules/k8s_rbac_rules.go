@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// rbacWildcard is the RBAC value matching every API group, resource or verb.
+const rbacWildcard = "*"
+
+// ── K8S_RBAC_WILDCARD_PERMISSION ─────────────────────────────────────────────
+
+// K8SRBACWildcardPermissionRule fires for each Role or ClusterRole with a rule
+// granting every verb ("*") on every resource ("*"). Anyone bound to such a
+// role can read Secrets, exec into pods and rewrite workloads in its scope.
+//
+// When the wildcard rule also covers every API group the role is equivalent to
+// cluster-admin (confidence "high"). When it is limited to named API groups,
+// the finding is still reported, with confidence "medium" and a note naming
+// the groups. cluster-admin and roles named "system:*" are built-in and are
+// skipped; bindings to cluster-admin are covered by
+// K8S_SERVICEACCOUNT_CLUSTER_ADMIN.
+type K8SRBACWildcardPermissionRule struct{}
+
+func (r K8SRBACWildcardPermissionRule) ID() string {
+	return "K8S_RBAC_WILDCARD_PERMISSION"
+}
+func (r K8SRBACWildcardPermissionRule) Name() string {
+	return "RBAC Role Grants Wildcard Permissions"
+}
+
+// Evaluate returns one HIGH finding per offending role. When a role has more
+// than one wildcard rule, the finding describes the broadest one.
+func (r K8SRBACWildcardPermissionRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, role := range ctx.ClusterData.Roles {
+		if role.Name == clusterAdminRole || strings.HasPrefix(role.Name, "system:") {
+			continue
+		}
+		rule, allGroups, ok := wildcardPolicyRule(role.Rules)
+		if !ok {
+			continue
+		}
+
+		resourceType := models.ResourceK8sClusterRole
+		scope := "the whole cluster"
+		if role.Kind == "Role" {
+			resourceType = models.ResourceK8sRole
+			scope = fmt.Sprintf("namespace %q", role.Namespace)
+		}
+		metadata := map[string]any{
+			"role_name": role.Name,
+			"role_kind": role.Kind,
+			"rule": map[string]any{
+				"api_groups": rule.APIGroups,
+				"resources":  rule.Resources,
+				"verbs":      rule.Verbs,
+			},
+		}
+		if role.Kind == "Role" {
+			metadata["namespace"] = role.Namespace
+		}
+		confidence := models.ConfidenceHigh
+		explanation := fmt.Sprintf(
+			"%s %q grants every verb on every resource in all API groups across %s; "+
+				"it is equivalent to cluster-admin within that scope.",
+			role.Kind, role.Name, scope,
+		)
+		if !allGroups {
+			groups := quotedAPIGroups(rule.APIGroups)
+			confidence = models.ConfidenceMedium
+			metadata["note"] = fmt.Sprintf("wildcard limited to API group(s) %s", groups)
+			explanation = fmt.Sprintf(
+				"%s %q grants every verb on every resource in API group(s) %s across %s.",
+				role.Kind, role.Name, groups, scope,
+			)
+		}
+
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, role.Kind, role.Namespace, role.Name),
+			RuleID:       r.ID(),
+			ResourceID:   role.Name,
+			ResourceType: resourceType,
+			Region:       ctx.ClusterData.ContextName,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityHigh,
+			Confidence:   confidence,
+			Explanation:  explanation,
+			Recommendation: fmt.Sprintf(
+				"Replace the wildcard rule in %s %q with rules listing only the API groups, "+
+					"resources and verbs its subjects need.",
+				role.Kind, role.Name,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata:   metadata,
+		})
+	}
+	return findings
+}
+
+// wildcardPolicyRule returns the first rule in rules granting "*" verbs on "*"
+// resources, preferring one that also covers every API group (allGroups).
+// ok is false when no rule grants wildcard verbs on wildcard resources.
+func wildcardPolicyRule(rules []models.KubernetesPolicyRuleData) (rule models.KubernetesPolicyRuleData, allGroups, ok bool) {
+	for _, pr := range rules {
+		if !slices.Contains(pr.Verbs, rbacWildcard) || !slices.Contains(pr.Resources, rbacWildcard) {
+			continue
+		}
+		if slices.Contains(pr.APIGroups, rbacWildcard) {
+			return pr, true, true
+		}
+		if !ok {
+			rule, ok = pr, true
+		}
+	}
+	return rule, false, ok
+}
+
+// quotedAPIGroups formats API groups for messages, naming the core group
+// "" explicitly: `"", "apps"`.
+func quotedAPIGroups(groups []string) string {
+	quoted := make([]string, len(groups))
+	for i, g := range groups {
+		quoted[i] = fmt.Sprintf("%q", g)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+func rbacCluster(roles ...models.KubernetesRoleData) *models.KubernetesClusterData {
+	return &models.KubernetesClusterData{ContextName: "test-ctx", Roles: roles}
+}
+
+func policyRule(groups, resources, verbs []string) models.KubernetesPolicyRuleData {
+	return models.KubernetesPolicyRuleData{APIGroups: groups, Resources: resources, Verbs: verbs}
+}
+
+// ── K8S_RBAC_WILDCARD_PERMISSION ─────────────────────────────────────────────
+
+func TestRBACWildcard_Fires_WildcardVerbsAndResources(t *testing.T) {
+	cluster := rbacCluster(models.KubernetesRoleData{
+		Kind: "ClusterRole", Name: "ops-superuser",
+		Rules: []models.KubernetesPolicyRuleData{
+			policyRule([]string{""}, []string{"pods"}, []string{"get"}),
+			policyRule([]string{"*"}, []string{"*"}, []string{"*"}),
+		},
+	})
+	findings := (K8SRBACWildcardPermissionRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for wildcard ClusterRole; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_RBAC_WILDCARD_PERMISSION" || f.Severity != models.SeverityHigh {
+		t.Errorf("RuleID/Severity = %s/%s; want K8S_RBAC_WILDCARD_PERMISSION/HIGH", f.RuleID, f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sClusterRole || f.ResourceID != "ops-superuser" {
+		t.Errorf("resource = %s/%s; want K8S_CLUSTERROLE/ops-superuser", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["role_name"] != "ops-superuser" || f.Confidence != models.ConfidenceHigh {
+		t.Errorf("metadata = %v, confidence = %q; want role_name ops-superuser, confidence high", f.Metadata, f.Confidence)
+	}
+	if _, ok := f.Metadata["namespace"]; ok {
+		t.Error("ClusterRole finding must not carry a namespace")
+	}
+	rule, _ := f.Metadata["rule"].(map[string]any)
+	if groups, _ := rule["api_groups"].([]string); len(groups) != 1 || groups[0] != "*" {
+		t.Errorf("metadata rule = %v; want the wildcard rule", f.Metadata["rule"])
+	}
+}
+
+func TestRBACWildcard_Fires_NamespacedRole(t *testing.T) {
+	cluster := rbacCluster(models.KubernetesRoleData{
+		Kind: "Role", Name: "team-admin", Namespace: "payments",
+		Rules: []models.KubernetesPolicyRuleData{
+			policyRule([]string{"*"}, []string{"*"}, []string{"*"}),
+		},
+	})
+	findings := (K8SRBACWildcardPermissionRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for wildcard Role; got %d", len(findings))
+	}
+	if findings[0].ResourceType != models.ResourceK8sRole {
+		t.Errorf("ResourceType = %s; want K8S_ROLE", findings[0].ResourceType)
+	}
+	if findings[0].Metadata["namespace"] != "payments" {
+		t.Errorf("metadata namespace = %v; want payments", findings[0].Metadata["namespace"])
+	}
+}
+
+func TestRBACWildcard_Silent_ScopedRules(t *testing.T) {
+	cluster := rbacCluster(
+		models.KubernetesRoleData{
+			Kind: "ClusterRole", Name: "pod-reader",
+			Rules: []models.KubernetesPolicyRuleData{
+				policyRule([]string{""}, []string{"pods", "pods/log"}, []string{"get", "list", "watch"}),
+			},
+		},
+		models.KubernetesRoleData{
+			Kind: "Role", Name: "deployer", Namespace: "prod",
+			Rules: []models.KubernetesPolicyRuleData{
+				policyRule([]string{"apps"}, []string{"deployments"}, []string{"*"}),
+				policyRule([]string{"*"}, []string{"*"}, []string{"get", "list"}),
+			},
+		},
+	)
+	if findings := (K8SRBACWildcardPermissionRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+		t.Errorf("expected no findings for scoped rules; got %d", len(findings))
+	}
+}
+
+func TestRBACWildcard_Fires_SingleAPIGroupWithLowerConfidence(t *testing.T) {
+	cluster := rbacCluster(models.KubernetesRoleData{
+		Kind: "ClusterRole", Name: "apps-admin",
+		Rules: []models.KubernetesPolicyRuleData{
+			policyRule([]string{"apps"}, []string{"*"}, []string{"*"}),
+		},
+	})
+	findings := (K8SRBACWildcardPermissionRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for wildcard on a single API group; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Severity != models.SeverityHigh {
+		t.Errorf("Severity = %s; want HIGH", f.Severity)
+	}
+	if f.Confidence != models.ConfidenceMedium {
+		t.Errorf("Confidence = %q; want medium", f.Confidence)
+	}
+	if _, ok := f.Metadata["confidence"]; ok {
+		t.Errorf("metadata carries confidence %v; want it only in Finding.Confidence", f.Metadata["confidence"])
+	}
+	if f.Metadata["note"] != `wildcard limited to API group(s) "apps"` {
+		t.Errorf("note = %v; want API group note", f.Metadata["note"])
+	}
+}
+
+func TestRBACWildcard_Silent_BuiltInRoles(t *testing.T) {
+	wildcard := []models.KubernetesPolicyRuleData{policyRule([]string{"*"}, []string{"*"}, []string{"*"})}
+	cluster := rbacCluster(
+		models.KubernetesRoleData{Kind: "ClusterRole", Name: "cluster-admin", Rules: wildcard},
+		models.KubernetesRoleData{Kind: "ClusterRole", Name: "system:controller:generic-garbage-collector", Rules: wildcard},
+	)
+	if findings := (K8SRBACWildcardPermissionRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+		t.Errorf("expected built-in roles to be skipped; got %d findings", len(findings))
+	}
+}
+
+func TestRBACWildcard_NilClusterData(t *testing.T) {
+	if findings := (K8SRBACWildcardPermissionRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("expected nil findings; got %v", findings)
+	}
+}
@@ -2,27 +2,57 @@ package rules
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
 )
 
-// ── K8S_CLUSTER_SINGLE_NODE ──────────────────────────────────────────────────
+// ── K8S_CLUSTER_INSUFFICIENT_NODES ───────────────────────────────────────────
 
-// K8SClusterSingleNodeRule fires when the cluster has exactly one node,
-// indicating no redundancy for workloads.
-type K8SClusterSingleNodeRule struct{}
+// defaultMinClusterNodes is the default minimum node count. Clusters with
+// fewer nodes fire, so by default only single-node clusters are flagged.
+const defaultMinClusterNodes = 2
 
-func (r K8SClusterSingleNodeRule) ID() string   { return "K8S_CLUSTER_SINGLE_NODE" }
-func (r K8SClusterSingleNodeRule) Name() string { return "Kubernetes Cluster Has Single Node" }
+// K8SClusterInsufficientNodesRule fires when the cluster has fewer than
+// MinNodes nodes, indicating too little redundancy for workloads. Clusters
+// reporting zero nodes are skipped. Formerly K8S_CLUSTER_SINGLE_NODE, which
+// is the behaviour at the default minimum of 2.
+type K8SClusterInsufficientNodesRule struct {
+	// MinNodes overrides the default minimum of 2. Zero or negative values
+	// fall back to defaultMinClusterNodes.
+	MinNodes int
+}
+
+func (r K8SClusterInsufficientNodesRule) ID() string { return "K8S_CLUSTER_INSUFFICIENT_NODES" }
+func (r K8SClusterInsufficientNodesRule) Name() string {
+	return "Kubernetes Cluster Has Insufficient Nodes"
+}
+
+// minNodes returns the effective minimum node count.
+func (r K8SClusterInsufficientNodesRule) minNodes() int {
+	if r.MinNodes <= 0 {
+		return defaultMinClusterNodes
+	}
+	return r.MinNodes
+}
 
-func (r K8SClusterSingleNodeRule) Evaluate(ctx RuleContext) []models.Finding {
+func (r K8SClusterInsufficientNodesRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.ClusterData == nil {
 		return nil
 	}
-	if ctx.ClusterData.NodeCount != 1 {
+	count, minimum := ctx.ClusterData.NodeCount, r.minNodes()
+	if count == 0 || count >= minimum {
 		return nil
 	}
+	explanation := fmt.Sprintf(
+		"Cluster has only %d nodes, fewer than the minimum of %d; a node failure leaves little capacity to reschedule workloads.",
+		count, minimum,
+	)
+	if count == 1 {
+		explanation = "Cluster has only 1 node; there is no redundancy for scheduled workloads."
+	}
 	return []models.Finding{
 		{
 			ID:             fmt.Sprintf("%s:%s", r.ID(), ctx.ClusterData.ContextName),
@@ -33,37 +63,54 @@ func (r K8SClusterSingleNodeRule) Evaluate(ctx RuleContext) []models.Finding {
 			AccountID:      ctx.AccountID,
 			Profile:        ctx.Profile,
 			Severity:       models.SeverityHigh,
-			Explanation:    "Cluster has only 1 node; there is no redundancy for scheduled workloads.",
-			Recommendation: "Add at least 2 more nodes to provide high availability for workloads.",
+			Explanation:    explanation,
+			Recommendation: fmt.Sprintf("Add nodes until the cluster has at least %d to provide high availability for workloads.", max(minimum, 3)),
 			DetectedAt:     time.Now().UTC(),
+			Metadata: map[string]any{
+				"node_count": count,
+				"min_nodes":  minimum,
+			},
 		},
 	}
 }
 
 // ── K8S_NODE_OVERALLOCATED ───────────────────────────────────────────────────
 
-// overallocatedCPUThresholdPercent is the minimum acceptable percentage of
-// allocatable CPU relative to total capacity. Nodes below this threshold fire.
+// overallocatedCPUThresholdPercent is the default minimum acceptable percentage
+// of allocatable CPU relative to total capacity. Nodes below this threshold fire.
 const overallocatedCPUThresholdPercent = 20.0
 
 // K8SNodeOverallocatedRule fires for each node where the allocatable CPU is
-// less than overallocatedCPUThresholdPercent of the node's total CPU capacity.
-type K8SNodeOverallocatedRule struct{}
+// strictly less than MinAllocatablePct of the node's total CPU capacity.
+type K8SNodeOverallocatedRule struct {
+	// MinAllocatablePct overrides the default 20% threshold. Zero or negative
+	// values fall back to overallocatedCPUThresholdPercent.
+	MinAllocatablePct float64
+}
 
 func (r K8SNodeOverallocatedRule) ID() string   { return "K8S_NODE_OVERALLOCATED" }
 func (r K8SNodeOverallocatedRule) Name() string { return "Kubernetes Node CPU Overallocated" }
 
+// threshold returns the effective allocatable-CPU percentage threshold.
+func (r K8SNodeOverallocatedRule) threshold() float64 {
+	if r.MinAllocatablePct <= 0 {
+		return overallocatedCPUThresholdPercent
+	}
+	return r.MinAllocatablePct
+}
+
 func (r K8SNodeOverallocatedRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.ClusterData == nil {
 		return nil
 	}
+	threshold := r.threshold()
 	var findings []models.Finding
 	for _, node := range ctx.ClusterData.Nodes {
 		if node.CPUCapacityMillis == 0 {
 			continue // skip nodes with no reported CPU capacity
 		}
 		freePercent := float64(node.AllocatableCPUMillis) / float64(node.CPUCapacityMillis) * 100.0
-		if freePercent < overallocatedCPUThresholdPercent {
+		if freePercent < threshold {
 			findings = append(findings, models.Finding{
 				ID:           fmt.Sprintf("%s:%s:%s", r.ID(), ctx.ClusterData.ContextName, node.Name),
 				RuleID:       r.ID(),
@@ -75,7 +122,7 @@ func (r K8SNodeOverallocatedRule) Evaluate(ctx RuleContext) []models.Finding {
 				Severity:     models.SeverityHigh,
 				Explanation: fmt.Sprintf(
 					"Node %q has only %.1f%% of CPU allocatable (threshold: %.0f%%).",
-					node.Name, freePercent, overallocatedCPUThresholdPercent,
+					node.Name, freePercent, threshold,
 				),
 				Recommendation: "Add more nodes or reduce pod resource requests on this node to restore scheduling headroom.",
 				DetectedAt:     time.Now().UTC(),
@@ -130,6 +177,66 @@ func (r K8SNamespaceWithoutLimitsRule) Evaluate(ctx RuleContext) []models.Findin
 	return findings
 }
 
+// ── K8S_NAMESPACE_NO_RESOURCEQUOTA ───────────────────────────────────────────
+
+// K8SNamespaceNoResourceQuotaRule fires for each non-system namespace that has
+// no ResourceQuota. It complements K8S_NAMESPACE_WITHOUT_LIMITS: a LimitRange
+// bounds each pod, while a ResourceQuota bounds the namespace as a whole, so
+// one team cannot exhaust the cluster by scaling out.
+//
+// System namespaces are skipped the same way as in K8S_NAMESPACE_NO_PSA:
+// SystemNamespaces, populated from dp.yaml system_namespaces, replaces the
+// default set when non-empty.
+type K8SNamespaceNoResourceQuotaRule struct {
+	SystemNamespaces []string
+}
+
+func (r K8SNamespaceNoResourceQuotaRule) ID() string {
+	return "K8S_NAMESPACE_NO_RESOURCEQUOTA"
+}
+func (r K8SNamespaceNoResourceQuotaRule) Name() string {
+	return "Kubernetes Namespace Without ResourceQuota"
+}
+
+func (r K8SNamespaceNoResourceQuotaRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	system := r.SystemNamespaces
+	if len(system) == 0 {
+		system = defaultSystemNamespaces
+	}
+	var findings []models.Finding
+	for _, ns := range ctx.ClusterData.Namespaces {
+		if ns.HasResourceQuota || slices.Contains(system, ns.Name) {
+			continue
+		}
+		findings = append(findings, models.Finding{
+			ID:           fmt.Sprintf("%s:%s:%s", r.ID(), ctx.ClusterData.ContextName, ns.Name),
+			RuleID:       r.ID(),
+			ResourceID:   ns.Name,
+			ResourceType: models.ResourceK8sNamespace,
+			Region:       ctx.ClusterData.ContextName,
+			AccountID:    ctx.AccountID,
+			Profile:      ctx.Profile,
+			Severity:     models.SeverityLow,
+			Explanation: fmt.Sprintf(
+				"Namespace %q has no ResourceQuota; its workloads can consume an unbounded share of cluster capacity.",
+				ns.Name,
+			),
+			Recommendation: fmt.Sprintf(
+				"Add a ResourceQuota to namespace %q capping requests.cpu, requests.memory and object counts.",
+				ns.Name,
+			),
+			DetectedAt: time.Now().UTC(),
+			Metadata: map[string]any{
+				"namespace": ns.Name,
+			},
+		})
+	}
+	return findings
+}
+
 // ── K8S_PRIVILEGED_CONTAINER ─────────────────────────────────────────────────
 
 // K8SPrivilegedContainerRule fires for each container running with
@@ -140,6 +247,9 @@ type K8SPrivilegedContainerRule struct{}
 func (r K8SPrivilegedContainerRule) ID() string   { return "K8S_PRIVILEGED_CONTAINER" }
 func (r K8SPrivilegedContainerRule) Name() string { return "Kubernetes Privileged Container Detected" }
 
+// Priority keeps this rule primary when merged with other pod findings.
+func (r K8SPrivilegedContainerRule) Priority() int { return privilegedContainerPriority }
+
 func (r K8SPrivilegedContainerRule) Evaluate(ctx RuleContext) []models.Finding {
 	if ctx.ClusterData == nil {
 		return nil
@@ -182,9 +292,24 @@ func (r K8SPrivilegedContainerRule) Evaluate(ctx RuleContext) []models.Finding {
 // as internal (reachable only within the VPC).
 const awsInternalLBAnnotation = "service.beta.kubernetes.io/aws-load-balancer-internal"
 
+// defaultInternalLBAnnotations maps each built-in annotation that marks a
+// LoadBalancer Service as internal to the value that enables it, for AWS,
+// GCP (legacy and current key), and Azure.
+var defaultInternalLBAnnotations = map[string]string{
+	awsInternalLBAnnotation:                                   "true",
+	"cloud.google.com/load-balancer-type":                     "Internal",
+	"networking.gke.io/load-balancer-type":                    "Internal",
+	"service.beta.kubernetes.io/azure-load-balancer-internal": "true",
+}
+
 // K8SServicePublicLoadBalancerRule fires for each Service of type LoadBalancer
-// that does NOT carry the AWS internal load-balancer annotation.
-type K8SServicePublicLoadBalancerRule struct{}
+// that does NOT carry an internal load-balancer annotation.
+type K8SServicePublicLoadBalancerRule struct {
+	// InternalAnnotations adds annotation → value pairs that mark a Service
+	// as internal, on top of defaultInternalLBAnnotations. Set from dp.yaml
+	// internal_lb_annotations.
+	InternalAnnotations map[string]string
+}
 
 func (r K8SServicePublicLoadBalancerRule) ID() string {
 	return "K8S_SERVICE_PUBLIC_LOADBALANCER"
@@ -202,7 +327,7 @@ func (r K8SServicePublicLoadBalancerRule) Evaluate(ctx RuleContext) []models.Fin
 		if svc.Type != "LoadBalancer" {
 			continue
 		}
-		if svc.Annotations[awsInternalLBAnnotation] == "true" {
+		if r.isInternal(svc.Annotations) {
 			continue
 		}
 		findings = append(findings, models.Finding{
@@ -219,8 +344,8 @@ func (r K8SServicePublicLoadBalancerRule) Evaluate(ctx RuleContext) []models.Fin
 				svc.Name, svc.Namespace,
 			),
 			Recommendation: fmt.Sprintf(
-				"Add annotation %q: \"true\" to restrict the load balancer to internal VPC traffic, "+
-					"or replace with an Ingress resource backed by an internal controller.",
+				"Add the cloud provider's internal load-balancer annotation (e.g. %q: \"true\" on AWS) "+
+					"to restrict it to internal VPC traffic, or replace with an Ingress resource backed by an internal controller.",
 				awsInternalLBAnnotation,
 			),
 			DetectedAt: time.Now().UTC(),
@@ -232,6 +357,20 @@ func (r K8SServicePublicLoadBalancerRule) Evaluate(ctx RuleContext) []models.Fin
 	return findings
 }
 
+// isInternal reports whether annotations carry a built-in or configured
+// internal load-balancer annotation with its enabling value. Values are
+// compared case-insensitively.
+func (r K8SServicePublicLoadBalancerRule) isInternal(annotations map[string]string) bool {
+	for _, set := range []map[string]string{defaultInternalLBAnnotations, r.InternalAnnotations} {
+		for key, want := range set {
+			if got, ok := annotations[key]; ok && strings.EqualFold(got, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ── K8S_POD_NO_RESOURCE_REQUESTS ─────────────────────────────────────────────
 
 // K8SPodNoResourceRequestsRule fires for each container that is missing a CPU
@@ -1,6 +1,7 @@
 package rules_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
@@ -12,31 +13,31 @@ func newK8sCtx(data *models.KubernetesClusterData) rules.RuleContext {
 	return rules.RuleContext{ClusterData: data}
 }
 
-// ── K8S_CLUSTER_SINGLE_NODE ──────────────────────────────────────────────────
+// ── K8S_CLUSTER_INSUFFICIENT_NODES ──────────────────────────────────────────────────
 
-func TestK8SClusterSingleNode_NoFinding_MultiNode(t *testing.T) {
+func TestK8SClusterInsufficientNodes_NoFinding_MultiNode(t *testing.T) {
 	ctx := newK8sCtx(&models.KubernetesClusterData{
 		ContextName: "prod",
 		NodeCount:   3,
 	})
-	findings := rules.K8SClusterSingleNodeRule{}.Evaluate(ctx)
+	findings := rules.K8SClusterInsufficientNodesRule{}.Evaluate(ctx)
 	if len(findings) != 0 {
 		t.Errorf("expected 0 findings for 3-node cluster; got %d", len(findings))
 	}
 }
 
-func TestK8SClusterSingleNode_Fires_OneNode(t *testing.T) {
+func TestK8SClusterInsufficientNodes_Fires_OneNode(t *testing.T) {
 	ctx := newK8sCtx(&models.KubernetesClusterData{
 		ContextName: "dev-cluster",
 		NodeCount:   1,
 	})
-	findings := rules.K8SClusterSingleNodeRule{}.Evaluate(ctx)
+	findings := rules.K8SClusterInsufficientNodesRule{}.Evaluate(ctx)
 	if len(findings) != 1 {
 		t.Fatalf("expected 1 finding; got %d", len(findings))
 	}
 	f := findings[0]
-	if f.RuleID != "K8S_CLUSTER_SINGLE_NODE" {
-		t.Errorf("RuleID = %q; want K8S_CLUSTER_SINGLE_NODE", f.RuleID)
+	if f.RuleID != "K8S_CLUSTER_INSUFFICIENT_NODES" {
+		t.Errorf("RuleID = %q; want K8S_CLUSTER_INSUFFICIENT_NODES", f.RuleID)
 	}
 	if f.Severity != models.SeverityHigh {
 		t.Errorf("Severity = %q; want HIGH", f.Severity)
@@ -49,25 +50,55 @@ func TestK8SClusterSingleNode_Fires_OneNode(t *testing.T) {
 	}
 }
 
-func TestK8SClusterSingleNode_NilClusterData(t *testing.T) {
-	findings := rules.K8SClusterSingleNodeRule{}.Evaluate(rules.RuleContext{})
+func TestK8SClusterInsufficientNodes_NilClusterData(t *testing.T) {
+	findings := rules.K8SClusterInsufficientNodesRule{}.Evaluate(rules.RuleContext{})
 	if len(findings) != 0 {
 		t.Errorf("expected 0 findings for nil ClusterData; got %d", len(findings))
 	}
 }
 
-func TestK8SClusterSingleNode_ZeroNodes(t *testing.T) {
-	// NodeCount == 0 should not fire (not a single node).
+func TestK8SClusterInsufficientNodes_ZeroNodes(t *testing.T) {
+	// NodeCount == 0 should not fire (no nodes reported, not an undersized cluster).
 	ctx := newK8sCtx(&models.KubernetesClusterData{
 		ContextName: "empty",
 		NodeCount:   0,
 	})
-	findings := rules.K8SClusterSingleNodeRule{}.Evaluate(ctx)
+	findings := rules.K8SClusterInsufficientNodesRule{}.Evaluate(ctx)
 	if len(findings) != 0 {
 		t.Errorf("expected 0 findings for 0-node cluster; got %d", len(findings))
 	}
 }
 
+func TestK8SClusterInsufficientNodes_DefaultMinimum_TwoNodesNoFinding(t *testing.T) {
+	ctx := newK8sCtx(&models.KubernetesClusterData{ContextName: "pair", NodeCount: 2})
+	if findings := (rules.K8SClusterInsufficientNodesRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("expected 0 findings for 2-node cluster at the default minimum; got %d", len(findings))
+	}
+}
+
+func TestK8SClusterInsufficientNodes_CustomMinimum(t *testing.T) {
+	rule := rules.K8SClusterInsufficientNodesRule{MinNodes: 3}
+
+	findings := rule.Evaluate(newK8sCtx(&models.KubernetesClusterData{ContextName: "pair", NodeCount: 2}))
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for 2-node cluster with MinNodes=3; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Metadata["node_count"] != 2 || f.Metadata["min_nodes"] != 3 {
+		t.Errorf("Metadata = %v; want node_count=2 min_nodes=3", f.Metadata)
+	}
+	if !strings.Contains(f.Explanation, "minimum of 3") {
+		t.Errorf("Explanation = %q; want it to mention the minimum of 3", f.Explanation)
+	}
+
+	if got := rule.Evaluate(newK8sCtx(&models.KubernetesClusterData{ContextName: "ha", NodeCount: 3})); len(got) != 0 {
+		t.Errorf("expected 0 findings for 3-node cluster with MinNodes=3; got %d", len(got))
+	}
+	if got := rule.Evaluate(newK8sCtx(&models.KubernetesClusterData{ContextName: "solo", NodeCount: 1})); len(got) != 1 {
+		t.Errorf("expected 1 finding for 1-node cluster with MinNodes=3; got %d", len(got))
+	}
+}
+
 // ── K8S_NODE_OVERALLOCATED ───────────────────────────────────────────────────
 
 func TestK8SNodeOverallocated_NoFinding_HealthyNode(t *testing.T) {
@@ -125,6 +156,40 @@ func TestK8SNodeOverallocated_ExactThreshold_NoFinding(t *testing.T) {
 	}
 }
 
+func TestK8SNodeOverallocated_CustomThreshold_Fires_Below(t *testing.T) {
+	// 25% allocatable → compliant at the default 20% but below a 30% threshold
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Nodes: []models.KubernetesNodeData{
+			{Name: "node-25", CPUCapacityMillis: 4000, AllocatableCPUMillis: 1000},
+		},
+	})
+	if findings := (rules.K8SNodeOverallocatedRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("default threshold: expected 0 findings; got %d", len(findings))
+	}
+	findings := rules.K8SNodeOverallocatedRule{MinAllocatablePct: 30}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("30%% threshold: expected 1 finding; got %d", len(findings))
+	}
+	if findings[0].ResourceID != "node-25" {
+		t.Errorf("ResourceID = %q; want node-25", findings[0].ResourceID)
+	}
+}
+
+func TestK8SNodeOverallocated_CustomThreshold_Exact_NoFinding(t *testing.T) {
+	// exactly 30% allocatable with a 30% threshold → must NOT fire (strictly less than)
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Nodes: []models.KubernetesNodeData{
+			{Name: "node-exact", CPUCapacityMillis: 4000, AllocatableCPUMillis: 1200},
+		},
+	})
+	findings := rules.K8SNodeOverallocatedRule{MinAllocatablePct: 30}.Evaluate(ctx)
+	if len(findings) != 0 {
+		t.Errorf("expected 0 findings at exactly 30%% threshold; got %d", len(findings))
+	}
+}
+
 func TestK8SNodeOverallocated_MultiNode_OnlyFiringNodes(t *testing.T) {
 	ctx := newK8sCtx(&models.KubernetesClusterData{
 		ContextName: "prod",
@@ -238,6 +303,67 @@ func TestK8SNamespaceWithoutLimits_NilClusterData(t *testing.T) {
 	}
 }
 
+// ── K8S_NAMESPACE_NO_RESOURCEQUOTA ───────────────────────────────────────────
+
+func TestK8SNamespaceNoResourceQuota_Fires_MissingQuota(t *testing.T) {
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Namespaces: []models.KubernetesNamespaceData{
+			{Name: "payments", HasResourceQuota: true},
+			{Name: "staging", HasResourceQuota: false},
+		},
+	})
+	findings := rules.K8SNamespaceNoResourceQuotaRule{}.Evaluate(ctx)
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_NAMESPACE_NO_RESOURCEQUOTA" || f.Severity != models.SeverityLow {
+		t.Errorf("RuleID/Severity = %s/%s; want K8S_NAMESPACE_NO_RESOURCEQUOTA/LOW", f.RuleID, f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sNamespace || f.ResourceID != "staging" {
+		t.Errorf("resource = %s/%s; want K8S_NAMESPACE/staging", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["namespace"] != "staging" {
+		t.Errorf("Metadata[namespace] = %v; want staging", f.Metadata["namespace"])
+	}
+}
+
+func TestK8SNamespaceNoResourceQuota_SkipsDefaultSystemNamespaces(t *testing.T) {
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Namespaces: []models.KubernetesNamespaceData{
+			{Name: "kube-system"},
+			{Name: "kube-public"},
+			{Name: "kube-node-lease"},
+		},
+	})
+	if findings := (rules.K8SNamespaceNoResourceQuotaRule{}).Evaluate(ctx); len(findings) != 0 {
+		t.Errorf("expected 0 findings for system namespaces; got %d", len(findings))
+	}
+}
+
+func TestK8SNamespaceNoResourceQuota_CustomSystemNamespaces(t *testing.T) {
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Namespaces: []models.KubernetesNamespaceData{
+			{Name: "kube-system"},
+			{Name: "monitoring"},
+		},
+	})
+	r := rules.K8SNamespaceNoResourceQuotaRule{SystemNamespaces: []string{"monitoring"}}
+	findings := r.Evaluate(ctx)
+	if len(findings) != 1 || findings[0].ResourceID != "kube-system" {
+		t.Fatalf("expected only kube-system to fire once the system set is overridden; got %+v", findings)
+	}
+}
+
+func TestK8SNamespaceNoResourceQuota_NilClusterData(t *testing.T) {
+	if findings := (rules.K8SNamespaceNoResourceQuotaRule{}).Evaluate(rules.RuleContext{}); len(findings) != 0 {
+		t.Errorf("expected 0 findings for nil ClusterData; got %d", len(findings))
+	}
+}
+
 // ── K8S_PRIVILEGED_CONTAINER ──────────────────────────────────────────────────
 
 func TestK8SPrivilegedContainer_NilClusterData(t *testing.T) {
@@ -447,6 +573,73 @@ func TestK8SServicePublicLoadBalancer_OnlyPublicLBsFire(t *testing.T) {
 	}
 }
 
+func TestK8SServicePublicLoadBalancer_CloudInternalAnnotations_NoFinding(t *testing.T) {
+	cases := map[string]map[string]string{
+		"aws":       {"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+		"gcp":       {"cloud.google.com/load-balancer-type": "Internal"},
+		"gcp-lower": {"cloud.google.com/load-balancer-type": "internal"},
+		"gke":       {"networking.gke.io/load-balancer-type": "Internal"},
+		"azure":     {"service.beta.kubernetes.io/azure-load-balancer-internal": "true"},
+	}
+	for name, annotations := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := newK8sCtx(&models.KubernetesClusterData{
+				ContextName: "prod",
+				Services: []models.KubernetesServiceData{
+					{Name: "internal-lb", Namespace: "default", Type: "LoadBalancer", Annotations: annotations},
+				},
+			})
+			if findings := (rules.K8SServicePublicLoadBalancerRule{}).Evaluate(ctx); len(findings) != 0 {
+				t.Errorf("expected 0 findings for %s internal LoadBalancer; got %d", name, len(findings))
+			}
+		})
+	}
+}
+
+func TestK8SServicePublicLoadBalancer_InternalAnnotationWrongValue_Fires(t *testing.T) {
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Services: []models.KubernetesServiceData{
+			{
+				Name:        "public-lb",
+				Namespace:   "default",
+				Type:        "LoadBalancer",
+				Annotations: map[string]string{"service.beta.kubernetes.io/azure-load-balancer-internal": "false"},
+			},
+		},
+	})
+	if findings := (rules.K8SServicePublicLoadBalancerRule{}).Evaluate(ctx); len(findings) != 1 {
+		t.Errorf("expected 1 finding when the internal annotation is false; got %d", len(findings))
+	}
+}
+
+func TestK8SServicePublicLoadBalancer_ConfiguredInternalAnnotation(t *testing.T) {
+	ctx := newK8sCtx(&models.KubernetesClusterData{
+		ContextName: "prod",
+		Services: []models.KubernetesServiceData{
+			{
+				Name:        "metallb-internal",
+				Namespace:   "default",
+				Type:        "LoadBalancer",
+				Annotations: map[string]string{"metallb.universe.tf/address-pool": "private"},
+			},
+			{Name: "public-lb", Namespace: "default", Type: "LoadBalancer"},
+		},
+	})
+	rule := rules.K8SServicePublicLoadBalancerRule{
+		InternalAnnotations: map[string]string{"metallb.universe.tf/address-pool": "private"},
+	}
+	findings := rule.Evaluate(ctx)
+	if len(findings) != 1 || findings[0].ResourceID != "public-lb" {
+		t.Fatalf("findings = %+v; want only public-lb", findings)
+	}
+
+	// Without the configured annotation both services fire.
+	if findings := (rules.K8SServicePublicLoadBalancerRule{}).Evaluate(ctx); len(findings) != 2 {
+		t.Errorf("expected 2 findings without configured annotations; got %d", len(findings))
+	}
+}
+
 func TestK8SServicePublicLoadBalancer_EmptyServices(t *testing.T) {
 	ctx := newK8sCtx(&models.KubernetesClusterData{ContextName: "prod"})
 	findings := rules.K8SServicePublicLoadBalancerRule{}.Evaluate(ctx)
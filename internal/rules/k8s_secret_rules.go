@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_SECRET_ENV ───────────────────────────────────────────────────────
+
+// K8SPodSecretEnvRule fires for each container (including init containers)
+// that reads a Secret into environment variables, through
+// env[].valueFrom.secretKeyRef or envFrom[].secretRef. Environment variables
+// leak through crash dumps, debug endpoints, error reporters and child
+// processes far more easily than a mounted secret volume.
+type K8SPodSecretEnvRule struct{}
+
+func (r K8SPodSecretEnvRule) ID() string { return "K8S_POD_SECRET_ENV" }
+func (r K8SPodSecretEnvRule) Name() string {
+	return "Kubernetes Secret Exposed As Environment Variable"
+}
+
+// Evaluate returns one LOW finding per container or init container with at
+// least one Secret environment reference. Metadata lists the referenced
+// Secrets (secret_names) and the variables set from individual keys
+// (env_vars).
+func (r K8SPodSecretEnvRule) Evaluate(ctx RuleContext) []models.Finding {
+	if ctx.ClusterData == nil {
+		return nil
+	}
+	var findings []models.Finding
+	for _, pod := range ctx.ClusterData.Pods {
+		for _, c := range pod.InitContainers {
+			if len(c.SecretEnvRefs) > 0 {
+				findings = append(findings, r.finding(ctx, pod, c, true))
+			}
+		}
+		for _, c := range pod.Containers {
+			if len(c.SecretEnvRefs) > 0 {
+				findings = append(findings, r.finding(ctx, pod, c, false))
+			}
+		}
+	}
+	return findings
+}
+
+func (r K8SPodSecretEnvRule) finding(
+	ctx RuleContext,
+	pod models.KubernetesPodData,
+	c models.KubernetesContainerData,
+	initContainer bool,
+) models.Finding {
+	kind := "Container"
+	if initContainer {
+		kind = "Init container"
+	}
+	seen := make(map[string]bool)
+	var secretNames, envVars []string
+	for _, ref := range c.SecretEnvRefs {
+		if !seen[ref.SecretName] {
+			seen[ref.SecretName] = true
+			secretNames = append(secretNames, ref.SecretName)
+		}
+		if ref.EnvVar != "" {
+			envVars = append(envVars, ref.EnvVar)
+		}
+	}
+	sort.Strings(secretNames)
+
+	return models.Finding{
+		ID:           fmt.Sprintf("%s:%s:%s/%s/%s", r.ID(), ctx.ClusterData.ContextName, pod.Namespace, pod.Name, c.Name),
+		RuleID:       r.ID(),
+		ResourceID:   pod.Name,
+		ResourceType: models.ResourceK8sPod,
+		Region:       ctx.ClusterData.ContextName,
+		AccountID:    ctx.AccountID,
+		Profile:      ctx.Profile,
+		Severity:     models.SeverityLow,
+		Explanation: fmt.Sprintf(
+			"%s %q in pod %q (namespace %q) exposes Secret(s) %s as environment variables.",
+			kind, c.Name, pod.Name, pod.Namespace, strings.Join(secretNames, ", "),
+		),
+		Recommendation: "Mount the Secret as a volume (optionally with items selecting only the keys " +
+			"needed) and have the application read it from the file instead of the environment.",
+		DetectedAt: time.Now().UTC(),
+		Metadata: map[string]any{
+			"namespace":      pod.Namespace,
+			"pod_name":       pod.Name,
+			"container_name": c.Name,
+			"init_container": initContainer,
+			"secret_names":   secretNames,
+			"env_vars":       envVars,
+		},
+	}
+}
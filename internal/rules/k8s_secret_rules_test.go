@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pankaj-dahiya-devops/Devops-proxy/internal/models"
+)
+
+// ── K8S_POD_SECRET_ENV ───────────────────────────────────────────────────────
+
+func TestPodSecretEnv_Fires_SecretKeyRef(t *testing.T) {
+	cluster := pssCluster(simplePod("api", "payments", models.KubernetesContainerData{
+		Name: "app",
+		SecretEnvRefs: []models.KubernetesSecretEnvRef{
+			{SecretName: "db-creds", EnvVar: "DB_PASSWORD", Key: "password"},
+			{SecretName: "db-creds", EnvVar: "DB_USER", Key: "username"},
+		},
+	}))
+	findings := (K8SPodSecretEnvRule{}).Evaluate(RuleContext{ClusterData: cluster})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.RuleID != "K8S_POD_SECRET_ENV" || f.Severity != models.SeverityLow {
+		t.Errorf("RuleID/Severity = %s/%s; want K8S_POD_SECRET_ENV/LOW", f.RuleID, f.Severity)
+	}
+	if f.ResourceType != models.ResourceK8sPod || f.ResourceID != "api" {
+		t.Errorf("resource = %s/%s; want K8S_POD/api", f.ResourceType, f.ResourceID)
+	}
+	if f.Metadata["namespace"] != "payments" || f.Metadata["container_name"] != "app" {
+		t.Errorf("metadata = %v; want namespace payments, container_name app", f.Metadata)
+	}
+	if names, _ := f.Metadata["secret_names"].([]string); !slices.Equal(names, []string{"db-creds"}) {
+		t.Errorf("secret_names = %v; want [db-creds]", f.Metadata["secret_names"])
+	}
+	if vars, _ := f.Metadata["env_vars"].([]string); !slices.Equal(vars, []string{"DB_PASSWORD", "DB_USER"}) {
+		t.Errorf("env_vars = %v; want [DB_PASSWORD DB_USER]", f.Metadata["env_vars"])
+	}
+}
+
+func TestPodSecretEnv_Fires_EnvFrom(t *testing.T) {
+	pod := models.KubernetesPodData{
+		Name:      "worker",
+		Namespace: "batch",
+		InitContainers: []models.KubernetesContainerData{{
+			Name:          "migrate",
+			SecretEnvRefs: []models.KubernetesSecretEnvRef{{SecretName: "api-keys"}},
+		}},
+		Containers: []models.KubernetesContainerData{{Name: "app"}},
+	}
+	findings := (K8SPodSecretEnvRule{}).Evaluate(RuleContext{ClusterData: pssCluster(pod)})
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding for the init container; got %d", len(findings))
+	}
+	f := findings[0]
+	if f.Metadata["container_name"] != "migrate" || f.Metadata["init_container"] != true {
+		t.Errorf("metadata = %v; want container_name migrate, init_container true", f.Metadata)
+	}
+	if names, _ := f.Metadata["secret_names"].([]string); !slices.Equal(names, []string{"api-keys"}) {
+		t.Errorf("secret_names = %v; want [api-keys]", f.Metadata["secret_names"])
+	}
+	if vars, _ := f.Metadata["env_vars"].([]string); len(vars) != 0 {
+		t.Errorf("env_vars = %v; want none for envFrom", vars)
+	}
+}
+
+func TestPodSecretEnv_Silent_NoSecretEnv(t *testing.T) {
+	cluster := pssCluster(simplePod("web", "prod", models.KubernetesContainerData{Name: "app"}))
+	if findings := (K8SPodSecretEnvRule{}).Evaluate(RuleContext{ClusterData: cluster}); len(findings) != 0 {
+		t.Errorf("expected no findings without secret env references; got %d", len(findings))
+	}
+}
+
+func TestPodSecretEnv_NilClusterData(t *testing.T) {
+	if findings := (K8SPodSecretEnvRule{}).Evaluate(RuleContext{}); findings != nil {
+		t.Errorf("expected nil with nil ClusterData; got %v", findings)
+	}
+}
@@ -29,6 +29,11 @@ type RuleContext struct {
 	// ClusterData holds Kubernetes cluster inventory for K8s rule evaluation.
 	// Nil when running AWS audits; K8s rules must check for nil before use.
 	ClusterData *models.KubernetesClusterData
+
+	// AzureData holds the Azure subscription inventory for Azure rule
+	// evaluation. Nil when running AWS or Kubernetes audits; Azure rules must
+	// check for nil before use.
+	AzureData *models.AzureSubscriptionData
 }
 
 // Rule is a single deterministic waste-detection rule.
@@ -46,6 +51,82 @@ type Rule interface {
 	Evaluate(ctx RuleContext) []models.Finding
 }
 
+// Control framework identifiers returned by FrameworkMapper implementations.
+const (
+	frameworkCIS14  = "CIS-1.4" // CIS AWS Foundations Benchmark v1.4
+	frameworkPCIDSS = "PCI-DSS"
+)
+
+// FrameworkMapper is an optional interface a Rule may implement to declare the
+// compliance frameworks whose controls it checks (e.g. "CIS-1.4", "PCI-DSS").
+// Rules that do not implement it are excluded from the compliance summary.
+type FrameworkMapper interface {
+	Frameworks() []string
+}
+
+// FrameworksOf returns the frameworks declared by r, or nil when r does not
+// implement FrameworkMapper.
+func FrameworksOf(r Rule) []string {
+	if m, ok := r.(FrameworkMapper); ok {
+		return m.Frameworks()
+	}
+	return nil
+}
+
+// DefaultPriority is the priority of rules that do not implement Prioritizer.
+const DefaultPriority = 0
+
+// privilegedContainerPriority ranks the privileged-container rules above the
+// narrower capability and host-namespace checks that usually fire alongside
+// them on the same pod.
+const privilegedContainerPriority = 100
+
+// Savings components shared by rules whose estimates price the same saving.
+const (
+	// savingsRDSInstanceSize is the saving from downsizing an RDS instance.
+	savingsRDSInstanceSize = "rds_instance_size"
+
+	// savingsNATGateway is the saving from deleting a NAT gateway.
+	savingsNATGateway = "nat_gateway"
+)
+
+// Prioritizer is an optional interface a Rule may implement to rank itself
+// against other rules that fire on the same resource. When findings are merged
+// per resource, the finding from the highest-priority rule becomes the primary
+// one (its RuleID, explanation and recommendation are kept). Rules that do not
+// implement it have DefaultPriority; ties keep evaluation order.
+type Prioritizer interface {
+	Priority() int
+}
+
+// PriorityOf returns the priority declared by r, or DefaultPriority when r
+// does not implement Prioritizer.
+func PriorityOf(r Rule) int {
+	if p, ok := r.(Prioritizer); ok {
+		return p.Priority()
+	}
+	return DefaultPriority
+}
+
+// SavingsOverlapper is an optional interface a Rule may implement to name the
+// cost its savings estimate reduces (e.g. an RDS instance's size). When
+// findings are merged per resource, only the highest-priority finding of each
+// component adds its EstimatedMonthlySavings, so two rules that price the same
+// saving differently are not summed. Rules that do not implement it never
+// overlap.
+type SavingsOverlapper interface {
+	SavingsComponent() string
+}
+
+// SavingsComponentOf returns the savings component declared by r, or "" when
+// r does not implement SavingsOverlapper.
+func SavingsComponentOf(r Rule) string {
+	if o, ok := r.(SavingsOverlapper); ok {
+		return o.SavingsComponent()
+	}
+	return ""
+}
+
 // RuleRegistry manages the set of active rules and drives evaluation.
 type RuleRegistry interface {
 	// Register adds a rule to the registry. Panics on duplicate ID.
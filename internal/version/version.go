@@ -21,3 +21,20 @@ func Info() string {
 		Date,
 	)
 }
+
+// BuildInfo is the machine-readable form of the version variables, emitted
+// by dp version --json.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+}
+
+// Current returns the BuildInfo for the running binary.
+func Current() BuildInfo {
+	return BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: Date,
+	}
+}